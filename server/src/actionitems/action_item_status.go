@@ -0,0 +1,34 @@
+package actionitems
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ActionItemStatus is the progress of an action item and can be one of open, in progress or done.
+type ActionItemStatus string
+
+const (
+	// Open is the initial state of every action item.
+	Open ActionItemStatus = "OPEN"
+
+	// InProgress marks an action item someone is currently working on.
+	InProgress ActionItemStatus = "IN_PROGRESS"
+
+	// Done marks an action item as completed.
+	Done ActionItemStatus = "DONE"
+)
+
+func (status *ActionItemStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	unmarshalledStatus := ActionItemStatus(s)
+	switch unmarshalledStatus {
+	case Open, InProgress, Done:
+		*status = unmarshalledStatus
+		return nil
+	}
+	return errors.New("invalid action item status")
+}
@@ -0,0 +1,36 @@
+package actionitems
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionItemStatusEnum(t *testing.T) {
+	values := []ActionItemStatus{Open, InProgress, Done}
+	for _, value := range values {
+		var status ActionItemStatus
+		err := status.UnmarshalJSON(fmt.Appendf(nil, "\"%s\"", value))
+		assert.Nil(t, err)
+		assert.Equal(t, value, status)
+	}
+}
+
+func TestUnmarshalActionItemStatusNil(t *testing.T) {
+	var status ActionItemStatus
+	err := status.UnmarshalJSON(nil)
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalActionItemStatusEmptyStringWithQuotation(t *testing.T) {
+	var status ActionItemStatus
+	err := status.UnmarshalJSON([]byte("\"\""))
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalActionItemStatusRandomValue(t *testing.T) {
+	var status ActionItemStatus
+	err := status.UnmarshalJSON([]byte("\"SOME_RANDOM_VALUE\""))
+	assert.NotNil(t, err)
+}
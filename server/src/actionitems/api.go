@@ -0,0 +1,16 @@
+package actionitems
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ActionItemService interface {
+	Create(ctx context.Context, body ActionItemCreateRequest) (*ActionItem, error)
+	Get(ctx context.Context, board, id uuid.UUID) (*ActionItem, error)
+	GetAll(ctx context.Context, board uuid.UUID) ([]*ActionItem, error)
+	Update(ctx context.Context, body ActionItemUpdateRequest) (*ActionItem, error)
+	Delete(ctx context.Context, board, id uuid.UUID) error
+	CarryOver(ctx context.Context, fromBoard, toBoard uuid.UUID) ([]*ActionItem, error)
+}
@@ -0,0 +1,90 @@
+package actionitems
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+)
+
+type DB struct {
+	db *bun.DB
+}
+
+func NewActionItemDatabase(database *bun.DB) ActionItemDatabase {
+	db := new(DB)
+	db.db = database
+
+	return db
+}
+
+// Create inserts a new action item
+func (d *DB) Create(ctx context.Context, insert DatabaseActionItemInsert) (DatabaseActionItem, error) {
+	var item DatabaseActionItem
+	_, err := d.db.NewInsert().
+		Model(&insert).
+		Returning("*").
+		Exec(common.ContextWithValues(ctx, "Database", d, identifiers.BoardIdentifier, insert.Board), &item)
+
+	return item, err
+}
+
+// Get gets a specific action item of a board
+func (d *DB) Get(ctx context.Context, board, id uuid.UUID) (DatabaseActionItem, error) {
+	var item DatabaseActionItem
+	err := d.db.NewSelect().
+		Model(&item).
+		Where("board = ?", board).
+		Where("id = ?", id).
+		Scan(ctx)
+
+	return item, err
+}
+
+// GetAll gets all action items of a board, the most recent ones last
+func (d *DB) GetAll(ctx context.Context, board uuid.UUID) ([]DatabaseActionItem, error) {
+	var items []DatabaseActionItem
+	err := d.db.NewSelect().
+		Model(&items).
+		Where("board = ?", board).
+		Order("created_at ASC").
+		Scan(ctx)
+
+	return items, err
+}
+
+// Update updates everything but the originating note of an action item
+func (d *DB) Update(ctx context.Context, update DatabaseActionItemUpdate) (DatabaseActionItem, error) {
+	var item DatabaseActionItem
+	_, err := d.db.NewUpdate().
+		Model(&update).
+		Column("text", "assignee", "status", "due_date").
+		Where("id = ?", update.ID).
+		Where("board = ?", update.Board).
+		Returning("*").
+		Exec(common.ContextWithValues(ctx, "Database", d, identifiers.BoardIdentifier, update.Board), &item)
+
+	return item, err
+}
+
+// Delete deletes an action item
+func (d *DB) Delete(ctx context.Context, board, id uuid.UUID) error {
+	_, err := d.db.NewDelete().
+		Model((*DatabaseActionItem)(nil)).
+		Where("board = ?", board).
+		Where("id = ?", id).
+		Exec(common.ContextWithValues(ctx, "Database", d, identifiers.BoardIdentifier, board))
+
+	return err
+}
+
+// NoteExists checks whether the note is part of the board
+func (d *DB) NoteExists(ctx context.Context, board, note uuid.UUID) (bool, error) {
+	return d.db.NewSelect().
+		Table("notes").
+		Where("board = ?", board).
+		Where("id = ?", note).
+		Exists(ctx)
+}
@@ -0,0 +1,40 @@
+package actionitems
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type DatabaseActionItem struct {
+	bun.BaseModel `bun:"table:action_items,alias:action_item"`
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	Board         uuid.UUID
+	Note          uuid.NullUUID
+	Assignee      uuid.NullUUID
+	Text          string
+	Status        ActionItemStatus
+	DueDate       *time.Time
+}
+
+type DatabaseActionItemInsert struct {
+	bun.BaseModel `bun:"table:action_items"`
+	Board         uuid.UUID
+	Note          uuid.NullUUID
+	Assignee      uuid.NullUUID
+	Text          string
+	Status        ActionItemStatus
+	DueDate       *time.Time
+}
+
+type DatabaseActionItemUpdate struct {
+	bun.BaseModel `bun:"table:action_items,alias:action_item"`
+	ID            uuid.UUID
+	Board         uuid.UUID
+	Assignee      uuid.NullUUID
+	Text          string
+	Status        ActionItemStatus
+	DueDate       *time.Time
+}
@@ -0,0 +1,209 @@
+package actionitems
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/initialize/testDbTemplates"
+)
+
+type DatabaseActionItemTestSuite struct {
+	suite.Suite
+	db          *bun.DB
+	users       map[string]TestUser
+	boards      map[string]uuid.UUID
+	notes       map[string]TestNote
+	actionItems map[string]DatabaseActionItem
+}
+
+func TestDatabaseActionItemTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseActionItemTestSuite))
+}
+
+func (suite *DatabaseActionItemTestSuite) SetupTest() {
+	suite.db = testDbTemplates.NewBaseTestDB(
+		suite.T(),
+		false,
+		testDbTemplates.AdditionalSeed{
+			Name: "action_items_database_test_data",
+			Func: suite.seedData,
+		},
+	)
+}
+
+func (suite *DatabaseActionItemTestSuite) Test_Database_Create() {
+	t := suite.T()
+	database := NewActionItemDatabase(suite.db)
+
+	insert := DatabaseActionItemInsert{
+		Board:    suite.boards["Write"],
+		Note:     uuid.NullUUID{UUID: suite.notes["Write"].id, Valid: true},
+		Assignee: uuid.NullUUID{UUID: suite.users["Stan"].id, Valid: true},
+		Text:     "Create action item",
+		Status:   Open,
+	}
+
+	dbItem, err := database.Create(context.Background(), insert)
+
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, dbItem.ID)
+	assert.Equal(t, insert.Board, dbItem.Board)
+	assert.Equal(t, insert.Note, dbItem.Note)
+	assert.Equal(t, insert.Assignee, dbItem.Assignee)
+	assert.Equal(t, insert.Text, dbItem.Text)
+	assert.Equal(t, Open, dbItem.Status)
+	assert.Nil(t, dbItem.DueDate)
+}
+
+func (suite *DatabaseActionItemTestSuite) Test_Database_Get() {
+	t := suite.T()
+	database := NewActionItemDatabase(suite.db)
+
+	expected := suite.actionItems["Read1"]
+	dbItem, err := database.Get(context.Background(), suite.boards["Read"], expected.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected.ID, dbItem.ID)
+	assert.Equal(t, expected.Text, dbItem.Text)
+	assert.Equal(t, expected.Status, dbItem.Status)
+}
+
+func (suite *DatabaseActionItemTestSuite) Test_Database_Get_OtherBoard() {
+	t := suite.T()
+	database := NewActionItemDatabase(suite.db)
+
+	_, err := database.Get(context.Background(), suite.boards["Write"], suite.actionItems["Read1"].ID)
+
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseActionItemTestSuite) Test_Database_GetAll() {
+	t := suite.T()
+	database := NewActionItemDatabase(suite.db)
+
+	dbItems, err := database.GetAll(context.Background(), suite.boards["Read"])
+
+	assert.Nil(t, err)
+	assert.Len(t, dbItems, 2)
+}
+
+func (suite *DatabaseActionItemTestSuite) Test_Database_Update() {
+	t := suite.T()
+	database := NewActionItemDatabase(suite.db)
+
+	item := suite.actionItems["Update"]
+	dbItem, err := database.Update(context.Background(), DatabaseActionItemUpdate{
+		ID:       item.ID,
+		Board:    suite.boards["Write"],
+		Assignee: uuid.NullUUID{UUID: suite.users["Santa"].id, Valid: true},
+		Text:     "Updated action item",
+		Status:   Done,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, item.ID, dbItem.ID)
+	assert.Equal(t, item.Note, dbItem.Note)
+	assert.Equal(t, suite.users["Santa"].id, dbItem.Assignee.UUID)
+	assert.Equal(t, "Updated action item", dbItem.Text)
+	assert.Equal(t, Done, dbItem.Status)
+}
+
+func (suite *DatabaseActionItemTestSuite) Test_Database_Delete() {
+	t := suite.T()
+	database := NewActionItemDatabase(suite.db)
+
+	item := suite.actionItems["Delete"]
+	err := database.Delete(context.Background(), suite.boards["Write"], item.ID)
+	assert.Nil(t, err)
+
+	_, err = database.Get(context.Background(), suite.boards["Write"], item.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseActionItemTestSuite) Test_Database_NoteExists() {
+	t := suite.T()
+	database := NewActionItemDatabase(suite.db)
+
+	exists, err := database.NoteExists(context.Background(), suite.boards["Write"], suite.notes["Write"].id)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	exists, err = database.NoteExists(context.Background(), suite.boards["Read"], suite.notes["Write"].id)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+type TestUser struct {
+	id          uuid.UUID
+	name        string
+	accountType common.AccountType
+}
+
+type TestNote struct {
+	id       uuid.UUID
+	authorId uuid.UUID
+	boardId  uuid.UUID
+	columnId uuid.UUID
+	text     string
+}
+
+func (suite *DatabaseActionItemTestSuite) seedData(db *bun.DB) {
+	suite.users = make(map[string]TestUser, 2)
+	suite.users["Stan"] = TestUser{id: uuid.New(), name: "Stan", accountType: common.Anonymous}
+	suite.users["Santa"] = TestUser{id: uuid.New(), name: "Santa", accountType: common.Anonymous}
+
+	suite.boards = make(map[string]uuid.UUID, 2)
+	suite.boards["Write"] = uuid.New()
+	suite.boards["Read"] = uuid.New()
+
+	columns := make(map[string]uuid.UUID, 2)
+	columns["Write"] = uuid.New()
+	columns["Read"] = uuid.New()
+
+	suite.notes = make(map[string]TestNote, 2)
+	suite.notes["Write"] = TestNote{id: uuid.New(), authorId: suite.users["Stan"].id, boardId: suite.boards["Write"], columnId: columns["Write"], text: "Write note"}
+	suite.notes["Read"] = TestNote{id: uuid.New(), authorId: suite.users["Stan"].id, boardId: suite.boards["Read"], columnId: columns["Read"], text: "Read note"}
+
+	suite.actionItems = make(map[string]DatabaseActionItem, 4)
+	suite.actionItems["Update"] = DatabaseActionItem{ID: uuid.New(), Board: suite.boards["Write"], Note: uuid.NullUUID{UUID: suite.notes["Write"].id, Valid: true}, Text: "Update action item", Status: Open}
+	suite.actionItems["Delete"] = DatabaseActionItem{ID: uuid.New(), Board: suite.boards["Write"], Text: "Delete action item", Status: Open}
+	suite.actionItems["Read1"] = DatabaseActionItem{ID: uuid.New(), Board: suite.boards["Read"], Note: uuid.NullUUID{UUID: suite.notes["Read"].id, Valid: true}, Text: "Read action item", Status: InProgress}
+	suite.actionItems["Read2"] = DatabaseActionItem{ID: uuid.New(), Board: suite.boards["Read"], Assignee: uuid.NullUUID{UUID: suite.users["Santa"].id, Valid: true}, Text: "Another read action item", Status: Done}
+
+	for _, user := range suite.users {
+		if err := testDbTemplates.InsertUser(db, user.id, user.name, string(user.accountType), nil); err != nil {
+			log.Fatalf("Failed to insert test user %s", err)
+		}
+	}
+
+	for name, board := range suite.boards {
+		if err := testDbTemplates.InsertBoard(db, board, name+" Board", "", nil, nil, "PUBLIC", true, true, true, true, false); err != nil {
+			log.Fatalf("Failed to insert test board %s", err)
+		}
+	}
+
+	for name, column := range columns {
+		if err := testDbTemplates.InsertColumn(db, column, suite.boards[name], name+" Column", "", "backlog-blue", true, 0); err != nil {
+			log.Fatalf("Failed to insert test column %s", err)
+		}
+	}
+
+	for _, note := range suite.notes {
+		if err := testDbTemplates.InsertNote(db, note.id, note.authorId, note.boardId, note.columnId, note.text, uuid.NullUUID{}, 0); err != nil {
+			log.Fatalf("Failed to insert test note %s", err)
+		}
+	}
+
+	for _, item := range suite.actionItems {
+		if err := testDbTemplates.InsertActionItem(db, item.ID, item.Board, item.Note, item.Assignee, item.Text, string(item.Status)); err != nil {
+			log.Fatalf("Failed to insert test action item %s", err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package actionitems
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActionItem is the response for all action item requests.
+type ActionItem struct {
+	// The action item id.
+	ID uuid.UUID `json:"id"`
+
+	// What needs to be done.
+	Text string `json:"text"`
+
+	// The note this action item originated from, if any.
+	Note uuid.NullUUID `json:"note"`
+
+	// The board participant responsible for this action item, if any.
+	Assignee uuid.NullUUID `json:"assignee"`
+
+	// The date this action item should be done by.
+	DueDate *time.Time `json:"dueDate,omitempty"`
+
+	// The progress of the action item.
+	Status ActionItemStatus `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ActionItemCreateRequest represents the request to create a new action item.
+type ActionItemCreateRequest struct {
+	Text     string        `json:"text"`
+	Note     uuid.NullUUID `json:"note"`
+	Assignee uuid.NullUUID `json:"assignee"`
+	DueDate  *time.Time    `json:"dueDate"`
+
+	Board uuid.UUID `json:"-"`
+}
+
+// ActionItemUpdateRequest represents the request to update an action item.
+// The link to the originating note cannot be changed once the action item is created.
+type ActionItemUpdateRequest struct {
+	Text     string           `json:"text"`
+	Assignee uuid.NullUUID    `json:"assignee"`
+	DueDate  *time.Time       `json:"dueDate"`
+	Status   ActionItemStatus `json:"status"`
+
+	ID    uuid.UUID `json:"-"`
+	Board uuid.UUID `json:"-"`
+}
+
+func (a *ActionItem) From(item DatabaseActionItem) *ActionItem {
+	a.ID = item.ID
+	a.Text = item.Text
+	a.Note = item.Note
+	a.Assignee = item.Assignee
+	a.DueDate = item.DueDate
+	a.Status = item.Status
+	a.CreatedAt = item.CreatedAt
+
+	return a
+}
+
+func ActionItems(items []DatabaseActionItem) []*ActionItem {
+	if items == nil {
+		return nil
+	}
+
+	list := make([]*ActionItem, len(items))
+	for index, item := range items {
+		list[index] = new(ActionItem).From(item)
+	}
+
+	return list
+}
@@ -0,0 +1,37 @@
+package actionitems
+
+import "fmt"
+
+type ActionItemErrorCategory string
+
+const (
+	BadRequest ActionItemErrorCategory = "BAD_REQUEST"
+	NotFound   ActionItemErrorCategory = "NOT_FOUND"
+	Internal   ActionItemErrorCategory = "INTERNAL"
+)
+
+type ActionItemError struct {
+	Category ActionItemErrorCategory
+	Message  string
+	Err      error
+}
+
+func (e ActionItemError) Error() string {
+	return fmt.Sprintf("action item error [%s]: %s", e.Category, e.Message)
+}
+
+func (e ActionItemError) Status() string {
+	return string(e.Category)
+}
+
+func (e ActionItemError) Unwrap() error {
+	return e.Err
+}
+
+func CreateActionItemError(category ActionItemErrorCategory, message string, err error) error {
+	return ActionItemError{
+		Category: category,
+		Message:  message,
+		Err:      err,
+	}
+}
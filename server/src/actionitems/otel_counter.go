@@ -0,0 +1,21 @@
+package actionitems
+
+import "go.opentelemetry.io/otel/metric"
+
+var actionItemCreatedCounter, _ = meter.Int64Counter(
+	"scrumlr.action_items.created.counter",
+	metric.WithDescription("Number of created action items"),
+	metric.WithUnit("actionitems"),
+)
+
+var actionItemUpdatedCounter, _ = meter.Int64Counter(
+	"scrumlr.action_items.updated.counter",
+	metric.WithDescription("Number of updated action items"),
+	metric.WithUnit("actionitems"),
+)
+
+var actionItemDeletedCounter, _ = meter.Int64Counter(
+	"scrumlr.action_items.deleted.counter",
+	metric.WithDescription("Number of deleted action items"),
+	metric.WithUnit("actionitems"),
+)
@@ -0,0 +1,377 @@
+package actionitems
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/logger"
+	"scrumlr.io/server/realtime"
+	"scrumlr.io/server/sessions"
+)
+
+var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/actionitems")
+var meter metric.Meter = otel.Meter("scrumlr.io/server/actionitems")
+
+type ActionItemDatabase interface {
+	Create(ctx context.Context, insert DatabaseActionItemInsert) (DatabaseActionItem, error)
+	Get(ctx context.Context, board, id uuid.UUID) (DatabaseActionItem, error)
+	GetAll(ctx context.Context, board uuid.UUID) ([]DatabaseActionItem, error)
+	Update(ctx context.Context, update DatabaseActionItemUpdate) (DatabaseActionItem, error)
+	Delete(ctx context.Context, board, id uuid.UUID) error
+	NoteExists(ctx context.Context, board, note uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	database                 ActionItemDatabase
+	realtime                 *realtime.Broker
+	sessionService           sessions.SessionService
+	boardLastModifiedUpdater common.BoardLastModifiedUpdater
+}
+
+func NewActionItemService(db ActionItemDatabase, rt *realtime.Broker, sessionService sessions.SessionService, boardLastModifiedUpdater common.BoardLastModifiedUpdater) ActionItemService {
+	service := new(Service)
+	service.database = db
+	service.realtime = rt
+	service.sessionService = sessionService
+	service.boardLastModifiedUpdater = boardLastModifiedUpdater
+
+	return service
+}
+
+const errUnableToUpdateLastModified = "unable to update last modified"
+
+func (service *Service) Create(ctx context.Context, body ActionItemCreateRequest) (*ActionItem, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.action_items.service.create")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.action_items.service.create.board", body.Board.String()),
+	)
+
+	text := strings.TrimSpace(body.Text)
+	if text == "" {
+		err := CreateActionItemError(BadRequest, "text must not be empty", errors.New("text must not be empty"))
+		span.SetStatus(codes.Error, "empty text")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if body.Note.Valid {
+		if err := service.checkNote(ctx, body.Board, body.Note.UUID); err != nil {
+			span.SetStatus(codes.Error, "invalid note")
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	if body.Assignee.Valid {
+		if err := service.checkAssignee(ctx, body.Board, body.Assignee.UUID); err != nil {
+			span.SetStatus(codes.Error, "invalid assignee")
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	item, err := service.database.Create(ctx, DatabaseActionItemInsert{
+		Board:    body.Board,
+		Note:     body.Note,
+		Assignee: body.Assignee,
+		Text:     text,
+		Status:   Open,
+		DueDate:  body.DueDate,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create action item")
+		span.RecordError(err)
+		log.Errorw("unable to create action item", "board", body.Board, "err", err)
+		return nil, CreateActionItemError(Internal, "failed to create action item", err)
+	}
+
+	if err := service.boardLastModifiedUpdater.UpdateLastModified(ctx, body.Board, time.Now()); err != nil {
+		log.Warnw(errUnableToUpdateLastModified, "board", body.Board, "err", err)
+	}
+
+	service.broadcastActionItem(ctx, body.Board, realtime.BoardEventActionItemCreated, item)
+	actionItemCreatedCounter.Add(ctx, 1)
+	return new(ActionItem).From(item), nil
+}
+
+func (service *Service) Get(ctx context.Context, board, id uuid.UUID) (*ActionItem, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.action_items.service.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.action_items.service.get.board", board.String()),
+		attribute.String("scrumlr.action_items.service.get.action_item", id.String()),
+	)
+
+	item, err := service.database.Get(ctx, board, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "action item not found")
+			span.RecordError(err)
+			return nil, CreateActionItemError(NotFound, "action item not found", err)
+		}
+		span.SetStatus(codes.Error, "failed to get action item")
+		span.RecordError(err)
+		log.Errorw("unable to get action item", "board", board, "actionItem", id, "err", err)
+		return nil, CreateActionItemError(Internal, "failed to get action item", err)
+	}
+
+	return new(ActionItem).From(item), nil
+}
+
+func (service *Service) GetAll(ctx context.Context, board uuid.UUID) ([]*ActionItem, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.action_items.service.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.action_items.service.get.all.board", board.String()),
+	)
+
+	items, err := service.database.GetAll(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get action items")
+		span.RecordError(err)
+		log.Errorw("unable to get action items", "board", board, "err", err)
+		return nil, CreateActionItemError(Internal, "failed to get action items", err)
+	}
+
+	return ActionItems(items), nil
+}
+
+func (service *Service) Update(ctx context.Context, body ActionItemUpdateRequest) (*ActionItem, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.action_items.service.update")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.action_items.service.update.board", body.Board.String()),
+		attribute.String("scrumlr.action_items.service.update.action_item", body.ID.String()),
+		attribute.String("scrumlr.action_items.service.update.status", string(body.Status)),
+	)
+
+	text := strings.TrimSpace(body.Text)
+	if text == "" {
+		err := CreateActionItemError(BadRequest, "text must not be empty", errors.New("text must not be empty"))
+		span.SetStatus(codes.Error, "empty text")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if body.Status == "" {
+		err := CreateActionItemError(BadRequest, "status must be set", errors.New("status must be set"))
+		span.SetStatus(codes.Error, "missing status")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if _, err := service.Get(ctx, body.Board, body.ID); err != nil {
+		span.SetStatus(codes.Error, "failed to get action item")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if body.Assignee.Valid {
+		if err := service.checkAssignee(ctx, body.Board, body.Assignee.UUID); err != nil {
+			span.SetStatus(codes.Error, "invalid assignee")
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	item, err := service.database.Update(ctx, DatabaseActionItemUpdate{
+		ID:       body.ID,
+		Board:    body.Board,
+		Assignee: body.Assignee,
+		Text:     text,
+		Status:   body.Status,
+		DueDate:  body.DueDate,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to update action item")
+		span.RecordError(err)
+		log.Errorw("unable to update action item", "board", body.Board, "actionItem", body.ID, "err", err)
+		return nil, CreateActionItemError(Internal, "failed to update action item", err)
+	}
+
+	if err := service.boardLastModifiedUpdater.UpdateLastModified(ctx, body.Board, time.Now()); err != nil {
+		log.Warnw(errUnableToUpdateLastModified, "board", body.Board, "err", err)
+	}
+
+	service.broadcastActionItem(ctx, body.Board, realtime.BoardEventActionItemUpdated, item)
+	actionItemUpdatedCounter.Add(ctx, 1)
+	return new(ActionItem).From(item), nil
+}
+
+func (service *Service) Delete(ctx context.Context, board, id uuid.UUID) error {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.action_items.service.delete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.action_items.service.delete.board", board.String()),
+		attribute.String("scrumlr.action_items.service.delete.action_item", id.String()),
+	)
+
+	if _, err := service.Get(ctx, board, id); err != nil {
+		span.SetStatus(codes.Error, "failed to get action item")
+		span.RecordError(err)
+		return err
+	}
+
+	if err := service.database.Delete(ctx, board, id); err != nil {
+		span.SetStatus(codes.Error, "failed to delete action item")
+		span.RecordError(err)
+		log.Errorw("unable to delete action item", "board", board, "actionItem", id, "err", err)
+		return CreateActionItemError(Internal, "failed to delete action item", err)
+	}
+
+	if err := service.boardLastModifiedUpdater.UpdateLastModified(ctx, board, time.Now()); err != nil {
+		log.Warnw(errUnableToUpdateLastModified, "board", board, "err", err)
+	}
+
+	service.broadcastDeletedActionItem(ctx, board, id)
+	actionItemDeletedCounter.Add(ctx, 1)
+	return nil
+}
+
+// CarryOver copies all action items of a board that are not done yet to another board.
+// The link to the originating note is dropped, since the note belongs to the previous board. The assignee
+// is only kept if they already participate in the other board.
+func (service *Service) CarryOver(ctx context.Context, fromBoard, toBoard uuid.UUID) ([]*ActionItem, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.action_items.service.carry_over")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.action_items.service.carry_over.from", fromBoard.String()),
+		attribute.String("scrumlr.action_items.service.carry_over.to", toBoard.String()),
+	)
+
+	items, err := service.database.GetAll(ctx, fromBoard)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get action items")
+		span.RecordError(err)
+		log.Errorw("unable to get action items to carry over", "board", fromBoard, "err", err)
+		return nil, CreateActionItemError(Internal, "failed to get action items", err)
+	}
+
+	carried := make([]*ActionItem, 0, len(items))
+	for _, item := range items {
+		if item.Status == Done {
+			continue
+		}
+
+		assignee := item.Assignee
+		if assignee.Valid {
+			participates, err := service.sessionService.Exists(ctx, toBoard, assignee.UUID)
+			if err != nil {
+				span.SetStatus(codes.Error, "failed to check assignee")
+				span.RecordError(err)
+				log.Errorw("unable to check assignee of carried over action item", "board", toBoard, "assignee", assignee.UUID, "err", err)
+				return nil, CreateActionItemError(Internal, "failed to check assignee", err)
+			}
+			if !participates {
+				assignee = uuid.NullUUID{}
+			}
+		}
+
+		copied, err := service.database.Create(ctx, DatabaseActionItemInsert{
+			Board:    toBoard,
+			Assignee: assignee,
+			Text:     item.Text,
+			Status:   item.Status,
+			DueDate:  item.DueDate,
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to carry over action item")
+			span.RecordError(err)
+			log.Errorw("unable to carry over action item", "from", fromBoard, "to", toBoard, "actionItem", item.ID, "err", err)
+			return nil, CreateActionItemError(Internal, "failed to carry over action item", err)
+		}
+
+		service.broadcastActionItem(ctx, toBoard, realtime.BoardEventActionItemCreated, copied)
+		actionItemCreatedCounter.Add(ctx, 1)
+		carried = append(carried, new(ActionItem).From(copied))
+	}
+
+	return carried, nil
+}
+
+func (service *Service) checkNote(ctx context.Context, board, note uuid.UUID) error {
+	exists, err := service.database.NoteExists(ctx, board, note)
+	if err != nil {
+		logger.FromContext(ctx).Errorw("unable to check note of action item", "board", board, "note", note, "err", err)
+		return CreateActionItemError(Internal, "failed to check note", err)
+	}
+	if !exists {
+		return CreateActionItemError(BadRequest, "note is not part of this board", errors.New("note is not part of this board"))
+	}
+	return nil
+}
+
+func (service *Service) checkAssignee(ctx context.Context, board, assignee uuid.UUID) error {
+	exists, err := service.sessionService.Exists(ctx, board, assignee)
+	if err != nil {
+		logger.FromContext(ctx).Errorw("unable to check assignee of action item", "board", board, "assignee", assignee, "err", err)
+		return CreateActionItemError(Internal, "failed to check assignee", err)
+	}
+	if !exists {
+		return CreateActionItemError(BadRequest, "assignee is not a participant of this board", errors.New("assignee is not a participant of this board"))
+	}
+	return nil
+}
+
+func (service *Service) broadcastActionItem(ctx context.Context, board uuid.UUID, eventType realtime.BoardEventType, item DatabaseActionItem) {
+	ctx, span := tracer.Start(ctx, "scrumlr.action_items.service.broadcast")
+	defer span.End()
+
+	err := service.realtime.BroadcastToBoard(
+		ctx,
+		board,
+		realtime.BoardEvent{
+			Type: eventType,
+			Data: new(ActionItem).From(item),
+		},
+	)
+
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to send action item message")
+		span.RecordError(err)
+		logger.FromContext(ctx).Errorw("unable to broadcast action item", "board", board, "type", eventType, "err", err)
+	}
+}
+
+func (service *Service) broadcastDeletedActionItem(ctx context.Context, board, id uuid.UUID) {
+	ctx, span := tracer.Start(ctx, "scrumlr.action_items.service.delete.broadcast")
+	defer span.End()
+
+	err := service.realtime.BroadcastToBoard(
+		ctx,
+		board,
+		realtime.BoardEvent{
+			Type: realtime.BoardEventActionItemDeleted,
+			Data: id,
+		},
+	)
+
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to send delete action item message")
+		span.RecordError(err)
+		logger.FromContext(ctx).Errorw("unable to broadcast deleted action item", "board", board, "actionItem", id, "err", err)
+	}
+}
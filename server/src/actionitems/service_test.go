@@ -0,0 +1,405 @@
+package actionitems
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/realtime"
+	"scrumlr.io/server/sessions"
+)
+
+func TestCreateActionItem(t *testing.T) {
+	boardId := uuid.New()
+	noteId := uuid.New()
+	assigneeId := uuid.New()
+	dueDate := time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	mockSessions := sessions.NewMockSessionService(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().NoteExists(mock.Anything, boardId, noteId).Return(true, nil)
+	mockSessions.EXPECT().Exists(mock.Anything, boardId, assigneeId).Return(true, nil)
+	mockDatabase.EXPECT().Create(mock.Anything, DatabaseActionItemInsert{
+		Board:    boardId,
+		Note:     uuid.NullUUID{UUID: noteId, Valid: true},
+		Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true},
+		Text:     "Fix the build pipeline",
+		Status:   Open,
+		DueDate:  &dueDate,
+	}).Return(DatabaseActionItem{
+		ID:       uuid.New(),
+		Board:    boardId,
+		Note:     uuid.NullUUID{UUID: noteId, Valid: true},
+		Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true},
+		Text:     "Fix the build pipeline",
+		Status:   Open,
+		DueDate:  &dueDate,
+	}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		return event.Type == realtime.BoardEventActionItemCreated
+	})).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewActionItemService(mockDatabase, broker, mockSessions, mockUpdater)
+
+	item, err := service.Create(context.Background(), ActionItemCreateRequest{
+		Board:    boardId,
+		Note:     uuid.NullUUID{UUID: noteId, Valid: true},
+		Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true},
+		Text:     "  Fix the build pipeline ",
+		DueDate:  &dueDate,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Fix the build pipeline", item.Text)
+	assert.Equal(t, Open, item.Status)
+	assert.Equal(t, assigneeId, item.Assignee.UUID)
+	assert.Equal(t, noteId, item.Note.UUID)
+	assert.Equal(t, &dueDate, item.DueDate)
+}
+
+func TestCreateActionItem_EmptyText(t *testing.T) {
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	service := NewActionItemService(NewMockActionItemDatabase(t), broker, sessions.NewMockSessionService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	item, err := service.Create(context.Background(), ActionItemCreateRequest{Board: uuid.New(), Text: "   "})
+
+	assert.Nil(t, item)
+	var actionItemErr ActionItemError
+	assert.ErrorAs(t, err, &actionItemErr)
+	assert.Equal(t, BadRequest, actionItemErr.Category)
+}
+
+func TestCreateActionItem_NoteOfOtherBoard(t *testing.T) {
+	boardId := uuid.New()
+	noteId := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().NoteExists(mock.Anything, boardId, noteId).Return(false, nil)
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	item, err := service.Create(context.Background(), ActionItemCreateRequest{
+		Board: boardId,
+		Note:  uuid.NullUUID{UUID: noteId, Valid: true},
+		Text:  "Something",
+	})
+
+	assert.Nil(t, item)
+	var actionItemErr ActionItemError
+	assert.ErrorAs(t, err, &actionItemErr)
+	assert.Equal(t, BadRequest, actionItemErr.Category)
+}
+
+func TestCreateActionItem_AssigneeNotParticipant(t *testing.T) {
+	boardId := uuid.New()
+	assigneeId := uuid.New()
+
+	mockSessions := sessions.NewMockSessionService(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockSessions.EXPECT().Exists(mock.Anything, boardId, assigneeId).Return(false, nil)
+
+	service := NewActionItemService(NewMockActionItemDatabase(t), broker, mockSessions, common.NewMockBoardLastModifiedUpdater(t))
+
+	item, err := service.Create(context.Background(), ActionItemCreateRequest{
+		Board:    boardId,
+		Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true},
+		Text:     "Something",
+	})
+
+	assert.Nil(t, item)
+	var actionItemErr ActionItemError
+	assert.ErrorAs(t, err, &actionItemErr)
+	assert.Equal(t, BadRequest, actionItemErr.Category)
+}
+
+func TestCreateActionItem_DatabaseError(t *testing.T) {
+	boardId := uuid.New()
+	dbErr := errors.New("database error")
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Create(mock.Anything, mock.AnythingOfType("DatabaseActionItemInsert")).Return(DatabaseActionItem{}, dbErr)
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	item, err := service.Create(context.Background(), ActionItemCreateRequest{Board: boardId, Text: "Something"})
+
+	assert.Nil(t, item)
+	assert.ErrorIs(t, err, dbErr)
+	var actionItemErr ActionItemError
+	assert.ErrorAs(t, err, &actionItemErr)
+	assert.Equal(t, Internal, actionItemErr.Category)
+}
+
+func TestGetActionItem_NotFound(t *testing.T) {
+	boardId := uuid.New()
+	id := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, id).Return(DatabaseActionItem{}, sql.ErrNoRows)
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	item, err := service.Get(context.Background(), boardId, id)
+
+	assert.Nil(t, item)
+	var actionItemErr ActionItemError
+	assert.ErrorAs(t, err, &actionItemErr)
+	assert.Equal(t, NotFound, actionItemErr.Category)
+}
+
+func TestGetAllActionItems(t *testing.T) {
+	boardId := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().GetAll(mock.Anything, boardId).Return([]DatabaseActionItem{
+		{ID: uuid.New(), Board: boardId, Text: "First", Status: Open},
+		{ID: uuid.New(), Board: boardId, Text: "Second", Status: Done},
+	}, nil)
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	items, err := service.GetAll(context.Background(), boardId)
+
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, Done, items[1].Status)
+}
+
+func TestUpdateActionItem(t *testing.T) {
+	boardId := uuid.New()
+	id := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, id).Return(DatabaseActionItem{ID: id, Board: boardId, Text: "Something", Status: Open}, nil)
+	mockDatabase.EXPECT().Update(mock.Anything, DatabaseActionItemUpdate{
+		ID:     id,
+		Board:  boardId,
+		Text:   "Something else",
+		Status: InProgress,
+	}).Return(DatabaseActionItem{ID: id, Board: boardId, Text: "Something else", Status: InProgress}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		return event.Type == realtime.BoardEventActionItemUpdated
+	})).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), mockUpdater)
+
+	item, err := service.Update(context.Background(), ActionItemUpdateRequest{
+		ID:     id,
+		Board:  boardId,
+		Text:   "Something else",
+		Status: InProgress,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, InProgress, item.Status)
+	assert.Equal(t, "Something else", item.Text)
+}
+
+func TestUpdateActionItem_MissingStatus(t *testing.T) {
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	service := NewActionItemService(NewMockActionItemDatabase(t), broker, sessions.NewMockSessionService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	item, err := service.Update(context.Background(), ActionItemUpdateRequest{ID: uuid.New(), Board: uuid.New(), Text: "Something"})
+
+	assert.Nil(t, item)
+	var actionItemErr ActionItemError
+	assert.ErrorAs(t, err, &actionItemErr)
+	assert.Equal(t, BadRequest, actionItemErr.Category)
+}
+
+func TestUpdateActionItem_NotFound(t *testing.T) {
+	boardId := uuid.New()
+	id := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, id).Return(DatabaseActionItem{}, sql.ErrNoRows)
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	item, err := service.Update(context.Background(), ActionItemUpdateRequest{ID: id, Board: boardId, Text: "Something", Status: Done})
+
+	assert.Nil(t, item)
+	var actionItemErr ActionItemError
+	assert.ErrorAs(t, err, &actionItemErr)
+	assert.Equal(t, NotFound, actionItemErr.Category)
+}
+
+func TestDeleteActionItem(t *testing.T) {
+	boardId := uuid.New()
+	id := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, id).Return(DatabaseActionItem{ID: id, Board: boardId}, nil)
+	mockDatabase.EXPECT().Delete(mock.Anything, boardId, id).Return(nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), realtime.BoardEvent{
+		Type: realtime.BoardEventActionItemDeleted,
+		Data: id,
+	}).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), mockUpdater)
+
+	err := service.Delete(context.Background(), boardId, id)
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteActionItem_UpdateLastModifiedFails(t *testing.T) {
+	boardId := uuid.New()
+	id := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, id).Return(DatabaseActionItem{ID: id, Board: boardId}, nil)
+	mockDatabase.EXPECT().Delete(mock.Anything, boardId, id).Return(nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.Anything).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(errors.New("cannot update board"))
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), mockUpdater)
+
+	err := service.Delete(context.Background(), boardId, id)
+
+	// the action item is deleted even if the board cannot be marked as modified
+	assert.Nil(t, err)
+}
+
+func TestDeleteActionItem_DatabaseError(t *testing.T) {
+	boardId := uuid.New()
+	id := uuid.New()
+	dbErr := errors.New("database error")
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, id).Return(DatabaseActionItem{ID: id, Board: boardId}, nil)
+	mockDatabase.EXPECT().Delete(mock.Anything, boardId, id).Return(dbErr)
+
+	service := NewActionItemService(mockDatabase, broker, sessions.NewMockSessionService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	err := service.Delete(context.Background(), boardId, id)
+
+	assert.ErrorIs(t, err, dbErr)
+}
+
+func TestCarryOverActionItems(t *testing.T) {
+	previousBoardId := uuid.New()
+	boardId := uuid.New()
+	assigneeId := uuid.New()
+	noteId := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	mockSessions := sessions.NewMockSessionService(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	mockDatabase.EXPECT().GetAll(mock.Anything, previousBoardId).Return([]DatabaseActionItem{
+		{ID: uuid.New(), Board: previousBoardId, Text: "Open item", Status: Open, Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true}, Note: uuid.NullUUID{UUID: noteId, Valid: true}},
+		{ID: uuid.New(), Board: previousBoardId, Text: "Done item", Status: Done},
+		{ID: uuid.New(), Board: previousBoardId, Text: "Started item", Status: InProgress},
+	}, nil)
+	mockSessions.EXPECT().Exists(mock.Anything, boardId, assigneeId).Return(true, nil)
+	mockDatabase.EXPECT().Create(mock.Anything, DatabaseActionItemInsert{
+		Board:    boardId,
+		Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true},
+		Text:     "Open item",
+		Status:   Open,
+	}).Return(DatabaseActionItem{ID: uuid.New(), Board: boardId, Text: "Open item", Status: Open, Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true}}, nil)
+	mockDatabase.EXPECT().Create(mock.Anything, DatabaseActionItemInsert{
+		Board:  boardId,
+		Text:   "Started item",
+		Status: InProgress,
+	}).Return(DatabaseActionItem{ID: uuid.New(), Board: boardId, Text: "Started item", Status: InProgress}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		return event.Type == realtime.BoardEventActionItemCreated
+	})).Return(nil).Times(2)
+
+	service := NewActionItemService(mockDatabase, broker, mockSessions, common.NewMockBoardLastModifiedUpdater(t))
+
+	items, err := service.CarryOver(context.Background(), previousBoardId, boardId)
+
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, "Open item", items[0].Text)
+	assert.Equal(t, InProgress, items[1].Status)
+}
+
+func TestCarryOverActionItems_AssigneeNotParticipating(t *testing.T) {
+	previousBoardId := uuid.New()
+	boardId := uuid.New()
+	assigneeId := uuid.New()
+
+	mockDatabase := NewMockActionItemDatabase(t)
+	mockSessions := sessions.NewMockSessionService(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	mockDatabase.EXPECT().GetAll(mock.Anything, previousBoardId).Return([]DatabaseActionItem{
+		{ID: uuid.New(), Board: previousBoardId, Text: "Open item", Status: Open, Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true}},
+	}, nil)
+	mockSessions.EXPECT().Exists(mock.Anything, boardId, assigneeId).Return(false, nil)
+	mockDatabase.EXPECT().Create(mock.Anything, DatabaseActionItemInsert{
+		Board:  boardId,
+		Text:   "Open item",
+		Status: Open,
+	}).Return(DatabaseActionItem{ID: uuid.New(), Board: boardId, Text: "Open item", Status: Open}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.Anything).Return(nil)
+
+	service := NewActionItemService(mockDatabase, broker, mockSessions, common.NewMockBoardLastModifiedUpdater(t))
+
+	items, err := service.CarryOver(context.Background(), previousBoardId, boardId)
+
+	assert.Nil(t, err)
+	assert.Len(t, items, 1)
+	assert.False(t, items[0].Assignee.Valid)
+}
@@ -0,0 +1,359 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+)
+
+type AdminService interface {
+	IsAdmin(ctx context.Context, user uuid.UUID) (bool, error)
+	GetBoards(ctx context.Context, query string, page common.Page) ([]*Board, error)
+	DeleteBoard(ctx context.Context, actor, board uuid.UUID) error
+	GetUsers(ctx context.Context, query string, page common.Page) ([]*User, error)
+	BanUser(ctx context.Context, actor, user uuid.UUID) (*User, error)
+	UnbanUser(ctx context.Context, actor, user uuid.UUID) (*User, error)
+	GetDeletedBoards(ctx context.Context, page common.Page) ([]*DeletedBoard, error)
+	GetActions(ctx context.Context, page common.Page) ([]*Action, error)
+}
+
+type API struct {
+	service AdminService
+}
+
+func NewAdminApi(service AdminService) AdminApi {
+	api := new(API)
+	api.service = service
+	return api
+}
+
+// Search the boards of the instance
+//
+//	@Summary		Search the boards of the instance
+//	@Description	Search all boards of the instance by name or id, requires the instance admin role
+//	@Tags			admin
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			query	query	string	false	"part of the board name or the board id"
+//	@Param			limit	query	int		false	"maximum number of boards, defaults to 50"
+//	@Param			offset	query	int		false	"number of boards to skip"
+//	@Produce		json
+//	@Success		200	{object}	[]Board
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/admin/boards [get]
+func (api *API) GetBoards(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.admin.api.boards.get.all")
+	defer span.End()
+
+	page, err := common.ParsePage(r.URL.Query())
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid page")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	result, err := api.service.GetBoards(ctx, r.URL.Query().Get("query"), page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get boards")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, result)
+}
+
+// Delete a board of the instance
+//
+//	@Summary		Delete a board of the instance
+//	@Description	Delete an abusive board, requires the instance admin role. The action is logged.
+//	@Tags			admin
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			board	path	string	true	"id of the board"
+//	@Success		204
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/admin/boards/{board} [delete]
+func (api *API) DeleteBoard(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.admin.api.boards.delete")
+	defer span.End()
+
+	actor := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+	board, err := uuid.Parse(chi.URLParam(r, "board"))
+	if err != nil {
+		span.SetStatus(codes.Error, "unable to parse board id")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(errors.New("invalid board id")))
+		return
+	}
+
+	if err := api.service.DeleteBoard(ctx, actor, board); err != nil {
+		span.SetStatus(codes.Error, "failed to delete board")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.Respond(w, r, nil)
+}
+
+// Search the users of the instance
+//
+//	@Summary		Search the users of the instance
+//	@Description	Search all users of the instance by name or id, requires the instance admin role
+//	@Tags			admin
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			query	query	string	false	"part of the user name or the user id"
+//	@Param			limit	query	int		false	"maximum number of users, defaults to 50"
+//	@Param			offset	query	int		false	"number of users to skip"
+//	@Produce		json
+//	@Success		200	{object}	[]User
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/admin/users [get]
+func (api *API) GetUsers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.admin.api.users.get.all")
+	defer span.End()
+
+	page, err := common.ParsePage(r.URL.Query())
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid page")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	result, err := api.service.GetUsers(ctx, r.URL.Query().Get("query"), page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get users")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, result)
+}
+
+// Ban a user from the instance
+//
+//	@Summary		Ban a user from the instance
+//	@Description	Ban a user instance-wide, requires the instance admin role. All sessions and api tokens of the user are revoked and further logins are refused. The action is logged.
+//	@Tags			admin
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			user	path	string	true	"id of the user"
+//	@Produce		json
+//	@Success		200	{object}	User
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/admin/users/{user}/ban [put]
+func (api *API) BanUser(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.admin.api.users.ban")
+	defer span.End()
+
+	actor := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+	user, err := uuid.Parse(chi.URLParam(r, "user"))
+	if err != nil {
+		span.SetStatus(codes.Error, "unable to parse user id")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(errors.New("invalid user id")))
+		return
+	}
+
+	result, err := api.service.BanUser(ctx, actor, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to ban user")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, result)
+}
+
+// Unban a user
+//
+//	@Summary		Unban a user
+//	@Description	Lift the instance-wide ban of a user, requires the instance admin role. The action is logged.
+//	@Tags			admin
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			user	path	string	true	"id of the user"
+//	@Produce		json
+//	@Success		200	{object}	User
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/admin/users/{user}/ban [delete]
+func (api *API) UnbanUser(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.admin.api.users.unban")
+	defer span.End()
+
+	actor := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+	user, err := uuid.Parse(chi.URLParam(r, "user"))
+	if err != nil {
+		span.SetStatus(codes.Error, "unable to parse user id")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(errors.New("invalid user id")))
+		return
+	}
+
+	result, err := api.service.UnbanUser(ctx, actor, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to unban user")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, result)
+}
+
+// Get the statistics of deleted boards
+//
+//	@Summary		Get the statistics of deleted boards
+//	@Description	Get the statistics recorded when boards were deleted, most recently deleted first. Requires the instance admin role.
+//	@Tags			admin
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			limit	query	int		false	"maximum number of entries, defaults to 50"
+//	@Param			offset	query	int		false	"number of entries to skip"
+//	@Produce		json
+//	@Success		200	{object}	[]DeletedBoard
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/admin/statistics/deleted-boards [get]
+func (api *API) GetDeletedBoards(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.admin.api.statistics.deleted_boards")
+	defer span.End()
+
+	page, err := common.ParsePage(r.URL.Query())
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid page")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	result, err := api.service.GetDeletedBoards(ctx, page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get deleted boards")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, result)
+}
+
+// Get the actions of instance administrators
+//
+//	@Summary		Get the actions of instance administrators
+//	@Description	Get the logged actions of all instance administrators, newest first. Requires the instance admin role.
+//	@Tags			admin
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			limit	query	int		false	"maximum number of actions, defaults to 50"
+//	@Param			offset	query	int		false	"number of actions to skip"
+//	@Produce		json
+//	@Success		200	{object}	[]Action
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/admin/actions [get]
+func (api *API) GetActions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.admin.api.actions.get.all")
+	defer span.End()
+
+	page, err := common.ParsePage(r.URL.Query())
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid page")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	result, err := api.service.GetActions(ctx, page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get admin actions")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, result)
+}
+
+// AdminContext allows only instance administrators
+func (api *API) AdminContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.Start(r.Context(), "scrumlr.admin.api.context")
+		defer span.End()
+		log := logger.FromContext(ctx)
+
+		user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+		span.SetAttributes(
+			attribute.String("scrumlr.admin.api.context.user", user.String()),
+		)
+
+		admin, err := api.service.IsAdmin(ctx, user)
+		if err != nil {
+			span.SetStatus(codes.Error, "unable to check admin role")
+			span.RecordError(err)
+			log.Errorw("unable to check admin role", "user", user, "err", err)
+			common.Throw(w, r, common.InternalServerError)
+			return
+		}
+
+		if !admin {
+			err := errors.New("user is not an instance administrator")
+			span.SetStatus(codes.Error, "not an admin")
+			span.RecordError(err)
+			common.Throw(w, r, common.ForbiddenError(err))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// apiError translates admin errors to HTTP API errors.
+func apiError(err error) error {
+	var adminErr AdminError
+	if errors.As(err, &adminErr) {
+		switch adminErr.Category {
+		case BadRequest:
+			return common.BadRequestError(err)
+		case NotFound:
+			return common.NotFoundError
+		}
+	}
+
+	return common.InternalServerError
+}
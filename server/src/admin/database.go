@@ -0,0 +1,153 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+)
+
+type DB struct {
+	db *bun.DB
+}
+
+func NewAdminDatabase(database *bun.DB) AdminDatabase {
+	db := new(DB)
+	db.db = database
+
+	return db
+}
+
+// GetBoards searches the boards of the instance by name or id, newest first
+func (d *DB) GetBoards(ctx context.Context, query string, page common.Page) ([]DatabaseBoard, error) {
+	var boards []DatabaseBoard
+	q := d.db.NewSelect().
+		Model(&boards).
+		ColumnExpr("board.id, board.name, board.access_policy, board.created_at, board.last_modified_at, board.archived_at").
+		ColumnExpr("(SELECT count(*) FROM board_sessions AS s WHERE s.board = board.id) AS participants")
+
+	if query != "" {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("board.name ILIKE ?", searchPattern(query)).
+				WhereOr("board.id::text = ?", query)
+		})
+	}
+
+	err := q.Order("board.created_at DESC").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx)
+
+	return boards, err
+}
+
+// GetUsers searches the users of the instance by name or id, newest first
+func (d *DB) GetUsers(ctx context.Context, query string, page common.Page) ([]DatabaseUser, error) {
+	var users []DatabaseUser
+	q := d.db.NewSelect().
+		Model(&users)
+
+	if query != "" {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("u.name ILIKE ?", searchPattern(query)).
+				WhereOr("u.id::text = ?", query)
+		})
+	}
+
+	err := q.Order("u.created_at DESC").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx)
+
+	return users, err
+}
+
+// GetUser gets a specific user
+func (d *DB) GetUser(ctx context.Context, id uuid.UUID) (DatabaseUser, error) {
+	var user DatabaseUser
+	err := d.db.NewSelect().
+		Model(&user).
+		Where("id = ?", id).
+		Scan(ctx)
+
+	return user, err
+}
+
+// IsAdmin checks whether the user has been granted the admin role by its identity provider
+func (d *DB) IsAdmin(ctx context.Context, id uuid.UUID) (bool, error) {
+	return d.db.NewSelect().
+		Model((*DatabaseUser)(nil)).
+		Where("id = ?", id).
+		Where("admin").
+		Exists(ctx)
+}
+
+// SetBanned bans or unbans a user. Banning also deletes the api tokens of the user.
+func (d *DB) SetBanned(ctx context.Context, id uuid.UUID, banned bool) (DatabaseUser, error) {
+	var user DatabaseUser
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model((*DatabaseUser)(nil)).
+			Set("banned = ?", banned).
+			Where("id = ?", id).
+			Returning("*").
+			Exec(ctx, &user)
+		if err != nil || !banned {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Table("api_tokens").
+			Where("\"user\" = ?", id).
+			Exec(ctx)
+
+		return err
+	})
+
+	return user, err
+}
+
+// GetDeletedBoards gets the statistics of deleted boards, most recently deleted first
+func (d *DB) GetDeletedBoards(ctx context.Context, page common.Page) ([]DatabaseDeletedBoard, error) {
+	var deletedBoards []DatabaseDeletedBoard
+	err := d.db.NewSelect().
+		Model(&deletedBoards).
+		Order("deleted_at DESC").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx)
+
+	return deletedBoards, err
+}
+
+// CreateAction records an action of an instance administrator
+func (d *DB) CreateAction(ctx context.Context, insert DatabaseActionInsert) (DatabaseAction, error) {
+	var action DatabaseAction
+	_, err := d.db.NewInsert().
+		Model(&insert).
+		Returning("*").
+		Exec(ctx, &action)
+
+	return action, err
+}
+
+// GetActions gets the actions of instance administrators, newest first
+func (d *DB) GetActions(ctx context.Context, page common.Page) ([]DatabaseAction, error) {
+	var actions []DatabaseAction
+	err := d.db.NewSelect().
+		Model(&actions).
+		Order("created_at DESC").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx)
+
+	return actions, err
+}
+
+// searchPattern matches names containing the query, treating LIKE wildcards in the query literally
+func searchPattern(query string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(query)
+	return "%" + escaped + "%"
+}
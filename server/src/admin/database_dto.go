@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/boards"
+	"scrumlr.io/server/common"
+)
+
+type DatabaseBoard struct {
+	bun.BaseModel  `bun:"table:boards,alias:board"`
+	ID             uuid.UUID
+	Name           *string
+	AccessPolicy   boards.AccessPolicy
+	CreatedAt      time.Time
+	LastModifiedAt time.Time
+	ArchivedAt     *time.Time
+
+	// Participants is the number of board sessions, which is only selected
+	Participants int `bun:",scanonly"`
+}
+
+type DatabaseUser struct {
+	bun.BaseModel `bun:"table:users,alias:u"`
+	ID            uuid.UUID
+	Name          string
+	AccountType   common.AccountType
+	Admin         bool
+	Banned        bool
+	CreatedAt     time.Time
+}
+
+// DatabaseDeletedBoard are the statistics recorded by the database when a board is deleted
+type DatabaseDeletedBoard struct {
+	bun.BaseModel     `bun:"table:deleted_boards"`
+	ID                uuid.UUID
+	AccessPolicy      *boards.AccessPolicy
+	TotalColumns      int
+	HiddenColumns     int
+	TotalNotes        int
+	HiddenNotes       int
+	AvgCharsPerNote   int
+	FirstNoteCreated  *time.Time
+	LastNoteCreated   *time.Time
+	TotalParticipants int
+	TotalModerators   int
+	TotalVotes        int
+	TotalVotings      int
+	CreatedAt         *time.Time
+	DeletedAt         time.Time
+}
+
+type DatabaseAction struct {
+	bun.BaseModel `bun:"table:admin_actions"`
+	ID            uuid.UUID
+	Actor         uuid.NullUUID
+	Action        ActionType
+	Target        uuid.UUID
+	CreatedAt     time.Time
+}
+
+type DatabaseActionInsert struct {
+	bun.BaseModel `bun:"table:admin_actions"`
+	Actor         uuid.UUID
+	Action        ActionType
+	Target        uuid.UUID
+}
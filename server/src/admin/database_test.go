@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/initialize/testDbTemplates"
+)
+
+type DatabaseAdminTestSuite struct {
+	suite.Suite
+	db     *bun.DB
+	users  map[string]uuid.UUID
+	boards map[string]uuid.UUID
+}
+
+func TestDatabaseAdminTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseAdminTestSuite))
+}
+
+func (suite *DatabaseAdminTestSuite) SetupTest() {
+	suite.db = testDbTemplates.NewBaseTestDB(
+		suite.T(),
+		false,
+		testDbTemplates.AdditionalSeed{
+			Name: "admin_database_test_data",
+			Func: suite.seedData,
+		},
+	)
+}
+
+func (suite *DatabaseAdminTestSuite) Test_Database_GetBoards() {
+	t := suite.T()
+	database := NewAdminDatabase(suite.db)
+
+	dbBoards, err := database.GetBoards(context.Background(), "spam", common.Page{Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, dbBoards, 1)
+	assert.Equal(t, suite.boards["Spam"], dbBoards[0].ID)
+	assert.Equal(t, 2, dbBoards[0].Participants)
+
+	dbBoards, err = database.GetBoards(context.Background(), suite.boards["Retro"].String(), common.Page{Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, dbBoards, 1)
+	assert.Equal(t, suite.boards["Retro"], dbBoards[0].ID)
+
+	dbBoards, err = database.GetBoards(context.Background(), "%", common.Page{Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, dbBoards, 0)
+
+	dbBoards, err = database.GetBoards(context.Background(), "", common.Page{Limit: 1, Offset: 1})
+	assert.Nil(t, err)
+	assert.Len(t, dbBoards, 1)
+}
+
+func (suite *DatabaseAdminTestSuite) Test_Database_GetUsers() {
+	t := suite.T()
+	database := NewAdminDatabase(suite.db)
+
+	dbUsers, err := database.GetUsers(context.Background(), "mall", common.Page{Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Len(t, dbUsers, 1)
+	assert.Equal(t, suite.users["Mallory"], dbUsers[0].ID)
+	assert.Equal(t, common.Anonymous, dbUsers[0].AccountType)
+}
+
+func (suite *DatabaseAdminTestSuite) Test_Database_IsAdmin() {
+	t := suite.T()
+	database := NewAdminDatabase(suite.db)
+
+	_, err := suite.db.NewUpdate().Table("users").Set("admin = true").Where("id = ?", suite.users["Alice"]).Exec(context.Background())
+	assert.Nil(t, err)
+
+	admin, err := database.IsAdmin(context.Background(), suite.users["Alice"])
+	assert.Nil(t, err)
+	assert.True(t, admin)
+
+	admin, err = database.IsAdmin(context.Background(), suite.users["Mallory"])
+	assert.Nil(t, err)
+	assert.False(t, admin)
+}
+
+func (suite *DatabaseAdminTestSuite) Test_Database_SetBanned() {
+	t := suite.T()
+	database := NewAdminDatabase(suite.db)
+	userId := suite.users["Mallory"]
+
+	_, err := suite.db.NewInsert().
+		Table("api_tokens").
+		Value("\"user\"", "?", userId).
+		Value("name", "?", "token").
+		Value("token_hash", "?", strings.Repeat("a", 64)).
+		Exec(context.Background())
+	assert.Nil(t, err)
+
+	user, err := database.SetBanned(context.Background(), userId, true)
+	assert.Nil(t, err)
+	assert.True(t, user.Banned)
+
+	tokens, err := suite.db.NewSelect().Table("api_tokens").Where("\"user\" = ?", userId).Count(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, tokens)
+
+	user, err = database.SetBanned(context.Background(), userId, false)
+	assert.Nil(t, err)
+	assert.False(t, user.Banned)
+
+	_, err = database.SetBanned(context.Background(), uuid.New(), true)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseAdminTestSuite) Test_Database_GetDeletedBoards() {
+	t := suite.T()
+	database := NewAdminDatabase(suite.db)
+
+	_, err := suite.db.NewDelete().Table("boards").Where("id = ?", suite.boards["Spam"]).Exec(context.Background())
+	assert.Nil(t, err)
+
+	deleted, err := database.GetDeletedBoards(context.Background(), common.Page{Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, deleted, 1)
+	assert.Equal(t, suite.boards["Spam"], deleted[0].ID)
+	assert.Equal(t, 1, deleted[0].TotalParticipants)
+}
+
+func (suite *DatabaseAdminTestSuite) Test_Database_Actions() {
+	t := suite.T()
+	database := NewAdminDatabase(suite.db)
+
+	action, err := database.CreateAction(context.Background(), DatabaseActionInsert{Actor: suite.users["Alice"], Action: ActionBanUser, Target: suite.users["Mallory"]})
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, action.ID)
+
+	actions, err := database.GetActions(context.Background(), common.Page{Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, actions, 1)
+	assert.Equal(t, uuid.NullUUID{UUID: suite.users["Alice"], Valid: true}, actions[0].Actor)
+	assert.Equal(t, ActionBanUser, actions[0].Action)
+	assert.Equal(t, suite.users["Mallory"], actions[0].Target)
+}
+
+func (suite *DatabaseAdminTestSuite) seedData(db *bun.DB) {
+	suite.users = map[string]uuid.UUID{"Alice": uuid.New(), "Mallory": uuid.New()}
+	suite.boards = map[string]uuid.UUID{"Retro": uuid.New(), "Spam": uuid.New()}
+
+	for name, user := range suite.users {
+		if err := testDbTemplates.InsertUser(db, user, name, string(common.Anonymous), nil); err != nil {
+			log.Fatalf("Failed to insert test user %s", err)
+		}
+	}
+
+	for name, board := range suite.boards {
+		if err := testDbTemplates.InsertBoard(db, board, name+" Board", "", nil, nil, "PUBLIC", true, true, true, true, false); err != nil {
+			log.Fatalf("Failed to insert test board %s", err)
+		}
+		if err := testDbTemplates.InsertSession(db, suite.users["Alice"], board, "OWNER", false, false, false, false); err != nil {
+			log.Fatalf("Failed to insert test session %s", err)
+		}
+	}
+
+	if err := testDbTemplates.InsertSession(db, suite.users["Mallory"], suite.boards["Spam"], "PARTICIPANT", false, false, false, false); err != nil {
+		log.Fatalf("Failed to insert test session %s", err)
+	}
+}
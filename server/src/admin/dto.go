@@ -0,0 +1,182 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"scrumlr.io/server/boards"
+	"scrumlr.io/server/common"
+)
+
+// ActionType is the kind of action an instance administrator performed.
+type ActionType string
+
+const (
+	ActionDeleteBoard ActionType = "DELETE_BOARD"
+	ActionBanUser     ActionType = "BAN_USER"
+	ActionUnbanUser   ActionType = "UNBAN_USER"
+)
+
+// Board is the overview of a board for instance administrators.
+type Board struct {
+	ID uuid.UUID `json:"id"`
+
+	// The board name.
+	Name *string `json:"name,omitempty"`
+
+	// The access policy of the board.
+	AccessPolicy boards.AccessPolicy `json:"accessPolicy"`
+
+	// The number of participants of the board.
+	Participants int `json:"participants"`
+
+	CreatedAt      time.Time  `json:"createdAt"`
+	LastModifiedAt time.Time  `json:"lastModifiedAt"`
+	ArchivedAt     *time.Time `json:"archivedAt,omitempty"`
+}
+
+// User is the overview of a user for instance administrators.
+type User struct {
+	ID uuid.UUID `json:"id"`
+
+	// The user name.
+	Name string `json:"name"`
+
+	// The account type of the user.
+	AccountType common.AccountType `json:"accountType"`
+
+	// Whether the user is an instance administrator.
+	Admin bool `json:"admin"`
+
+	// Whether the user is banned from the instance.
+	Banned bool `json:"banned"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// DeletedBoard holds the statistics recorded when a board was deleted.
+type DeletedBoard struct {
+	ID                uuid.UUID            `json:"id"`
+	AccessPolicy      *boards.AccessPolicy `json:"accessPolicy,omitempty"`
+	TotalColumns      int                  `json:"totalColumns"`
+	HiddenColumns     int                  `json:"hiddenColumns"`
+	TotalNotes        int                  `json:"totalNotes"`
+	HiddenNotes       int                  `json:"hiddenNotes"`
+	AvgCharsPerNote   int                  `json:"avgCharsPerNote"`
+	FirstNoteCreated  *time.Time           `json:"firstNoteCreated,omitempty"`
+	LastNoteCreated   *time.Time           `json:"lastNoteCreated,omitempty"`
+	TotalParticipants int                  `json:"totalParticipants"`
+	TotalModerators   int                  `json:"totalModerators"`
+	TotalVotes        int                  `json:"totalVotes"`
+	TotalVotings      int                  `json:"totalVotings"`
+	CreatedAt         *time.Time           `json:"createdAt,omitempty"`
+	DeletedAt         time.Time            `json:"deletedAt"`
+}
+
+// Action is an action performed by an instance administrator.
+type Action struct {
+	ID uuid.UUID `json:"id"`
+
+	// The administrator who performed the action, empty if the user was deleted since.
+	Actor *uuid.UUID `json:"actor,omitempty"`
+
+	// The kind of action.
+	Action ActionType `json:"action"`
+
+	// The id of the board or user the action targeted.
+	Target uuid.UUID `json:"target"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (b *Board) From(board DatabaseBoard) *Board {
+	b.ID = board.ID
+	b.Name = board.Name
+	b.AccessPolicy = board.AccessPolicy
+	b.Participants = board.Participants
+	b.CreatedAt = board.CreatedAt
+	b.LastModifiedAt = board.LastModifiedAt
+	b.ArchivedAt = board.ArchivedAt
+
+	return b
+}
+
+func Boards(boards []DatabaseBoard) []*Board {
+	result := make([]*Board, len(boards))
+	for index, board := range boards {
+		result[index] = new(Board).From(board)
+	}
+
+	return result
+}
+
+func (u *User) From(user DatabaseUser) *User {
+	u.ID = user.ID
+	u.Name = user.Name
+	u.AccountType = user.AccountType
+	u.Admin = user.Admin
+	u.Banned = user.Banned
+	u.CreatedAt = user.CreatedAt
+
+	return u
+}
+
+func Users(users []DatabaseUser) []*User {
+	result := make([]*User, len(users))
+	for index, user := range users {
+		result[index] = new(User).From(user)
+	}
+
+	return result
+}
+
+func (d *DeletedBoard) From(board DatabaseDeletedBoard) *DeletedBoard {
+	d.ID = board.ID
+	d.AccessPolicy = board.AccessPolicy
+	d.TotalColumns = board.TotalColumns
+	d.HiddenColumns = board.HiddenColumns
+	d.TotalNotes = board.TotalNotes
+	d.HiddenNotes = board.HiddenNotes
+	d.AvgCharsPerNote = board.AvgCharsPerNote
+	d.FirstNoteCreated = board.FirstNoteCreated
+	d.LastNoteCreated = board.LastNoteCreated
+	d.TotalParticipants = board.TotalParticipants
+	d.TotalModerators = board.TotalModerators
+	d.TotalVotes = board.TotalVotes
+	d.TotalVotings = board.TotalVotings
+	d.CreatedAt = board.CreatedAt
+	d.DeletedAt = board.DeletedAt
+
+	return d
+}
+
+func DeletedBoards(boards []DatabaseDeletedBoard) []*DeletedBoard {
+	result := make([]*DeletedBoard, len(boards))
+	for index, board := range boards {
+		result[index] = new(DeletedBoard).From(board)
+	}
+
+	return result
+}
+
+func (a *Action) From(action DatabaseAction) *Action {
+	a.ID = action.ID
+	if action.Actor.Valid {
+		actor := action.Actor.UUID
+		a.Actor = &actor
+	}
+	a.Action = action.Action
+	a.Target = action.Target
+	a.CreatedAt = action.CreatedAt
+
+	return a
+}
+
+func Actions(actions []DatabaseAction) []*Action {
+	result := make([]*Action, len(actions))
+	for index, action := range actions {
+		result[index] = new(Action).From(action)
+	}
+
+	return result
+}
@@ -0,0 +1,37 @@
+package admin
+
+import "fmt"
+
+type AdminErrorCategory string
+
+const (
+	BadRequest AdminErrorCategory = "BAD_REQUEST"
+	NotFound   AdminErrorCategory = "NOT_FOUND"
+	Internal   AdminErrorCategory = "INTERNAL"
+)
+
+type AdminError struct {
+	Category AdminErrorCategory
+	Message  string
+	Err      error
+}
+
+func (e AdminError) Error() string {
+	return fmt.Sprintf("admin error [%s]: %s", e.Category, e.Message)
+}
+
+func (e AdminError) Status() string {
+	return string(e.Category)
+}
+
+func (e AdminError) Unwrap() error {
+	return e.Err
+}
+
+func CreateAdminError(category AdminErrorCategory, message string, err error) error {
+	return AdminError{
+		Category: category,
+		Message:  message,
+		Err:      err,
+	}
+}
@@ -0,0 +1,21 @@
+package admin
+
+import "go.opentelemetry.io/otel/metric"
+
+var boardDeletedCounter, _ = meter.Int64Counter(
+	"scrumlr.admin.boards.deleted.counter",
+	metric.WithDescription("Number of boards deleted by instance administrators"),
+	metric.WithUnit("boards"),
+)
+
+var userBannedCounter, _ = meter.Int64Counter(
+	"scrumlr.admin.users.banned.counter",
+	metric.WithDescription("Number of users banned by instance administrators"),
+	metric.WithUnit("users"),
+)
+
+var userUnbannedCounter, _ = meter.Int64Counter(
+	"scrumlr.admin.users.unbanned.counter",
+	metric.WithDescription("Number of users unbanned by instance administrators"),
+	metric.WithUnit("users"),
+)
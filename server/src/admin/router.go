@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type AdminApi interface {
+	GetBoards(w http.ResponseWriter, r *http.Request)
+	DeleteBoard(w http.ResponseWriter, r *http.Request)
+	GetUsers(w http.ResponseWriter, r *http.Request)
+	BanUser(w http.ResponseWriter, r *http.Request)
+	UnbanUser(w http.ResponseWriter, r *http.Request)
+	GetDeletedBoards(w http.ResponseWriter, r *http.Request)
+	GetActions(w http.ResponseWriter, r *http.Request)
+	AdminContext(next http.Handler) http.Handler
+}
+
+type Router struct {
+	adminAPI AdminApi
+}
+
+func (r *Router) RegisterRoutes() chi.Router {
+	router := chi.NewRouter()
+	router.Use(r.adminAPI.AdminContext)
+
+	router.Get("/boards", r.adminAPI.GetBoards)
+	router.Delete("/boards/{board}", r.adminAPI.DeleteBoard)
+
+	router.Get("/users", r.adminAPI.GetUsers)
+	router.Put("/users/{user}/ban", r.adminAPI.BanUser)
+	router.Delete("/users/{user}/ban", r.adminAPI.UnbanUser)
+
+	router.Get("/statistics/deleted-boards", r.adminAPI.GetDeletedBoards)
+	router.Get("/actions", r.adminAPI.GetActions)
+	return router
+}
+
+func NewAdminRouter(adminApi AdminApi) *Router {
+	r := new(Router)
+	r.adminAPI = adminApi
+	return r
+}
@@ -0,0 +1,301 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/boards"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/logger"
+)
+
+var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/admin")
+var meter metric.Meter = otel.Meter("scrumlr.io/server/admin")
+
+type AdminDatabase interface {
+	GetBoards(ctx context.Context, query string, page common.Page) ([]DatabaseBoard, error)
+	GetUsers(ctx context.Context, query string, page common.Page) ([]DatabaseUser, error)
+	GetUser(ctx context.Context, id uuid.UUID) (DatabaseUser, error)
+	IsAdmin(ctx context.Context, id uuid.UUID) (bool, error)
+	SetBanned(ctx context.Context, id uuid.UUID, banned bool) (DatabaseUser, error)
+	GetDeletedBoards(ctx context.Context, page common.Page) ([]DatabaseDeletedBoard, error)
+	CreateAction(ctx context.Context, insert DatabaseActionInsert) (DatabaseAction, error)
+	GetActions(ctx context.Context, page common.Page) ([]DatabaseAction, error)
+}
+
+// SessionRevoker invalidates the sessions of users
+type SessionRevoker interface {
+	RevokeSessions(ctx context.Context, user uuid.UUID) error
+}
+
+type Service struct {
+	database       AdminDatabase
+	boardService   boards.BoardService
+	sessionRevoker SessionRevoker
+	admins         []uuid.UUID
+}
+
+// NewAdminService creates the service for instance administrators. The configured
+// admins are administrators regardless of the groups reported by their identity provider.
+func NewAdminService(db AdminDatabase, boardService boards.BoardService, sessionRevoker SessionRevoker, admins []uuid.UUID) AdminService {
+	service := new(Service)
+	service.database = db
+	service.boardService = boardService
+	service.sessionRevoker = sessionRevoker
+	service.admins = admins
+
+	return service
+}
+
+func (service *Service) IsAdmin(ctx context.Context, user uuid.UUID) (bool, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.admin.service.is_admin")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.admin.service.is_admin.user", user.String()),
+	)
+
+	if slices.Contains(service.admins, user) {
+		return true, nil
+	}
+
+	admin, err := service.database.IsAdmin(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to check admin role")
+		span.RecordError(err)
+		log.Errorw("unable to check admin role", "user", user, "err", err)
+		return false, CreateAdminError(Internal, "failed to check admin role", err)
+	}
+
+	return admin, nil
+}
+
+func (service *Service) GetBoards(ctx context.Context, query string, page common.Page) ([]*Board, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.admin.service.boards.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.admin.service.boards.get.all.query", query),
+		attribute.Int("scrumlr.admin.service.boards.get.all.limit", page.Limit),
+		attribute.Int("scrumlr.admin.service.boards.get.all.offset", page.Offset),
+	)
+
+	result, err := service.database.GetBoards(ctx, query, page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get boards")
+		span.RecordError(err)
+		log.Errorw("unable to get boards", "query", query, "err", err)
+		return nil, CreateAdminError(Internal, "failed to get boards", err)
+	}
+
+	return Boards(result), nil
+}
+
+func (service *Service) DeleteBoard(ctx context.Context, actor, board uuid.UUID) error {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.admin.service.boards.delete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.admin.service.boards.delete.actor", actor.String()),
+		attribute.String("scrumlr.admin.service.boards.delete.board", board.String()),
+	)
+
+	if _, err := service.boardService.Get(ctx, board); err != nil {
+		var boardErr boards.BoardError
+		if errors.As(err, &boardErr) && boardErr.Category == boards.NotFound {
+			span.SetStatus(codes.Error, "board not found")
+			span.RecordError(err)
+			return CreateAdminError(NotFound, "board not found", err)
+		}
+		span.SetStatus(codes.Error, "failed to get board")
+		span.RecordError(err)
+		return CreateAdminError(Internal, "failed to get board", err)
+	}
+
+	if err := service.boardService.Delete(ctx, board); err != nil {
+		span.SetStatus(codes.Error, "failed to delete board")
+		span.RecordError(err)
+		log.Errorw("unable to delete board", "board", board, "err", err)
+		return CreateAdminError(Internal, "failed to delete board", err)
+	}
+
+	service.logAction(ctx, actor, ActionDeleteBoard, board)
+	boardDeletedCounter.Add(ctx, 1)
+	return nil
+}
+
+func (service *Service) GetUsers(ctx context.Context, query string, page common.Page) ([]*User, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.admin.service.users.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.admin.service.users.get.all.query", query),
+		attribute.Int("scrumlr.admin.service.users.get.all.limit", page.Limit),
+		attribute.Int("scrumlr.admin.service.users.get.all.offset", page.Offset),
+	)
+
+	result, err := service.database.GetUsers(ctx, query, page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get users")
+		span.RecordError(err)
+		log.Errorw("unable to get users", "query", query, "err", err)
+		return nil, CreateAdminError(Internal, "failed to get users", err)
+	}
+
+	users := Users(result)
+	for _, user := range users {
+		user.Admin = user.Admin || slices.Contains(service.admins, user.ID)
+	}
+
+	return users, nil
+}
+
+func (service *Service) BanUser(ctx context.Context, actor, user uuid.UUID) (*User, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.admin.service.users.ban")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.admin.service.users.ban.actor", actor.String()),
+		attribute.String("scrumlr.admin.service.users.ban.user", user.String()),
+	)
+
+	if actor == user {
+		err := errors.New("administrators cannot ban themselves")
+		span.SetStatus(codes.Error, "cannot ban oneself")
+		span.RecordError(err)
+		return nil, CreateAdminError(BadRequest, err.Error(), err)
+	}
+
+	admin, err := service.IsAdmin(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to check admin role")
+		span.RecordError(err)
+		return nil, err
+	}
+	if admin {
+		err := errors.New("administrators cannot be banned")
+		span.SetStatus(codes.Error, "cannot ban an administrator")
+		span.RecordError(err)
+		return nil, CreateAdminError(BadRequest, err.Error(), err)
+	}
+
+	banned, err := service.setBanned(ctx, user, true)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to ban user")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := service.sessionRevoker.RevokeSessions(ctx, user); err != nil {
+		span.SetStatus(codes.Error, "failed to revoke sessions")
+		span.RecordError(err)
+		log.Errorw("unable to revoke sessions of banned user", "user", user, "err", err)
+		return nil, CreateAdminError(Internal, "failed to revoke sessions", err)
+	}
+
+	service.logAction(ctx, actor, ActionBanUser, user)
+	userBannedCounter.Add(ctx, 1)
+	return banned, nil
+}
+
+func (service *Service) UnbanUser(ctx context.Context, actor, user uuid.UUID) (*User, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.admin.service.users.unban")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.admin.service.users.unban.actor", actor.String()),
+		attribute.String("scrumlr.admin.service.users.unban.user", user.String()),
+	)
+
+	unbanned, err := service.setBanned(ctx, user, false)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to unban user")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	service.logAction(ctx, actor, ActionUnbanUser, user)
+	userUnbannedCounter.Add(ctx, 1)
+	return unbanned, nil
+}
+
+func (service *Service) GetDeletedBoards(ctx context.Context, page common.Page) ([]*DeletedBoard, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.admin.service.statistics.deleted_boards")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("scrumlr.admin.service.statistics.deleted_boards.limit", page.Limit),
+		attribute.Int("scrumlr.admin.service.statistics.deleted_boards.offset", page.Offset),
+	)
+
+	result, err := service.database.GetDeletedBoards(ctx, page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get deleted boards")
+		span.RecordError(err)
+		log.Errorw("unable to get deleted boards", "err", err)
+		return nil, CreateAdminError(Internal, "failed to get deleted boards", err)
+	}
+
+	return DeletedBoards(result), nil
+}
+
+func (service *Service) GetActions(ctx context.Context, page common.Page) ([]*Action, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.admin.service.actions.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("scrumlr.admin.service.actions.get.all.limit", page.Limit),
+		attribute.Int("scrumlr.admin.service.actions.get.all.offset", page.Offset),
+	)
+
+	result, err := service.database.GetActions(ctx, page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get admin actions")
+		span.RecordError(err)
+		log.Errorw("unable to get admin actions", "err", err)
+		return nil, CreateAdminError(Internal, "failed to get admin actions", err)
+	}
+
+	return Actions(result), nil
+}
+
+func (service *Service) setBanned(ctx context.Context, user uuid.UUID, banned bool) (*User, error) {
+	log := logger.FromContext(ctx)
+
+	result, err := service.database.SetBanned(ctx, user, banned)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, CreateAdminError(NotFound, "user not found", err)
+		}
+		log.Errorw("unable to update ban of user", "user", user, "banned", banned, "err", err)
+		return nil, CreateAdminError(Internal, "failed to update ban of user", err)
+	}
+
+	return new(User).From(result), nil
+}
+
+// logAction records the action in the log and in the database. The action already
+// happened at this point, so a failure to record it is only logged.
+func (service *Service) logAction(ctx context.Context, actor uuid.UUID, action ActionType, target uuid.UUID) {
+	log := logger.FromContext(ctx)
+	log.Infow("admin action", "actor", actor, "action", action, "target", target)
+
+	if _, err := service.database.CreateAction(ctx, DatabaseActionInsert{Actor: actor, Action: action, Target: target}); err != nil {
+		log.Errorw("unable to record admin action", "actor", actor, "action", action, "target", target, "err", err)
+	}
+}
@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"scrumlr.io/server/boards"
+	"scrumlr.io/server/common"
+)
+
+func assertAdminError(t *testing.T, err error, category AdminErrorCategory) {
+	var adminErr AdminError
+	assert.ErrorAs(t, err, &adminErr)
+	assert.Equal(t, category, adminErr.Category)
+}
+
+func TestIsAdmin_Configured(t *testing.T) {
+	adminId := uuid.New()
+
+	service := NewAdminService(NewMockAdminDatabase(t), boards.NewMockBoardService(t), NewMockSessionRevoker(t), []uuid.UUID{adminId})
+	admin, err := service.IsAdmin(context.Background(), adminId)
+
+	assert.Nil(t, err)
+	assert.True(t, admin)
+}
+
+func TestIsAdmin_Database(t *testing.T) {
+	userId := uuid.New()
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().IsAdmin(mock.Anything, userId).Return(true, nil)
+
+	service := NewAdminService(mockDatabase, boards.NewMockBoardService(t), NewMockSessionRevoker(t), nil)
+	admin, err := service.IsAdmin(context.Background(), userId)
+
+	assert.Nil(t, err)
+	assert.True(t, admin)
+}
+
+func TestIsAdmin_DatabaseError(t *testing.T) {
+	userId := uuid.New()
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().IsAdmin(mock.Anything, userId).Return(false, errors.New("database error"))
+
+	service := NewAdminService(mockDatabase, boards.NewMockBoardService(t), NewMockSessionRevoker(t), nil)
+	admin, err := service.IsAdmin(context.Background(), userId)
+
+	assert.False(t, admin)
+	assertAdminError(t, err, Internal)
+}
+
+func TestGetBoards(t *testing.T) {
+	boardId := uuid.New()
+	page := common.Page{Limit: 10, Offset: 20}
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().GetBoards(mock.Anything, "retro", page).
+		Return([]DatabaseBoard{{ID: boardId, AccessPolicy: boards.Public, Participants: 3}}, nil)
+
+	service := NewAdminService(mockDatabase, boards.NewMockBoardService(t), NewMockSessionRevoker(t), nil)
+	result, err := service.GetBoards(context.Background(), "retro", page)
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, boardId, result[0].ID)
+	assert.Equal(t, 3, result[0].Participants)
+}
+
+func TestDeleteBoard(t *testing.T) {
+	actorId := uuid.New()
+	boardId := uuid.New()
+
+	mockBoards := boards.NewMockBoardService(t)
+	mockBoards.EXPECT().Get(mock.Anything, boardId).Return(&boards.Board{ID: boardId}, nil)
+	mockBoards.EXPECT().Delete(mock.Anything, boardId).Return(nil)
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().CreateAction(mock.Anything, DatabaseActionInsert{Actor: actorId, Action: ActionDeleteBoard, Target: boardId}).
+		Return(DatabaseAction{}, nil)
+
+	service := NewAdminService(mockDatabase, mockBoards, NewMockSessionRevoker(t), nil)
+	err := service.DeleteBoard(context.Background(), actorId, boardId)
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteBoard_NotFound(t *testing.T) {
+	boardId := uuid.New()
+
+	mockBoards := boards.NewMockBoardService(t)
+	mockBoards.EXPECT().Get(mock.Anything, boardId).
+		Return(nil, boards.CreateBoardError(boards.NotFound, "no board found", sql.ErrNoRows))
+
+	service := NewAdminService(NewMockAdminDatabase(t), mockBoards, NewMockSessionRevoker(t), nil)
+	err := service.DeleteBoard(context.Background(), uuid.New(), boardId)
+
+	assertAdminError(t, err, NotFound)
+}
+
+func TestDeleteBoard_ActionNotRecorded(t *testing.T) {
+	boardId := uuid.New()
+
+	mockBoards := boards.NewMockBoardService(t)
+	mockBoards.EXPECT().Get(mock.Anything, boardId).Return(&boards.Board{ID: boardId}, nil)
+	mockBoards.EXPECT().Delete(mock.Anything, boardId).Return(nil)
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().CreateAction(mock.Anything, mock.Anything).Return(DatabaseAction{}, errors.New("database error"))
+
+	service := NewAdminService(mockDatabase, mockBoards, NewMockSessionRevoker(t), nil)
+	err := service.DeleteBoard(context.Background(), uuid.New(), boardId)
+
+	assert.Nil(t, err)
+}
+
+func TestGetUsers_ConfiguredAdmin(t *testing.T) {
+	adminId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().GetUsers(mock.Anything, "", common.Page{Limit: 50}).
+		Return([]DatabaseUser{{ID: adminId}, {ID: userId}}, nil)
+
+	service := NewAdminService(mockDatabase, boards.NewMockBoardService(t), NewMockSessionRevoker(t), []uuid.UUID{adminId})
+	users, err := service.GetUsers(context.Background(), "", common.Page{Limit: 50})
+
+	assert.Nil(t, err)
+	assert.True(t, users[0].Admin)
+	assert.False(t, users[1].Admin)
+}
+
+func TestBanUser(t *testing.T) {
+	actorId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().IsAdmin(mock.Anything, userId).Return(false, nil)
+	mockDatabase.EXPECT().SetBanned(mock.Anything, userId, true).Return(DatabaseUser{ID: userId, Banned: true}, nil)
+	mockDatabase.EXPECT().CreateAction(mock.Anything, DatabaseActionInsert{Actor: actorId, Action: ActionBanUser, Target: userId}).
+		Return(DatabaseAction{}, nil)
+
+	mockRevoker := NewMockSessionRevoker(t)
+	mockRevoker.EXPECT().RevokeSessions(mock.Anything, userId).Return(nil)
+
+	service := NewAdminService(mockDatabase, boards.NewMockBoardService(t), mockRevoker, nil)
+	user, err := service.BanUser(context.Background(), actorId, userId)
+
+	assert.Nil(t, err)
+	assert.True(t, user.Banned)
+}
+
+func TestBanUser_Self(t *testing.T) {
+	actorId := uuid.New()
+
+	service := NewAdminService(NewMockAdminDatabase(t), boards.NewMockBoardService(t), NewMockSessionRevoker(t), nil)
+	user, err := service.BanUser(context.Background(), actorId, actorId)
+
+	assert.Nil(t, user)
+	assertAdminError(t, err, BadRequest)
+}
+
+func TestBanUser_Admin(t *testing.T) {
+	adminId := uuid.New()
+
+	service := NewAdminService(NewMockAdminDatabase(t), boards.NewMockBoardService(t), NewMockSessionRevoker(t), []uuid.UUID{adminId})
+	user, err := service.BanUser(context.Background(), uuid.New(), adminId)
+
+	assert.Nil(t, user)
+	assertAdminError(t, err, BadRequest)
+}
+
+func TestBanUser_NotFound(t *testing.T) {
+	userId := uuid.New()
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().IsAdmin(mock.Anything, userId).Return(false, nil)
+	mockDatabase.EXPECT().SetBanned(mock.Anything, userId, true).Return(DatabaseUser{}, sql.ErrNoRows)
+
+	service := NewAdminService(mockDatabase, boards.NewMockBoardService(t), NewMockSessionRevoker(t), nil)
+	user, err := service.BanUser(context.Background(), uuid.New(), userId)
+
+	assert.Nil(t, user)
+	assertAdminError(t, err, NotFound)
+}
+
+func TestUnbanUser(t *testing.T) {
+	actorId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().SetBanned(mock.Anything, userId, false).Return(DatabaseUser{ID: userId}, nil)
+	mockDatabase.EXPECT().CreateAction(mock.Anything, DatabaseActionInsert{Actor: actorId, Action: ActionUnbanUser, Target: userId}).
+		Return(DatabaseAction{}, nil)
+
+	service := NewAdminService(mockDatabase, boards.NewMockBoardService(t), NewMockSessionRevoker(t), nil)
+	user, err := service.UnbanUser(context.Background(), actorId, userId)
+
+	assert.Nil(t, err)
+	assert.False(t, user.Banned)
+}
+
+func TestGetActions(t *testing.T) {
+	actorId := uuid.New()
+	page := common.Page{Limit: 50}
+
+	mockDatabase := NewMockAdminDatabase(t)
+	mockDatabase.EXPECT().GetActions(mock.Anything, page).Return([]DatabaseAction{
+		{Actor: uuid.NullUUID{UUID: actorId, Valid: true}, Action: ActionBanUser},
+		{Action: ActionDeleteBoard},
+	}, nil)
+
+	service := NewAdminService(mockDatabase, boards.NewMockBoardService(t), NewMockSessionRevoker(t), nil)
+	actions, err := service.GetActions(context.Background(), page)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &actorId, actions[0].Actor)
+	assert.Nil(t, actions[1].Actor)
+}
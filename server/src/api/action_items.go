@@ -0,0 +1,212 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+)
+
+// Create a new action item on a board
+//
+//	@Summary		Create a new action item on a board
+//	@Description	Create a new action item on a board, optionally assigned to a participant and linked to the originating note
+//	@Tags			action items
+//	@Accept			json
+//	@Param			Cookie		header	string								true	"jwt token to authenticate"
+//	@Param			boardId		path	string								true	"id of the board"
+//	@Param			actionItem	body	actionitems.ActionItemCreateRequest	true	"action item to create"
+//	@Produce		json
+//	@Header			201	{string}	Location	"Path to the created action item"
+//	@Success		201	{object}	actionitems.ActionItem
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/action-items [post]
+func (s *Server) createActionItem(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.action_items.api.create")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+
+	var body actionitems.ActionItemCreateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	body.Board = board
+
+	item, err := s.actionItems.Create(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create action item")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+	w.Header().Set("Location", s.buildRelativeURL(fmt.Sprintf("/boards/%s/action-items/%s", board, item.ID)))
+
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, item)
+}
+
+// Get all action items of a board
+//
+//	@Summary		Get all action items of a board
+//	@Description	Get all action items of a board
+//	@Tags			action items
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			boardId	path	string	true	"id of the board"
+//	@Produce		json
+//	@Success		200	{object}	[]actionitems.ActionItem
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/action-items [get]
+func (s *Server) getActionItems(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.action_items.api.get.all")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+
+	items, err := s.actionItems.GetAll(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get action items")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, items)
+}
+
+// Get an action item of a board
+//
+//	@Summary		Get an action item of a board
+//	@Description	Get an action item of a board
+//	@Tags			action items
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			boardId	path	string	true	"id of the board"
+//	@Param			id		path	string	true	"id of the action item"
+//	@Produce		json
+//	@Success		200	{object}	actionitems.ActionItem
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/action-items/{id} [get]
+func (s *Server) getActionItem(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.action_items.api.get")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+	id := ctx.Value(identifiers.ActionItemIdentifier).(uuid.UUID)
+
+	item, err := s.actionItems.Get(ctx, board, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get action item")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, item)
+}
+
+// Update an action item of a board
+//
+//	@Summary		Update an action item of a board
+//	@Description	Update text, assignee, due date and status of an action item
+//	@Tags			action items
+//	@Accept			json
+//	@Param			Cookie		header	string								true	"jwt token to authenticate"
+//	@Param			boardId		path	string								true	"id of the board"
+//	@Param			id			path	string								true	"id of the action item"
+//	@Param			actionItem	body	actionitems.ActionItemUpdateRequest	true	"values to update the action item"
+//	@Produce		json
+//	@Success		200	{object}	actionitems.ActionItem
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/action-items/{id} [put]
+func (s *Server) updateActionItem(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.action_items.api.update")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+	id := ctx.Value(identifiers.ActionItemIdentifier).(uuid.UUID)
+
+	var body actionitems.ActionItemUpdateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	body.ID = id
+	body.Board = board
+
+	item, err := s.actionItems.Update(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to update action item")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, item)
+}
+
+// Delete an action item of a board
+//
+//	@Summary		Delete an action item of a board
+//	@Description	Delete an action item of a board
+//	@Tags			action items
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			boardId	path	string	true	"id of the board"
+//	@Param			id		path	string	true	"id of the action item"
+//	@Produce		json
+//	@Success		204
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/action-items/{id} [delete]
+func (s *Server) deleteActionItem(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.action_items.api.delete")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+	id := ctx.Value(identifiers.ActionItemIdentifier).(uuid.UUID)
+
+	if err := s.actionItems.Delete(ctx, board, id); err != nil {
+		span.SetStatus(codes.Error, "failed to delete action item")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.Respond(w, r, nil)
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+	"scrumlr.io/server/technical_helper"
+)
+
+type ActionItemTestSuite struct {
+	suite.Suite
+}
+
+func TestActionItemTestSuite(t *testing.T) {
+	suite.Run(t, new(ActionItemTestSuite))
+}
+
+func (suite *ActionItemTestSuite) TestCreateActionItem() {
+	testParameterBundles := *TestParameterBundles{}.
+		Append("all ok", http.StatusCreated, nil, false, false, nil).
+		Append("bad request", http.StatusBadRequest, actionitems.CreateActionItemError(actionitems.BadRequest, "assignee is not a participant of this board", errors.New("assignee is not a participant of this board")), false, false, nil).
+		Append("unexpected error", http.StatusInternalServerError, errors.New("oops"), false, false, nil)
+
+	for _, tt := range testParameterBundles {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			s.basePath = "/"
+			actionItemMock := actionitems.NewMockActionItemService(suite.T())
+			s.actionItems = actionItemMock
+
+			boardId := uuid.New()
+			assigneeId := uuid.New()
+			itemId := uuid.New()
+
+			req := technical_helper.NewTestRequestBuilder("POST", "/", strings.NewReader(fmt.Sprintf(`{
+				"text": "Write the retro summary",
+				"assignee": "%s"
+				}`, assigneeId)))
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+			req.AddToContext(identifiers.BoardIdentifier, boardId)
+
+			actionItemMock.EXPECT().Create(mock.Anything, actionitems.ActionItemCreateRequest{
+				Text:     "Write the retro summary",
+				Assignee: uuid.NullUUID{UUID: assigneeId, Valid: true},
+				Board:    boardId,
+			}).Return(&actionitems.ActionItem{ID: itemId, Text: "Write the retro summary", Status: actionitems.Open}, tt.err)
+
+			rr := httptest.NewRecorder()
+			s.createActionItem(rr, req.Request())
+
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+			if tt.err == nil {
+				suite.Equal(fmt.Sprintf("/boards/%s/action-items/%s", boardId, itemId), rr.Result().Header.Get("Location"))
+			}
+		})
+	}
+}
+
+func (suite *ActionItemTestSuite) TestUpdateActionItem() {
+	testParameterBundles := *TestParameterBundles{}.
+		Append("all ok", http.StatusOK, nil, false, false, nil).
+		Append("not found", http.StatusNotFound, actionitems.CreateActionItemError(actionitems.NotFound, "action item not found", errors.New("not found")), false, false, nil)
+
+	for _, tt := range testParameterBundles {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			actionItemMock := actionitems.NewMockActionItemService(suite.T())
+			s.actionItems = actionItemMock
+
+			boardId := uuid.New()
+			itemId := uuid.New()
+
+			req := technical_helper.NewTestRequestBuilder("PUT", "/", strings.NewReader(`{
+				"text": "Write the retro summary",
+				"status": "DONE"
+				}`))
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+			req.AddToContext(identifiers.BoardIdentifier, boardId).
+				AddToContext(identifiers.ActionItemIdentifier, itemId)
+
+			actionItemMock.EXPECT().Update(mock.Anything, actionitems.ActionItemUpdateRequest{
+				Text:   "Write the retro summary",
+				Status: actionitems.Done,
+				ID:     itemId,
+				Board:  boardId,
+			}).Return(&actionitems.ActionItem{ID: itemId, Status: actionitems.Done}, tt.err)
+
+			rr := httptest.NewRecorder()
+			s.updateActionItem(rr, req.Request())
+
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+		})
+	}
+}
+
+func (suite *ActionItemTestSuite) TestUpdateActionItem_InvalidStatus() {
+	s := new(Server)
+	s.actionItems = actionitems.NewMockActionItemService(suite.T())
+
+	req := technical_helper.NewTestRequestBuilder("PUT", "/", strings.NewReader(`{"text": "Something", "status": "SOMEDAY"}`))
+	req.Req = logger.InitTestLoggerRequest(req.Request())
+	req.AddToContext(identifiers.BoardIdentifier, uuid.New()).
+		AddToContext(identifiers.ActionItemIdentifier, uuid.New())
+
+	rr := httptest.NewRecorder()
+	s.updateActionItem(rr, req.Request())
+
+	suite.Equal(http.StatusBadRequest, rr.Result().StatusCode)
+}
+
+func (suite *ActionItemTestSuite) TestDeleteActionItem() {
+	s := new(Server)
+	actionItemMock := actionitems.NewMockActionItemService(suite.T())
+	s.actionItems = actionItemMock
+
+	boardId := uuid.New()
+	itemId := uuid.New()
+
+	req := technical_helper.NewTestRequestBuilder("DELETE", "/", nil)
+	req.AddToContext(identifiers.BoardIdentifier, boardId).
+		AddToContext(identifiers.ActionItemIdentifier, itemId)
+
+	actionItemMock.EXPECT().Delete(mock.Anything, boardId, itemId).Return(nil)
+
+	rr := httptest.NewRecorder()
+	s.deleteActionItem(rr, req.Request())
+
+	suite.Equal(http.StatusNoContent, rr.Result().StatusCode)
+}
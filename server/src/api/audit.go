@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+)
+
+// Get the audit log of a board
+//
+//	@Summary		Get the audit log of a board
+//	@Description	Get the changes of board settings and the moderation of a board, newest first
+//	@Tags			boards
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			id		path	string	true	"Board ID"
+//	@Param			limit	query	int		false	"maximum number of entries, defaults to 50"
+//	@Param			offset	query	int		false	"number of entries to skip"
+//	@Produce		json
+//	@Success		200	{object}	[]audit.Entry
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{id}/audit [get]
+func (s *Server) getAuditLog(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.audit.api.get.all")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+
+	page, err := common.ParsePage(r.URL.Query())
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid page")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	entries, err := s.audit.GetAll(ctx, board, page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get audit log")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, entries)
+}
+
+// exportAuditLog collects the complete audit log of a board for the export of a moderator.
+// Other participants get no audit log.
+func (s *Server) exportAuditLog(ctx context.Context, board, user uuid.UUID) ([]*audit.Entry, error) {
+	isModerator, err := s.sessions.ModeratorSessionExists(ctx, board, user)
+	if err != nil || !isModerator {
+		return nil, err
+	}
+
+	var auditLog []*audit.Entry
+	page := common.Page{Limit: 100}
+	for {
+		entries, err := s.audit.GetAll(ctx, board, page)
+		if err != nil {
+			return nil, err
+		}
+
+		auditLog = append(auditLog, entries...)
+		if len(entries) < page.Limit {
+			return auditLog, nil
+		}
+		page.Offset += page.Limit
+	}
+}
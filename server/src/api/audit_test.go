@@ -0,0 +1,120 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/boards"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/technical_helper"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+}
+
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
+
+func (suite *AuditTestSuite) TestGetAuditLog() {
+	testParameterBundles := *TestParameterBundles{}.
+		Append("all ok", http.StatusOK, nil, false, false, nil).
+		Append("unexpected error", http.StatusInternalServerError, errors.New("oops"), false, false, nil)
+
+	for _, tt := range testParameterBundles {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			auditMock := audit.NewMockAuditService(suite.T())
+			s.audit = auditMock
+
+			boardId := uuid.New()
+
+			req := technical_helper.NewTestRequestBuilder("GET", "/?limit=10&offset=20", nil)
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+			req.AddToContext(identifiers.BoardIdentifier, boardId)
+
+			auditMock.EXPECT().GetAll(mock.Anything, boardId, common.Page{Limit: 10, Offset: 20}).
+				Return([]*audit.Entry{{ID: uuid.New(), Action: audit.VotingClosed}}, tt.err)
+
+			rr := httptest.NewRecorder()
+			s.getAuditLog(rr, req.Request())
+
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+		})
+	}
+}
+
+func (suite *AuditTestSuite) TestGetAuditLog_InvalidPage() {
+	s := new(Server)
+
+	req := technical_helper.NewTestRequestBuilder("GET", "/?limit=1000", nil)
+	req.AddToContext(identifiers.BoardIdentifier, uuid.New())
+
+	rr := httptest.NewRecorder()
+	s.getAuditLog(rr, req.Request())
+
+	suite.Equal(http.StatusBadRequest, rr.Result().StatusCode)
+}
+
+func (suite *AuditTestSuite) TestExportBoard_AuditLogOnlyForModerators() {
+	tests := []struct {
+		name        string
+		isModerator bool
+	}{
+		{name: "moderator", isModerator: true},
+		{name: "participant", isModerator: false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			boardMock := boards.NewMockBoardService(suite.T())
+			sessionMock := sessions.NewMockSessionService(suite.T())
+			auditMock := audit.NewMockAuditService(suite.T())
+			s.boards = boardMock
+			s.sessions = sessionMock
+			s.audit = auditMock
+
+			boardId := uuid.New()
+			userId := uuid.New()
+
+			boardMock.EXPECT().FullBoard(mock.Anything, boardId).Return(&boards.FullBoard{Board: &boards.Board{ID: boardId}}, nil)
+			sessionMock.EXPECT().ModeratorSessionExists(mock.Anything, boardId, userId).Return(tt.isModerator, nil)
+			if tt.isModerator {
+				auditMock.EXPECT().GetAll(mock.Anything, boardId, common.Page{Limit: 100}).
+					Return([]*audit.Entry{{ID: uuid.New(), Action: audit.BoardUpdated}}, nil)
+			}
+
+			req := technical_helper.NewTestRequestBuilder("GET", "/", nil)
+			req.Req.Header.Set("Accept", "application/json")
+			req.AddToContext(identifiers.BoardIdentifier, boardId).
+				AddToContext(identifiers.UserIdentifier, userId)
+
+			rr := httptest.NewRecorder()
+			s.exportBoard(rr, req.Request())
+
+			suite.Equal(http.StatusOK, rr.Result().StatusCode)
+
+			var export struct {
+				AuditLog []*audit.Entry `json:"auditLog"`
+			}
+			suite.NoError(json.NewDecoder(rr.Body).Decode(&export))
+			if tt.isModerator {
+				suite.Len(export.AuditLog, 1)
+			} else {
+				suite.Empty(export.AuditLog)
+			}
+		})
+	}
+}
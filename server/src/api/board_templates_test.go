@@ -6,8 +6,11 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/crewjam/saml"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/jwtauth/v5"
 	"github.com/google/uuid"
 	"github.com/markbates/goth"
@@ -63,6 +66,24 @@ func (t *testAuthService) Sign(_ map[string]any) (string, error) {
 	return "test-token", nil
 }
 
+func (t *testAuthService) SetSessionCookie(_ http.ResponseWriter, _ *http.Request, _ uuid.UUID) error {
+	return nil
+}
+
+func (t *testAuthService) RevokeSession(_ *http.Request) error {
+	return nil
+}
+
+func (t *testAuthService) RevokeSessions(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+func (t *testAuthService) BeginAccountLink(_ http.ResponseWriter, _ *http.Request) {}
+
+func (t *testAuthService) CompleteAccountLink(_ http.ResponseWriter, _ *http.Request) (auth.AccountLink, bool) {
+	return auth.AccountLink{}, false
+}
+
 func (t *testAuthService) Verifier() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -100,15 +121,36 @@ func (t *testAuthService) Exists(_ common.AccountType) bool {
 	return true
 }
 
-func (t *testAuthService) ExtractUserInformation(accountType common.AccountType, _ *goth.User) (*auth.UserInformation, error) {
+func (t *testAuthService) OIDCProviders() []string {
+	return []string{}
+}
+
+func (t *testAuthService) ExtractUserInformation(user *goth.User) (*auth.UserInformation, error) {
 	return &auth.UserInformation{
-		Provider:  accountType,
-		Ident:     "test-user",
-		Name:      "Test User",
-		AvatarURL: "",
+		Provider:     common.AccountType(strings.ToUpper(user.Provider)),
+		ProviderName: user.Provider,
+		Ident:        "test-user",
+		Name:         "Test User",
+		AvatarURL:    "",
 	}, nil
 }
 
+func (t *testAuthService) SAMLMetadata() (*saml.EntityDescriptor, error) {
+	return &saml.EntityDescriptor{}, nil
+}
+
+func (t *testAuthService) BeginSAMLAuth(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (t *testAuthService) CompleteSAMLAuth(_ http.ResponseWriter, _ *http.Request) (*auth.UserInformation, string, error) {
+	return &auth.UserInformation{
+		Provider: common.TypeSAML,
+		Ident:    "test-user",
+		Name:     "Test User",
+	}, "", nil
+}
+
 // Test suite for AnonymousCustomTemplateCreationContext middleware
 func TestAnonymousCustomTemplateCreationContext(t *testing.T) {
 	userID := uuid.New()
@@ -386,7 +428,7 @@ func TestTemplateRoutesMiddlewareIntegration(t *testing.T) {
 			sessionServiceMock := sessions.NewMockSessionService(t)
 
 			apiInitializer := serviceinitialize.NewApiInitializer("/")
-			userApi := apiInitializer.InitializeUserApi(mockUsers, sessionServiceMock, false, false)
+			userApi := apiInitializer.InitializeUserApi(mockUsers, sessionServiceMock, nil, false, false)
 			routesInitializer := serviceinitialize.NewRoutesInitializer()
 			userRoutes := routesInitializer.InitializeUserRoutes(userApi, sessionApiMock)
 			sessionRoutes := routesInitializer.InitializeSessionRoutes(sessionApiMock)
@@ -399,6 +441,9 @@ func TestTemplateRoutesMiddlewareIntegration(t *testing.T) {
 				mockAuth, // auth
 				userRoutes,
 				sessionRoutes,
+				chi.NewRouter(),                  // teamRoutes
+				chi.NewRouter(),                  // tokenRoutes
+				chi.NewRouter(),                  // adminRoutes
 				nil,                              // swaggerRoutes
 				nil,                              // boards
 				nil,                              // columns
@@ -413,6 +458,10 @@ func TestTemplateRoutesMiddlewareIntegration(t *testing.T) {
 				nil,                              // boardReactions
 				mockBoardTemplates,               // boardTemplates
 				mockColumnTemplates,              // columntemplates
+				nil,                              // actionItems
+				nil,                              // estimations
+				nil,                              // teams
+				nil,                              // audit
 				false,                            // verbose
 				true,                             // checkOrigin
 				false,                            // anonymousLoginDisabled
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"encoding/csv"
 	"errors"
 	"fmt"
@@ -8,10 +9,13 @@ import (
 	"strconv"
 
 	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/columns"
 	"scrumlr.io/server/hash"
 	"scrumlr.io/server/role"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
 
 	"scrumlr.io/server/boards"
 	"scrumlr.io/server/votings"
@@ -112,13 +116,78 @@ func (s *Server) deleteBoard(w http.ResponseWriter, r *http.Request) {
 	render.Respond(w, r, nil)
 }
 
+// Archive a board
+//
+//	@Summary		Archive a board
+//	@Description	Archive a board, archived boards are read-only for all participants
+//	@Tags			boards
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			id		path	string	true	"id of the board to archive"
+//	@Produce		json
+//	@Success		200	{object}	boards.Board
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{id}/archive [put]
+func (s *Server) archiveBoard(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.boards.api.archive")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+
+	b, err := s.boards.Archive(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to archive board")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, b)
+}
+
+// Unarchive a board
+//
+//	@Summary		Unarchive a board
+//	@Description	Restore an archived board, so that it can be edited again
+//	@Tags			boards
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			id		path	string	true	"id of the board to unarchive"
+//	@Produce		json
+//	@Success		200	{object}	boards.Board
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{id}/archive [delete]
+func (s *Server) unarchiveBoard(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.boards.api.unarchive")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+
+	b, err := s.boards.Unarchive(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to unarchive board")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, b)
+}
+
 // Get all boards
 //
 //	@Summary		Get all board
-//	@Description	Get all board
+//	@Description	Get all board, archived boards are only returned if requested
 //	@Tags			boards
 //	@Accept			json
-//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			Cookie		header	string	true	"jwt token to authenticate"
+//	@Param			archived	query	bool	false	"get archived boards instead of the active ones"
 //	@Produce		json
 //	@Success		200	{object}	boards.BoardOverview
 //	@Failure		400	{object}	common.APIError
@@ -131,7 +200,19 @@ func (s *Server) getBoards(w http.ResponseWriter, r *http.Request) {
 
 	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
 
-	boardIDs, err := s.boards.GetBoards(ctx, user)
+	archived := false
+	if archivedParam := r.URL.Query().Get("archived"); archivedParam != "" {
+		var err error
+		archived, err = strconv.ParseBool(archivedParam)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to parse archived filter")
+			span.RecordError(err)
+			common.Throw(w, r, common.BadRequestError(errors.New("invalid archived filter")))
+			return
+		}
+	}
+
+	boardIDs, err := s.boards.GetBoards(ctx, user, archived)
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to get boards")
 		span.RecordError(err)
@@ -158,8 +239,9 @@ func (s *Server) getBoards(w http.ResponseWriter, r *http.Request) {
 //	@Description	Get a board
 //	@Tags			boards
 //	@Accept			json
-//	@Param			Cookie	header	string	true	"jwt token to authenticate"
-//	@Param			id		path	string	true	"id of the board to get"
+//	@Param			Cookie			header	string	true	"jwt token to authenticate"
+//	@Param			id				path	string	true	"id of the board to get"
+//	@Param			lastSequence	query	int		false	"sequence of the last received event, to only receive the missed events when reconnecting to the websocket"
 //	@Produce		json
 //	@Success		200	{object}	boards.Board
 //	@Failure		400	{object}	common.APIError
@@ -269,6 +351,37 @@ func (s *Server) joinBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// members of the owning team may join regardless of the access policy
+	if b.Team.Valid {
+		member, err := s.teams.GetMember(ctx, b.Team.UUID, user)
+		var teamErr teams.TeamError
+		if err != nil && !(errors.As(err, &teamErr) && teamErr.Category == teams.NotFound) {
+			span.SetStatus(codes.Error, "failed to check team membership")
+			span.RecordError(err)
+			common.Throw(w, r, mapError(err))
+			return
+		}
+
+		if member != nil {
+			sessionRole := role.ParticipantRole
+			if member.Role.Includes(teams.Admin) {
+				sessionRole = role.ModeratorRole
+			}
+
+			_, err := s.sessions.Create(ctx, sessions.BoardSessionCreateRequest{Board: board, User: user, Role: sessionRole})
+			if err != nil {
+				span.SetStatus(codes.Error, "failed to create session")
+				span.RecordError(err)
+				common.Throw(w, r, mapError(err))
+				return
+			}
+
+			w.Header().Set("Location", s.buildRelativeURL(fmt.Sprintf(boardParticipantsPath, board, user)))
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+	}
+
 	if b.AccessPolicy == boards.Public {
 		_, err := s.sessions.Create(ctx, sessions.BoardSessionCreateRequest{Board: board, User: user, Role: role.ParticipantRole})
 		if err != nil {
@@ -388,6 +501,24 @@ func (s *Server) updateBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if body.RetentionDays != nil {
+		user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+		isOwner, err := s.sessions.OwnerSessionExists(ctx, boardId, user)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to verify board session")
+			span.RecordError(err)
+			log.Errorw("Unable to verify board session", "err", err)
+			common.Throw(w, r, common.InternalServerError)
+			return
+		}
+
+		if !isOwner {
+			span.SetStatus(codes.Error, "only the owner can change the retention")
+			common.Throw(w, r, common.ForbiddenError(errors.New("only the owner of the board can change its retention")))
+			return
+		}
+	}
+
 	body.ID = boardId
 	board, err := s.boards.Update(ctx, body)
 	if err != nil {
@@ -520,12 +651,12 @@ func (s *Server) incrementTimer(w http.ResponseWriter, r *http.Request) {
 // Export a board
 //
 //	@Summary		Export a board
-//	@Description	Export a board
+//	@Description	Export a board as json, csv, markdown, html or pdf depending on the Accept header
 //	@Tags			boards
-//	@Accept			json text/csv
+//	@Accept			json,text/csv,text/markdown,text/html,application/pdf
 //	@Param			Cookie	header	string	true	"jwt token to authenticate"
 //	@Param			id		path	string	true	"id of the board to export"
-//	@Produce		json text/csv
+//	@Produce		json,text/csv,text/markdown,text/html,application/pdf
 //	@Success		200	{object}	boards.Board
 //	@Failure		400	{object}	common.APIError
 //	@Failure		403	{object}	common.APIError
@@ -565,19 +696,33 @@ func (s *Server) exportBoard(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if r.Header.Get("Accept") == "" || r.Header.Get("Accept") == "*/*" || r.Header.Get("Accept") == "application/json" {
+		user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+		auditLog, err := s.exportAuditLog(ctx, boardId, user)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to get audit log")
+			span.RecordError(err)
+			log.Errorw("unable to get audit log for export", "board", boardId, "err", err)
+			common.Throw(w, r, mapError(err))
+			return
+		}
+
 		render.Status(r, http.StatusOK)
 		render.Respond(w, r, struct {
-			Board        *boards.Board            `json:"board"`
-			Participants []*sessions.BoardSession `json:"participants"`
-			Columns      []*columns.Column        `json:"columns"`
-			Notes        []*notes.Note            `json:"notes"`
-			Votings      []*votings.Voting        `json:"votings"`
+			Board        *boards.Board             `json:"board"`
+			Participants []*sessions.BoardSession  `json:"participants"`
+			Columns      []*columns.Column         `json:"columns"`
+			Notes        []*notes.Note             `json:"notes"`
+			Votings      []*votings.Voting         `json:"votings"`
+			ActionItems  []*actionitems.ActionItem `json:"actionItems"`
+			AuditLog     []*audit.Entry            `json:"auditLog,omitempty"`
 		}{
 			Board:        fullBoard.Board,
 			Participants: fullBoard.BoardSessions,
 			Columns:      visibleColumns,
 			Notes:        visibleNotes,
 			Votings:      fullBoard.Votings,
+			ActionItems:  fullBoard.ActionItems,
+			AuditLog:     auditLog,
 		})
 		return
 	} else if r.Header.Get("Accept") == "text/csv" {
@@ -651,6 +796,54 @@ func (s *Server) exportBoard(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		return
+	} else if r.Header.Get("Accept") == "text/markdown" || r.Header.Get("Accept") == "text/html" {
+		document, err := s.buildBoardDocument(ctx, fullBoard, visibleColumns, visibleNotes)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to build board document")
+			span.RecordError(err)
+			common.Throw(w, r, mapError(err))
+			return
+		}
+
+		renderDocument := renderMarkdown
+		if r.Header.Get("Accept") == "text/html" {
+			renderDocument = renderHTML
+		}
+
+		w.Header().Set("Content-Type", r.Header.Get("Accept")+"; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		if err := renderDocument(w, document); err != nil {
+			span.SetStatus(codes.Error, "failed to respond with document")
+			span.RecordError(err)
+			log.Errorw("failed to respond with document", "format", r.Header.Get("Accept"), "err", err)
+		}
+		return
+	} else if r.Header.Get("Accept") == "application/pdf" {
+		document, err := s.buildBoardDocument(ctx, fullBoard, visibleColumns, visibleNotes)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to build board document")
+			span.RecordError(err)
+			common.Throw(w, r, mapError(err))
+			return
+		}
+
+		var report bytes.Buffer
+		if err := renderPDF(&report, document); err != nil {
+			span.SetStatus(codes.Error, "failed to render pdf")
+			span.RecordError(err)
+			log.Errorw("failed to render pdf", "board", boardId, "err", err)
+			common.Throw(w, r, common.InternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.WriteHeader(http.StatusOK)
+		if _, err := report.WriteTo(w); err != nil {
+			span.SetStatus(codes.Error, "failed to respond with pdf")
+			span.RecordError(err)
+			log.Errorw("failed to respond with pdf", "err", err)
+		}
+		return
 	}
 
 	render.Status(r, http.StatusNotAcceptable)
@@ -0,0 +1,346 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/boards"
+	"scrumlr.io/server/columns"
+	"scrumlr.io/server/notes"
+	"scrumlr.io/server/votings"
+)
+
+// boardDocument is the presentation model shared by the markdown, html and pdf exports.
+type boardDocument struct {
+	Name         string
+	Description  string
+	Participants int
+	Columns      []documentColumn
+	ActionItems  []documentActionItem
+}
+
+type documentColumn struct {
+	Name        string
+	Description string
+	Notes       []documentNote
+}
+
+type documentNote struct {
+	Text        string
+	Author      string
+	Votes       int
+	LatestVotes int
+	Voters      []string
+	Reactions   []documentReaction
+	Stack       []documentNote
+}
+
+type documentReaction struct {
+	Type  string
+	Count int
+}
+
+type documentActionItem struct {
+	Text     string
+	Assignee string
+	Status   string
+	DueDate  string
+}
+
+// formerParticipantName is shown instead of the name of users who are no longer participants of the board
+const formerParticipantName = "Former participant"
+
+// buildBoardDocument groups the visible notes by column, nests stacked notes under their
+// stack parent and attaches vote totals of closed votings and reaction counts to every note.
+// Author names are only included if the board shows authors, voter names only for votings
+// that are not anonymous and reactions only if the board shows them.
+func (s *Server) buildBoardDocument(ctx context.Context, fullBoard *boards.FullBoard, visibleColumns []*columns.Column, visibleNotes []*notes.Note) (*boardDocument, error) {
+	names := make(map[uuid.UUID]string, len(fullBoard.BoardSessions))
+	for _, session := range fullBoard.BoardSessions {
+		user, err := s.users.Get(ctx, session.UserID)
+		if err != nil {
+			return nil, err
+		}
+		names[session.UserID] = user.Name
+	}
+
+	nameOf := func(id uuid.UUID) string {
+		if name, ok := names[id]; ok {
+			return name
+		}
+		return formerParticipantName
+	}
+
+	closedVotings := make([]*votings.Voting, 0, len(fullBoard.Votings))
+	for _, voting := range fullBoard.Votings {
+		if voting.Status == votings.Closed && voting.VotingResults != nil {
+			closedVotings = append(closedVotings, voting)
+		}
+	}
+
+	reactionCounts := make(map[uuid.UUID]map[string]int)
+	if fullBoard.Board.ShowNoteReactions {
+		for _, reaction := range fullBoard.Reactions {
+			if reactionCounts[reaction.Note] == nil {
+				reactionCounts[reaction.Note] = make(map[string]int)
+			}
+			reactionCounts[reaction.Note][string(reaction.ReactionType)]++
+		}
+	}
+
+	toDocumentNote := func(note *notes.Note) documentNote {
+		documentNote := documentNote{Text: note.Text}
+		if fullBoard.Board.ShowAuthors {
+			documentNote.Author = nameOf(note.Author)
+		}
+
+		for _, voting := range closedVotings {
+			result, ok := voting.VotingResults.Votes[note.ID]
+			if !ok {
+				continue
+			}
+			documentNote.Votes += result.Total
+			if !voting.IsAnonymous && result.Users != nil {
+				for _, userVotes := range *result.Users {
+					documentNote.Voters = append(documentNote.Voters, nameOf(userVotes.ID))
+				}
+			}
+		}
+		sort.Strings(documentNote.Voters)
+
+		// votings are ordered by creation date, so the first closed voting is the latest one
+		if len(closedVotings) > 0 {
+			documentNote.LatestVotes = closedVotings[0].VotingResults.Votes[note.ID].Total
+		}
+
+		for reactionType, count := range reactionCounts[note.ID] {
+			documentNote.Reactions = append(documentNote.Reactions, documentReaction{Type: reactionType, Count: count})
+		}
+		sort.Slice(documentNote.Reactions, func(i, j int) bool {
+			return documentNote.Reactions[i].Type < documentNote.Reactions[j].Type
+		})
+
+		return documentNote
+	}
+
+	sortedNotes := make([]*notes.Note, len(visibleNotes))
+	copy(sortedNotes, visibleNotes)
+	sort.SliceStable(sortedNotes, func(i, j int) bool {
+		return sortedNotes[i].Position.Rank > sortedNotes[j].Position.Rank
+	})
+
+	stacks := make(map[uuid.UUID][]documentNote)
+	for _, note := range sortedNotes {
+		if note.Position.Stack.Valid {
+			stacks[note.Position.Stack.UUID] = append(stacks[note.Position.Stack.UUID], toDocumentNote(note))
+		}
+	}
+
+	sortedColumns := make([]*columns.Column, len(visibleColumns))
+	copy(sortedColumns, visibleColumns)
+	sort.SliceStable(sortedColumns, func(i, j int) bool {
+		return sortedColumns[i].Index < sortedColumns[j].Index
+	})
+
+	document := &boardDocument{Name: "scrumlr.io", Participants: len(fullBoard.BoardSessions)}
+	if fullBoard.Board.Name != nil && *fullBoard.Board.Name != "" {
+		document.Name = *fullBoard.Board.Name
+	}
+	if fullBoard.Board.Description != nil {
+		document.Description = *fullBoard.Board.Description
+	}
+
+	for _, column := range sortedColumns {
+		documentColumn := documentColumn{Name: column.Name, Description: column.Description}
+		for _, note := range sortedNotes {
+			if note.Position.Column != column.ID || note.Position.Stack.Valid {
+				continue
+			}
+			documentNote := toDocumentNote(note)
+			documentNote.Stack = stacks[note.ID]
+			documentColumn.Notes = append(documentColumn.Notes, documentNote)
+		}
+		document.Columns = append(document.Columns, documentColumn)
+	}
+
+	for _, actionItem := range fullBoard.ActionItems {
+		documentActionItem := documentActionItem{Text: actionItem.Text, Status: string(actionItem.Status)}
+		if actionItem.Assignee.Valid {
+			documentActionItem.Assignee = nameOf(actionItem.Assignee.UUID)
+		}
+		if actionItem.DueDate != nil {
+			documentActionItem.DueDate = actionItem.DueDate.Format("2006-01-02")
+		}
+		document.ActionItems = append(document.ActionItems, documentActionItem)
+	}
+
+	return document, nil
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`,
+)
+
+// escapeMarkdown escapes markdown control characters and folds line breaks so that
+// multi-line note texts stay within their list item.
+func escapeMarkdown(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = markdownEscaper.Replace(line)
+	}
+	return strings.Join(lines, "<br>")
+}
+
+func writeMarkdownNote(builder *strings.Builder, note documentNote, indent string) {
+	builder.WriteString(indent)
+	builder.WriteString("- ")
+	builder.WriteString(escapeMarkdown(note.Text))
+
+	var details []string
+	if note.Author != "" {
+		details = append(details, "by "+escapeMarkdown(note.Author))
+	}
+	if note.Votes > 0 {
+		votes := fmt.Sprintf("%d votes", note.Votes)
+		if note.Votes == 1 {
+			votes = "1 vote"
+		}
+		if len(note.Voters) > 0 {
+			voters := make([]string, 0, len(note.Voters))
+			for _, voter := range note.Voters {
+				voters = append(voters, escapeMarkdown(voter))
+			}
+			votes += " from " + strings.Join(voters, ", ")
+		}
+		details = append(details, votes)
+	}
+	for _, reaction := range note.Reactions {
+		details = append(details, fmt.Sprintf("%s ×%d", reaction.Type, reaction.Count))
+	}
+	if len(details) > 0 {
+		builder.WriteString(" _(")
+		builder.WriteString(strings.Join(details, ", "))
+		builder.WriteString(")_")
+	}
+	builder.WriteString("\n")
+
+	for _, child := range note.Stack {
+		writeMarkdownNote(builder, child, indent+"  ")
+	}
+}
+
+// renderMarkdown writes the board document as a markdown document with one section per column.
+func renderMarkdown(w io.Writer, document *boardDocument) error {
+	var builder strings.Builder
+
+	builder.WriteString("# " + escapeMarkdown(document.Name) + "\n")
+	if document.Description != "" {
+		builder.WriteString("\n" + escapeMarkdown(document.Description) + "\n")
+	}
+
+	for _, column := range document.Columns {
+		builder.WriteString("\n## " + escapeMarkdown(column.Name) + "\n\n")
+		if column.Description != "" {
+			builder.WriteString(escapeMarkdown(column.Description) + "\n\n")
+		}
+		if len(column.Notes) == 0 {
+			builder.WriteString("_No notes_\n")
+			continue
+		}
+		for _, note := range column.Notes {
+			writeMarkdownNote(&builder, note, "")
+		}
+	}
+
+	if len(document.ActionItems) > 0 {
+		builder.WriteString("\n## Action items\n\n")
+		for _, actionItem := range document.ActionItems {
+			checkbox := "[ ]"
+			if actionItem.Status == string(actionitems.Done) {
+				checkbox = "[x]"
+			}
+			builder.WriteString("- " + checkbox + " " + escapeMarkdown(actionItem.Text))
+
+			var details []string
+			if actionItem.Assignee != "" {
+				details = append(details, "assigned to "+escapeMarkdown(actionItem.Assignee))
+			}
+			if actionItem.DueDate != "" {
+				details = append(details, "due "+actionItem.DueDate)
+			}
+			if len(details) > 0 {
+				builder.WriteString(" _(" + strings.Join(details, ", ") + ")_")
+			}
+			builder.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+var htmlExportTemplate = template.Must(template.New("board").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+{{- if .Description}}
+<p>{{.Description}}</p>
+{{- end}}
+{{- range .Columns}}
+<section>
+<h2>{{.Name}}</h2>
+{{- if .Description}}
+<p>{{.Description}}</p>
+{{- end}}
+{{- if .Notes}}
+<ul>
+{{- range .Notes}}{{template "note" .}}{{end}}
+</ul>
+{{- else}}
+<p><em>No notes</em></p>
+{{- end}}
+</section>
+{{- end}}
+{{- if .ActionItems}}
+<section>
+<h2>Action items</h2>
+<ul>
+{{- range .ActionItems}}
+<li>{{if eq .Status "DONE"}}&#9745;{{else}}&#9744;{{end}} {{.Text}}
+{{- if .Assignee}} <small>assigned to {{.Assignee}}</small>{{end}}
+{{- if .DueDate}} <small>due {{.DueDate}}</small>{{end}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+</body>
+</html>
+{{define "note"}}
+<li><p>{{.Text}}</p>
+{{- if .Author}}<small>by {{.Author}}</small>{{end}}
+{{- if .Votes}} <small>{{.Votes}} {{if eq .Votes 1}}vote{{else}}votes{{end}}{{if .Voters}} from {{range $i, $voter := .Voters}}{{if $i}}, {{end}}{{$voter}}{{end}}{{end}}</small>{{end}}
+{{- range .Reactions}} <small>{{.Type}} &times;{{.Count}}</small>{{end}}
+{{- if .Stack}}
+<ul>
+{{- range .Stack}}{{template "note" .}}{{end}}
+</ul>
+{{- end}}
+</li>
+{{- end}}`))
+
+// renderHTML writes the board document as a standalone html page with one section per column.
+func renderHTML(w io.Writer, document *boardDocument) error {
+	return htmlExportTemplate.Execute(w, document)
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	"scrumlr.io/server/actionitems"
+)
+
+const (
+	pdfLineHeight = 5.5
+	pdfNoteIndent = 6.0
+)
+
+// renderPDF writes a printable summary of the board document. The notes of every column
+// are ranked by the votes they received in the latest closed voting. Only the core fonts
+// are used so nothing has to be embedded or fetched.
+func renderPDF(w io.Writer, document *boardDocument) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetTitle(document.Name, true)
+	pdf.SetCreator("scrumlr.io", true)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 5, fmt.Sprintf("%d/{nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.MultiCell(0, 9, tr(document.Name), "", "L", false)
+
+	pdf.SetFont("Helvetica", "", 11)
+	if document.Description != "" {
+		pdf.MultiCell(0, pdfLineHeight, tr(document.Description), "", "L", false)
+	}
+	pdf.SetTextColor(96, 96, 96)
+	pdf.MultiCell(0, pdfLineHeight, fmt.Sprintf("Participants: %d", document.Participants), "", "L", false)
+	pdf.SetTextColor(0, 0, 0)
+
+	for _, column := range document.Columns {
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.MultiCell(0, 7, tr(column.Name), "B", "L", false)
+		pdf.Ln(1)
+
+		if len(column.Notes) == 0 {
+			pdf.SetFont("Helvetica", "I", 10)
+			pdf.MultiCell(0, pdfLineHeight, "No notes", "", "L", false)
+			continue
+		}
+
+		rankedNotes := make([]documentNote, len(column.Notes))
+		copy(rankedNotes, column.Notes)
+		sort.SliceStable(rankedNotes, func(i, j int) bool {
+			return rankedNotes[i].LatestVotes > rankedNotes[j].LatestVotes
+		})
+
+		for _, note := range rankedNotes {
+			writePDFNote(pdf, tr, note, 0)
+		}
+	}
+
+	if len(document.ActionItems) > 0 {
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.MultiCell(0, 7, "Action items", "B", "L", false)
+		pdf.Ln(1)
+
+		for _, actionItem := range document.ActionItems {
+			checkbox := "[ ]"
+			if actionItem.Status == string(actionitems.Done) {
+				checkbox = "[x]"
+			}
+
+			pdf.SetFont("Helvetica", "", 11)
+			pdf.MultiCell(0, pdfLineHeight, tr(checkbox+" "+actionItem.Text), "", "L", false)
+
+			var details []string
+			if actionItem.Assignee != "" {
+				details = append(details, "assigned to "+actionItem.Assignee)
+			}
+			if actionItem.DueDate != "" {
+				details = append(details, "due "+actionItem.DueDate)
+			}
+			if len(details) > 0 {
+				left, _, _, _ := pdf.GetMargins()
+				pdf.SetX(left + pdfNoteIndent)
+				pdf.SetFont("Helvetica", "I", 9)
+				pdf.SetTextColor(96, 96, 96)
+				pdf.MultiCell(0, pdfLineHeight, tr(strings.Join(details, ", ")), "", "L", false)
+				pdf.SetTextColor(0, 0, 0)
+			}
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+func writePDFNote(pdf *fpdf.Fpdf, tr func(string) string, note documentNote, depth int) {
+	left, _, _, _ := pdf.GetMargins()
+	indent := float64(depth) * pdfNoteIndent
+
+	votes := ""
+	if note.LatestVotes > 0 {
+		votes = fmt.Sprintf(" (%d)", note.LatestVotes)
+	}
+
+	pdf.SetX(left + indent)
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.MultiCell(0, pdfLineHeight, tr("- "+note.Text+votes), "", "L", false)
+
+	if note.Author != "" {
+		pdf.SetX(left + indent + pdfNoteIndent)
+		pdf.SetFont("Helvetica", "I", 9)
+		pdf.SetTextColor(96, 96, 96)
+		pdf.MultiCell(0, pdfLineHeight-1, tr("by "+note.Author), "", "L", false)
+		pdf.SetTextColor(0, 0, 0)
+	}
+
+	for _, child := range note.Stack {
+		writePDFNote(pdf, tr, child, depth+1)
+	}
+}
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/boards"
+	"scrumlr.io/server/columns"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/notes"
+	"scrumlr.io/server/reactions"
+	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/technical_helper"
+	"scrumlr.io/server/users"
+	"scrumlr.io/server/votings"
+)
+
+type BoardExportTestSuite struct {
+	suite.Suite
+	boardID  uuid.UUID
+	authorID uuid.UUID
+	voterID  uuid.UUID
+	noteID   uuid.UUID
+}
+
+func TestBoardExportTestSuite(t *testing.T) {
+	suite.Run(t, new(BoardExportTestSuite))
+}
+
+func (suite *BoardExportTestSuite) SetupTest() {
+	suite.boardID = uuid.New()
+	suite.authorID = uuid.New()
+	suite.voterID = uuid.New()
+	suite.noteID = uuid.New()
+}
+
+func (suite *BoardExportTestSuite) fullBoard(showAuthors bool, anonymousVoting bool) *boards.FullBoard {
+	name := "Sprint 42"
+	columnID := uuid.New()
+	hiddenColumnID := uuid.New()
+	childID := uuid.New()
+
+	userVotes := []votings.VotingResultsPerUser{{ID: suite.voterID, Total: 2}}
+	votingResults := votings.VotingResultsPerNote{Total: 2, Users: &userVotes}
+	if anonymousVoting {
+		votingResults.Users = nil
+	}
+
+	return &boards.FullBoard{
+		Board: &boards.Board{ID: suite.boardID, Name: &name, ShowAuthors: showAuthors, ShowNoteReactions: true},
+		BoardSessions: []*sessions.BoardSession{
+			{UserID: suite.authorID},
+			{UserID: suite.voterID},
+		},
+		Columns: []*columns.Column{
+			{ID: columnID, Name: "Went well", Visible: true},
+			{ID: hiddenColumnID, Name: "Secret", Visible: false},
+		},
+		Notes: []*notes.Note{
+			{ID: suite.noteID, Author: suite.authorID, Text: "Pairing *worked*", Position: notes.NotePosition{Column: columnID, Rank: 1}},
+			{ID: childID, Author: suite.authorID, Text: "Mob programming", Position: notes.NotePosition{Column: columnID, Stack: uuid.NullUUID{UUID: suite.noteID, Valid: true}}},
+			{ID: uuid.New(), Author: suite.authorID, Text: "Hidden thoughts", Position: notes.NotePosition{Column: hiddenColumnID}},
+		},
+		Reactions: []*reactions.Reaction{
+			{ID: uuid.New(), Note: suite.noteID, User: suite.voterID, ReactionType: reactions.Heart},
+		},
+		Votings: []*votings.Voting{
+			{
+				ID:            uuid.New(),
+				Status:        votings.Closed,
+				IsAnonymous:   anonymousVoting,
+				VotingResults: &votings.VotingResults{Total: 2, Votes: map[uuid.UUID]votings.VotingResultsPerNote{suite.noteID: votingResults}},
+			},
+		},
+	}
+}
+
+func (suite *BoardExportTestSuite) exportBoard(accept string, fullBoard *boards.FullBoard) *httptest.ResponseRecorder {
+	s := new(Server)
+	boardMock := boards.NewMockBoardService(suite.T())
+	userMock := users.NewMockUserService(suite.T())
+	s.boards = boardMock
+	s.users = userMock
+
+	boardMock.EXPECT().FullBoard(mock.Anything, suite.boardID).Return(fullBoard, nil)
+	userMock.EXPECT().Get(mock.Anything, suite.authorID).Return(&users.User{ID: suite.authorID, Name: "Alice"}, nil)
+	userMock.EXPECT().Get(mock.Anything, suite.voterID).Return(&users.User{ID: suite.voterID, Name: "Bob"}, nil)
+
+	req := technical_helper.NewTestRequestBuilder("GET", "/", nil)
+	req.Req.Header.Set("Accept", accept)
+	req.AddToContext(identifiers.BoardIdentifier, suite.boardID)
+
+	rr := httptest.NewRecorder()
+	s.exportBoard(rr, req.Request())
+	return rr
+}
+
+func (suite *BoardExportTestSuite) TestExportMarkdown() {
+	rr := suite.exportBoard("text/markdown", suite.fullBoard(true, false))
+
+	suite.Equal(http.StatusOK, rr.Result().StatusCode)
+	suite.Equal("text/markdown; charset=utf-8", rr.Result().Header.Get("Content-Type"))
+	suite.Equal(`# Sprint 42
+
+## Went well
+
+- Pairing \*worked\* _(by Alice, 2 votes from Bob, heart ×1)_
+  - Mob programming _(by Alice)_
+`, rr.Body.String())
+}
+
+func (suite *BoardExportTestSuite) TestExportMarkdown_HidesAuthorsAndAnonymousVoters() {
+	rr := suite.exportBoard("text/markdown", suite.fullBoard(false, true))
+
+	suite.Equal(http.StatusOK, rr.Result().StatusCode)
+	suite.Equal(`# Sprint 42
+
+## Went well
+
+- Pairing \*worked\* _(2 votes, heart ×1)_
+  - Mob programming
+`, rr.Body.String())
+}
+
+func (suite *BoardExportTestSuite) TestExportMarkdown_HidesReactions() {
+	fullBoard := suite.fullBoard(true, false)
+	fullBoard.Board.ShowNoteReactions = false
+
+	rr := suite.exportBoard("text/markdown", fullBoard)
+
+	suite.Equal(http.StatusOK, rr.Result().StatusCode)
+	suite.Equal(`# Sprint 42
+
+## Went well
+
+- Pairing \*worked\* _(by Alice, 2 votes from Bob)_
+  - Mob programming _(by Alice)_
+`, rr.Body.String())
+}
+
+func (suite *BoardExportTestSuite) TestBuildBoardDocument_FormerParticipants() {
+	s := new(Server)
+	userMock := users.NewMockUserService(suite.T())
+	s.users = userMock
+	userMock.EXPECT().Get(mock.Anything, suite.authorID).Return(&users.User{ID: suite.authorID, Name: "Alice"}, nil)
+
+	// the voter left the board after the voting
+	fullBoard := suite.fullBoard(true, false)
+	fullBoard.BoardSessions = fullBoard.BoardSessions[:1]
+
+	document, err := s.buildBoardDocument(context.Background(), fullBoard, fullBoard.Columns[:1], fullBoard.Notes[:2])
+
+	suite.Nil(err)
+	suite.Equal([]string{formerParticipantName}, document.Columns[0].Notes[0].Voters)
+}
+
+func (suite *BoardExportTestSuite) TestExportHTML() {
+	fullBoard := suite.fullBoard(true, false)
+	fullBoard.Notes[0].Text = "<script>alert(1)</script>"
+
+	rr := suite.exportBoard("text/html", fullBoard)
+
+	suite.Equal(http.StatusOK, rr.Result().StatusCode)
+	suite.Equal("text/html; charset=utf-8", rr.Result().Header.Get("Content-Type"))
+	body := rr.Body.String()
+	suite.Contains(body, "<h1>Sprint 42</h1>")
+	suite.Contains(body, "<h2>Went well</h2>")
+	suite.Contains(body, "&lt;script&gt;alert(1)&lt;/script&gt;")
+	suite.Contains(body, "<small>by Alice</small> <small>2 votes from Bob</small> <small>heart &times;1</small>")
+	suite.Contains(body, "<li><p>Mob programming</p><small>by Alice</small>")
+	suite.NotContains(body, "Secret")
+	suite.NotContains(body, "Hidden thoughts")
+}
+
+func (suite *BoardExportTestSuite) TestExportPDF() {
+	rr := suite.exportBoard("application/pdf", suite.fullBoard(true, false))
+
+	suite.Equal(http.StatusOK, rr.Result().StatusCode)
+	suite.Equal("application/pdf", rr.Result().Header.Get("Content-Type"))
+	suite.True(strings.HasPrefix(rr.Body.String(), "%PDF-"))
+}
+
+func (suite *BoardExportTestSuite) TestBuildBoardDocument_LatestVotesFromFirstClosedVoting() {
+	s := new(Server)
+	userMock := users.NewMockUserService(suite.T())
+	s.users = userMock
+	userMock.EXPECT().Get(mock.Anything, suite.authorID).Return(&users.User{ID: suite.authorID, Name: "Alice"}, nil)
+	userMock.EXPECT().Get(mock.Anything, suite.voterID).Return(&users.User{ID: suite.voterID, Name: "Bob"}, nil)
+
+	fullBoard := suite.fullBoard(true, true)
+	olderVoting := &votings.Voting{
+		ID:            uuid.New(),
+		Status:        votings.Closed,
+		IsAnonymous:   true,
+		VotingResults: &votings.VotingResults{Total: 5, Votes: map[uuid.UUID]votings.VotingResultsPerNote{suite.noteID: {Total: 5}}},
+	}
+	fullBoard.Votings = append(fullBoard.Votings, olderVoting)
+
+	document, err := s.buildBoardDocument(context.Background(), fullBoard, fullBoard.Columns[:1], fullBoard.Notes[:2])
+
+	suite.Nil(err)
+	suite.Equal(2, document.Participants)
+	suite.Len(document.Columns, 1)
+	suite.Len(document.Columns[0].Notes, 1)
+	suite.Equal(7, document.Columns[0].Notes[0].Votes)
+	suite.Equal(2, document.Columns[0].Notes[0].LatestVotes)
+	suite.Len(document.Columns[0].Notes[0].Stack, 1)
+}
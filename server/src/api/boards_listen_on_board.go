@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"scrumlr.io/server/websocket"
@@ -32,8 +33,9 @@ type BoardSubscription struct {
 }
 
 type InitEvent struct {
-	Type realtime.BoardEventType `json:"type"`
-	Data boards.FullBoard        `json:"data"`
+	Type     realtime.BoardEventType `json:"type"`
+	Sequence uint64                  `json:"sequence,omitempty"`
+	Data     boards.FullBoard        `json:"data"`
 }
 
 const MaxRetries = 10
@@ -63,6 +65,15 @@ func (s *Server) openBoardSocket(w http.ResponseWriter, r *http.Request) {
 	}
 	defer s.closeBoardSocket(context.Background(), id, userID, conn, "normal closure")
 
+	// the sequence is read before the board, so that no event between both gets lost
+	var sequence uint64
+	if s.realtime.EventLog != nil {
+		sequence, err = s.realtime.EventLog.Current(ctx, id)
+		if err != nil {
+			log.Warnw("failed to get current event sequence", "board", id, "err", err)
+		}
+	}
+
 	fullBoard, err := s.boards.FullBoard(ctx, id)
 	if err != nil {
 		message := "failed to get full board"
@@ -73,13 +84,21 @@ func (s *Server) openBoardSocket(w http.ResponseWriter, r *http.Request) {
 	}
 
 	initEvent := InitEvent{
-		Type: realtime.BoardEventInit,
-		Data: *fullBoard,
+		Type:     realtime.BoardEventInit,
+		Sequence: sequence,
+		Data:     *fullBoard,
 	}
 
 	initEvent = eventInitFilter(initEvent, userID)
 
-	err = conn.WriteJSON(ctx, initEvent)
+	replayed := false
+	if lastSequence, err := strconv.ParseUint(r.URL.Query().Get("lastSequence"), 10, 64); err == nil {
+		replayed = s.replayBoardEvents(ctx, id, userID, conn, initEvent.Data, lastSequence)
+	}
+
+	if !replayed {
+		err = conn.WriteJSON(ctx, initEvent)
+	}
 	if err != nil {
 		message := "failed to send init message"
 		span.SetStatus(codes.Error, message)
@@ -127,9 +146,10 @@ func (s *Server) listenOnBoard(ctx context.Context, boardID, userID uuid.UUID, c
 	b.boardNotes = initEventData.Notes
 	b.boardReactions = initEventData.Reactions
 
-	// if not already done, start listening to board changes
+	// if not already done, start listening to board changes. The subscription is shared by all
+	// clients of the board, so it must not end with the request of the client that opened it.
 	if b.subscription == nil {
-		ch, err := s.getBoardChannelWithRetry(ctx, boardID, retryDelay)
+		ch, err := s.getBoardChannelWithRetry(context.WithoutCancel(ctx), boardID, retryDelay)
 		if err != nil {
 			log.Errorw("could not establish board subscription after retries", "board", boardID, "err", err)
 			return
@@ -139,6 +159,49 @@ func (s *Server) listenOnBoard(ctx context.Context, boardID, userID uuid.UUID, c
 	}
 }
 
+// replayBoardEvents sends the events the client missed since the given sequence instead of the whole board.
+// It returns false if the events are no longer available, in which case the client needs an init event.
+func (s *Server) replayBoardEvents(ctx context.Context, boardID, userID uuid.UUID, conn websocket.Connection, initEventData boards.FullBoard, lastSequence uint64) bool {
+	ctx, span := tracer.Start(ctx, "scrumlr.listen.api.socket.replay")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	if s.realtime.EventLog == nil {
+		return false
+	}
+
+	events, ok, err := s.realtime.EventLog.Since(ctx, boardID, lastSequence)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get missed events")
+		span.RecordError(err)
+		log.Warnw("failed to get missed events", "board", boardID, "user", userID, "sequence", lastSequence, "err", err)
+		return false
+	}
+	if !ok {
+		log.Debugw("missed events are no longer available", "board", boardID, "user", userID, "sequence", lastSequence)
+		return false
+	}
+
+	// filter with a separate subscription, so that the replayed events do not overwrite the current state
+	replay := &BoardSubscription{
+		boardParticipants: initEventData.BoardSessions,
+		boardSettings:     initEventData.Board,
+		boardColumns:      initEventData.Columns,
+		boardNotes:        initEventData.Notes,
+		boardReactions:    initEventData.Reactions,
+	}
+	for _, event := range events {
+		if err := conn.WriteJSON(ctx, replay.eventFilter(event, userID)); err != nil {
+			span.SetStatus(codes.Error, "failed to send missed event")
+			span.RecordError(err)
+			log.Warnw("failed to send missed event", "board", boardID, "user", userID, "sequence", event.Sequence, "err", err)
+			return false
+		}
+	}
+
+	return true
+}
+
 func (s *Server) getBoardChannelWithRetry(ctx context.Context, boardID uuid.UUID, retryDelay time.Duration) (chan *realtime.BoardEvent, error) {
 	log := logger.FromContext(ctx)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"scrumlr.io/server/boards"
+	"scrumlr.io/server/cache"
 	"scrumlr.io/server/realtime"
 	"scrumlr.io/server/websocket"
 )
@@ -76,3 +77,72 @@ func (suite *BoardsListenIntegrationTestSuite) TestListenOnBoard_FailsAfterMaxRe
 	savedSubscription := s.boardSubscriptions[boardID].subscription
 	assert.Nil(t, savedSubscription, "No subscription should be stored if all retries fail")
 }
+
+func (suite *BoardsListenIntegrationTestSuite) TestReplayBoardEvents() {
+	t := suite.T()
+
+	boardID := uuid.New()
+	userID := uuid.New()
+	conn := websocket.NewMockConnection(t)
+
+	fullBoard := boards.FullBoard{
+		Board: &boards.Board{ID: boardID},
+	}
+
+	mockCache := cache.NewMockClient(t)
+	mockCache.EXPECT().GetList(mock.Anything, fmt.Sprintf("board-events.%s", boardID)).Return([][]byte{
+		[]byte(`{"type":"BOARD_TIMER_UPDATED","sequence":4}`),
+		[]byte(`{"type":"BOARD_TIMER_UPDATED","sequence":5}`),
+		[]byte(`{"type":"BOARD_TIMER_UPDATED","sequence":6}`),
+	}, nil)
+
+	conn.EXPECT().WriteJSON(mock.Anything, &realtime.BoardEvent{Type: realtime.BoardEventBoardTimerUpdated, Sequence: 5}).Return(nil).Once()
+	conn.EXPECT().WriteJSON(mock.Anything, &realtime.BoardEvent{Type: realtime.BoardEventBoardTimerUpdated, Sequence: 6}).Return(nil).Once()
+
+	s := &Server{
+		realtime: &realtime.Broker{EventLog: realtime.NewEventLog(&cache.Cache{Con: mockCache}, realtime.DefaultEventLogSize, realtime.DefaultEventLogTTL)},
+	}
+
+	replayed := s.replayBoardEvents(context.Background(), boardID, userID, conn, fullBoard, 4)
+
+	assert.True(t, replayed)
+}
+
+func (suite *BoardsListenIntegrationTestSuite) TestReplayBoardEvents_EventsNoLongerAvailable() {
+	t := suite.T()
+
+	boardID := uuid.New()
+	userID := uuid.New()
+	conn := websocket.NewMockConnection(t)
+
+	fullBoard := boards.FullBoard{
+		Board: &boards.Board{ID: boardID},
+	}
+
+	mockCache := cache.NewMockClient(t)
+	mockCache.EXPECT().GetList(mock.Anything, fmt.Sprintf("board-events.%s", boardID)).Return([][]byte{
+		[]byte(`{"type":"BOARD_TIMER_UPDATED","sequence":6}`),
+	}, nil)
+
+	s := &Server{
+		realtime: &realtime.Broker{EventLog: realtime.NewEventLog(&cache.Cache{Con: mockCache}, realtime.DefaultEventLogSize, realtime.DefaultEventLogTTL)},
+	}
+
+	replayed := s.replayBoardEvents(context.Background(), boardID, userID, conn, fullBoard, 2)
+
+	assert.False(t, replayed)
+}
+
+func (suite *BoardsListenIntegrationTestSuite) TestReplayBoardEvents_WithoutEventLog() {
+	t := suite.T()
+
+	conn := websocket.NewMockConnection(t)
+
+	s := &Server{
+		realtime: new(realtime.Broker),
+	}
+
+	replayed := s.replayBoardEvents(context.Background(), uuid.New(), uuid.New(), conn, boards.FullBoard{}, 2)
+
+	assert.False(t, replayed)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,6 +26,7 @@ import (
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/identifiers"
 	"scrumlr.io/server/sessionrequests"
+	"scrumlr.io/server/teams"
 )
 
 type BoardTestSuite struct {
@@ -196,7 +198,7 @@ func (suite *BoardTestSuite) TestGetBoards() {
 			req := technical_helper.NewTestRequestBuilder("POST", "/", nil).
 				AddToContext(identifiers.UserIdentifier, userID)
 
-			boardMock.EXPECT().GetBoards(mock.Anything, userID).Return(boardIDs, te.err)
+			boardMock.EXPECT().GetBoards(mock.Anything, userID, false).Return(boardIDs, te.err)
 			if te.err == nil {
 				boardMock.EXPECT().BoardOverview(mock.Anything, boardIDs, userID).Return([]*boards.BoardOverview{{
 					Board:        firstBoard,
@@ -412,6 +414,54 @@ func (suite *BoardTestSuite) TestJoinBoard_PublicCreatesParticipantLocation() {
 	sessionRequestMock.AssertExpectations(suite.T())
 }
 
+func (suite *BoardTestSuite) TestJoinBoard_TeamAdminJoinsAsModerator() {
+	s, boardMock, sessionMock, sessionRequestMock, boardID, userID, req := suite.setupRootJoinBoardRequest()
+	teamMock := teams.NewMockTeamService(suite.T())
+	s.teams = teamMock
+
+	teamID := uuid.New()
+	board := suite.createBoard(nil, nil, boards.ByInvite, nil, nil)
+	board.Team = uuid.NullUUID{UUID: teamID, Valid: true}
+	sessionMock.EXPECT().Exists(mock.Anything, boardID, userID).Return(false, nil)
+	boardMock.EXPECT().Get(mock.Anything, boardID).Return(board, nil)
+	teamMock.EXPECT().GetMember(mock.Anything, teamID, userID).Return(&teams.TeamMember{User: userID, Role: teams.Admin}, nil)
+	sessionMock.EXPECT().Create(mock.Anything, sessions.BoardSessionCreateRequest{Board: boardID, User: userID, Role: role.ModeratorRole}).
+		Return(new(sessions.BoardSession), nil)
+
+	rr := httptest.NewRecorder()
+	s.joinBoard(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Result().StatusCode)
+	suite.Equal(fmt.Sprintf("/boards/%s/participants/%s", boardID, userID), rr.Result().Header.Get("Location"))
+	boardMock.AssertExpectations(suite.T())
+	sessionMock.AssertExpectations(suite.T())
+	sessionRequestMock.AssertExpectations(suite.T())
+}
+
+func (suite *BoardTestSuite) TestJoinBoard_TeamBoardNonMemberFollowsAccessPolicy() {
+	s, boardMock, sessionMock, sessionRequestMock, boardID, userID, req := suite.setupRootJoinBoardRequest()
+	teamMock := teams.NewMockTeamService(suite.T())
+	s.teams = teamMock
+
+	teamID := uuid.New()
+	board := suite.createBoard(nil, nil, boards.Public, nil, nil)
+	board.Team = uuid.NullUUID{UUID: teamID, Valid: true}
+	sessionMock.EXPECT().Exists(mock.Anything, boardID, userID).Return(false, nil)
+	boardMock.EXPECT().Get(mock.Anything, boardID).Return(board, nil)
+	teamMock.EXPECT().GetMember(mock.Anything, teamID, userID).
+		Return(nil, teams.CreateTeamError(teams.NotFound, "team member not found", sql.ErrNoRows))
+	sessionMock.EXPECT().Create(mock.Anything, sessions.BoardSessionCreateRequest{Board: boardID, User: userID, Role: role.ParticipantRole}).
+		Return(new(sessions.BoardSession), nil)
+
+	rr := httptest.NewRecorder()
+	s.joinBoard(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Result().StatusCode)
+	boardMock.AssertExpectations(suite.T())
+	sessionMock.AssertExpectations(suite.T())
+	sessionRequestMock.AssertExpectations(suite.T())
+}
+
 func (suite *BoardTestSuite) TestJoinBoard_ByInviteExistingRequestLocation() {
 	s, boardMock, sessionMock, sessionRequestMock, boardID, userID, req := suite.setupRootJoinBoardRequest()
 
@@ -496,6 +546,46 @@ func (suite *BoardTestSuite) TestUpdateBoards() {
 	}
 }
 
+func (suite *BoardTestSuite) TestUpdateBoardRetentionDays() {
+	tests := []struct {
+		name         string
+		isOwner      bool
+		expectedCode int
+	}{
+		{name: "Owner changes the retention", isOwner: true, expectedCode: http.StatusOK},
+		{name: "Moderator cannot change the retention", isOwner: false, expectedCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			boardMock := boards.NewMockBoardService(suite.T())
+			sessionMock := sessions.NewMockSessionService(suite.T())
+			s.boards = boardMock
+			s.sessions = sessionMock
+			boardID := uuid.New()
+			userID := uuid.New()
+
+			req := technical_helper.NewTestRequestBuilder("PUT", fmt.Sprintf("/%s", boardID), strings.NewReader(`{"retentionDays": 0}`)).
+				AddToContext(identifiers.BoardIdentifier, boardID).
+				AddToContext(identifiers.UserIdentifier, userID)
+
+			sessionMock.EXPECT().OwnerSessionExists(mock.Anything, boardID, userID).Return(tt.isOwner, nil)
+			if tt.isOwner {
+				boardMock.EXPECT().Update(mock.Anything, boards.BoardUpdateRequest{ID: boardID, RetentionDays: new(0)}).Return(new(boards.Board), nil)
+			}
+
+			rr := httptest.NewRecorder()
+
+			s.updateBoard(rr, req.Request())
+
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+			boardMock.AssertExpectations(suite.T())
+			sessionMock.AssertExpectations(suite.T())
+		})
+	}
+}
+
 func (suite *BoardTestSuite) TestSetTimer() {
 
 	testParameterBundles := *TestParameterBundles{}.
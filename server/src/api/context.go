@@ -144,6 +144,40 @@ func (s *Server) BoardOwnerContext(next http.Handler) http.Handler {
 	})
 }
 
+// BoardNotArchivedContext rejects all requests that would change an archived board, regardless of the role
+// of the user. Reading an archived board is still allowed.
+func (s *Server) BoardNotArchivedContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !common.IsMutatingRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		log := logger.FromRequest(r)
+
+		boardParam := chi.URLParam(r, "id")
+		board, err := uuid.Parse(boardParam)
+		if err != nil {
+			common.Throw(w, r, common.BadRequestError(errors.New("invalid board id")))
+			return
+		}
+
+		settings, err := s.boards.Get(r.Context(), board)
+		if err != nil {
+			log.Errorw("unable to check if board is archived", "board", board, "err", err)
+			common.Throw(w, r, mapError(err))
+			return
+		}
+
+		if settings.ArchivedAt != nil {
+			common.Throw(w, r, common.ForbiddenError(errors.New("board is archived")))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (s *Server) BoardEditableContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromRequest(r)
@@ -307,6 +341,20 @@ func (s *Server) NoteContext(next http.Handler) http.Handler {
 	})
 }
 
+func (s *Server) NoteRevisionContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		revisionParam := chi.URLParam(r, "revision")
+		revision, err := uuid.Parse(revisionParam)
+		if err != nil {
+			common.Throw(w, r, common.BadRequestError(errors.New("invalid revision id")))
+			return
+		}
+
+		revisionContext := context.WithValue(r.Context(), identifiers.NoteRevisionIdentifier, revision)
+		next.ServeHTTP(w, r.WithContext(revisionContext))
+	})
+}
+
 func (s *Server) ReactionContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		reactionParam := chi.URLParam(r, "reaction")
@@ -321,6 +369,34 @@ func (s *Server) ReactionContext(next http.Handler) http.Handler {
 	})
 }
 
+func (s *Server) ActionItemContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actionItemParam := chi.URLParam(r, "actionItem")
+		actionItem, err := uuid.Parse(actionItemParam)
+		if err != nil {
+			common.Throw(w, r, common.BadRequestError(errors.New("invalid action item id")))
+			return
+		}
+
+		actionItemContext := context.WithValue(r.Context(), identifiers.ActionItemIdentifier, actionItem)
+		next.ServeHTTP(w, r.WithContext(actionItemContext))
+	})
+}
+
+func (s *Server) EstimationContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		estimationParam := chi.URLParam(r, "estimation")
+		estimation, err := uuid.Parse(estimationParam)
+		if err != nil {
+			common.Throw(w, r, common.BadRequestError(errors.New("invalid estimation id")))
+			return
+		}
+
+		estimationContext := context.WithValue(r.Context(), identifiers.EstimationIdentifier, estimation)
+		next.ServeHTTP(w, r.WithContext(estimationContext))
+	})
+}
+
 func (s *Server) VotingContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		votingParam := chi.URLParam(r, "voting")
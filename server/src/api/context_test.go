@@ -2,15 +2,18 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"scrumlr.io/server/boards"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/identifiers"
 	"scrumlr.io/server/sessions"
@@ -265,3 +268,87 @@ func TestBoardModeratorContext_DoesNotExists(t *testing.T) {
 	assert.Equal(t, http.StatusForbidden, rr.Code)
 	assert.False(t, nextCalled)
 }
+
+func TestBoardNotArchivedContext(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		archived       bool
+		expectedStatus int
+		expectedToCall bool
+	}{
+		{name: "reading an archived board is allowed", method: http.MethodGet, archived: true, expectedStatus: http.StatusOK, expectedToCall: true},
+		{name: "changing an active board is allowed", method: http.MethodPut, archived: false, expectedStatus: http.StatusOK, expectedToCall: true},
+		{name: "changing an archived board is forbidden", method: http.MethodPut, archived: true, expectedStatus: http.StatusForbidden, expectedToCall: false},
+		{name: "joining an archived board is forbidden", method: http.MethodPost, archived: true, expectedStatus: http.StatusForbidden, expectedToCall: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boardId := uuid.New()
+
+			boardMock := boards.NewMockBoardService(t)
+			if tt.method != http.MethodGet {
+				var archivedAt *time.Time
+				if tt.archived {
+					now := time.Now()
+					archivedAt = &now
+				}
+				boardMock.EXPECT().Get(mock.Anything, boardId).
+					Return(&boards.Board{ID: boardId, ArchivedAt: archivedAt}, nil)
+			}
+
+			server := &Server{
+				boards: boardMock,
+			}
+
+			req := httptest.NewRequest(tt.method, fmt.Sprintf("/boards/%s", boardId), nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("id", boardId.String())
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+
+			rr := httptest.NewRecorder()
+
+			nextCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+			})
+
+			handler := server.BoardNotArchivedContext(next)
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectedToCall, nextCalled)
+		})
+	}
+}
+
+func TestBoardNotArchivedContext_BoardNotFound(t *testing.T) {
+	boardId := uuid.New()
+
+	boardMock := boards.NewMockBoardService(t)
+	boardMock.EXPECT().Get(mock.Anything, boardId).
+		Return(nil, boards.CreateBoardError(boards.NotFound, "board not found", errors.New("not found")))
+
+	server := &Server{
+		boards: boardMock,
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/boards/%s/participants", boardId), nil)
+	chiCtx := chi.NewRouteContext()
+	chiCtx.URLParams.Add("id", boardId.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+
+	rr := httptest.NewRecorder()
+
+	nextCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalled = true
+	})
+
+	handler := server.BoardNotArchivedContext(next)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.False(t, nextCalled)
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/estimations"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+)
+
+// Start an estimation round on a note
+//
+//	@Summary		Start an estimation round on a note
+//	@Description	Start a planning poker round on a note, only a single round can be in progress on a board
+//	@Tags			estimations
+//	@Accept			json
+//	@Param			Cookie		header	string								true	"jwt token to authenticate"
+//	@Param			boardId		path	string								true	"id of the board"
+//	@Param			estimation	body	estimations.EstimationCreateRequest	true	"note and deck of the estimation round"
+//	@Produce		json
+//	@Header			201	{string}	Location	"Path to the created estimation round"
+//	@Success		201	{object}	estimations.Estimation
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		409	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/estimations [post]
+func (s *Server) createEstimation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.estimations.api.create")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+
+	var body estimations.EstimationCreateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	body.Board = board
+
+	estimation, err := s.estimations.Create(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create estimation round")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+	w.Header().Set("Location", s.buildRelativeURL(fmt.Sprintf("/boards/%s/estimations/%s", board, estimation.ID)))
+
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, estimation)
+}
+
+// Get all estimation rounds of a board
+//
+//	@Summary		Get all estimation rounds of a board
+//	@Description	Get all estimation rounds of a board, the cards of an open round are hidden
+//	@Tags			estimations
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			boardId	path	string	true	"id of the board"
+//	@Produce		json
+//	@Success		200	{object}	[]estimations.Estimation
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/estimations [get]
+func (s *Server) getEstimations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.estimations.api.get.all")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+
+	list, err := s.estimations.GetAll(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimation rounds")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, list)
+}
+
+// Get an estimation round of a board
+//
+//	@Summary		Get an estimation round of a board
+//	@Description	Get an estimation round of a board, the cards are hidden while the round is open
+//	@Tags			estimations
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			boardId	path	string	true	"id of the board"
+//	@Param			id		path	string	true	"id of the estimation round"
+//	@Produce		json
+//	@Success		200	{object}	estimations.Estimation
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/estimations/{id} [get]
+func (s *Server) getEstimation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.estimations.api.get")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+	id := ctx.Value(identifiers.EstimationIdentifier).(uuid.UUID)
+
+	estimation, err := s.estimations.Get(ctx, board, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimation round")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, estimation)
+}
+
+// Reveal or close an estimation round
+//
+//	@Summary		Reveal or close an estimation round
+//	@Description	Reveal the estimates of all participants or close the round, optionally storing the final estimate on the note
+//	@Tags			estimations
+//	@Accept			json
+//	@Param			Cookie		header	string								true	"jwt token to authenticate"
+//	@Param			boardId		path	string								true	"id of the board"
+//	@Param			id			path	string								true	"id of the estimation round"
+//	@Param			estimation	body	estimations.EstimationUpdateRequest	true	"new status and final estimate of the estimation round"
+//	@Produce		json
+//	@Success		200	{object}	estimations.Estimation
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/estimations/{id} [put]
+func (s *Server) updateEstimation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.estimations.api.update")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+	id := ctx.Value(identifiers.EstimationIdentifier).(uuid.UUID)
+
+	var body estimations.EstimationUpdateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	body.ID = id
+	body.Board = board
+
+	estimation, err := s.estimations.Update(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to update estimation round")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, estimation)
+}
+
+// Submit an estimate in an estimation round
+//
+//	@Summary		Submit an estimate in an estimation round
+//	@Description	Submit or change the estimate of the calling participant, which stays hidden until the round is revealed
+//	@Tags			estimations
+//	@Accept			json
+//	@Param			Cookie		header	string						true	"jwt token to authenticate"
+//	@Param			boardId		path	string						true	"id of the board"
+//	@Param			id			path	string						true	"id of the estimation round"
+//	@Param			estimate	body	estimations.EstimateRequest	true	"chosen card of the deck"
+//	@Produce		json
+//	@Success		200	{object}	estimations.Estimate
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/estimations/{id}/estimate [put]
+func (s *Server) submitEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.estimations.api.submit")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+	id := ctx.Value(identifiers.EstimationIdentifier).(uuid.UUID)
+
+	var body estimations.EstimateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+
+	body.Estimation = id
+	body.Board = board
+	body.User = user
+
+	estimate, err := s.estimations.SubmitEstimate(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to submit estimate")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, estimate)
+}
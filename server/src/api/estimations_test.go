@@ -0,0 +1,217 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/estimations"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+	"scrumlr.io/server/technical_helper"
+)
+
+type EstimationTestSuite struct {
+	suite.Suite
+}
+
+func TestEstimationTestSuite(t *testing.T) {
+	suite.Run(t, new(EstimationTestSuite))
+}
+
+func (suite *EstimationTestSuite) TestCreateEstimation() {
+
+	testParameterBundles := *TestParameterBundles{}.
+		Append("all ok", http.StatusCreated, nil, false, false, nil).
+		Append("note not found", http.StatusNotFound, estimations.CreateEstimationError(estimations.NotFound, "note not found", errors.New("note not found")), false, false, nil).
+		Append("round in progress", http.StatusConflict, estimations.CreateEstimationError(estimations.Conflict, "only a single estimation round can be in progress on a board", errors.New("conflict")), false, false, nil).
+		Append("unhandled error", http.StatusInternalServerError, errors.New("that was unexpected"), false, false, nil)
+
+	for _, tt := range testParameterBundles {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			s.basePath = "/"
+			estimationMock := estimations.NewMockEstimationService(suite.T())
+			s.estimations = estimationMock
+
+			boardId := uuid.New()
+			noteId := uuid.New()
+			estimationId := uuid.New()
+
+			req := technical_helper.NewTestRequestBuilder("POST", "/", strings.NewReader(fmt.Sprintf(`{
+				"note": "%s",
+				"deck": "T_SHIRT"
+				}`, noteId)))
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+			req.AddToContext(identifiers.BoardIdentifier, boardId)
+
+			estimationMock.EXPECT().Create(mock.Anything, estimations.EstimationCreateRequest{
+				Note:  noteId,
+				Deck:  estimations.TShirtDeck,
+				Board: boardId,
+			}).Return(&estimations.Estimation{
+				ID:     estimationId,
+				Note:   noteId,
+				Deck:   estimations.TShirtDeck,
+				Status: estimations.Open,
+			}, tt.err)
+
+			rr := httptest.NewRecorder()
+			s.createEstimation(rr, req.Request())
+
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+			if tt.err == nil {
+				suite.Equal(fmt.Sprintf("/boards/%s/estimations/%s", boardId, estimationId), rr.Result().Header.Get("Location"))
+			}
+			estimationMock.AssertExpectations(suite.T())
+		})
+	}
+}
+
+func (suite *EstimationTestSuite) TestCreateEstimation_InvalidDeck() {
+	s := new(Server)
+	estimationMock := estimations.NewMockEstimationService(suite.T())
+	s.estimations = estimationMock
+
+	req := technical_helper.NewTestRequestBuilder("POST", "/", strings.NewReader(fmt.Sprintf(`{
+		"note": "%s",
+		"deck": "POWERS_OF_TWO"
+		}`, uuid.New())))
+	req.Req = logger.InitTestLoggerRequest(req.Request())
+	req.AddToContext(identifiers.BoardIdentifier, uuid.New())
+
+	rr := httptest.NewRecorder()
+	s.createEstimation(rr, req.Request())
+
+	suite.Equal(http.StatusBadRequest, rr.Result().StatusCode)
+	estimationMock.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *EstimationTestSuite) TestGetEstimation() {
+	s := new(Server)
+	estimationMock := estimations.NewMockEstimationService(suite.T())
+	s.estimations = estimationMock
+	boardId := uuid.New()
+	estimationId := uuid.New()
+
+	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
+		AddToContext(identifiers.BoardIdentifier, boardId).
+		AddToContext(identifiers.EstimationIdentifier, estimationId)
+	rr := httptest.NewRecorder()
+
+	estimationMock.EXPECT().Get(mock.Anything, boardId, estimationId).Return(&estimations.Estimation{
+		ID:     estimationId,
+		Status: estimations.Revealed,
+	}, nil)
+
+	s.getEstimation(rr, req.Request())
+
+	suite.Equal(http.StatusOK, rr.Result().StatusCode)
+	estimationMock.AssertExpectations(suite.T())
+}
+
+func (suite *EstimationTestSuite) TestGetEstimations() {
+	s := new(Server)
+	estimationMock := estimations.NewMockEstimationService(suite.T())
+	s.estimations = estimationMock
+	boardId := uuid.New()
+
+	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
+		AddToContext(identifiers.BoardIdentifier, boardId)
+	rr := httptest.NewRecorder()
+
+	estimationMock.EXPECT().GetAll(mock.Anything, boardId).Return([]*estimations.Estimation{}, nil)
+
+	s.getEstimations(rr, req.Request())
+
+	suite.Equal(http.StatusOK, rr.Result().StatusCode)
+	estimationMock.AssertExpectations(suite.T())
+}
+
+func (suite *EstimationTestSuite) TestUpdateEstimation() {
+
+	testParameterBundles := *TestParameterBundles{}.
+		Append("all ok", http.StatusOK, nil, false, false, nil).
+		Append("invalid estimate", http.StatusBadRequest, estimations.CreateEstimationError(estimations.BadRequest, "the estimate is not part of the deck of the estimation round", errors.New("invalid estimate")), false, false, nil).
+		Append("unexpected error", http.StatusInternalServerError, errors.New("oops"), false, false, nil)
+
+	for _, tt := range testParameterBundles {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			estimationMock := estimations.NewMockEstimationService(suite.T())
+			s.estimations = estimationMock
+
+			boardId := uuid.New()
+			estimationId := uuid.New()
+			estimate := "8"
+
+			req := technical_helper.NewTestRequestBuilder("PUT", "/", strings.NewReader(`{
+				"status": "CLOSED",
+				"estimate": "8"
+				}`))
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+			req.AddToContext(identifiers.BoardIdentifier, boardId).
+				AddToContext(identifiers.EstimationIdentifier, estimationId)
+
+			estimationMock.EXPECT().Update(mock.Anything, estimations.EstimationUpdateRequest{
+				ID:       estimationId,
+				Board:    boardId,
+				Status:   estimations.Closed,
+				Estimate: &estimate,
+			}).Return(&estimations.Estimation{ID: estimationId, Status: estimations.Closed, Estimate: &estimate}, tt.err)
+
+			rr := httptest.NewRecorder()
+			s.updateEstimation(rr, req.Request())
+
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+			estimationMock.AssertExpectations(suite.T())
+		})
+	}
+}
+
+func (suite *EstimationTestSuite) TestSubmitEstimate() {
+
+	testParameterBundles := *TestParameterBundles{}.
+		Append("all ok", http.StatusOK, nil, false, false, nil).
+		Append("round not open", http.StatusBadRequest, estimations.CreateEstimationError(estimations.BadRequest, "estimates can only be submitted while the estimation round is open", errors.New("round not open")), false, false, nil).
+		Append("round not found", http.StatusNotFound, estimations.CreateEstimationError(estimations.NotFound, "estimation round not found", errors.New("not found")), false, false, nil)
+
+	for _, tt := range testParameterBundles {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			estimationMock := estimations.NewMockEstimationService(suite.T())
+			s.estimations = estimationMock
+
+			boardId := uuid.New()
+			userId := uuid.New()
+			estimationId := uuid.New()
+
+			req := technical_helper.NewTestRequestBuilder("PUT", "/", strings.NewReader(`{
+				"card": "5"
+				}`))
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+			req.AddToContext(identifiers.BoardIdentifier, boardId).
+				AddToContext(identifiers.UserIdentifier, userId).
+				AddToContext(identifiers.EstimationIdentifier, estimationId)
+
+			estimationMock.EXPECT().SubmitEstimate(mock.Anything, estimations.EstimateRequest{
+				Card:       "5",
+				Estimation: estimationId,
+				Board:      boardId,
+				User:       userId,
+			}).Return(&estimations.Estimate{Estimation: estimationId, User: userId, Card: "5"}, tt.err)
+
+			rr := httptest.NewRecorder()
+			s.submitEstimate(rr, req.Request())
+
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+			estimationMock.AssertExpectations(suite.T())
+		})
+	}
+}
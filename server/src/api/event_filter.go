@@ -59,8 +59,9 @@ func (bs *BoardSubscription) columnsUpdated(event *realtime.BoardEvent, userID u
 		return event, true
 	} else {
 		return &realtime.BoardEvent{
-			Type: event.Type,
-			Data: updateColumns.FilterVisibleColumns(),
+			Type:     event.Type,
+			Sequence: event.Sequence,
+			Data:     updateColumns.FilterVisibleColumns(),
 		}, true
 	}
 }
@@ -83,8 +84,9 @@ func (bs *BoardSubscription) notesUpdated(event *realtime.BoardEvent, userID uui
 			})
 		}
 		return &realtime.BoardEvent{
-			Type: event.Type,
-			Data: noteSlice.FilterNotesByBoardSettingsOrAuthorInformation(userID, bs.boardSettings.ShowNotesOfOtherUsers, bs.boardSettings.ShowAuthors, columnVisibility),
+			Type:     event.Type,
+			Sequence: event.Sequence,
+			Data:     noteSlice.FilterNotesByBoardSettingsOrAuthorInformation(userID, bs.boardSettings.ShowNotesOfOtherUsers, bs.boardSettings.ShowAuthors, columnVisibility),
 		}, true
 	}
 }
@@ -113,7 +115,8 @@ func (bs *BoardSubscription) votesDeleted(event *realtime.BoardEvent, userID uui
 	}
 
 	ret := realtime.BoardEvent{
-		Type: event.Type,
+		Type:     event.Type,
+		Sequence: event.Sequence,
 		Data: technical_helper.Filter[*votings.Vote](votes, func(vote *votings.Vote) bool {
 			return vote.User == userID
 		}),
@@ -129,7 +132,17 @@ func (bs *BoardSubscription) votingUpdated(event *realtime.BoardEvent, userID uu
 	}
 
 	if isMod {
-		return event, true
+		if voting.Voting.Scope.IsEmpty() {
+			return event, true
+		}
+
+		// only send the results of the notes in the scope of the voting
+		ret := realtime.BoardEvent{
+			Type:     event.Type,
+			Sequence: event.Sequence,
+			Data:     voting.Voting.UpdateVoting(voting.Notes),
+		}
+		return &ret, true
 	} else if voting.Voting.Status != votings.Closed {
 		return event, true
 	} else {
@@ -182,8 +195,9 @@ func (bs *BoardSubscription) votingUpdated(event *realtime.BoardEvent, userID uu
 			Voting: voting.Voting.UpdateVoting(filteredvotingNotesIDs).Voting,
 		}
 		ret := realtime.BoardEvent{
-			Type: event.Type,
-			Data: votingUpdate,
+			Type:     event.Type,
+			Sequence: event.Sequence,
+			Data:     votingUpdate,
 		}
 		return &ret, true
 	}
@@ -222,6 +236,13 @@ func eventInitFilter(event InitEvent, clientID uuid.UUID) InitEvent {
 		})
 	}
 	if isMod {
+		allNotes := votings.AffectedNotes(event.Data.Notes)
+		event.Data.Votings = technical_helper.MapSlice[*votings.Voting, *votings.Voting](event.Data.Votings, func(voting *votings.Voting) *votings.Voting {
+			if voting.Scope.IsEmpty() {
+				return voting
+			}
+			return voting.UpdateVoting(allNotes).Voting
+		})
 		return event
 	}
 
@@ -257,7 +278,8 @@ func eventInitFilter(event InitEvent, clientID uuid.UUID) InitEvent {
 	}
 
 	return InitEvent{
-		Type: event.Type,
+		Type:     event.Type,
+		Sequence: event.Sequence,
 		Data: boards.FullBoard{
 			Board:                event.Data.Board,
 			BoardSessions:        event.Data.BoardSessions,
@@ -272,6 +294,8 @@ func eventInitFilter(event InitEvent, clientID uuid.UUID) InitEvent {
 				_, exists := notesMap[vote.Note]
 				return exists
 			}),
+			ActionItems: event.Data.ActionItems,
+			Estimations: event.Data.Estimations,
 		},
 	}
 }
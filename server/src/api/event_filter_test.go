@@ -134,6 +134,7 @@ var (
 			AllowMultipleVotes: true,
 			ShowVotesOfOthers:  false,
 			Status:             "CLOSED",
+			Mode:               votings.DefaultMode,
 			VotingResults: &votings.VotingResults{
 				Total: 5,
 				Votes: map[uuid.UUID]votings.VotingResultsPerNote{
@@ -200,6 +201,7 @@ func TestEventFilter(t *testing.T) {
 	t.Run("TestFilterVotingUpdatedAsOwner", testFilterVotingUpdatedAsOwner)
 	t.Run("TestFilterVotingUpdatedAsModerator", testFilterVotingUpdatedAsModerator)
 	t.Run("TestFilterVotingUpdatedAsParticipant", testFilterVotingUpdatedAsParticipant)
+	t.Run("TestFilterScopedVotingUpdatedAsModerator", testFilterScopedVotingUpdatedAsModerator)
 	t.Run("TestInitEventAsOwner", testInitFilterAsOwner)
 	t.Run("TestInitEventAsModerator", testInitFilterAsModerator)
 	t.Run("TestInitEventAsParticipant", testInitFilterAsParticipant)
@@ -396,6 +398,23 @@ func testFilterVotingUpdatedAsModerator(t *testing.T) {
 	assert.Equal(t, expectedVotingEvent, returnedVoteEvent)
 }
 
+func testFilterScopedVotingUpdatedAsModerator(t *testing.T) {
+	scopedVoting := *votingData.Voting
+	scopedVoting.Scope = &votings.VotingScope{Notes: []uuid.UUID{aOwnerNote.ID}}
+	scopedVotingEvent := &realtime.BoardEvent{
+		Type: realtime.BoardEventVotingUpdated,
+		Data: &votings.VotingUpdated{Notes: votingData.Notes, Voting: &scopedVoting},
+	}
+
+	returnedVoteEvent := boardSub.eventFilter(scopedVotingEvent, moderatorBoardSession.UserID)
+
+	assert.NotNil(t, returnedVoteEvent)
+	returnedVoting := returnedVoteEvent.Data.(*votings.VotingUpdated).Voting
+	assert.Equal(t, 2, returnedVoting.VotingResults.Total)
+	assert.Len(t, returnedVoting.VotingResults.Votes, 1)
+	assert.Contains(t, returnedVoting.VotingResults.Votes, aOwnerNote.ID)
+}
+
 func testFilterVotingUpdatedAsParticipant(t *testing.T) {
 	expectedVoting := &votings.VotingUpdated{
 		Notes: []votings.Note{
@@ -417,6 +436,7 @@ func testFilterVotingUpdatedAsParticipant(t *testing.T) {
 			AllowMultipleVotes: true,
 			ShowVotesOfOthers:  false,
 			Status:             "CLOSED",
+			Mode:               votings.DefaultMode,
 			VotingResults: &votings.VotingResults{
 				Total: 2,
 				Votes: map[uuid.UUID]votings.VotingResultsPerNote{
@@ -459,6 +479,7 @@ func testInitFilterAsParticipant(t *testing.T) {
 		AllowMultipleVotes: true,
 		ShowVotesOfOthers:  false,
 		Status:             "CLOSED",
+		Mode:               votings.DefaultMode,
 		VotingResults: &votings.VotingResults{
 			Total: 2,
 			Votes: map[uuid.UUID]votings.VotingResultsPerNote{
@@ -741,6 +762,7 @@ func buildVoting(id uuid.UUID, status votings.VotingStatus) *votings.Voting {
 	return &votings.Voting{
 		ID:     id,
 		Status: status,
+		Mode:   votings.DefaultMode,
 	}
 }
 
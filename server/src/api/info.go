@@ -10,6 +10,7 @@ import (
 
 type Info struct {
 	AuthProvider                  []common.AccountType `json:"authProvider"`
+	OIDCProviders                 []string             `json:"oidcProviders"`
 	AnonymousLoginDisabled        bool                 `json:"anonymousLoginDisabled"`
 	AllowAnonymousCustomTemplates bool                 `json:"allowAnonymousCustomTemplates"`
 	AllowAnonymousBoardCreation   bool                 `json:"allowAnonymousBoardCreation"`
@@ -56,6 +57,10 @@ func (s *Server) getServerInfo(w http.ResponseWriter, r *http.Request) {
 	if s.auth.Exists(common.TypeOIDC) {
 		info.AuthProvider = append(info.AuthProvider, common.TypeOIDC)
 	}
+	if s.auth.Exists(common.TypeSAML) {
+		info.AuthProvider = append(info.AuthProvider, common.TypeSAML)
+	}
+	info.OIDCProviders = s.auth.OIDCProviders()
 
 	info.ServerTime = time.Now()
 
@@ -1,7 +1,9 @@
 package api
 
 import (
-	"math"
+	"context"
+	"encoding/xml"
+	"errors"
 	"net/http"
 	"strings"
 	"time"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/markbates/goth/gothic"
+	"scrumlr.io/server/auth"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/logger"
 )
@@ -59,8 +62,7 @@ func (s *Server) signInAnonymously(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tokenString, err := s.auth.Sign(map[string]any{"id": user.ID})
-	if err != nil {
+	if err := s.auth.SetSessionCookie(w, r, user.ID); err != nil {
 		span.SetStatus(codes.Error, "failed to generate token string")
 		span.RecordError(err)
 		log.Errorw("unable to generate token string", "err", err)
@@ -68,10 +70,6 @@ func (s *Server) signInAnonymously(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cookie := http.Cookie{Name: "jwt", Value: tokenString, Path: "/", HttpOnly: true, MaxAge: math.MaxInt32}
-	common.SealCookie(r, &cookie)
-	http.SetCookie(w, &cookie)
-
 	render.Status(r, http.StatusCreated)
 	render.Respond(w, r, user)
 }
@@ -79,15 +77,23 @@ func (s *Server) signInAnonymously(w http.ResponseWriter, r *http.Request) {
 // Log the current user out
 //
 //	@Summary		Log the current user out
-//	@Description	Log the current user out
+//	@Description	Log the current user out and revoke the session, so that its token can not be used anymore
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
 //	@Success		204
 //	@Router			/login [delete]
 func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
-	_, span := tracer.Start(r.Context(), "scrumlr.login.api.logout")
+	ctx, span := tracer.Start(r.Context(), "scrumlr.login.api.logout")
 	defer span.End()
+	log := logger.FromContext(ctx)
+
+	// the cookie is removed in any case, even if the session could not be revoked
+	if err := s.auth.RevokeSession(r.WithContext(ctx)); err != nil {
+		span.SetStatus(codes.Error, "failed to revoke session")
+		span.RecordError(err)
+		log.Errorw("unable to revoke session", "err", err)
+	}
 
 	cookie := http.Cookie{Name: "jwt", Value: "deleted", Path: "/", MaxAge: -1, Expires: time.UnixMilli(0)}
 	common.SealCookie(r, &cookie)
@@ -110,12 +116,14 @@ func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
 //	@Description	Redirect the user to the specified auth provider consent page
 //	@Tags			auth
 //	@Accept			json
-//	@Param			provider	path	string	true	"provider to use to login"	Enums(GOOGLE, MICROSOFT, AZURE_AD, GITHUB, APPLE, OIDC)
+//	@Param			provider	path	string	true	"provider to use to login, or the name of an additional OIDC provider"
+//	@Param			link		query	string	false	"set to true to link the account of the provider to the logged in user"
 //	@Produce		json
 //	@Success		307
 //	@Failure		400	{object}	common.APIError
 //	@Router			/login/{provider} [get]
 func (s *Server) beginAuthProviderVerification(w http.ResponseWriter, r *http.Request) {
+	s.auth.BeginAccountLink(w, r)
 	gothic.BeginAuthHandler(w, r)
 }
 
@@ -123,7 +131,7 @@ func (s *Server) beginAuthProviderVerification(w http.ResponseWriter, r *http.Re
 // Redirect to the page provider with the state
 //
 //	@Summary		Verify the auth provider call and create or update a user
-//	@Description	Verify the auth provider call and create or update a user. Redirect to the page provider with the state
+//	@Description	Verify the auth provider call and create or update a user. The account is linked to the user who started the login with link=true, otherwise an anonymous user who started the login is merged into this user. Redirect to the page provider with the state
 //	@Tags			auth
 //	@Accept			json
 //	@Param			user	body	AnonymousSignUpRequest	true	"user to create"
@@ -149,16 +157,7 @@ func (s *Server) verifyAuthProviderCallback(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	provider, err := common.NewAccountType(externalUser.Provider)
-	if err != nil {
-		span.SetStatus(codes.Error, "user provider not supported")
-		span.RecordError(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		log.Errorw("unsupported user provider", "err", err)
-		return
-	}
-
-	userInfo, err := s.auth.ExtractUserInformation(provider, &externalUser)
+	userInfo, err := s.auth.ExtractUserInformation(&externalUser)
 	if err != nil {
 		span.SetStatus(codes.Error, "insufficient user information from external auth source")
 		span.RecordError(err)
@@ -167,20 +166,22 @@ func (s *Server) verifyAuthProviderCallback(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	var internalUser *users.User
-	internalUser, err = s.users.Create(ctx, userInfo.Ident, userInfo.Name, userInfo.AvatarURL, provider)
+	internalUser, err := s.loginUser(ctx, w, r, userInfo)
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to create user")
 		span.RecordError(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(loginErrorStatus(err))
 		log.Errorw("could not create user", "err", err)
 		return
 	}
 
-	tokenString, _ := s.auth.Sign(map[string]any{"id": internalUser.ID})
-	cookie := http.Cookie{Name: "jwt", Value: tokenString, Path: "/", Expires: time.Now().AddDate(0, 0, 3*7)}
-	common.SealCookie(r, &cookie)
-	http.SetCookie(w, &cookie)
+	if err := s.auth.SetSessionCookie(w, r, internalUser.ID); err != nil {
+		span.SetStatus(codes.Error, "failed to generate token string")
+		span.RecordError(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Errorw("unable to generate token string", "err", err)
+		return
+	}
 
 	state := gothic.GetState(r)
 	stateSplit := strings.Split(state, "__")
@@ -192,3 +193,195 @@ func (s *Server) verifyAuthProviderCallback(w http.ResponseWriter, r *http.Reque
 	w.Header().Set("Location", s.buildRelativeURL("/"))
 	w.WriteHeader(http.StatusSeeOther)
 }
+
+// Get the SAML service provider metadata
+//
+//	@Summary		Get the SAML service provider metadata
+//	@Description	Get the metadata of the SAML service provider, which has to be registered at the identity provider
+//	@Tags			auth
+//	@Produce		xml
+//	@Success		200
+//	@Failure		404	{object}	common.APIError
+//	@Router			/login/saml/metadata [get]
+func (s *Server) samlMetadata(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+
+	metadata, err := s.auth.SAMLMetadata()
+	if err != nil {
+		common.Throw(w, r, common.NotFoundError)
+		return
+	}
+
+	data, err := xml.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		log.Errorw("unable to marshal saml metadata", "err", err)
+		common.Throw(w, r, common.InternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// Redirect the user to the SAML identity provider
+//
+//	@Summary		Redirect the user to the SAML identity provider
+//	@Description	Redirect the user to the SAML identity provider
+//	@Tags			auth
+//	@Param			state	query	string	false	"location to redirect to after the login"
+//	@Param			link	query	string	false	"set to true to link the account of the provider to the logged in user"
+//	@Success		302
+//	@Failure		400	{object}	common.APIError
+//	@Router			/login/saml [get]
+func (s *Server) beginSAMLAuth(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.login.api.begin_saml")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	s.auth.BeginAccountLink(w, r.WithContext(ctx))
+	if err := s.auth.BeginSAMLAuth(w, r.WithContext(ctx)); err != nil {
+		span.SetStatus(codes.Error, "failed to begin saml auth")
+		span.RecordError(err)
+		log.Errorw("could not begin saml auth", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+	}
+}
+
+// Verify the response of the SAML identity provider and create or update a user
+// Redirect to the page provider with the state
+//
+//	@Summary		Verify the response of the SAML identity provider and create or update a user
+//	@Description	Assertion consumer service of the SAML service provider. Verify the response of the identity provider and create or update a user. The account is linked to the user who started the login with link=true, otherwise an anonymous user who started the login is merged into this user. Redirect to the location given when the login started
+//	@Tags			auth
+//	@Accept			x-www-form-urlencoded
+//	@Param			SAMLResponse	formData	string		true	"response of the identity provider"
+//	@Param			RelayState		formData	string		false	"relay state of the request"
+//	@Header			303				{string}	Cookie		"jwt token to sign in"
+//	@Header			303				{string}	Location	"Redirect url"
+//	@Success		303
+//	@Failure		401
+//	@Failure		500
+//	@Router			/login/saml/acs [post]
+func (s *Server) verifySAMLCallback(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.login.api.verify_saml")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	userInfo, redirect, err := s.auth.CompleteSAMLAuth(w, r.WithContext(ctx))
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to complete saml auth")
+		span.RecordError(err)
+		w.WriteHeader(http.StatusUnauthorized)
+		log.Errorw("could not complete saml auth", "err", err)
+		return
+	}
+
+	internalUser, err := s.loginUser(ctx, w, r, userInfo)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create user")
+		span.RecordError(err)
+		w.WriteHeader(loginErrorStatus(err))
+		log.Errorw("could not create user", "err", err)
+		return
+	}
+
+	if err := s.auth.SetSessionCookie(w, r, internalUser.ID); err != nil {
+		span.SetStatus(codes.Error, "failed to generate token string")
+		span.RecordError(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Errorw("unable to generate token string", "err", err)
+		return
+	}
+
+	if redirect != "" {
+		w.Header().Set("Location", redirect)
+		w.WriteHeader(http.StatusSeeOther)
+		return
+	}
+	w.Header().Set("Location", s.buildRelativeURL("/"))
+	w.WriteHeader(http.StatusSeeOther)
+}
+
+// loginUser returns the user of the identity provider account. Providers with an admin group grant and revoke
+// the instance administration on every login.
+func (s *Server) loginUser(ctx context.Context, w http.ResponseWriter, r *http.Request, userInfo *auth.UserInformation) (*users.User, error) {
+	user, err := s.createOrLinkUser(ctx, w, r, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if userInfo.Admin != nil {
+		if err := s.users.SetAdmin(ctx, user.ID, *userInfo.Admin); err != nil {
+			logger.FromContext(ctx).Errorw("unable to update instance administration", "user", user.ID, "err", err)
+		}
+	}
+
+	return user, nil
+}
+
+// createOrLinkUser returns the user of the identity provider account, which is created on the first login.
+// If a user of this browser asked to link the account, the account is added to that user instead.
+// Otherwise the boards, notes, votes and templates of an anonymous user, who logs in with an identity provider,
+// are moved to the user of the provider. Failures of this merge do not prevent the login.
+func (s *Server) createOrLinkUser(ctx context.Context, w http.ResponseWriter, r *http.Request, userInfo *auth.UserInformation) (*users.User, error) {
+	log := logger.FromContext(ctx)
+
+	link, linked := s.auth.CompleteAccountLink(w, r)
+	if linked && link.LinkIdentity {
+		previous, err := s.users.Get(ctx, link.User)
+		if err == nil && previous.AccountType != common.Anonymous {
+			return s.users.LinkIdentity(ctx, link.User, users.Identity{
+				AccountType: userInfo.Provider,
+				Provider:    userInfo.ProviderName,
+				Issuer:      userInfo.Issuer,
+				ID:          userInfo.Ident,
+				Name:        userInfo.Name,
+				AvatarUrl:   userInfo.AvatarURL,
+			})
+		}
+	}
+
+	var user *users.User
+	var err error
+	if userInfo.Provider == common.TypeOIDC {
+		// users of different OIDC issuers are kept apart, even if their identifiers match
+		user, err = s.users.CreateOIDCUser(ctx, userInfo.ProviderName, userInfo.Issuer, userInfo.Ident, userInfo.Name, userInfo.AvatarURL)
+	} else {
+		user, err = s.users.Create(ctx, userInfo.Ident, userInfo.Name, userInfo.AvatarURL, userInfo.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !linked || link.User == user.ID {
+		return user, nil
+	}
+
+	previous, err := s.users.Get(ctx, link.User)
+	if err != nil || previous.AccountType != common.Anonymous {
+		return user, nil
+	}
+
+	if _, err := s.users.MergeAnonymousUser(ctx, link.User, user.ID); err != nil {
+		log.Errorw("unable to merge anonymous user", "anonymous", link.User, "user", user.ID, "err", err)
+	}
+
+	return user, nil
+}
+
+// loginErrorStatus returns the status of a failed login, which is a bad request if the account can not be linked
+// and forbidden for banned users
+func loginErrorStatus(err error) int {
+	var userErr users.UserError
+	if errors.As(err, &userErr) {
+		switch userErr.Category {
+		case users.BadRequest:
+			return http.StatusBadRequest
+		case users.Forbidden:
+			return http.StatusForbidden
+		}
+	}
+
+	return http.StatusInternalServerError
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -234,3 +236,146 @@ func (s *Server) deleteNote(w http.ResponseWriter, r *http.Request) {
 	render.Status(r, http.StatusNoContent)
 	render.Respond(w, r, nil)
 }
+
+// Get the revisions of a note
+//
+//	@Summary		Get the revisions of a note
+//	@Description	Get every version of the text and position of a note, newest first. Participants only get the revisions of notes they can see on the board.
+//	@Tags			notes
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			boardId	path	string	true	"id of the board"
+//	@Param			id		path	string	true	"id of the note"
+//	@Produce		json
+//	@Success		200	{object}	[]notes.NoteRevision
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/notes/{id}/revisions [get]
+func (s *Server) getNoteRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.notes.api.get.revisions")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+	note := ctx.Value(identifiers.NoteIdentifier).(uuid.UUID)
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	isMod, err := s.sessions.ModeratorSessionExists(ctx, board, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to check moderator session")
+		span.RecordError(err)
+		log.Errorw("unable to verify board session", "board", board, "err", err)
+		common.Throw(w, r, common.InternalServerError)
+		return
+	}
+
+	showAuthors := true
+	if !isMod {
+		showAuthors, err = s.checkNoteVisible(ctx, board, note, user)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to check note visibility")
+			span.RecordError(err)
+			log.Warnw("unable to check visibility of note", "note", note, "err", err)
+			common.Throw(w, r, mapError(err))
+			return
+		}
+	}
+
+	revisions, err := s.notes.GetRevisions(ctx, board, note)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get revisions")
+		span.RecordError(err)
+		log.Warnw("unable to get revisions of note", "note", note, "err", err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	if !showAuthors {
+		for _, revision := range revisions {
+			if revision.Author != nil && *revision.Author != user {
+				revision.Author = nil
+			}
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, revisions)
+}
+
+// checkNoteVisible applies the same rules participants see the notes of the board with. The note is not found
+// if it is in a hidden column or written by someone else while the notes of other users are hidden.
+// It returns whether the authors are shown on the board.
+func (s *Server) checkNoteVisible(ctx context.Context, board, note, user uuid.UUID) (bool, error) {
+	currentNote, err := s.notes.Get(ctx, note)
+	if err != nil {
+		return false, err
+	}
+
+	settings, err := s.boards.Get(ctx, board)
+	if err != nil {
+		return false, err
+	}
+
+	boardColumns, err := s.columns.GetAll(ctx, board)
+	if err != nil {
+		return false, err
+	}
+
+	var columnVisibility []notes.ColumnVisability
+	for _, column := range boardColumns {
+		columnVisibility = append(columnVisibility, notes.ColumnVisability{
+			ID:      column.ID,
+			Visible: column.Visible,
+		})
+	}
+
+	visibleNotes := notes.NoteSlice{currentNote}.FilterNotesByBoardSettingsOrAuthorInformation(user, settings.ShowNotesOfOtherUsers, settings.ShowAuthors, columnVisibility)
+	if len(visibleNotes) == 0 {
+		return false, notes.CreateNoteError(notes.NotFound, "note not found", errors.New("note is not visible to the user"))
+	}
+
+	return settings.ShowAuthors, nil
+}
+
+// Restore a revision of a note
+//
+//	@Summary		Restore a revision of a note
+//	@Description	Change the text of a note back to the text of an earlier revision. The position of the note is not changed.
+//	@Tags			notes
+//	@Accept			json
+//	@Param			Cookie		header	string	true	"jwt token to authenticate"
+//	@Param			boardId		path	string	true	"id of the board"
+//	@Param			id			path	string	true	"id of the note"
+//	@Param			revision	path	string	true	"id of the revision"
+//	@Produce		json
+//	@Success		200	{object}	notes.Note
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		409	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/notes/{id}/revisions/{revision}/restore [post]
+func (s *Server) restoreNoteRevision(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.notes.api.restore.revision")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+	note := ctx.Value(identifiers.NoteIdentifier).(uuid.UUID)
+	revision := ctx.Value(identifiers.NoteRevisionIdentifier).(uuid.UUID)
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	restored, err := s.notes.RestoreRevision(ctx, user, board, note, revision)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to restore revision")
+		span.RecordError(err)
+		log.Warnw("unable to restore revision of note", "note", note, "revision", revision, "err", err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, restored)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/columns"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/identifiers"
 	"scrumlr.io/server/logger"
@@ -298,3 +299,150 @@ func (suite *NotesTestSuite) TestEditNote() {
 		})
 	}
 }
+
+func (suite *NotesTestSuite) TestGetNoteRevisions() {
+	testParameterBundles := *TestParameterBundles{}.
+		Append("all ok", http.StatusOK, nil, false, false, nil).
+		Append("not found err", http.StatusNotFound, notes.CreateNoteError(notes.NotFound, "note not found", errors.New("note not found")), false, false, nil).
+		Append("unexpected err", http.StatusInternalServerError, errors.New("oops"), false, false, nil)
+
+	for _, tt := range testParameterBundles {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			noteMock := notes.NewMockNotesService(suite.T())
+			sessionMock := sessions.NewMockSessionService(suite.T())
+			s.notes = noteMock
+			s.sessions = sessionMock
+
+			boardID := uuid.New()
+			noteID := uuid.New()
+			userID := uuid.New()
+
+			req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
+				AddToContext(identifiers.BoardIdentifier, boardID).
+				AddToContext(identifiers.NoteIdentifier, noteID).
+				AddToContext(identifiers.UserIdentifier, userID)
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+
+			sessionMock.EXPECT().ModeratorSessionExists(mock.Anything, boardID, userID).Return(true, nil)
+			noteMock.EXPECT().GetRevisions(mock.Anything, boardID, noteID).
+				Return([]*notes.NoteRevision{{ID: uuid.New(), Note: noteID, Text: "first"}}, tt.err)
+
+			rr := httptest.NewRecorder()
+
+			s.getNoteRevisions(rr, req.Request())
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+		})
+	}
+}
+
+func (suite *NotesTestSuite) TestGetNoteRevisions_Participant() {
+	tests := []struct {
+		name                  string
+		ownNote               bool
+		columnVisible         bool
+		showNotesOfOtherUsers bool
+		showAuthors           bool
+		expectedCode          int
+		expectAuthor          bool
+	}{
+		{name: "visible note with authors", columnVisible: true, showNotesOfOtherUsers: true, showAuthors: true, expectedCode: http.StatusOK, expectAuthor: true},
+		{name: "visible note without authors", columnVisible: true, showNotesOfOtherUsers: true, expectedCode: http.StatusOK},
+		{name: "own note without authors", ownNote: true, columnVisible: true, expectedCode: http.StatusOK, expectAuthor: true},
+		{name: "note of other user hidden", columnVisible: true, expectedCode: http.StatusNotFound},
+		{name: "note in hidden column", showNotesOfOtherUsers: true, showAuthors: true, expectedCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			noteMock := notes.NewMockNotesService(suite.T())
+			sessionMock := sessions.NewMockSessionService(suite.T())
+			boardMock := boards.NewMockBoardService(suite.T())
+			columnMock := columns.NewMockColumnService(suite.T())
+			s.notes = noteMock
+			s.sessions = sessionMock
+			s.boards = boardMock
+			s.columns = columnMock
+
+			boardID := uuid.New()
+			noteID := uuid.New()
+			columnID := uuid.New()
+			userID := uuid.New()
+			authorID := uuid.New()
+			if tt.ownNote {
+				authorID = userID
+			}
+
+			req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
+				AddToContext(identifiers.BoardIdentifier, boardID).
+				AddToContext(identifiers.NoteIdentifier, noteID).
+				AddToContext(identifiers.UserIdentifier, userID)
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+
+			sessionMock.EXPECT().ModeratorSessionExists(mock.Anything, boardID, userID).Return(false, nil)
+			noteMock.EXPECT().Get(mock.Anything, noteID).
+				Return(&notes.Note{ID: noteID, Author: authorID, Position: notes.NotePosition{Column: columnID}}, nil)
+			boardMock.EXPECT().Get(mock.Anything, boardID).
+				Return(&boards.Board{ID: boardID, ShowNotesOfOtherUsers: tt.showNotesOfOtherUsers, ShowAuthors: tt.showAuthors}, nil)
+			columnMock.EXPECT().GetAll(mock.Anything, boardID).
+				Return([]*columns.Column{{ID: columnID, Visible: tt.columnVisible}}, nil)
+			if tt.expectedCode == http.StatusOK {
+				noteMock.EXPECT().GetRevisions(mock.Anything, boardID, noteID).
+					Return([]*notes.NoteRevision{{ID: uuid.New(), Note: noteID, Author: &authorID, Text: "first"}}, nil)
+			}
+
+			rr := httptest.NewRecorder()
+
+			s.getNoteRevisions(rr, req.Request())
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+
+			if tt.expectedCode == http.StatusOK {
+				var revisions []notes.NoteRevision
+				suite.Nil(json.NewDecoder(rr.Body).Decode(&revisions))
+				suite.Len(revisions, 1)
+				if tt.expectAuthor {
+					suite.Equal(&authorID, revisions[0].Author)
+				} else {
+					suite.Nil(revisions[0].Author)
+				}
+			}
+		})
+	}
+}
+
+func (suite *NotesTestSuite) TestRestoreNoteRevision() {
+	testParameterBundles := *TestParameterBundles{}.
+		Append("all ok", http.StatusOK, nil, false, false, nil).
+		Append("forbidden err", http.StatusForbidden, notes.CreateNoteError(notes.Forbidden, "not allowed to change note text", errors.New("forbidden")), false, false, nil).
+		Append("locked err", http.StatusConflict, notes.CreateNoteError(notes.Conflict, "note is currently locked", errors.New("locked")), false, false, nil).
+		Append("unexpected err", http.StatusInternalServerError, errors.New("oops"), false, false, nil)
+
+	for _, tt := range testParameterBundles {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			noteMock := notes.NewMockNotesService(suite.T())
+			s.notes = noteMock
+
+			boardID := uuid.New()
+			noteID := uuid.New()
+			revisionID := uuid.New()
+			userID := uuid.New()
+
+			req := technical_helper.NewTestRequestBuilder("POST", "/", nil).
+				AddToContext(identifiers.BoardIdentifier, boardID).
+				AddToContext(identifiers.NoteIdentifier, noteID).
+				AddToContext(identifiers.NoteRevisionIdentifier, revisionID).
+				AddToContext(identifiers.UserIdentifier, userID)
+			req.Req = logger.InitTestLoggerRequest(req.Request())
+
+			noteMock.EXPECT().RestoreRevision(mock.Anything, userID, boardID, noteID, revisionID).
+				Return(&notes.Note{ID: noteID, Text: "first"}, tt.err)
+
+			rr := httptest.NewRecorder()
+
+			s.restoreNoteRevision(rr, req.Request())
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"scrumlr.io/server/boardtemplates"
 	"scrumlr.io/server/columns"
 	"scrumlr.io/server/columntemplates"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/notes"
 
 	"github.com/go-chi/chi/v5"
@@ -32,6 +33,8 @@ import (
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/auth"
 	"scrumlr.io/server/feedback"
 	"scrumlr.io/server/health"
@@ -39,6 +42,7 @@ import (
 	"scrumlr.io/server/reactions"
 	"scrumlr.io/server/realtime"
 	"scrumlr.io/server/sessionrequests"
+	"scrumlr.io/server/teams"
 )
 
 type Server struct {
@@ -50,6 +54,9 @@ type Server struct {
 
 	userRoutes    chi.Router
 	sessionRoutes chi.Router
+	teamRoutes    chi.Router
+	tokenRoutes   chi.Router
+	adminRoutes   chi.Router
 	swaggerRoutes chi.Router
 
 	boards          boards.BoardService
@@ -65,6 +72,10 @@ type Server struct {
 	boardReactions  boardreactions.BoardReactionCreater
 	boardTemplates  boardtemplates.BoardTemplateService
 	columntemplates columntemplates.ColumnTemplateService
+	actionItems     actionitems.ActionItemService
+	estimations     estimations.EstimationService
+	teams           teams.TeamService
+	audit           audit.AuditService
 
 	checkOrigin bool
 
@@ -90,6 +101,9 @@ func New(
 
 	userRoutes chi.Router,
 	sessionRoutes chi.Router,
+	teamRoutes chi.Router,
+	tokenRoutes chi.Router,
+	adminRoutes chi.Router,
 	swaggerRoutes chi.Router,
 
 	boards boards.BoardService,
@@ -105,6 +119,10 @@ func New(
 	boardReactions boardreactions.BoardReactionCreater,
 	boardTemplates boardtemplates.BoardTemplateService,
 	columntemplates columntemplates.ColumnTemplateService,
+	actionItems actionitems.ActionItemService,
+	estimations estimations.EstimationService,
+	teams teams.TeamService,
+	audit audit.AuditService,
 
 	verbose bool,
 	checkOrigin bool,
@@ -146,6 +164,9 @@ func New(
 		wsService:                        wsService,
 		userRoutes:                       userRoutes,
 		sessionRoutes:                    sessionRoutes,
+		teamRoutes:                       teamRoutes,
+		tokenRoutes:                      tokenRoutes,
+		adminRoutes:                      adminRoutes,
 		swaggerRoutes:                    swaggerRoutes,
 		boardSubscriptions:               make(map[uuid.UUID]*BoardSubscription),
 		boardSessionRequestSubscriptions: make(map[uuid.UUID]*sessionrequests.BoardSessionRequestSubscription),
@@ -163,6 +184,10 @@ func New(
 		boardReactions:                   boardReactions,
 		boardTemplates:                   boardTemplates,
 		columntemplates:                  columntemplates,
+		actionItems:                      actionItems,
+		estimations:                      estimations,
+		teams:                            teams,
+		audit:                            audit,
 
 		anonymousLoginDisabled:        anonymousLoginDisabled,
 		allowAnonymousCustomTemplates: allowAnonymousCustomTemplates,
@@ -200,6 +225,12 @@ func (s *Server) publicRoutes(r chi.Router) chi.Router {
 			r.Delete("/", s.logout)
 			r.With(s.AnonymousLoginDisabledContext).Post("/anonymous", s.signInAnonymously)
 
+			r.Route("/saml", func(r chi.Router) {
+				r.Get("/", s.beginSAMLAuth)
+				r.Get("/metadata", s.samlMetadata)
+				r.Post("/acs", s.verifySAMLCallback)
+			})
+
 			r.Route("/{provider}", func(r chi.Router) {
 				r.Get("/", s.beginAuthProviderVerification)
 				r.Get("/callback", s.verifyAuthProviderCallback)
@@ -248,26 +279,41 @@ func (s *Server) protectedRoutes(r chi.Router) {
 			})
 		})
 
+		r.Mount("/teams", s.teamRoutes)
+		r.Mount("/tokens", s.tokenRoutes)
+		r.Mount("/admin", s.adminRoutes)
+
 		r.With(s.AnonymousBoardCreationContext).Post("/boards", s.createBoard)
 		r.With(s.AnonymousBoardCreationContext).Post("/import", s.importBoard)
 		r.Get("/boards", s.getBoards)
 		r.Route("/boards/{id}", func(r chi.Router) {
-			r.With(s.BoardParticipantContext).Get("/", s.getBoard)
-			r.With(s.BoardParticipantContext).Get("/export", s.exportBoard)
-			r.With(s.BoardModeratorContext).Post("/timer", s.setTimer)
-			r.With(s.BoardModeratorContext).Delete("/timer", s.deleteTimer)
-			r.With(s.BoardModeratorContext).Post("/timer/increment", s.incrementTimer)
-			r.With(s.BoardModeratorContext).Put("/", s.updateBoard)
+			// archived boards can still be deleted and restored by their owner
 			r.With(s.BoardOwnerContext).Delete("/", s.deleteBoard)
-
-			s.initBoardSessionRequestResources(r)
-			s.initBoardSessionResources(r)
-			s.initColumnResources(r)
-			s.initNoteResources(r)
-			s.initReactionResources(r)
-			s.initVotingResources(r)
-			s.initVoteResources(r)
-			s.initBoardReactionResources(r)
+			r.With(s.BoardOwnerContext).Put("/archive", s.archiveBoard)
+			r.With(s.BoardOwnerContext).Delete("/archive", s.unarchiveBoard)
+
+			r.Group(func(r chi.Router) {
+				r.Use(s.BoardNotArchivedContext)
+
+				r.With(s.BoardParticipantContext).Get("/", s.getBoard)
+				r.With(s.BoardParticipantContext).Get("/export", s.exportBoard)
+				r.With(s.BoardModeratorContext).Get("/audit", s.getAuditLog)
+				r.With(s.BoardModeratorContext).Post("/timer", s.setTimer)
+				r.With(s.BoardModeratorContext).Delete("/timer", s.deleteTimer)
+				r.With(s.BoardModeratorContext).Post("/timer/increment", s.incrementTimer)
+				r.With(s.BoardModeratorContext).Put("/", s.updateBoard)
+
+				s.initBoardSessionRequestResources(r)
+				s.initBoardSessionResources(r)
+				s.initColumnResources(r)
+				s.initNoteResources(r)
+				s.initReactionResources(r)
+				s.initVotingResources(r)
+				s.initVoteResources(r)
+				s.initBoardReactionResources(r)
+				s.initActionItemResources(r)
+				s.initEstimationResources(r)
+			})
 		})
 
 		r.Mount("/", s.userRoutes)
@@ -291,6 +337,7 @@ func (s *Server) initVotingResources(r chi.Router) {
 	r.Route("/votings", func(r chi.Router) {
 		r.With(s.BoardParticipantContext).Get("/", s.getVotings)
 		r.With(s.BoardModeratorContext).Post("/", s.createVoting)
+		r.With(s.BoardModeratorContext).Get("/analytics", s.getVotingAnalytics)
 
 		r.Route("/{voting}", func(r chi.Router) {
 			r.Use(s.VotingContext)
@@ -363,6 +410,9 @@ func (s *Server) initNoteResources(r chi.Router) {
 			r.Get("/", s.getNote)
 			r.With(s.BoardEditableContext).Put("/", s.updateNote)
 			r.With(s.BoardEditableContext).Delete("/", s.deleteNote)
+
+			r.Get("/revisions", s.getNoteRevisions)
+			r.With(s.BoardEditableContext, s.NoteRevisionContext).Post("/revisions/{revision}/restore", s.restoreNoteRevision)
 		})
 	})
 }
@@ -392,6 +442,40 @@ func (s *Server) initBoardReactionResources(r chi.Router) {
 	})
 }
 
+func (s *Server) initActionItemResources(r chi.Router) {
+	r.Route("/action-items", func(r chi.Router) {
+		r.Use(s.BoardParticipantContext)
+
+		r.Get("/", s.getActionItems)
+		r.With(s.BoardEditableContext).Post("/", s.createActionItem)
+
+		r.Route("/{actionItem}", func(r chi.Router) {
+			r.Use(s.ActionItemContext)
+
+			r.Get("/", s.getActionItem)
+			r.With(s.BoardEditableContext).Put("/", s.updateActionItem)
+			r.With(s.BoardEditableContext).Delete("/", s.deleteActionItem)
+		})
+	})
+}
+
+func (s *Server) initEstimationResources(r chi.Router) {
+	r.Route("/estimations", func(r chi.Router) {
+		r.Use(s.BoardParticipantContext)
+
+		r.Get("/", s.getEstimations)
+		r.With(s.BoardModeratorContext, s.BoardEditableContext).Post("/", s.createEstimation)
+
+		r.Route("/{estimation}", func(r chi.Router) {
+			r.Use(s.EstimationContext)
+
+			r.Get("/", s.getEstimation)
+			r.With(s.BoardModeratorContext, s.BoardEditableContext).Put("/", s.updateEstimation)
+			r.With(s.BoardEditableContext).Put("/estimate", s.submitEstimate)
+		})
+	})
+}
+
 // buildRelativeURL constructs an relative URL from path and the basePath.
 // If basePath is not "/", it prepends it.
 func (s *Server) buildRelativeURL(path string) string {
@@ -58,6 +58,14 @@ func (s *Server) createVoting(w http.ResponseWriter, r *http.Request) {
 		common.Throw(w, r, mapError(err))
 		return
 	}
+
+	if body.StartTimer && voting.EndsAt != nil {
+		// the voting is closed by its end anyway, so a failing timer does not fail the request
+		if _, err := s.boards.SetTimerUntil(ctx, board, *voting.EndsAt); err != nil {
+			span.RecordError(err)
+			log.Warnw("unable to start board timer for voting", "board", board, "voting", voting.ID, "err", err)
+		}
+	}
 	w.Header().Set("Location", s.buildRelativeURL(fmt.Sprintf("/boards/%s/votings/%s", board, voting.ID)))
 
 	render.Status(r, http.StatusCreated)
@@ -94,21 +102,7 @@ func (s *Server) updateVoting(w http.ResponseWriter, r *http.Request) {
 		common.Throw(w, r, mapError(err))
 		return
 	}
-	var affectedNotes []votings.Note
-
-	for _, note := range notes {
-		affectedNotes = append(affectedNotes, votings.Note{
-			ID:     note.ID,
-			Author: note.Author,
-			Text:   note.Text,
-			Edited: note.Edited,
-			Position: votings.NotePosition{
-				Column: note.Position.Column,
-				Stack:  note.Position.Stack,
-				Rank:   note.Position.Rank,
-			},
-		})
-	}
+	affectedNotes := votings.AffectedNotes(notes)
 
 	voting, err := s.votings.Close(ctx, id, board, affectedNotes)
 	if err != nil {
@@ -189,3 +183,36 @@ func (s *Server) getVotings(w http.ResponseWriter, r *http.Request) {
 	render.Status(r, http.StatusOK)
 	render.Respond(w, r, votings)
 }
+
+// Get the analytics of the votings on a board
+//
+//	@Summary		Get the analytics of the votings on a board
+//	@Description	Compare the results of all closed votings on a board, including the rank movement of the notes and the participation per voting
+//	@Tags			votings
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			boardId	path	string	true	"id of the board"
+//	@Produce		json
+//	@Success		200	{object}	votings.VotingAnalytics
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/boards/{boardId}/votings/analytics [get]
+func (s *Server) getVotingAnalytics(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.votings.api.get.analytics")
+	defer span.End()
+
+	board := ctx.Value(identifiers.BoardIdentifier).(uuid.UUID)
+
+	analytics, err := s.votings.GetAnalytics(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get voting analytics")
+		span.RecordError(err)
+		common.Throw(w, r, mapError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, analytics)
+}
@@ -7,7 +7,9 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"scrumlr.io/server/boards"
 	"scrumlr.io/server/notes"
 	"scrumlr.io/server/technical_helper"
 
@@ -77,6 +79,40 @@ func (suite *VotingTestSuite) TestCreateVoting() {
 
 }
 
+func (suite *VotingTestSuite) TestCreateVotingStartsBoardTimer() {
+	s := new(Server)
+	s.basePath = "/"
+	votingMock := votings.NewMockVotingService(suite.T())
+	boardMock := boards.NewMockBoardService(suite.T())
+	s.votings = votingMock
+	s.boards = boardMock
+
+	boardId := uuid.New()
+	votingID := uuid.New()
+	endsAt := time.Now().Add(5 * time.Minute)
+
+	req := technical_helper.NewTestRequestBuilder("POST", "/", strings.NewReader(`{
+		"voteLimit": 4,
+		"duration": 300,
+		"startTimer": true
+		}`))
+	req.Req = logger.InitTestLoggerRequest(req.Request())
+	req.AddToContext(identifiers.BoardIdentifier, boardId)
+
+	votingMock.EXPECT().Create(mock.Anything, votings.VotingCreateRequest{
+		VoteLimit:  4,
+		Duration:   300,
+		StartTimer: true,
+		Board:      boardId,
+	}).Return(&votings.Voting{ID: votingID, EndsAt: &endsAt}, nil)
+	boardMock.EXPECT().SetTimerUntil(mock.Anything, boardId, endsAt).Return(&boards.Board{ID: boardId}, nil)
+
+	rr := httptest.NewRecorder()
+	s.createVoting(rr, req.Request())
+
+	suite.Equal(http.StatusCreated, rr.Result().StatusCode)
+}
+
 func (suite *VotingTestSuite) TestCloseVoting() {
 
 	testParameterBundles := *TestParameterBundles{}.
@@ -134,3 +170,45 @@ func (suite *VotingTestSuite) TestGetVoting() {
 	s.getVoting(rr, req.Request())
 	votingMock.AssertExpectations(suite.T())
 }
+
+func (suite *VotingTestSuite) TestGetVotingAnalytics() {
+	tests := []struct {
+		name         string
+		expectedCode int
+		err          error
+	}{
+		{
+			name:         "Successful",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Failed",
+			expectedCode: http.StatusInternalServerError,
+			err:          votings.CreateVotingError(votings.Internal, "failed to get votings", errors.New("database error")),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			s := new(Server)
+			votingMock := votings.NewMockVotingService(suite.T())
+			s.votings = votingMock
+			boardId, _ := uuid.NewRandom()
+
+			req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
+				AddToContext(identifiers.BoardIdentifier, boardId)
+			rr := httptest.NewRecorder()
+
+			var analytics *votings.VotingAnalytics
+			if tt.err == nil {
+				analytics = &votings.VotingAnalytics{Participants: 2}
+			}
+			votingMock.EXPECT().GetAnalytics(mock.Anything, boardId).Return(analytics, tt.err)
+
+			s.getVotingAnalytics(rr, req.Request())
+
+			suite.Equal(tt.expectedCode, rr.Result().StatusCode)
+			votingMock.AssertExpectations(suite.T())
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package apitokens
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+)
+
+type ApiTokenService interface {
+	Create(ctx context.Context, body ApiTokenCreateRequest) (*ApiTokenCreated, error)
+	GetAll(ctx context.Context, user uuid.UUID) ([]*ApiToken, error)
+	Delete(ctx context.Context, user, id uuid.UUID) error
+	Verify(ctx context.Context, secret string) (*ApiToken, error)
+}
+
+type API struct {
+	service ApiTokenService
+}
+
+func NewApiTokensApi(service ApiTokenService) ApiTokensApi {
+	api := new(API)
+	api.service = service
+	return api
+}
+
+// Create a new personal access token
+//
+//	@Summary		Create a personal access token
+//	@Description	Create a personal access token for scripts and integrations. The token is only returned once and is sent as bearer token in the Authorization header.
+//	@Tags			tokens
+//	@Accept			json
+//	@Param			Cookie	header	string					true	"jwt token to authenticate"
+//	@Param			token	body	ApiTokenCreateRequest	true	"token to create"
+//	@Produce		json
+//	@Success		201	{object}	ApiTokenCreated
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/tokens [post]
+func (api *API) CreateToken(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.apitokens.api.create")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	var body ApiTokenCreateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+	body.User = user
+
+	token, err := api.service.Create(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create token")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, token)
+}
+
+// Get all personal access tokens of the user
+//
+//	@Summary		Get all personal access tokens
+//	@Description	Get all personal access tokens of the user, the tokens themselves are not included
+//	@Tags			tokens
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Produce		json
+//	@Success		200	{object}	[]ApiToken
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/tokens [get]
+func (api *API) GetTokens(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.apitokens.api.get.all")
+	defer span.End()
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	tokens, err := api.service.GetAll(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get tokens")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, tokens)
+}
+
+// Revoke a personal access token
+//
+//	@Summary		Revoke a personal access token
+//	@Description	Revoke a personal access token of the user, it can not be used anymore afterwards
+//	@Tags			tokens
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			token	path	string	true	"id of the token"
+//	@Success		204
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/tokens/{token} [delete]
+func (api *API) DeleteToken(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.apitokens.api.delete")
+	defer span.End()
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	id, err := uuid.Parse(chi.URLParam(r, "token"))
+	if err != nil {
+		span.SetStatus(codes.Error, "unable to parse token id")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(errors.New("invalid token id")))
+		return
+	}
+
+	if err := api.service.Delete(ctx, user, id); err != nil {
+		span.SetStatus(codes.Error, "failed to delete token")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.Respond(w, r, nil)
+}
+
+// BrowserSessionContext rejects requests that are authenticated with a personal access token
+func (api *API) BrowserSessionContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Context().Value(identifiers.ApiTokenIdentifier).(uuid.UUID); ok {
+			common.Throw(w, r, common.ForbiddenError(errors.New("personal access tokens can not be used to manage tokens")))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// apiError translates api token errors to HTTP API errors.
+func apiError(err error) error {
+	var tokenErr ApiTokenError
+	if errors.As(err, &tokenErr) {
+		switch tokenErr.Category {
+		case BadRequest:
+			return common.BadRequestError(err)
+		case NotFound:
+			return common.NotFoundError
+		}
+	}
+
+	return common.InternalServerError
+}
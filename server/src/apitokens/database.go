@@ -0,0 +1,85 @@
+package apitokens
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type DB struct {
+	db *bun.DB
+}
+
+func NewApiTokenDatabase(database *bun.DB) ApiTokenDatabase {
+	db := new(DB)
+	db.db = database
+
+	return db
+}
+
+// CreateToken inserts a new personal access token
+func (d *DB) CreateToken(ctx context.Context, insert DatabaseApiTokenInsert) (DatabaseApiToken, error) {
+	var token DatabaseApiToken
+	_, err := d.db.NewInsert().
+		Model(&insert).
+		Returning("*").
+		Exec(ctx, &token)
+
+	return token, err
+}
+
+// GetTokens gets all personal access tokens of a user, the newest first
+func (d *DB) GetTokens(ctx context.Context, user uuid.UUID) ([]DatabaseApiToken, error) {
+	var tokens []DatabaseApiToken
+	err := d.db.NewSelect().
+		Model(&tokens).
+		Where("\"user\" = ?", user).
+		Order("created_at DESC").
+		Scan(ctx)
+
+	return tokens, err
+}
+
+// GetTokenByHash gets the personal access token with the given hash
+func (d *DB) GetTokenByHash(ctx context.Context, hash string) (DatabaseApiToken, error) {
+	var token DatabaseApiToken
+	err := d.db.NewSelect().
+		Model(&token).
+		Where("token_hash = ?", hash).
+		Scan(ctx)
+
+	return token, err
+}
+
+// DeleteToken deletes a personal access token of the user.
+// Returns sql.ErrNoRows if the user has no such token.
+func (d *DB) DeleteToken(ctx context.Context, user, id uuid.UUID) error {
+	result, err := d.db.NewDelete().
+		Model((*DatabaseApiToken)(nil)).
+		Where("id = ?", id).
+		Where("\"user\" = ?", user).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// UpdateLastUsed sets the date the token was last used
+func (d *DB) UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	_, err := d.db.NewUpdate().
+		Model((*DatabaseApiToken)(nil)).
+		Set("last_used_at = ?", lastUsedAt).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	return err
+}
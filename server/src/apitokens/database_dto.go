@@ -0,0 +1,29 @@
+package apitokens
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type DatabaseApiToken struct {
+	bun.BaseModel `bun:"table:api_tokens,alias:api_token"`
+	ID            uuid.UUID
+	User          uuid.UUID
+	Name          string
+	TokenHash     string
+	ReadOnly      bool
+	ExpiresAt     *time.Time
+	LastUsedAt    *time.Time
+	CreatedAt     time.Time
+}
+
+type DatabaseApiTokenInsert struct {
+	bun.BaseModel `bun:"table:api_tokens"`
+	User          uuid.UUID
+	Name          string
+	TokenHash     string
+	ReadOnly      bool
+	ExpiresAt     *time.Time
+}
@@ -0,0 +1,125 @@
+package apitokens
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/initialize/testDbTemplates"
+)
+
+type DatabaseApiTokenTestSuite struct {
+	suite.Suite
+	db    *bun.DB
+	users map[string]uuid.UUID
+}
+
+func TestDatabaseApiTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseApiTokenTestSuite))
+}
+
+func (suite *DatabaseApiTokenTestSuite) SetupTest() {
+	suite.db = testDbTemplates.NewBaseTestDB(
+		suite.T(),
+		false,
+		testDbTemplates.AdditionalSeed{
+			Name: "apitokens_database_test_data",
+			Func: suite.seedData,
+		},
+	)
+}
+
+func (suite *DatabaseApiTokenTestSuite) Test_Database_CreateAndGetToken() {
+	t := suite.T()
+	database := NewApiTokenDatabase(suite.db)
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+
+	created, err := database.CreateToken(context.Background(), DatabaseApiTokenInsert{
+		User:      suite.users["Stan"],
+		Name:      "CI",
+		TokenHash: hashToken(TokenPrefix + "create"),
+		ReadOnly:  true,
+		ExpiresAt: &expiresAt,
+	})
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.True(t, created.ReadOnly)
+	assert.Nil(t, created.LastUsedAt)
+
+	token, err := database.GetTokenByHash(context.Background(), hashToken(TokenPrefix+"create"))
+	assert.Nil(t, err)
+	assert.Equal(t, created.ID, token.ID)
+	assert.Equal(t, suite.users["Stan"], token.User)
+	assert.True(t, expiresAt.Equal(*token.ExpiresAt))
+
+	_, err = database.GetTokenByHash(context.Background(), hashToken(TokenPrefix+"unknown"))
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseApiTokenTestSuite) Test_Database_GetTokens() {
+	t := suite.T()
+	database := NewApiTokenDatabase(suite.db)
+
+	for _, name := range []string{"First", "Second"} {
+		_, err := database.CreateToken(context.Background(), DatabaseApiTokenInsert{User: suite.users["Santa"], Name: name, TokenHash: hashToken(TokenPrefix + name)})
+		assert.Nil(t, err)
+	}
+
+	tokens, err := database.GetTokens(context.Background(), suite.users["Santa"])
+	assert.Nil(t, err)
+	assert.Len(t, tokens, 2)
+
+	tokens, err = database.GetTokens(context.Background(), uuid.New())
+	assert.Nil(t, err)
+	assert.Empty(t, tokens)
+}
+
+func (suite *DatabaseApiTokenTestSuite) Test_Database_DeleteToken() {
+	t := suite.T()
+	database := NewApiTokenDatabase(suite.db)
+
+	token, err := database.CreateToken(context.Background(), DatabaseApiTokenInsert{User: suite.users["Stan"], Name: "Delete", TokenHash: hashToken(TokenPrefix + "delete")})
+	assert.Nil(t, err)
+
+	err = database.DeleteToken(context.Background(), suite.users["Santa"], token.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	err = database.DeleteToken(context.Background(), suite.users["Stan"], token.ID)
+	assert.Nil(t, err)
+
+	_, err = database.GetTokenByHash(context.Background(), hashToken(TokenPrefix+"delete"))
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseApiTokenTestSuite) Test_Database_UpdateLastUsed() {
+	t := suite.T()
+	database := NewApiTokenDatabase(suite.db)
+	lastUsedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	token, err := database.CreateToken(context.Background(), DatabaseApiTokenInsert{User: suite.users["Stan"], Name: "Used", TokenHash: hashToken(TokenPrefix + "used")})
+	assert.Nil(t, err)
+
+	err = database.UpdateLastUsed(context.Background(), token.ID, lastUsedAt)
+	assert.Nil(t, err)
+
+	token, err = database.GetTokenByHash(context.Background(), hashToken(TokenPrefix+"used"))
+	assert.Nil(t, err)
+	assert.True(t, lastUsedAt.Equal(*token.LastUsedAt))
+}
+
+func (suite *DatabaseApiTokenTestSuite) seedData(db *bun.DB) {
+	suite.users = map[string]uuid.UUID{"Stan": uuid.New(), "Santa": uuid.New()}
+
+	for name, user := range suite.users {
+		if err := testDbTemplates.InsertUser(db, user, name, string(common.Anonymous), nil); err != nil {
+			log.Fatalf("Failed to insert test user %s", err)
+		}
+	}
+}
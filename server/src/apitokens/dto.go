@@ -0,0 +1,74 @@
+package apitokens
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApiToken is the response for all personal access token requests.
+// The token itself is never returned after its creation.
+type ApiToken struct {
+	// The token id.
+	ID uuid.UUID `json:"id"`
+
+	// The id of the user the token belongs to.
+	User uuid.UUID `json:"user"`
+
+	// The name to recognize the token by.
+	Name string `json:"name"`
+
+	// Read-only tokens may only be used for GET requests.
+	ReadOnly bool `json:"readOnly"`
+
+	// The date the token expires, the token does not expire if not set.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// The date the token was last used to authenticate a request.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (t *ApiToken) From(token DatabaseApiToken) *ApiToken {
+	t.ID = token.ID
+	t.User = token.User
+	t.Name = token.Name
+	t.ReadOnly = token.ReadOnly
+	t.ExpiresAt = token.ExpiresAt
+	t.LastUsedAt = token.LastUsedAt
+	t.CreatedAt = token.CreatedAt
+
+	return t
+}
+
+func ApiTokens(tokens []DatabaseApiToken) []*ApiToken {
+	if tokens == nil {
+		return nil
+	}
+
+	list := make([]*ApiToken, len(tokens))
+	for index, token := range tokens {
+		list[index] = new(ApiToken).From(token)
+	}
+
+	return list
+}
+
+// ApiTokenCreated is the response to the creation of a personal access token.
+// It is the only response that includes the token.
+type ApiTokenCreated struct {
+	ApiToken
+
+	// The token to send in the Authorization header as bearer token.
+	Token string `json:"token"`
+}
+
+// ApiTokenCreateRequest represents the request to create a personal access token.
+type ApiTokenCreateRequest struct {
+	Name      string     `json:"name"`
+	ReadOnly  bool       `json:"readOnly"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	User uuid.UUID `json:"-"`
+}
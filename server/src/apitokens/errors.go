@@ -0,0 +1,38 @@
+package apitokens
+
+import "fmt"
+
+type ApiTokenErrorCategory string
+
+const (
+	BadRequest   ApiTokenErrorCategory = "BAD_REQUEST"
+	Unauthorized ApiTokenErrorCategory = "UNAUTHORIZED"
+	NotFound     ApiTokenErrorCategory = "NOT_FOUND"
+	Internal     ApiTokenErrorCategory = "INTERNAL"
+)
+
+type ApiTokenError struct {
+	Category ApiTokenErrorCategory
+	Message  string
+	Err      error
+}
+
+func (e ApiTokenError) Error() string {
+	return fmt.Sprintf("api token error [%s]: %s", e.Category, e.Message)
+}
+
+func (e ApiTokenError) Status() string {
+	return string(e.Category)
+}
+
+func (e ApiTokenError) Unwrap() error {
+	return e.Err
+}
+
+func CreateApiTokenError(category ApiTokenErrorCategory, message string, err error) error {
+	return ApiTokenError{
+		Category: category,
+		Message:  message,
+		Err:      err,
+	}
+}
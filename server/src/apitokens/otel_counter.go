@@ -0,0 +1,15 @@
+package apitokens
+
+import "go.opentelemetry.io/otel/metric"
+
+var apiTokenCreatedCounter, _ = meter.Int64Counter(
+	"scrumlr.apitokens.created.counter",
+	metric.WithDescription("Number of created personal access tokens"),
+	metric.WithUnit("tokens"),
+)
+
+var apiTokenDeletedCounter, _ = meter.Int64Counter(
+	"scrumlr.apitokens.deleted.counter",
+	metric.WithDescription("Number of revoked personal access tokens"),
+	metric.WithUnit("tokens"),
+)
@@ -0,0 +1,35 @@
+package apitokens
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ApiTokensApi interface {
+	CreateToken(w http.ResponseWriter, r *http.Request)
+	GetTokens(w http.ResponseWriter, r *http.Request)
+	DeleteToken(w http.ResponseWriter, r *http.Request)
+	BrowserSessionContext(next http.Handler) http.Handler
+}
+
+type Router struct {
+	apiTokensAPI ApiTokensApi
+}
+
+func (r *Router) RegisterRoutes() chi.Router {
+	router := chi.NewRouter()
+	// tokens can not be used to manage tokens, so a read-only token can not create a writable one
+	router.Use(r.apiTokensAPI.BrowserSessionContext)
+
+	router.Post("/", r.apiTokensAPI.CreateToken)
+	router.Get("/", r.apiTokensAPI.GetTokens)
+	router.Delete("/{token}", r.apiTokensAPI.DeleteToken)
+	return router
+}
+
+func NewApiTokensRouter(apiTokensApi ApiTokensApi) *Router {
+	r := new(Router)
+	r.apiTokensAPI = apiTokensApi
+	return r
+}
@@ -0,0 +1,235 @@
+package apitokens
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/logger"
+)
+
+var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/apitokens")
+var meter metric.Meter = otel.Meter("scrumlr.io/server/apitokens")
+
+const (
+	// TokenPrefix distinguishes personal access tokens from JWTs and makes leaked tokens easy to find
+	TokenPrefix = "scrumlr_pat_"
+
+	maxTokenNameLength = 64
+
+	// lastUsedInterval limits how often the last usage is written for a token that is used frequently
+	lastUsedInterval = time.Minute
+)
+
+type ApiTokenDatabase interface {
+	CreateToken(ctx context.Context, insert DatabaseApiTokenInsert) (DatabaseApiToken, error)
+	GetTokens(ctx context.Context, user uuid.UUID) ([]DatabaseApiToken, error)
+	GetTokenByHash(ctx context.Context, hash string) (DatabaseApiToken, error)
+	DeleteToken(ctx context.Context, user, id uuid.UUID) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+}
+
+type Service struct {
+	database ApiTokenDatabase
+}
+
+func NewApiTokenService(db ApiTokenDatabase) ApiTokenService {
+	service := new(Service)
+	service.database = db
+
+	return service
+}
+
+// IsApiToken checks whether the given bearer token is a personal access token
+func IsApiToken(token string) bool {
+	return strings.HasPrefix(token, TokenPrefix)
+}
+
+func (service *Service) Create(ctx context.Context, body ApiTokenCreateRequest) (*ApiTokenCreated, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.apitokens.service.create")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.apitokens.service.create.user", body.User.String()),
+		attribute.Bool("scrumlr.apitokens.service.create.read_only", body.ReadOnly),
+	)
+
+	name, err := validateName(body.Name)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid token name")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if body.ExpiresAt != nil && !body.ExpiresAt.After(time.Now()) {
+		err := CreateApiTokenError(BadRequest, "expiry date must be in the future", errors.New("expiry date must be in the future"))
+		span.SetStatus(codes.Error, "invalid expiry date")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	secret, err := generateToken()
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to generate token")
+		span.RecordError(err)
+		log.Errorw("unable to generate token", "err", err)
+		return nil, CreateApiTokenError(Internal, "failed to generate token", err)
+	}
+
+	token, err := service.database.CreateToken(ctx, DatabaseApiTokenInsert{
+		User:      body.User,
+		Name:      name,
+		TokenHash: hashToken(secret),
+		ReadOnly:  body.ReadOnly,
+		ExpiresAt: body.ExpiresAt,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create token")
+		span.RecordError(err)
+		log.Errorw("unable to create token", "user", body.User, "err", err)
+		return nil, CreateApiTokenError(Internal, "failed to create token", err)
+	}
+
+	apiTokenCreatedCounter.Add(ctx, 1)
+	return &ApiTokenCreated{ApiToken: *new(ApiToken).From(token), Token: secret}, nil
+}
+
+func (service *Service) GetAll(ctx context.Context, user uuid.UUID) ([]*ApiToken, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.apitokens.service.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.apitokens.service.get.all.user", user.String()),
+	)
+
+	tokens, err := service.database.GetTokens(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get tokens")
+		span.RecordError(err)
+		log.Errorw("unable to get tokens", "user", user, "err", err)
+		return nil, CreateApiTokenError(Internal, "failed to get tokens", err)
+	}
+
+	return ApiTokens(tokens), nil
+}
+
+func (service *Service) Delete(ctx context.Context, user, id uuid.UUID) error {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.apitokens.service.delete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.apitokens.service.delete.user", user.String()),
+		attribute.String("scrumlr.apitokens.service.delete.token", id.String()),
+	)
+
+	err := service.database.DeleteToken(ctx, user, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "token not found")
+			span.RecordError(err)
+			return CreateApiTokenError(NotFound, "token not found", err)
+		}
+		span.SetStatus(codes.Error, "failed to delete token")
+		span.RecordError(err)
+		log.Errorw("unable to delete token", "user", user, "token", id, "err", err)
+		return CreateApiTokenError(Internal, "failed to delete token", err)
+	}
+
+	apiTokenDeletedCounter.Add(ctx, 1)
+	return nil
+}
+
+// Verify returns the personal access token matching the given bearer token,
+// unknown and expired tokens are rejected as unauthorized.
+func (service *Service) Verify(ctx context.Context, secret string) (*ApiToken, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.apitokens.service.verify")
+	defer span.End()
+
+	if !IsApiToken(secret) {
+		err := CreateApiTokenError(Unauthorized, "invalid token", errors.New("invalid token"))
+		span.SetStatus(codes.Error, "invalid token format")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	token, err := service.database.GetTokenByHash(ctx, hashToken(secret))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "token not found")
+			span.RecordError(err)
+			return nil, CreateApiTokenError(Unauthorized, "invalid token", err)
+		}
+		span.SetStatus(codes.Error, "failed to get token")
+		span.RecordError(err)
+		log.Errorw("unable to get token", "err", err)
+		return nil, CreateApiTokenError(Internal, "failed to verify token", err)
+	}
+
+	span.SetAttributes(
+		attribute.String("scrumlr.apitokens.service.verify.token", token.ID.String()),
+		attribute.String("scrumlr.apitokens.service.verify.user", token.User.String()),
+	)
+
+	now := time.Now()
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(now) {
+		err := CreateApiTokenError(Unauthorized, "token expired", errors.New("token expired"))
+		span.SetStatus(codes.Error, "token expired")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedInterval {
+		// a failed update must not prevent the request
+		if err := service.database.UpdateLastUsed(ctx, token.ID, now); err != nil {
+			log.Warnw("unable to update last usage of token", "token", token.ID, "err", err)
+		} else {
+			token.LastUsedAt = &now
+		}
+	}
+
+	return new(ApiToken).From(token), nil
+}
+
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", CreateApiTokenError(BadRequest, "token name must not be empty", errors.New("token name must not be empty"))
+	}
+	if utf8.RuneCountInString(name) > maxTokenNameLength {
+		return "", CreateApiTokenError(BadRequest, "token name is too long", errors.New("token name is too long"))
+	}
+
+	return name, nil
+}
+
+func generateToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashToken hashes the token for storage, a fast hash is sufficient for random tokens of this length
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
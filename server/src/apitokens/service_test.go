@@ -0,0 +1,176 @@
+package apitokens
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func assertApiTokenError(t *testing.T, err error, category ApiTokenErrorCategory) {
+	var tokenErr ApiTokenError
+	assert.ErrorAs(t, err, &tokenErr)
+	assert.Equal(t, category, tokenErr.Category)
+}
+
+func TestCreateToken(t *testing.T) {
+	userId := uuid.New()
+	tokenId := uuid.New()
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	var insert DatabaseApiTokenInsert
+	mockDatabase := NewMockApiTokenDatabase(t)
+	mockDatabase.EXPECT().CreateToken(mock.Anything, mock.AnythingOfType("DatabaseApiTokenInsert")).
+		Run(func(_ context.Context, i DatabaseApiTokenInsert) { insert = i }).
+		Return(DatabaseApiToken{ID: tokenId, User: userId, Name: "CI", ReadOnly: true, ExpiresAt: &expiresAt}, nil)
+
+	service := NewApiTokenService(mockDatabase)
+	token, err := service.Create(context.Background(), ApiTokenCreateRequest{Name: " CI ", ReadOnly: true, ExpiresAt: &expiresAt, User: userId})
+
+	assert.Nil(t, err)
+	assert.Equal(t, tokenId, token.ID)
+	assert.True(t, strings.HasPrefix(token.Token, TokenPrefix))
+	assert.Equal(t, "CI", insert.Name)
+	assert.Equal(t, userId, insert.User)
+	assert.True(t, insert.ReadOnly)
+	assert.Equal(t, hashToken(token.Token), insert.TokenHash)
+	assert.NotContains(t, insert.TokenHash, token.Token)
+}
+
+func TestCreateToken_EmptyName(t *testing.T) {
+	service := NewApiTokenService(NewMockApiTokenDatabase(t))
+
+	token, err := service.Create(context.Background(), ApiTokenCreateRequest{Name: "  ", User: uuid.New()})
+
+	assert.Nil(t, token)
+	assertApiTokenError(t, err, BadRequest)
+}
+
+func TestCreateToken_ExpiryInThePast(t *testing.T) {
+	expiresAt := time.Now().Add(-time.Minute)
+	service := NewApiTokenService(NewMockApiTokenDatabase(t))
+
+	token, err := service.Create(context.Background(), ApiTokenCreateRequest{Name: "CI", ExpiresAt: &expiresAt, User: uuid.New()})
+
+	assert.Nil(t, token)
+	assertApiTokenError(t, err, BadRequest)
+}
+
+func TestGetTokens(t *testing.T) {
+	userId := uuid.New()
+
+	mockDatabase := NewMockApiTokenDatabase(t)
+	mockDatabase.EXPECT().GetTokens(mock.Anything, userId).
+		Return([]DatabaseApiToken{{ID: uuid.New(), User: userId, Name: "CI"}, {ID: uuid.New(), User: userId, Name: "Export"}}, nil)
+
+	service := NewApiTokenService(mockDatabase)
+	tokens, err := service.GetAll(context.Background(), userId)
+
+	assert.Nil(t, err)
+	assert.Len(t, tokens, 2)
+	assert.Equal(t, "Export", tokens[1].Name)
+}
+
+func TestDeleteToken_NotFound(t *testing.T) {
+	userId := uuid.New()
+	tokenId := uuid.New()
+
+	mockDatabase := NewMockApiTokenDatabase(t)
+	mockDatabase.EXPECT().DeleteToken(mock.Anything, userId, tokenId).Return(sql.ErrNoRows)
+
+	service := NewApiTokenService(mockDatabase)
+	err := service.Delete(context.Background(), userId, tokenId)
+
+	assertApiTokenError(t, err, NotFound)
+}
+
+func TestVerifyToken(t *testing.T) {
+	secret := TokenPrefix + "secret"
+	tokenId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockApiTokenDatabase(t)
+	mockDatabase.EXPECT().GetTokenByHash(mock.Anything, hashToken(secret)).
+		Return(DatabaseApiToken{ID: tokenId, User: userId, Name: "CI"}, nil)
+	mockDatabase.EXPECT().UpdateLastUsed(mock.Anything, tokenId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewApiTokenService(mockDatabase)
+	token, err := service.Verify(context.Background(), secret)
+
+	assert.Nil(t, err)
+	assert.Equal(t, userId, token.User)
+	assert.NotNil(t, token.LastUsedAt)
+}
+
+func TestVerifyToken_RecentlyUsed(t *testing.T) {
+	secret := TokenPrefix + "secret"
+	lastUsedAt := time.Now().Add(-time.Second)
+
+	mockDatabase := NewMockApiTokenDatabase(t)
+	mockDatabase.EXPECT().GetTokenByHash(mock.Anything, hashToken(secret)).
+		Return(DatabaseApiToken{ID: uuid.New(), User: uuid.New(), LastUsedAt: &lastUsedAt}, nil)
+
+	service := NewApiTokenService(mockDatabase)
+	token, err := service.Verify(context.Background(), secret)
+
+	assert.Nil(t, err)
+	assert.Equal(t, lastUsedAt, *token.LastUsedAt)
+}
+
+func TestVerifyToken_LastUsedUpdateFails(t *testing.T) {
+	secret := TokenPrefix + "secret"
+
+	mockDatabase := NewMockApiTokenDatabase(t)
+	mockDatabase.EXPECT().GetTokenByHash(mock.Anything, hashToken(secret)).
+		Return(DatabaseApiToken{ID: uuid.New(), User: uuid.New()}, nil)
+	mockDatabase.EXPECT().UpdateLastUsed(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+	service := NewApiTokenService(mockDatabase)
+	token, err := service.Verify(context.Background(), secret)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, token)
+}
+
+func TestVerifyToken_Expired(t *testing.T) {
+	secret := TokenPrefix + "secret"
+	expiresAt := time.Now().Add(-time.Minute)
+
+	mockDatabase := NewMockApiTokenDatabase(t)
+	mockDatabase.EXPECT().GetTokenByHash(mock.Anything, hashToken(secret)).
+		Return(DatabaseApiToken{ID: uuid.New(), User: uuid.New(), ExpiresAt: &expiresAt}, nil)
+
+	service := NewApiTokenService(mockDatabase)
+	token, err := service.Verify(context.Background(), secret)
+
+	assert.Nil(t, token)
+	assertApiTokenError(t, err, Unauthorized)
+}
+
+func TestVerifyToken_Unknown(t *testing.T) {
+	secret := TokenPrefix + "unknown"
+
+	mockDatabase := NewMockApiTokenDatabase(t)
+	mockDatabase.EXPECT().GetTokenByHash(mock.Anything, hashToken(secret)).Return(DatabaseApiToken{}, sql.ErrNoRows)
+
+	service := NewApiTokenService(mockDatabase)
+	token, err := service.Verify(context.Background(), secret)
+
+	assert.Nil(t, token)
+	assertApiTokenError(t, err, Unauthorized)
+}
+
+func TestVerifyToken_NoApiToken(t *testing.T) {
+	service := NewApiTokenService(NewMockApiTokenDatabase(t))
+
+	token, err := service.Verify(context.Background(), "eyJhbGciOiJFUzUxMiJ9")
+
+	assert.Nil(t, token)
+	assertApiTokenError(t, err, Unauthorized)
+}
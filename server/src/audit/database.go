@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+)
+
+type DB struct {
+	db *bun.DB
+}
+
+func NewAuditDatabase(database *bun.DB) AuditDatabase {
+	db := new(DB)
+	db.db = database
+
+	return db
+}
+
+// CreateEntry appends an entry to the audit log of a board
+func (d *DB) CreateEntry(ctx context.Context, insert DatabaseEntryInsert) (DatabaseEntry, error) {
+	var entry DatabaseEntry
+	_, err := d.db.NewInsert().
+		Model(&insert).
+		Returning("*").
+		Exec(ctx, &entry)
+
+	return entry, err
+}
+
+// GetEntries gets the audit log of a board, newest first
+func (d *DB) GetEntries(ctx context.Context, board uuid.UUID, page common.Page) ([]DatabaseEntry, error) {
+	var entries []DatabaseEntry
+	err := d.db.NewSelect().
+		Model(&entries).
+		Where("board = ?", board).
+		Order("created_at DESC", "id").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx)
+
+	return entries, err
+}
+
+// GetEntriesByActor gets the entries of the audit log of a board the user made, newest first
+func (d *DB) GetEntriesByActor(ctx context.Context, board, actor uuid.UUID) ([]DatabaseEntry, error) {
+	var entries []DatabaseEntry
+	err := d.db.NewSelect().
+		Model(&entries).
+		Where("board = ?", board).
+		Where("actor = ?", actor).
+		Order("created_at DESC", "id").
+		Scan(ctx)
+
+	return entries, err
+}
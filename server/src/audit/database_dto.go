@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type DatabaseEntry struct {
+	bun.BaseModel `bun:"table:audit_log"`
+	ID            uuid.UUID
+	Board         uuid.UUID
+	Actor         uuid.NullUUID
+	Action        Action
+	Before        json.RawMessage
+	After         json.RawMessage
+	CreatedAt     time.Time
+}
+
+type DatabaseEntryInsert struct {
+	bun.BaseModel `bun:"table:audit_log"`
+	Board         uuid.UUID
+	Actor         uuid.NullUUID
+	Action        Action
+	Before        json.RawMessage
+	After         json.RawMessage
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/initialize/testDbTemplates"
+)
+
+type DatabaseAuditTestSuite struct {
+	suite.Suite
+	db    *bun.DB
+	user  uuid.UUID
+	board uuid.UUID
+}
+
+func TestDatabaseAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseAuditTestSuite))
+}
+
+func (suite *DatabaseAuditTestSuite) SetupTest() {
+	suite.db = testDbTemplates.NewBaseTestDB(
+		suite.T(),
+		false,
+		testDbTemplates.AdditionalSeed{
+			Name: "audit_database_test_data",
+			Func: suite.seedData,
+		},
+	)
+}
+
+func (suite *DatabaseAuditTestSuite) Test_Database_CreateAndGetEntries() {
+	t := suite.T()
+	database := NewAuditDatabase(suite.db)
+
+	created, err := database.CreateEntry(context.Background(), DatabaseEntryInsert{
+		Board:  suite.board,
+		Actor:  uuid.NullUUID{UUID: suite.user, Valid: true},
+		Action: BoardUpdated,
+		Before: json.RawMessage(`{"isLocked":false}`),
+		After:  json.RawMessage(`{"isLocked":true}`),
+	})
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+
+	_, err = database.CreateEntry(context.Background(), DatabaseEntryInsert{Board: suite.board, Action: VotingCreated, After: json.RawMessage(`{"voteLimit":5}`)})
+	assert.Nil(t, err)
+
+	entries, err := database.GetEntries(context.Background(), suite.board, common.Page{Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, VotingCreated, entries[0].Action)
+	assert.False(t, entries[0].Actor.Valid)
+	assert.Nil(t, entries[0].Before)
+	assert.Equal(t, created.ID, entries[1].ID)
+	assert.JSONEq(t, `{"isLocked":true}`, string(entries[1].After))
+
+	entries, err = database.GetEntries(context.Background(), suite.board, common.Page{Limit: 1, Offset: 1})
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, created.ID, entries[0].ID)
+}
+
+func (suite *DatabaseAuditTestSuite) Test_Database_GetEntriesByActor() {
+	t := suite.T()
+	database := NewAuditDatabase(suite.db)
+
+	created, err := database.CreateEntry(context.Background(), DatabaseEntryInsert{Board: suite.board, Actor: uuid.NullUUID{UUID: suite.user, Valid: true}, Action: BoardUpdated})
+	assert.Nil(t, err)
+	_, err = database.CreateEntry(context.Background(), DatabaseEntryInsert{Board: suite.board, Action: VotingCreated})
+	assert.Nil(t, err)
+
+	entries, err := database.GetEntriesByActor(context.Background(), suite.board, suite.user)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, created.ID, entries[0].ID)
+}
+
+func (suite *DatabaseAuditTestSuite) Test_Database_AppendOnly() {
+	t := suite.T()
+	database := NewAuditDatabase(suite.db)
+
+	entry, err := database.CreateEntry(context.Background(), DatabaseEntryInsert{Board: suite.board, Action: ColumnDeleted, Before: json.RawMessage(`{"name":"Went well"}`)})
+	assert.Nil(t, err)
+
+	_, err = suite.db.NewUpdate().Table("audit_log").Set("action = ?", NoteDeleted).Where("id = ?", entry.ID).Exec(context.Background())
+	assert.NotNil(t, err)
+}
+
+func (suite *DatabaseAuditTestSuite) seedData(db *bun.DB) {
+	suite.user = uuid.New()
+	suite.board = uuid.New()
+
+	if err := testDbTemplates.InsertUser(db, suite.user, "Stan", string(common.Anonymous), nil); err != nil {
+		log.Fatalf("Failed to insert test user %s", err)
+	}
+	if err := testDbTemplates.InsertBoard(db, suite.board, "Audit Board", "", nil, nil, "PUBLIC", true, true, true, true, false); err != nil {
+		log.Fatalf("Failed to insert test board %s", err)
+	}
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Action is the kind of change recorded in the audit log.
+type Action string
+
+const (
+	BoardUpdated   Action = "BOARD_UPDATED"
+	SessionUpdated Action = "SESSION_UPDATED"
+	ColumnDeleted  Action = "COLUMN_DELETED"
+	VotingCreated  Action = "VOTING_CREATED"
+	VotingClosed   Action = "VOTING_CLOSED"
+	NoteDeleted    Action = "NOTE_DELETED"
+)
+
+// Entry is a change of a board recorded in the audit log.
+type Entry struct {
+	ID uuid.UUID `json:"id"`
+
+	// The user who made the change, empty if the user was deleted since.
+	Actor *uuid.UUID `json:"actor,omitempty"`
+
+	// The kind of change.
+	Action Action `json:"action"`
+
+	// The changed object before the change, empty if it was created.
+	Before json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+
+	// The changed object after the change, empty if it was deleted.
+	After json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (e *Entry) From(entry DatabaseEntry) *Entry {
+	e.ID = entry.ID
+	if entry.Actor.Valid {
+		actor := entry.Actor.UUID
+		e.Actor = &actor
+	}
+	e.Action = entry.Action
+	e.Before = entry.Before
+	e.After = entry.After
+	e.CreatedAt = entry.CreatedAt
+
+	return e
+}
+
+func Entries(entries []DatabaseEntry) []*Entry {
+	result := make([]*Entry, len(entries))
+	for index, entry := range entries {
+		result[index] = new(Entry).From(entry)
+	}
+
+	return result
+}
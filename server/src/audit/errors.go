@@ -0,0 +1,35 @@
+package audit
+
+import "fmt"
+
+type AuditErrorCategory string
+
+const (
+	Internal AuditErrorCategory = "INTERNAL"
+)
+
+type AuditError struct {
+	Category AuditErrorCategory
+	Message  string
+	Err      error
+}
+
+func (e AuditError) Error() string {
+	return fmt.Sprintf("audit error [%s]: %s", e.Category, e.Message)
+}
+
+func (e AuditError) Status() string {
+	return string(e.Category)
+}
+
+func (e AuditError) Unwrap() error {
+	return e.Err
+}
+
+func CreateAuditError(category AuditErrorCategory, message string, err error) error {
+	return AuditError{
+		Category: category,
+		Message:  message,
+		Err:      err,
+	}
+}
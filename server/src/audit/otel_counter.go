@@ -0,0 +1,9 @@
+package audit
+
+import "go.opentelemetry.io/otel/metric"
+
+var entryRecordedCounter, _ = meter.Int64Counter(
+	"scrumlr.audit.entries.recorded.counter",
+	metric.WithDescription("Number of recorded audit log entries"),
+	metric.WithUnit("entries"),
+)
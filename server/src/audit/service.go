@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+)
+
+var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/audit")
+var meter metric.Meter = otel.Meter("scrumlr.io/server/audit")
+
+type AuditDatabase interface {
+	CreateEntry(ctx context.Context, insert DatabaseEntryInsert) (DatabaseEntry, error)
+	GetEntries(ctx context.Context, board uuid.UUID, page common.Page) ([]DatabaseEntry, error)
+	GetEntriesByActor(ctx context.Context, board, actor uuid.UUID) ([]DatabaseEntry, error)
+}
+
+type AuditService interface {
+	Record(ctx context.Context, board uuid.UUID, action Action, before, after any)
+	GetAll(ctx context.Context, board uuid.UUID, page common.Page) ([]*Entry, error)
+	GetAllByActor(ctx context.Context, board, actor uuid.UUID) ([]*Entry, error)
+}
+
+type Service struct {
+	database AuditDatabase
+}
+
+func NewAuditService(db AuditDatabase) AuditService {
+	service := new(Service)
+	service.database = db
+
+	return service
+}
+
+// Record appends a change to the audit log of the board. The actor is the user of the request.
+// Before and after are stored as json and are omitted if nil. The change already happened
+// at this point, so a failure to record it is only logged.
+func (service *Service) Record(ctx context.Context, board uuid.UUID, action Action, before, after any) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.audit.service.record")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.audit.service.record.board", board.String()),
+		attribute.String("scrumlr.audit.service.record.action", string(action)),
+	)
+
+	insert := DatabaseEntryInsert{Board: board, Action: action}
+	if actor, ok := ctx.Value(identifiers.UserIdentifier).(uuid.UUID); ok {
+		insert.Actor = uuid.NullUUID{UUID: actor, Valid: true}
+	}
+
+	var err error
+	if insert.Before, err = marshal(before); err == nil {
+		insert.After, err = marshal(after)
+	}
+	if err == nil {
+		_, err = service.database.CreateEntry(ctx, insert)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to record audit log entry")
+		span.RecordError(err)
+		log.Errorw("unable to record audit log entry", "board", board, "action", action, "err", err)
+		return
+	}
+
+	entryRecordedCounter.Add(ctx, 1)
+}
+
+func (service *Service) GetAll(ctx context.Context, board uuid.UUID, page common.Page) ([]*Entry, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.audit.service.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.audit.service.get.all.board", board.String()),
+		attribute.Int("scrumlr.audit.service.get.all.limit", page.Limit),
+		attribute.Int("scrumlr.audit.service.get.all.offset", page.Offset),
+	)
+
+	entries, err := service.database.GetEntries(ctx, board, page)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get audit log")
+		span.RecordError(err)
+		log.Errorw("unable to get audit log", "board", board, "err", err)
+		return nil, CreateAuditError(Internal, "failed to get audit log", err)
+	}
+
+	return Entries(entries), nil
+}
+
+// GetAllByActor gets all entries of the audit log of the board the user made
+func (service *Service) GetAllByActor(ctx context.Context, board, actor uuid.UUID) ([]*Entry, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.audit.service.get.actor")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.audit.service.get.actor.board", board.String()),
+		attribute.String("scrumlr.audit.service.get.actor.actor", actor.String()),
+	)
+
+	entries, err := service.database.GetEntriesByActor(ctx, board, actor)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get audit log")
+		span.RecordError(err)
+		log.Errorw("unable to get audit log of actor", "board", board, "actor", actor, "err", err)
+		return nil, CreateAuditError(Internal, "failed to get audit log", err)
+	}
+
+	return Entries(entries), nil
+}
+
+func marshal(value any) (json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(value)
+}
@@ -0,0 +1,110 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+)
+
+func TestRecord(t *testing.T) {
+	boardId := uuid.New()
+	actorId := uuid.New()
+
+	mockDatabase := NewMockAuditDatabase(t)
+	mockDatabase.EXPECT().CreateEntry(mock.Anything, DatabaseEntryInsert{
+		Board:  boardId,
+		Actor:  uuid.NullUUID{UUID: actorId, Valid: true},
+		Action: BoardUpdated,
+		Before: json.RawMessage(`{"isLocked":false}`),
+		After:  json.RawMessage(`{"isLocked":true}`),
+	}).Return(DatabaseEntry{ID: uuid.New()}, nil)
+
+	ctx := context.WithValue(context.Background(), identifiers.UserIdentifier, actorId)
+	service := NewAuditService(mockDatabase)
+	service.Record(ctx, boardId, BoardUpdated, map[string]bool{"isLocked": false}, map[string]bool{"isLocked": true})
+}
+
+func TestRecord_WithoutActorAndBefore(t *testing.T) {
+	boardId := uuid.New()
+
+	mockDatabase := NewMockAuditDatabase(t)
+	mockDatabase.EXPECT().CreateEntry(mock.Anything, DatabaseEntryInsert{
+		Board:  boardId,
+		Action: VotingCreated,
+		After:  json.RawMessage(`{"voteLimit":5}`),
+	}).Return(DatabaseEntry{ID: uuid.New()}, nil)
+
+	service := NewAuditService(mockDatabase)
+	service.Record(context.Background(), boardId, VotingCreated, nil, map[string]int{"voteLimit": 5})
+}
+
+func TestRecord_DatabaseError(t *testing.T) {
+	mockDatabase := NewMockAuditDatabase(t)
+	mockDatabase.EXPECT().CreateEntry(mock.Anything, mock.Anything).Return(DatabaseEntry{}, errors.New("database error"))
+
+	service := NewAuditService(mockDatabase)
+
+	// the change already happened, so a failure to record it must not panic or fail the caller
+	assert.NotPanics(t, func() {
+		service.Record(context.Background(), uuid.New(), NoteDeleted, map[string]string{"text": "note"}, nil)
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	boardId := uuid.New()
+	actorId := uuid.New()
+	page := common.Page{Limit: 10, Offset: 20}
+
+	mockDatabase := NewMockAuditDatabase(t)
+	mockDatabase.EXPECT().GetEntries(mock.Anything, boardId, page).Return([]DatabaseEntry{
+		{ID: uuid.New(), Board: boardId, Actor: uuid.NullUUID{UUID: actorId, Valid: true}, Action: SessionUpdated},
+		{ID: uuid.New(), Board: boardId, Action: VotingClosed},
+	}, nil)
+
+	service := NewAuditService(mockDatabase)
+	entries, err := service.GetAll(context.Background(), boardId, page)
+
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, actorId, *entries[0].Actor)
+	assert.Nil(t, entries[1].Actor)
+}
+
+func TestGetAll_DatabaseError(t *testing.T) {
+	boardId := uuid.New()
+
+	mockDatabase := NewMockAuditDatabase(t)
+	mockDatabase.EXPECT().GetEntries(mock.Anything, boardId, common.Page{Limit: 10}).Return(nil, errors.New("database error"))
+
+	service := NewAuditService(mockDatabase)
+	entries, err := service.GetAll(context.Background(), boardId, common.Page{Limit: 10})
+
+	assert.Nil(t, entries)
+	var auditErr AuditError
+	assert.ErrorAs(t, err, &auditErr)
+	assert.Equal(t, Internal, auditErr.Category)
+}
+
+func TestGetAllByActor(t *testing.T) {
+	boardId := uuid.New()
+	actorId := uuid.New()
+
+	mockDatabase := NewMockAuditDatabase(t)
+	mockDatabase.EXPECT().GetEntriesByActor(mock.Anything, boardId, actorId).Return([]DatabaseEntry{
+		{ID: uuid.New(), Board: boardId, Actor: uuid.NullUUID{UUID: actorId, Valid: true}, Action: SessionUpdated},
+	}, nil)
+
+	service := NewAuditService(mockDatabase)
+	entries, err := service.GetAllByActor(context.Background(), boardId, actorId)
+
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, actorId, *entries[0].Actor)
+}
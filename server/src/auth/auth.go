@@ -1,20 +1,24 @@
 package auth
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
-	"math"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
+	"scrumlr.io/server/apitokens"
 	"scrumlr.io/server/users"
 
 	"github.com/uptrace/bun"
 
+	"github.com/crewjam/saml"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/jwtauth/v5"
 	"github.com/google/uuid"
@@ -33,15 +37,35 @@ import (
 	"scrumlr.io/server/logger"
 )
 
+const (
+	// accountLinkCookie keeps the user who started a login with an identity provider
+	accountLinkCookie = "account_link"
+
+	// accountLinkLifetime is the time a user has to log in at the identity provider
+	accountLinkLifetime = 10 * time.Minute
+)
+
 type Auth interface {
 	Sign(map[string]any) (string, error)
+	SetSessionCookie(w http.ResponseWriter, r *http.Request, user uuid.UUID) error
+	RevokeSession(r *http.Request) error
+	RevokeSessions(ctx context.Context, user uuid.UUID) error
+	BeginAccountLink(w http.ResponseWriter, r *http.Request)
+	CompleteAccountLink(w http.ResponseWriter, r *http.Request) (AccountLink, bool)
 	Verifier() func(http.Handler) http.Handler
 	Authenticator() func(http.Handler) http.Handler
 	Exists(accountType common.AccountType) bool
-	ExtractUserInformation(common.AccountType, *goth.User) (*UserInformation, error)
+	OIDCProviders() []string
+	ExtractUserInformation(*goth.User) (*UserInformation, error)
+	SAMLMetadata() (*saml.EntityDescriptor, error)
+	BeginSAMLAuth(w http.ResponseWriter, r *http.Request) error
+	CompleteSAMLAuth(w http.ResponseWriter, r *http.Request) (*UserInformation, string, error)
 }
 
 type AuthProviderConfiguration struct {
+	// Name of additional OIDC providers, which are available at /login/{name}
+	Name string
+
 	TenantId       string
 	ClientId       string
 	ClientSecret   string
@@ -49,6 +73,22 @@ type AuthProviderConfiguration struct {
 	DiscoveryUri   string
 	UserIdentScope string
 	UserNameScope  string
+
+	// scopes and claim mapping of additional OIDC providers
+	Scopes         []string
+	UserIdentClaim string
+	UserNameClaim  string
+
+	// SAML service provider settings
+	IdpMetadataUri     string
+	Certificate        string
+	PrivateKey         string
+	UserIdentAttribute string
+	UserNameAttribute  string
+
+	// members of the admin group are instance administrators, the groups are read from the given claim or SAML attribute
+	AdminGroup      string
+	AdminGroupClaim string
 }
 
 type AuthConfiguration struct {
@@ -59,19 +99,47 @@ type AuthConfiguration struct {
 	auth             *jwtauth.JWTAuth
 	database         *bun.DB
 	userService      users.UserService
+	apiTokenService  apitokens.ApiTokenService
+	revocations      *Revocations
+	sessionLifetime  time.Duration
+	saml             *samlProvider
+
+	// issuers of the OIDC providers by their name
+	issuers map[string]string
+}
+
+// AccountLink is the user who started a login with an identity provider
+type AccountLink struct {
+	User uuid.UUID
+
+	// LinkIdentity is set if the user asked to add the account of the identity provider to the user
+	LinkIdentity bool
 }
 
 type UserInformation struct {
-	Provider               common.AccountType
+	Provider common.AccountType
+
+	// ProviderName distinguishes the additional OIDC providers, otherwise it is the lowercase account type
+	ProviderName string
+
+	// Issuer of OIDC providers from their discovery, which keeps the users of the providers apart
+	Issuer string
+
 	Ident, Name, AvatarURL string
+
+	// Admin is the membership in the admin group, which is nil if the provider has no admin group
+	Admin *bool
 }
 
-func NewAuthConfiguration(providers map[string]AuthProviderConfiguration, unsafePrivateKey, privateKey string, database *bun.DB, userService users.UserService) (Auth, error) {
+func NewAuthConfiguration(providers map[string]AuthProviderConfiguration, unsafePrivateKey, privateKey string, database *bun.DB, userService users.UserService, apiTokenService apitokens.ApiTokenService, revocations *Revocations, sessionLifetime time.Duration) (Auth, error) {
 	a := new(AuthConfiguration)
 	a.providers = providers
 	a.unsafePrivateKey = unsafePrivateKey
 	a.database = database
 	a.userService = userService
+	a.apiTokenService = apiTokenService
+	a.revocations = revocations
+	a.sessionLifetime = sessionLifetime
 	a.privateKey = privateKey
 	if err := a.initializeProviders(); err != nil {
 		return nil, err
@@ -85,6 +153,7 @@ func NewAuthConfiguration(providers map[string]AuthProviderConfiguration, unsafe
 
 func (a *AuthConfiguration) initializeProviders() error {
 	providers := []goth.Provider{}
+	a.issuers = map[string]string{}
 	if provider, ok := a.providers[(string)(common.Google)]; ok {
 		p := google.New(
 			provider.ClientId,
@@ -153,8 +222,42 @@ func (a *AuthConfiguration) initializeProviders() error {
 		}
 
 		p.SetName(strings.ToLower((string)(common.TypeOIDC)))
+		a.issuers[p.Name()] = p.OpenIDConfig.Issuer
 		providers = append(providers, p)
 	}
+	for _, provider := range a.providers {
+		if provider.Name == "" {
+			continue
+		}
+
+		p, err := oidc.NewNamed(
+			provider.Name,
+			provider.ClientId,
+			provider.ClientSecret,
+			provider.RedirectUri,
+			provider.DiscoveryUri,
+			provider.Scopes...,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to set up oidc provider %s: %w", provider.Name, err)
+		}
+
+		if provider.UserIdentClaim != "" {
+			p.UserIdClaims = []string{provider.UserIdentClaim}
+		}
+		if provider.UserNameClaim != "" {
+			p.NickNameClaims = []string{provider.UserNameClaim}
+		}
+		a.issuers[provider.Name] = p.OpenIDConfig.Issuer
+		providers = append(providers, p)
+	}
+	if provider, ok := a.providers[(string)(common.TypeSAML)]; ok {
+		p, err := newSAMLProvider(provider)
+		if err != nil {
+			return fmt.Errorf("unable to set up saml provider: %w", err)
+		}
+		a.saml = p
+	}
 	goth.UseProviders(providers...)
 	gothic.GetProviderName = func(r *http.Request) (string, error) {
 		return chi.URLParam(r, "provider"), nil
@@ -178,58 +281,274 @@ func (a *AuthConfiguration) initializeProviders() error {
 	return nil
 }
 
+// Sign signs the claims as JWT. Unless given, a token id, the issue date and the expiry date are added.
 func (a *AuthConfiguration) Sign(claims map[string]any) (string, error) {
+	if _, ok := claims[jwt.JwtIDKey]; !ok {
+		claims[jwt.JwtIDKey] = uuid.NewString()
+	}
+	jwtauth.SetIssuedNow(claims)
+	jwtauth.SetExpiryIn(claims, a.sessionLifetime)
+
 	_, token, err := a.auth.Encode(claims)
 	return token, err
 }
 
+// SetSessionCookie signs in the user by setting the cookie of a new session
+func (a *AuthConfiguration) SetSessionCookie(w http.ResponseWriter, r *http.Request, user uuid.UUID) error {
+	_, err := a.setSessionCookie(w, r, map[string]any{"id": user.String()})
+	return err
+}
+
+func (a *AuthConfiguration) setSessionCookie(w http.ResponseWriter, r *http.Request, claims map[string]any) (string, error) {
+	tokenString, err := a.Sign(claims)
+	if err != nil {
+		return "", err
+	}
+
+	cookie := http.Cookie{Name: "jwt", Value: tokenString, Path: "/", HttpOnly: true, MaxAge: int(a.sessionLifetime.Seconds())}
+	common.SealCookie(r, &cookie)
+	http.SetCookie(w, &cookie)
+
+	return tokenString, nil
+}
+
+// RevokeSession revokes the session of the cookie sent with the request, if there is a valid one
+func (a *AuthConfiguration) RevokeSession(r *http.Request) error {
+	token, err := jwtauth.VerifyRequest(a.auth, r, jwtauth.TokenFromCookie)
+	if err != nil {
+		return nil
+	}
+
+	user, err := userOf(token)
+	if err != nil {
+		return err
+	}
+
+	// sessions from before token ids were introduced can only be revoked all at once
+	session, ok := token.JwtID()
+	if !ok {
+		return nil
+	}
+
+	return a.revocations.RevokeSession(r.Context(), user, session)
+}
+
+// RevokeSessions revokes all sessions of the user, e.g. to log out everywhere
+func (a *AuthConfiguration) RevokeSessions(ctx context.Context, user uuid.UUID) error {
+	return a.revocations.RevokeAll(ctx, user)
+}
+
+// BeginAccountLink remembers the user of the session cookie sent with the request, so that the user can be linked
+// to the account of the identity provider the user is about to log in with. The session cookie itself is not sent
+// along with the response of the identity provider, since it is restricted to same site requests.
+// With the query parameter link=true the account of the identity provider is added to the user.
+func (a *AuthConfiguration) BeginAccountLink(w http.ResponseWriter, r *http.Request) {
+	token, err := jwtauth.VerifyRequest(a.auth, r, jwtauth.TokenFromCookie)
+	if err != nil || a.checkSession(r.Context(), token) != nil {
+		return
+	}
+
+	user, err := userOf(token)
+	if err != nil {
+		return
+	}
+
+	claims := map[string]any{"id": user.String(), "link": r.URL.Query().Get("link") == "true"}
+	jwtauth.SetExpiryIn(claims, accountLinkLifetime)
+	_, tokenString, err := a.auth.Encode(claims)
+	if err != nil {
+		logger.FromContext(r.Context()).Errorw("unable to encode account link", "user", user, "err", err)
+		return
+	}
+
+	cookie := http.Cookie{Name: accountLinkCookie, Value: tokenString, Path: "/", MaxAge: int(accountLinkLifetime.Seconds())}
+	sealAccountLinkCookie(r, &cookie)
+	http.SetCookie(w, &cookie)
+}
+
+// CompleteAccountLink returns the user remembered when the login started and forgets it
+func (a *AuthConfiguration) CompleteAccountLink(w http.ResponseWriter, r *http.Request) (AccountLink, bool) {
+	cookie, err := r.Cookie(accountLinkCookie)
+	if err != nil {
+		return AccountLink{}, false
+	}
+
+	removal := http.Cookie{Name: accountLinkCookie, Value: "deleted", Path: "/", MaxAge: -1, Expires: time.UnixMilli(0)}
+	sealAccountLinkCookie(r, &removal)
+	http.SetCookie(w, &removal)
+
+	token, err := jwtauth.VerifyToken(a.auth, cookie.Value)
+	if err != nil {
+		return AccountLink{}, false
+	}
+
+	user, err := userOf(token)
+	if err != nil {
+		return AccountLink{}, false
+	}
+
+	var linkIdentity bool
+	_ = token.Get("link", &linkIdentity)
+
+	return AccountLink{User: user, LinkIdentity: linkIdentity}, true
+}
+
+// sealAccountLinkCookie is like common.SealCookie, but the cookie is also sent with the cross site
+// responses of the identity providers, including the posted responses of SAML
+func sealAccountLinkCookie(r *http.Request, cookie *http.Cookie) {
+	common.SealCookie(r, cookie)
+	if cookie.Secure {
+		cookie.SameSite = http.SameSiteNoneMode
+	} else {
+		cookie.SameSite = http.SameSiteLaxMode
+	}
+}
+
+// Verifier verifies personal access tokens sent as bearer token and JWTs otherwise.
+// JWTs of revoked sessions are rejected and sessions are renewed before they expire.
 func (a *AuthConfiguration) Verifier() func(http.Handler) http.Handler {
-	if a.unsafeAuth != nil {
-		return func(next http.Handler) http.Handler {
-			hfn := func(w http.ResponseWriter, r *http.Request) {
-				ctx := r.Context()
-				log := logger.FromContext(ctx)
-				var token jwt.Token
-				var err error
-
-				if token, err = jwtauth.VerifyRequest(a.unsafeAuth, r, jwtauth.TokenFromCookie); err == nil {
-					// check if user tries to authenticate by a prior authentication key
-					// attempt to migrate JWT to new key
-					var userID string
-					err = token.Get("id", &userID)
-					if err != nil {
-						log.Errorw("Error getting user ID", "error", err)
-					}
-					var user uuid.UUID
-					user, err = uuid.Parse(userID)
-
-					if err == nil {
-						var ok bool
-						if ok, err = a.userService.IsUserAvailableForKeyMigration(ctx, user); ok {
-							// prepare new JWT
-							tokenString, _ := a.Sign(map[string]any{"id": user})
-							cookie := http.Cookie{Name: "jwt", Value: tokenString, Path: "/", HttpOnly: true, MaxAge: math.MaxInt32}
-							common.SealCookie(r, &cookie)
-							http.SetCookie(w, &cookie)
-
-							// update rotation flag in database for user, ignore errors
-							_, _ = a.userService.SetKeyMigration(ctx, user)
-						} else {
-							err = errors.New("not permitted to access key rotation")
-						}
-					}
-				} else {
-					// attempt to verify request by new key
-					token, err = jwtauth.VerifyRequest(a.auth, r, jwtauth.TokenFromCookie)
-				}
-
-				ctx = jwtauth.NewContext(ctx, token, err)
-				next.ServeHTTP(w, r.WithContext(ctx))
+	return func(next http.Handler) http.Handler {
+		hfn := func(w http.ResponseWriter, r *http.Request) {
+			if secret := jwtauth.TokenFromHeader(r); apitokens.IsApiToken(secret) {
+				a.verifyApiToken(w, r, next, secret)
+				return
+			}
+
+			ctx := r.Context()
+			token, err := a.verifyJWT(w, r)
+			if err == nil {
+				err = a.checkSession(ctx, token)
 			}
-			return http.HandlerFunc(hfn)
+			if err == nil {
+				a.renewSession(w, r, token)
+			}
+
+			next.ServeHTTP(w, r.WithContext(jwtauth.NewContext(ctx, token, err)))
+		}
+		return http.HandlerFunc(hfn)
+	}
+}
+
+// verifyApiToken passes a token with the claims of the owning user on to the authenticator,
+// so that requests with personal access tokens are handled like requests with JWTs.
+func (a *AuthConfiguration) verifyApiToken(w http.ResponseWriter, r *http.Request, next http.Handler, secret string) {
+	ctx := r.Context()
+
+	apiToken, err := a.apiTokenService.Verify(ctx, secret)
+	if err != nil {
+		next.ServeHTTP(w, r.WithContext(jwtauth.NewContext(ctx, nil, err)))
+		return
+	}
+
+	if apiToken.ReadOnly && r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodOptions {
+		common.Throw(w, r, common.ForbiddenError(errors.New("read-only token may only be used for reading requests")))
+		return
+	}
+
+	token, err := jwt.NewBuilder().
+		Claim("id", apiToken.User.String()).
+		Claim("token", apiToken.ID.String()).
+		Build()
+
+	next.ServeHTTP(w, r.WithContext(jwtauth.NewContext(ctx, token, err)))
+}
+
+func (a *AuthConfiguration) verifyJWT(w http.ResponseWriter, r *http.Request) (jwt.Token, error) {
+	if a.unsafeAuth == nil {
+		return jwtauth.VerifyRequest(a.auth, r, jwtauth.TokenFromHeader, jwtauth.TokenFromCookie)
+	}
+
+	ctx := r.Context()
+	log := logger.FromContext(ctx)
+
+	token, err := jwtauth.VerifyRequest(a.unsafeAuth, r, jwtauth.TokenFromCookie)
+	if err != nil {
+		// attempt to verify request by new key
+		return jwtauth.VerifyRequest(a.auth, r, jwtauth.TokenFromCookie)
+	}
+
+	// user tries to authenticate by a prior authentication key
+	// attempt to migrate JWT to new key
+	user, err := userOf(token)
+	if err != nil {
+		log.Errorw("Error getting user ID", "error", err)
+		return token, err
+	}
+
+	ok, err := a.userService.IsUserAvailableForKeyMigration(ctx, user)
+	if !ok {
+		if err == nil {
+			err = errors.New("not permitted to access key rotation")
+		}
+		return token, err
+	}
+
+	tokenString, err := a.setSessionCookie(w, r, map[string]any{"id": user.String()})
+	if err != nil {
+		return token, err
+	}
+
+	// update rotation flag in database for user, ignore errors
+	_, _ = a.userService.SetKeyMigration(ctx, user)
+
+	// continue with the new session, so that it is not renewed right away
+	return a.auth.Decode(tokenString)
+}
+
+// checkSession rejects tokens of revoked sessions. Personal access tokens are not affected.
+func (a *AuthConfiguration) checkSession(ctx context.Context, token jwt.Token) error {
+	user, err := userOf(token)
+	if err != nil {
+		return err
+	}
+
+	session, _ := token.JwtID()
+	issuedAt, _ := token.IssuedAt()
+
+	if err := a.revocations.Check(ctx, user, session, issuedAt); err != nil {
+		if !errors.Is(err, errSessionRevoked) {
+			logger.FromContext(ctx).Errorw("unable to check session revocation", "user", user, "err", err)
 		}
+		return err
+	}
+
+	return nil
+}
+
+// renewSession replaces the session cookie once half of its lifetime has passed, so that active users stay signed in.
+// Tokens without expiry date from older versions are replaced right away.
+func (a *AuthConfiguration) renewSession(w http.ResponseWriter, r *http.Request, token jwt.Token) {
+	if jwtauth.TokenFromHeader(r) != "" || jwtauth.TokenFromCookie(r) == "" {
+		return
+	}
+
+	expiration, ok := token.Expiration()
+	if ok && time.Until(expiration) > a.sessionLifetime/2 {
+		return
+	}
+
+	user, err := userOf(token)
+	if err != nil {
+		return
+	}
+
+	claims := map[string]any{"id": user.String()}
+	if session, ok := token.JwtID(); ok {
+		claims[jwt.JwtIDKey] = session
 	}
-	return jwtauth.Verifier(a.auth)
+
+	if _, err := a.setSessionCookie(w, r, claims); err != nil {
+		logger.FromRequest(r).Warnw("unable to renew session", "user", user, "err", err)
+	}
+}
+
+func userOf(token jwt.Token) (uuid.UUID, error) {
+	var userID string
+	if err := token.Get("id", &userID); err != nil {
+		return uuid.Nil, err
+	}
+
+	return uuid.Parse(userID)
 }
 
 func (a *AuthConfiguration) Authenticator() func(http.Handler) http.Handler {
@@ -243,7 +562,31 @@ func (a *AuthConfiguration) Exists(accountType common.AccountType) bool {
 	return false
 }
 
-func (a *AuthConfiguration) ExtractUserInformation(accountType common.AccountType, user *goth.User) (*UserInformation, error) {
+// OIDCProviders returns the names of the additional OIDC providers
+func (a *AuthConfiguration) OIDCProviders() []string {
+	names := []string{}
+	for _, provider := range a.providers {
+		if provider.Name != "" {
+			names = append(names, provider.Name)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+func (a *AuthConfiguration) ExtractUserInformation(user *goth.User) (*UserInformation, error) {
+	accountType := common.TypeOIDC
+	providerName := user.Provider
+	if provider, ok := a.providers[user.Provider]; !ok || provider.Name == "" {
+		var err error
+		accountType, err = common.NewAccountType(user.Provider)
+		if err != nil {
+			return nil, err
+		}
+		providerName = strings.ToLower(string(accountType))
+	}
+
 	ident := user.UserID
 	name := user.NickName
 	avatar := user.AvatarURL
@@ -261,15 +604,56 @@ func (a *AuthConfiguration) ExtractUserInformation(accountType common.AccountTyp
 	}
 
 	result := &UserInformation{
-		Provider:  accountType,
-		Ident:     ident,
-		Name:      name,
-		AvatarURL: avatar,
+		Provider:     accountType,
+		ProviderName: providerName,
+		Ident:        ident,
+		Name:         name,
+		AvatarURL:    avatar,
+	}
+
+	if accountType == common.TypeOIDC {
+		config, ok := a.providers[user.Provider]
+		if !ok {
+			config = a.providers[string(common.TypeOIDC)]
+		}
+		result.Admin = adminGroupMembership(config.AdminGroup, claimValues(user.RawData[config.AdminGroupClaim]))
+		result.Issuer = a.issuers[providerName]
 	}
 
 	return result, nil
 }
 
+// adminGroupMembership checks whether the groups contain the admin group of the provider.
+// The result is nil if the provider has no admin group.
+func adminGroupMembership(adminGroup string, groups []string) *bool {
+	if adminGroup == "" {
+		return nil
+	}
+
+	admin := slices.Contains(groups, adminGroup)
+	return &admin
+}
+
+// claimValues returns the values of a claim, which is either a single string or a list of strings
+func claimValues(claim any) []string {
+	switch value := claim.(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
+
 func (a *AuthConfiguration) initializeJWTAuth() error {
 	if a.privateKey == "" {
 		logger.Get().Warnw("invalid keypair config, falling back to dev keys!")
@@ -22,6 +22,18 @@ func AuthContext(next http.Handler) http.Handler {
 			return
 		}
 		newContext := context.WithValue(r.Context(), identifiers.UserIdentifier, user)
+
+		// requests authenticated with a personal access token carry its id
+		if tokenID, ok := claims["token"].(string); ok {
+			apiToken, err := uuid.Parse(tokenID)
+			if err != nil {
+				logger.FromRequest(r).Errorw("invalid api token id", "token", tokenID, "err", err)
+				http.Error(w, "invalid api token id", http.StatusBadRequest)
+				return
+			}
+			newContext = context.WithValue(newContext, identifiers.ApiTokenIdentifier, apiToken)
+		}
+
 		next.ServeHTTP(w, r.WithContext(newContext))
 	})
 }
@@ -0,0 +1,311 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/markbates/goth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/cache"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/users"
+)
+
+const testSessionLifetime = 24 * time.Hour
+
+func newTestAuth(t *testing.T) *AuthConfiguration {
+	a, err := NewAuthConfiguration(
+		map[string]AuthProviderConfiguration{},
+		"",
+		"",
+		nil,
+		users.NewMockUserService(t),
+		apitokens.NewMockApiTokenService(t),
+		NewRevocations(cache.NewMemory(), testSessionLifetime),
+		testSessionLifetime,
+	)
+	require.NoError(t, err)
+
+	return a.(*AuthConfiguration)
+}
+
+// verify sends a request with the given session cookie through the verifier and returns the verified user
+func verify(a *AuthConfiguration, tokenString string) (*httptest.ResponseRecorder, uuid.UUID) {
+	var verifiedUser uuid.UUID
+	handler := a.Verifier()(a.Authenticator()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, _, _ := jwtauth.FromContext(r.Context())
+		verifiedUser, _ = userOf(token)
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: "jwt", Value: tokenString})
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	return rr, verifiedUser
+}
+
+func TestSign(t *testing.T) {
+	a := newTestAuth(t)
+
+	tokenString, err := a.Sign(map[string]any{"id": uuid.NewString()})
+	require.NoError(t, err)
+
+	token, err := a.auth.Decode(tokenString)
+	require.NoError(t, err)
+
+	session, ok := token.JwtID()
+	assert.True(t, ok)
+	assert.NotEmpty(t, session)
+
+	issuedAt, ok := token.IssuedAt()
+	assert.True(t, ok)
+	expiration, ok := token.Expiration()
+	assert.True(t, ok)
+	assert.Equal(t, testSessionLifetime, expiration.Sub(issuedAt))
+}
+
+func TestVerifier_ValidSession(t *testing.T) {
+	a := newTestAuth(t)
+	user := uuid.New()
+
+	tokenString, err := a.Sign(map[string]any{"id": user.String()})
+	require.NoError(t, err)
+
+	rr, verifiedUser := verify(a, tokenString)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, user, verifiedUser)
+	assert.Empty(t, rr.Result().Cookies())
+}
+
+func TestVerifier_RevokedSession(t *testing.T) {
+	a := newTestAuth(t)
+	user := uuid.New()
+
+	tokenString, err := a.Sign(map[string]any{"id": user.String()})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodDelete, "/login", nil)
+	req.AddCookie(&http.Cookie{Name: "jwt", Value: tokenString})
+	require.NoError(t, a.RevokeSession(req))
+
+	rr, _ := verify(a, tokenString)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestVerifier_RevokedSessions(t *testing.T) {
+	a := newTestAuth(t)
+	user := uuid.New()
+
+	tokenString, err := a.Sign(map[string]any{"id": user.String()})
+	require.NoError(t, err)
+
+	require.NoError(t, a.RevokeSessions(context.Background(), user))
+
+	rr, _ := verify(a, tokenString)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestVerifier_RenewsLegacySession(t *testing.T) {
+	a := newTestAuth(t)
+	user := uuid.New()
+
+	// tokens of older versions only carry the user id
+	_, tokenString, err := a.auth.Encode(map[string]any{"id": user.String()})
+	require.NoError(t, err)
+
+	rr, verifiedUser := verify(a, tokenString)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, user, verifiedUser)
+	require.Len(t, rr.Result().Cookies(), 1)
+
+	renewed, err := a.auth.Decode(rr.Result().Cookies()[0].Value)
+	require.NoError(t, err)
+	_, ok := renewed.Expiration()
+	assert.True(t, ok)
+}
+
+func TestVerifier_RenewsExpiringSession(t *testing.T) {
+	a := newTestAuth(t)
+	user := uuid.New()
+
+	claims := map[string]any{"id": user.String(), jwt.JwtIDKey: "session"}
+	jwtauth.SetIssuedNow(claims)
+	jwtauth.SetExpiryIn(claims, time.Hour)
+	_, tokenString, err := a.auth.Encode(claims)
+	require.NoError(t, err)
+
+	rr, _ := verify(a, tokenString)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.Len(t, rr.Result().Cookies(), 1)
+
+	renewed, err := a.auth.Decode(rr.Result().Cookies()[0].Value)
+	require.NoError(t, err)
+	session, _ := renewed.JwtID()
+	assert.Equal(t, "session", session)
+}
+
+func TestAccountLink(t *testing.T) {
+	a := newTestAuth(t)
+	user := uuid.New()
+
+	tokenString, err := a.Sign(map[string]any{"id": user.String()})
+	require.NoError(t, err)
+
+	begin := httptest.NewRequest(http.MethodGet, "/login/google", nil)
+	begin.AddCookie(&http.Cookie{Name: "jwt", Value: tokenString})
+	rr := httptest.NewRecorder()
+	a.BeginAccountLink(rr, begin)
+
+	cookies := rr.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, accountLinkCookie, cookies[0].Name)
+
+	callback := httptest.NewRequest(http.MethodGet, "/login/google/callback", nil)
+	callback.AddCookie(cookies[0])
+	rr = httptest.NewRecorder()
+	link, ok := a.CompleteAccountLink(rr, callback)
+
+	assert.True(t, ok)
+	assert.Equal(t, AccountLink{User: user}, link)
+
+	// the cookie is removed after the login
+	cookies = rr.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, accountLinkCookie, cookies[0].Name)
+	assert.Negative(t, cookies[0].MaxAge)
+}
+
+func TestAccountLink_LinkIdentity(t *testing.T) {
+	a := newTestAuth(t)
+	user := uuid.New()
+
+	tokenString, err := a.Sign(map[string]any{"id": user.String()})
+	require.NoError(t, err)
+
+	begin := httptest.NewRequest(http.MethodGet, "/login/google?link=true", nil)
+	begin.AddCookie(&http.Cookie{Name: "jwt", Value: tokenString})
+	rr := httptest.NewRecorder()
+	a.BeginAccountLink(rr, begin)
+
+	cookies := rr.Result().Cookies()
+	require.Len(t, cookies, 1)
+
+	callback := httptest.NewRequest(http.MethodGet, "/login/google/callback", nil)
+	callback.AddCookie(cookies[0])
+	link, ok := a.CompleteAccountLink(httptest.NewRecorder(), callback)
+
+	assert.True(t, ok)
+	assert.Equal(t, AccountLink{User: user, LinkIdentity: true}, link)
+}
+
+func TestAccountLink_WithoutSession(t *testing.T) {
+	a := newTestAuth(t)
+
+	rr := httptest.NewRecorder()
+	a.BeginAccountLink(rr, httptest.NewRequest(http.MethodGet, "/login/google", nil))
+	assert.Empty(t, rr.Result().Cookies())
+
+	_, ok := a.CompleteAccountLink(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/login/google/callback", nil))
+	assert.False(t, ok)
+}
+
+func TestAccountLink_RevokedSession(t *testing.T) {
+	a := newTestAuth(t)
+	user := uuid.New()
+
+	tokenString, err := a.Sign(map[string]any{"id": user.String()})
+	require.NoError(t, err)
+	require.NoError(t, a.RevokeSessions(context.Background(), user))
+
+	begin := httptest.NewRequest(http.MethodGet, "/login/google", nil)
+	begin.AddCookie(&http.Cookie{Name: "jwt", Value: tokenString})
+	rr := httptest.NewRecorder()
+	a.BeginAccountLink(rr, begin)
+
+	assert.Empty(t, rr.Result().Cookies())
+}
+
+func TestExtractUserInformation(t *testing.T) {
+	a := &AuthConfiguration{
+		providers: map[string]AuthProviderConfiguration{
+			string(common.TypeOIDC): {},
+			"sales":                 {Name: "sales"},
+		},
+		issuers: map[string]string{"oidc": "https://id.example.com", "sales": "https://sales.example.com"},
+	}
+
+	tests := map[string]struct {
+		provider         string
+		wantAccountType  common.AccountType
+		wantProviderName string
+		wantIssuer       string
+	}{
+		"built-in provider": {provider: "github", wantAccountType: common.GitHub, wantProviderName: "github"},
+		"default oidc":      {provider: "oidc", wantAccountType: common.TypeOIDC, wantProviderName: "oidc", wantIssuer: "https://id.example.com"},
+		"named oidc":        {provider: "sales", wantAccountType: common.TypeOIDC, wantProviderName: "sales", wantIssuer: "https://sales.example.com"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			userInfo, err := a.ExtractUserInformation(&goth.User{Provider: test.provider, UserID: "id", NickName: "Stan"})
+
+			require.NoError(t, err)
+			assert.Equal(t, test.wantAccountType, userInfo.Provider)
+			assert.Equal(t, test.wantProviderName, userInfo.ProviderName)
+			assert.Equal(t, test.wantIssuer, userInfo.Issuer)
+			assert.Equal(t, "id", userInfo.Ident)
+			assert.Equal(t, "Stan", userInfo.Name)
+		})
+	}
+}
+
+func TestExtractUserInformation_AdminGroup(t *testing.T) {
+	a := &AuthConfiguration{providers: map[string]AuthProviderConfiguration{
+		string(common.TypeOIDC): {},
+		"sales":                 {Name: "sales", AdminGroup: "scrumlr-admins", AdminGroupClaim: "groups"},
+	}}
+
+	userInfo, err := a.ExtractUserInformation(&goth.User{Provider: "sales", UserID: "id", NickName: "Stan", RawData: map[string]any{"groups": []any{"staff", "scrumlr-admins"}}})
+	require.NoError(t, err)
+	require.NotNil(t, userInfo.Admin)
+	assert.True(t, *userInfo.Admin)
+
+	userInfo, err = a.ExtractUserInformation(&goth.User{Provider: "sales", UserID: "id", NickName: "Stan", RawData: map[string]any{"groups": "staff"}})
+	require.NoError(t, err)
+	require.NotNil(t, userInfo.Admin)
+	assert.False(t, *userInfo.Admin)
+
+	userInfo, err = a.ExtractUserInformation(&goth.User{Provider: "oidc", UserID: "id", NickName: "Stan", RawData: map[string]any{"groups": "scrumlr-admins"}})
+	require.NoError(t, err)
+	assert.Nil(t, userInfo.Admin)
+}
+
+func TestExtractUserInformation_UnknownProvider(t *testing.T) {
+	a := &AuthConfiguration{providers: map[string]AuthProviderConfiguration{}}
+
+	_, err := a.ExtractUserInformation(&goth.User{Provider: "sales", UserID: "id", NickName: "Stan"})
+
+	assert.Error(t, err)
+}
+
+func TestOIDCProviders(t *testing.T) {
+	a := &AuthConfiguration{providers: map[string]AuthProviderConfiguration{
+		string(common.Google): {},
+		"support":             {Name: "support"},
+		"sales":               {Name: "sales"},
+	}}
+
+	assert.Equal(t, []string{"sales", "support"}, a.OIDCProviders())
+}
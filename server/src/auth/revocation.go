@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"scrumlr.io/server/cache"
+)
+
+var errSessionRevoked = errors.New("session has been revoked")
+
+// Revocations keeps the revoked sessions of users in the cache, so that all server instances reject them.
+// A revoked session is kept until all of its tokens have expired. The latest revocation of all sessions
+// of a user is kept without expiry, since tokens of older versions do not expire.
+type Revocations struct {
+	cache           *cache.Cache
+	sessionLifetime time.Duration
+}
+
+func NewRevocations(cache *cache.Cache, sessionLifetime time.Duration) *Revocations {
+	return &Revocations{cache: cache, sessionLifetime: sessionLifetime}
+}
+
+// RevokeSession revokes the session with the given token id
+func (r *Revocations) RevokeSession(ctx context.Context, user uuid.UUID, session string) error {
+	// the counter only marks the session as revoked. Renewed tokens keep their session, but each of them
+	// expires within the session lifetime, so the mark is not needed any longer once it expires.
+	_, err := r.cache.Con.Increment(ctx, revokedSessionKey(user, session), r.sessionLifetime)
+	return err
+}
+
+// RevokeAll revokes all sessions of the user issued until now
+func (r *Revocations) RevokeAll(ctx context.Context, user uuid.UUID) error {
+	return r.cache.Con.Append(ctx, revokedBeforeKey(user), time.Now().Unix(), 1, 0)
+}
+
+// Check returns an error if the session was revoked. Sessions without issue date are
+// revoked by every revocation of all sessions.
+func (r *Revocations) Check(ctx context.Context, user uuid.UUID, session string, issuedAt time.Time) error {
+	if session != "" {
+		revoked, err := r.cache.Con.GetCounter(ctx, revokedSessionKey(user, session))
+		if err != nil {
+			return fmt.Errorf("unable to get revoked session: %w", err)
+		}
+		if revoked > 0 {
+			return errSessionRevoked
+		}
+	}
+
+	entries, err := r.cache.Con.GetList(ctx, revokedBeforeKey(user))
+	if err != nil {
+		return fmt.Errorf("unable to get revocation of all sessions: %w", err)
+	}
+
+	for _, entry := range entries {
+		var before int64
+		if err := json.Unmarshal(entry, &before); err != nil {
+			return fmt.Errorf("unable to read revocation of all sessions: %w", err)
+		}
+
+		// token issue dates only have a precision of seconds, so tokens of the same second are revoked as well
+		if issuedAt.Unix() <= before {
+			return errSessionRevoked
+		}
+	}
+
+	return nil
+}
+
+func revokedSessionKey(user uuid.UUID, session string) string {
+	return fmt.Sprintf("revoked-session.%s.%s", user, session)
+}
+
+func revokedBeforeKey(user uuid.UUID) string {
+	return fmt.Sprintf("revoked-sessions-before.%s", user)
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"scrumlr.io/server/cache"
+)
+
+func TestRevokeSession(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.New()
+	revocations := NewRevocations(cache.NewMemory(), time.Hour)
+
+	err := revocations.RevokeSession(ctx, user, "revoked")
+	assert.Nil(t, err)
+
+	assert.ErrorIs(t, revocations.Check(ctx, user, "revoked", time.Now()), errSessionRevoked)
+	assert.Nil(t, revocations.Check(ctx, user, "other", time.Now()))
+	assert.Nil(t, revocations.Check(ctx, uuid.New(), "revoked", time.Now()))
+}
+
+func TestRevokeAll(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.New()
+	revocations := NewRevocations(cache.NewMemory(), time.Hour)
+
+	err := revocations.RevokeAll(ctx, user)
+	assert.Nil(t, err)
+
+	assert.ErrorIs(t, revocations.Check(ctx, user, "before", time.Now().Add(-time.Minute)), errSessionRevoked)
+	assert.ErrorIs(t, revocations.Check(ctx, user, "", time.Time{}), errSessionRevoked)
+	assert.Nil(t, revocations.Check(ctx, user, "after", time.Now().Add(time.Minute)))
+	assert.Nil(t, revocations.Check(ctx, uuid.New(), "before", time.Now().Add(-time.Minute)))
+}
+
+func TestRevokeAll_KeptAfterManyRevokedSessions(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.New()
+	revocations := NewRevocations(cache.NewMemory(), time.Hour)
+
+	err := revocations.RevokeAll(ctx, user)
+	assert.Nil(t, err)
+	for i := 0; i < 200; i++ {
+		err := revocations.RevokeSession(ctx, user, uuid.NewString())
+		assert.Nil(t, err)
+	}
+
+	assert.ErrorIs(t, revocations.Check(ctx, user, "before", time.Now().Add(-time.Minute)), errSessionRevoked)
+}
+
+func TestRevokeSession_ExpiresWithSessionLifetime(t *testing.T) {
+	ctx := context.Background()
+	user := uuid.New()
+	revocations := NewRevocations(cache.NewMemory(), time.Millisecond)
+
+	err := revocations.RevokeSession(ctx, user, "revoked")
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	assert.Nil(t, revocations.Check(ctx, user, "revoked", time.Now()))
+}
@@ -0,0 +1,257 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	dsig "github.com/russellhaering/goxmldsig"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/logger"
+)
+
+const (
+	// samlRequestLifetime is the time a user has to log in at the identity provider
+	samlRequestLifetime = 10 * time.Minute
+
+	// samlMetadataTimeout is the maximum time to fetch the metadata of the identity provider
+	samlMetadataTimeout = 30 * time.Second
+)
+
+var errSAMLNotConfigured = errors.New("saml provider is not configured")
+
+// samlProvider is the service provider for logins with a SAML 2.0 identity provider.
+// Unlike the OAuth providers it is not handled by goth.
+type samlProvider struct {
+	serviceProvider     *saml.ServiceProvider
+	requestTracker      samlsp.RequestTracker
+	userIdentAttribute  string
+	userNameAttribute   string
+	adminGroup          string
+	adminGroupAttribute string
+}
+
+func newSAMLProvider(config AuthProviderConfiguration) (*samlProvider, error) {
+	acsUrl, err := url.Parse(config.RedirectUri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid assertion consumer service url: %w", err)
+	}
+
+	idpMetadataUrl, err := url.Parse(config.IdpMetadataUri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid identity provider metadata url: %w", err)
+	}
+
+	keyPair, err := tls.X509KeyPair([]byte(config.Certificate), []byte(config.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse saml certificate and key: %w", err)
+	}
+	key, ok := keyPair.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the provided saml key is no rsa key")
+	}
+	certificate, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse saml certificate: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), samlMetadataTimeout)
+	defer cancel()
+	idpMetadata, err := samlsp.FetchMetadata(ctx, http.DefaultClient, *idpMetadataUrl)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch identity provider metadata: %w", err)
+	}
+
+	serviceProvider := &saml.ServiceProvider{
+		Key:             key,
+		Certificate:     certificate,
+		MetadataURL:     *acsUrl.ResolveReference(&url.URL{Path: "metadata"}),
+		AcsURL:          *acsUrl,
+		IDPMetadata:     idpMetadata,
+		SignatureMethod: dsig.RSASHA256SignatureMethod,
+	}
+
+	codec := samlsp.DefaultTrackedRequestCodec(samlsp.Options{URL: *acsUrl, Key: key})
+	codec.MaxAge = samlRequestLifetime
+
+	// the identity provider posts the response cross-site, so the cookie of the pending request must allow that
+	sameSite := http.SameSiteLaxMode
+	if acsUrl.Scheme == "https" {
+		sameSite = http.SameSiteNoneMode
+	}
+
+	return &samlProvider{
+		serviceProvider: serviceProvider,
+		requestTracker: samlsp.CookieRequestTracker{
+			ServiceProvider: serviceProvider,
+			NamePrefix:      "saml_",
+			Codec:           codec,
+			MaxAge:          samlRequestLifetime,
+			SameSite:        sameSite,
+		},
+		userIdentAttribute:  config.UserIdentAttribute,
+		userNameAttribute:   config.UserNameAttribute,
+		adminGroup:          config.AdminGroup,
+		adminGroupAttribute: config.AdminGroupClaim,
+	}, nil
+}
+
+// SAMLMetadata returns the metadata of the service provider, which has to be registered at the identity provider
+func (a *AuthConfiguration) SAMLMetadata() (*saml.EntityDescriptor, error) {
+	if a.saml == nil {
+		return nil, errSAMLNotConfigured
+	}
+
+	return a.saml.serviceProvider.Metadata(), nil
+}
+
+// BeginSAMLAuth redirects the user to the identity provider. The request is tracked in a cookie, so that
+// only responses to requests of this user are accepted. Like with the OAuth providers the user is
+// redirected to the location given by the state parameter after the login.
+func (a *AuthConfiguration) BeginSAMLAuth(w http.ResponseWriter, r *http.Request) error {
+	if a.saml == nil {
+		return errSAMLNotConfigured
+	}
+	sp := a.saml.serviceProvider
+
+	location := sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if location == "" {
+		return errors.New("identity provider does not support the redirect binding")
+	}
+
+	authRequest, err := sp.MakeAuthenticationRequest(location, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return err
+	}
+
+	// the uri of the tracked request is the location to return to after the login
+	trackedRequest := r.Clone(r.Context())
+	trackedRequest.URL, err = url.Parse(r.URL.Query().Get("state"))
+	if err != nil {
+		return fmt.Errorf("invalid state: %w", err)
+	}
+
+	relayState, err := a.saml.requestTracker.TrackRequest(w, trackedRequest, authRequest.ID)
+	if err != nil {
+		return err
+	}
+
+	redirect, err := authRequest.Redirect(relayState, sp)
+	if err != nil {
+		return err
+	}
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+	return nil
+}
+
+// CompleteSAMLAuth validates the response of the identity provider and returns the information of the user
+// and the location to return to, which is empty if none was requested.
+func (a *AuthConfiguration) CompleteSAMLAuth(w http.ResponseWriter, r *http.Request) (*UserInformation, string, error) {
+	if a.saml == nil {
+		return nil, "", errSAMLNotConfigured
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return nil, "", err
+	}
+
+	possibleRequestIDs := []string{}
+	for _, trackedRequest := range a.saml.requestTracker.GetTrackedRequests(r) {
+		possibleRequestIDs = append(possibleRequestIDs, trackedRequest.SAMLRequestID)
+	}
+
+	assertion, err := a.saml.serviceProvider.ParseResponse(r, possibleRequestIDs)
+	if err != nil {
+		// the returned error is deliberately vague, the actual reason is kept separately
+		var invalidResponse *saml.InvalidResponseError
+		if errors.As(err, &invalidResponse) {
+			return nil, "", fmt.Errorf("invalid saml response: %w", invalidResponse.PrivateErr)
+		}
+		return nil, "", err
+	}
+
+	redirect := ""
+	if trackedRequest, err := a.saml.requestTracker.GetTrackedRequest(r, r.Form.Get("RelayState")); err == nil {
+		redirect = trackedRequest.URI
+		if err := a.saml.requestTracker.StopTrackingRequest(w, r, trackedRequest.Index); err != nil {
+			logger.FromContext(r.Context()).Warnw("unable to stop tracking saml request", "err", err)
+		}
+	}
+
+	userInfo, err := a.saml.extractUserInformation(assertion)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return userInfo, redirect, nil
+}
+
+// extractUserInformation maps the assertion to the user information.
+// Without a configured attribute the name id of the subject identifies the user.
+func (p *samlProvider) extractUserInformation(assertion *saml.Assertion) (*UserInformation, error) {
+	ident := ""
+	if p.userIdentAttribute != "" {
+		ident = assertionAttribute(assertion, p.userIdentAttribute)
+	} else if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		ident = assertion.Subject.NameID.Value
+	}
+
+	if ident == "" {
+		return nil, fmt.Errorf("unable to extract identifier information for user")
+	}
+
+	name := assertionAttribute(assertion, p.userNameAttribute)
+	if name == "" {
+		return nil, fmt.Errorf("unable to extract name information for user %q", ident)
+	}
+
+	result := &UserInformation{
+		Provider:     common.TypeSAML,
+		ProviderName: strings.ToLower(string(common.TypeSAML)),
+		Ident:        ident,
+		Name:         name,
+		Admin:        adminGroupMembership(p.adminGroup, assertionAttributeValues(assertion, p.adminGroupAttribute)),
+	}
+
+	return result, nil
+}
+
+// assertionAttribute returns the first value of the attribute with the given name or friendly name
+func assertionAttribute(assertion *saml.Assertion, name string) string {
+	values := assertionAttributeValues(assertion, name)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// assertionAttributeValues returns the non-empty values of the attributes with the given name or friendly name
+func assertionAttributeValues(assertion *saml.Assertion, name string) []string {
+	values := []string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			if attribute.Name != name && attribute.FriendlyName != name {
+				continue
+			}
+
+			for _, value := range attribute.Values {
+				if value := strings.TrimSpace(value.Value); value != "" {
+					values = append(values, value)
+				}
+			}
+		}
+	}
+
+	return values
+}
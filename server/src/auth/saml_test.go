@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/cache"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/users"
+)
+
+const testSSOUrl = "https://idp.example.com/sso"
+
+// newTestSAMLAuth returns an auth configuration with a saml provider, whose identity provider metadata is served by a test server
+func newTestSAMLAuth(t *testing.T) *AuthConfiguration {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "scrumlr"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	idpMetadata, err := xml.Marshal(saml.EntityDescriptor{
+		EntityID: "https://idp.example.com",
+		IDPSSODescriptors: []saml.IDPSSODescriptor{{
+			SSODescriptor: saml.SSODescriptor{
+				RoleDescriptor: saml.RoleDescriptor{ProtocolSupportEnumeration: "urn:oasis:names:tc:SAML:2.0:protocol"},
+			},
+			SingleSignOnServices: []saml.Endpoint{{Binding: saml.HTTPRedirectBinding, Location: testSSOUrl}},
+		}},
+	})
+	require.NoError(t, err)
+
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(idpMetadata)
+	}))
+	t.Cleanup(idp.Close)
+
+	a, err := NewAuthConfiguration(
+		map[string]AuthProviderConfiguration{
+			(string)(common.TypeSAML): {
+				RedirectUri:       "https://scrumlr.example.com/login/saml/acs",
+				IdpMetadataUri:    idp.URL,
+				Certificate:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+				PrivateKey:        string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+				UserNameAttribute: "displayName",
+			},
+		},
+		"",
+		"",
+		nil,
+		users.NewMockUserService(t),
+		apitokens.NewMockApiTokenService(t),
+		NewRevocations(cache.NewMemory(), testSessionLifetime),
+		testSessionLifetime,
+	)
+	require.NoError(t, err)
+
+	return a.(*AuthConfiguration)
+}
+
+func TestSAML_NotConfigured(t *testing.T) {
+	a := newTestAuth(t)
+
+	_, err := a.SAMLMetadata()
+	assert.ErrorIs(t, err, errSAMLNotConfigured)
+
+	err = a.BeginSAMLAuth(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/login/saml", nil))
+	assert.ErrorIs(t, err, errSAMLNotConfigured)
+}
+
+func TestSAMLMetadata(t *testing.T) {
+	a := newTestSAMLAuth(t)
+
+	assert.True(t, a.Exists(common.TypeSAML))
+
+	metadata, err := a.SAMLMetadata()
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://scrumlr.example.com/login/saml/metadata", metadata.EntityID)
+	require.Len(t, metadata.SPSSODescriptors, 1)
+	require.Len(t, metadata.SPSSODescriptors[0].AssertionConsumerServices, 2)
+	assert.Equal(t, "https://scrumlr.example.com/login/saml/acs", metadata.SPSSODescriptors[0].AssertionConsumerServices[0].Location)
+}
+
+func TestBeginSAMLAuth(t *testing.T) {
+	a := newTestSAMLAuth(t)
+
+	rr := httptest.NewRecorder()
+	err := a.BeginSAMLAuth(rr, httptest.NewRequest(http.MethodGet, "/login/saml?state=https://scrumlr.example.com/board/1", nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusFound, rr.Code)
+	location, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, testSSOUrl, location.Scheme+"://"+location.Host+location.Path)
+	assert.NotEmpty(t, location.Query().Get("SAMLRequest"))
+
+	relayState := location.Query().Get("RelayState")
+	require.NotEmpty(t, relayState)
+
+	cookies := rr.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "saml_"+relayState, cookies[0].Name)
+	assert.Equal(t, http.SameSiteNoneMode, cookies[0].SameSite)
+	assert.True(t, cookies[0].Secure)
+
+	// the response of the identity provider returns the cookie and the relay state
+	acs := httptest.NewRequest(http.MethodPost, "/login/saml/acs", nil)
+	acs.AddCookie(cookies[0])
+	trackedRequest, err := a.saml.requestTracker.GetTrackedRequest(acs, relayState)
+	require.NoError(t, err)
+	assert.Equal(t, "https://scrumlr.example.com/board/1", trackedRequest.URI)
+}
+
+func TestCompleteSAMLAuth_InvalidResponse(t *testing.T) {
+	a := newTestSAMLAuth(t)
+
+	form := url.Values{"SAMLResponse": {"invalid"}}
+	req := httptest.NewRequest(http.MethodPost, "/login/saml/acs", nil)
+	req.PostForm = form
+
+	userInfo, redirect, err := a.CompleteSAMLAuth(httptest.NewRecorder(), req)
+
+	assert.Error(t, err)
+	assert.Nil(t, userInfo)
+	assert.Empty(t, redirect)
+}
+
+func TestExtractSAMLUserInformation(t *testing.T) {
+	attributes := []saml.AttributeStatement{{
+		Attributes: []saml.Attribute{
+			{Name: "urn:oid:2.16.840.1.113730.3.1.241", FriendlyName: "displayName", Values: []saml.AttributeValue{{Value: "Stan"}}},
+			{Name: "uid", Values: []saml.AttributeValue{{Value: ""}, {Value: "stan"}}},
+			{Name: "groups", Values: []saml.AttributeValue{{Value: "staff"}, {Value: "scrumlr-admins"}}},
+		},
+	}}
+
+	admin, member := true, false
+
+	tests := map[string]struct {
+		provider  samlProvider
+		assertion saml.Assertion
+		want      *UserInformation
+		wantError bool
+	}{
+		"name id": {
+			provider:  samlProvider{userNameAttribute: "displayName"},
+			assertion: saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Value: "stan@example.com"}}, AttributeStatements: attributes},
+			want:      &UserInformation{Provider: common.TypeSAML, ProviderName: "saml", Ident: "stan@example.com", Name: "Stan"},
+		},
+		"ident attribute": {
+			provider:  samlProvider{userIdentAttribute: "uid", userNameAttribute: "urn:oid:2.16.840.1.113730.3.1.241"},
+			assertion: saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Value: "stan@example.com"}}, AttributeStatements: attributes},
+			want:      &UserInformation{Provider: common.TypeSAML, ProviderName: "saml", Ident: "stan", Name: "Stan"},
+		},
+		"admin group member": {
+			provider:  samlProvider{userNameAttribute: "displayName", adminGroup: "scrumlr-admins", adminGroupAttribute: "groups"},
+			assertion: saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Value: "stan@example.com"}}, AttributeStatements: attributes},
+			want:      &UserInformation{Provider: common.TypeSAML, ProviderName: "saml", Ident: "stan@example.com", Name: "Stan", Admin: &admin},
+		},
+		"not an admin group member": {
+			provider:  samlProvider{userNameAttribute: "displayName", adminGroup: "owners", adminGroupAttribute: "groups"},
+			assertion: saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Value: "stan@example.com"}}, AttributeStatements: attributes},
+			want:      &UserInformation{Provider: common.TypeSAML, ProviderName: "saml", Ident: "stan@example.com", Name: "Stan", Admin: &member},
+		},
+		"missing ident": {
+			provider:  samlProvider{userIdentAttribute: "employeeNumber", userNameAttribute: "displayName"},
+			assertion: saml.Assertion{AttributeStatements: attributes},
+			wantError: true,
+		},
+		"missing name": {
+			provider:  samlProvider{userNameAttribute: "cn"},
+			assertion: saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Value: "stan@example.com"}}, AttributeStatements: attributes},
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.provider.extractUserInformation(&test.assertion)
+			if test.wantError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package main
 
 import (
 	"flag"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 	"scrumlr.io/server/common"
@@ -214,3 +217,123 @@ func TestConfigureAuthProvider(t *testing.T) {
 	_, ok = providerMap[string(common.TypeOIDC)]
 	assert.True(t, ok)
 }
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestConfigureAuthProviderNamedOIDC(t *testing.T) {
+	basePath := "/"
+	config := writeConfigFile(t, `
+[[auth-oidc-providers]]
+name = "sales"
+client-id = "salesClientID"
+client-secret = "salesClientSecret"
+discovery-url = "http://localhost:8070/realms/sales/.well-known/openid-configuration"
+user-ident-claim = "sub"
+user-name-claim = "preferred_username"
+admin-group = "scrumlr-admins"
+
+[[auth-oidc-providers]]
+name = "support"
+client-id = "supportClientID"
+client-secret = "supportClientSecret"
+discovery-url = "http://localhost:8070/realms/support/.well-known/openid-configuration"
+scopes = ["openid", "email"]
+`)
+	flagset := flag.NewFlagSet("scrumlr-tests", flag.ExitOnError)
+	flagset.String("auth-callback-host", "http://localhost:8080/callback", "")
+	flagset.String("config", config, "")
+	flagset.String("session-secret", "ThisIsNotASecureSessessionSecret", "")
+	init := cli.NewContext(nil, flagset, nil)
+
+	providerMap, err := configureAuthProvider(init, basePath)
+
+	assert.Nil(t, err)
+	assert.Len(t, providerMap, 2)
+
+	sales, ok := providerMap["sales"]
+	assert.True(t, ok)
+	assert.Equal(t, "sales", sales.Name)
+	assert.Equal(t, "salesClientID", sales.ClientId)
+	assert.Equal(t, "salesClientSecret", sales.ClientSecret)
+	assert.Equal(t, "http://localhost:8080/callback/login/sales/callback", sales.RedirectUri)
+	assert.Equal(t, []string{"openid", "profile"}, sales.Scopes)
+	assert.Equal(t, "sub", sales.UserIdentClaim)
+	assert.Equal(t, "preferred_username", sales.UserNameClaim)
+	assert.Equal(t, "scrumlr-admins", sales.AdminGroup)
+	assert.Equal(t, "groups", sales.AdminGroupClaim)
+
+	support, ok := providerMap["support"]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"openid", "email"}, support.Scopes)
+	assert.Equal(t, "http://localhost:8080/callback/login/support/callback", support.RedirectUri)
+}
+
+func TestConfigureAuthProviderNamedOIDCInvalid(t *testing.T) {
+	tests := map[string]string{
+		"reserved name": `
+[[auth-oidc-providers]]
+name = "google"
+client-id = "id"
+client-secret = "secret"
+discovery-url = "http://localhost:8070/.well-known/openid-configuration"
+`,
+		"duplicate name": `
+[[auth-oidc-providers]]
+name = "sales"
+client-id = "id"
+client-secret = "secret"
+discovery-url = "http://localhost:8070/.well-known/openid-configuration"
+
+[[auth-oidc-providers]]
+name = "sales"
+client-id = "id"
+client-secret = "secret"
+discovery-url = "http://localhost:8070/.well-known/openid-configuration"
+`,
+		"invalid name": `
+[[auth-oidc-providers]]
+name = "Sales Department"
+client-id = "id"
+client-secret = "secret"
+discovery-url = "http://localhost:8070/.well-known/openid-configuration"
+`,
+		"missing secret": `
+[[auth-oidc-providers]]
+name = "sales"
+client-id = "id"
+discovery-url = "http://localhost:8070/.well-known/openid-configuration"
+`,
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			flagset := flag.NewFlagSet("scrumlr-tests", flag.ExitOnError)
+			flagset.String("auth-callback-host", "http://localhost:8080/callback", "")
+			flagset.String("config", writeConfigFile(t, config), "")
+			flagset.String("session-secret", "ThisIsNotASecureSessessionSecret", "")
+			init := cli.NewContext(nil, flagset, nil)
+
+			providerMap, err := configureAuthProvider(init, "/")
+
+			assert.Error(t, err)
+			assert.Nil(t, providerMap)
+		})
+	}
+}
+
+func TestParseAdminUsers(t *testing.T) {
+	id := uuid.New()
+
+	admins, err := parseAdminUsers([]string{id.String(), " " + id.String() + " "})
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{id, id}, admins)
+
+	_, err = parseAdminUsers([]string{"not-an-id"})
+	assert.NotNil(t, err)
+}
@@ -3,6 +3,7 @@ package boards
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,12 +12,16 @@ type BoardService interface {
 	Create(ctx context.Context, body CreateBoardRequest) (*Board, error)
 	Import(ctx context.Context, owner uuid.UUID, body ImportBoardRequest) (*ImportBoardResponse, error)
 	Get(ctx context.Context, id uuid.UUID) (*Board, error)
-	GetBoards(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	GetBoards(ctx context.Context, userID uuid.UUID, archived bool) ([]uuid.UUID, error)
 	BoardOverview(ctx context.Context, boardIDs []uuid.UUID, user uuid.UUID) ([]*BoardOverview, error)
 	FullBoard(ctx context.Context, boardID uuid.UUID) (*FullBoard, error)
 	Update(ctx context.Context, body BoardUpdateRequest) (*Board, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	Archive(ctx context.Context, id uuid.UUID) (*Board, error)
+	Unarchive(ctx context.Context, id uuid.UUID) (*Board, error)
+	DeleteExpired(ctx context.Context, defaultRetentionDays int) (int, error)
 	SetTimer(ctx context.Context, id uuid.UUID, minutes uint8) (*Board, error)
+	SetTimerUntil(ctx context.Context, id uuid.UUID, end time.Time) (*Board, error)
 	IncrementTimer(ctx context.Context, id uuid.UUID) (*Board, error)
 	DeleteTimer(ctx context.Context, id uuid.UUID) (*Board, error)
 	BoardEditableContext(next http.Handler) http.Handler
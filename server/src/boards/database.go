@@ -71,6 +71,9 @@ func (d *DB) UpdateBoard(ctx context.Context, update DatabaseBoardUpdate) (Datab
 	if update.Description != nil {
 		query.Column("description")
 	}
+	if update.RetentionDays != nil {
+		query.Column("retention_days")
+	}
 	if update.AccessPolicy != nil {
 		query.Column("access_policy", "passphrase", "salt")
 	}
@@ -137,18 +140,132 @@ func (d *DB) GetBoard(ctx context.Context, id uuid.UUID) (DatabaseBoard, error)
 	return board, err
 }
 
-func (d *DB) GetBoards(ctx context.Context, userID uuid.UUID) ([]DatabaseBoard, error) {
+// GetBoards gets all boards the user participates in or that are owned by one of the teams of the user.
+// Depending on archived either only archived or only not archived boards are returned.
+func (d *DB) GetBoards(ctx context.Context, userID uuid.UUID, archived bool) ([]DatabaseBoard, error) {
 	var boards []DatabaseBoard
-	err := d.db.NewSelect().
+	query := d.db.NewSelect().
 		TableExpr("boards AS b").
 		ColumnExpr("b.*").
-		Join("INNER JOIN board_sessions AS s ON s.board = b.id").
-		Where("s.user = ?", userID).
-		Scan(ctx, &boards)
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("b.id IN (?)", d.db.NewSelect().Table("board_sessions").Column("board").Where("\"user\" = ?", userID)).
+				WhereOr("b.team IN (?)", d.db.NewSelect().Table("team_members").Column("team").Where("\"user\" = ?", userID))
+		})
+
+	if archived {
+		query = query.Where("b.archived_at IS NOT NULL")
+	} else {
+		query = query.Where("b.archived_at IS NULL")
+	}
+
+	err := query.Scan(ctx, &boards)
 
 	return boards, err
 }
 
+// SetArchived archives the board at the given time or restores it if archivedAt is nil.
+// Archiving an already archived board keeps the original archive date.
+func (d *DB) SetArchived(ctx context.Context, id uuid.UUID, archivedAt *time.Time) (DatabaseBoard, error) {
+	query := d.db.NewUpdate().
+		Model((*DatabaseBoard)(nil))
+
+	if archivedAt != nil {
+		query = query.Set("archived_at = COALESCE(archived_at, ?)", *archivedAt)
+	} else {
+		query = query.Set("archived_at = NULL")
+	}
+
+	var board DatabaseBoard
+	_, err := query.
+		Where("id = ?", id).
+		Returning("*").
+		Exec(common.ContextWithValues(ctx, "Database", d, "Result", &board), &board)
+
+	return board, err
+}
+
+// GetBoardSeries gets, for each of the given boards, all boards that are linked to it as follow-ups in either
+// direction and that the user can access as participant or team member, ordered by creation date. The series of all
+// boards are read at once.
+func (d *DB) GetBoardSeries(ctx context.Context, boardIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID][]DatabaseBoard, error) {
+	series := make(map[uuid.UUID][]DatabaseBoard, len(boardIDs))
+	if len(boardIDs) == 0 {
+		return series, nil
+	}
+
+	var members []DatabaseBoardSeriesMember
+	err := d.db.NewRaw(
+		`WITH RECURSIVE predecessors AS (
+			SELECT id AS series_of, id, follow_up_of FROM boards WHERE id IN (?)
+			UNION
+			SELECT p.series_of, b.id, b.follow_up_of FROM boards AS b INNER JOIN predecessors AS p ON b.id = p.follow_up_of
+		), series AS (
+			SELECT series_of, id FROM predecessors WHERE follow_up_of IS NULL
+			UNION
+			SELECT s.series_of, b.id FROM boards AS b INNER JOIN series AS s ON b.follow_up_of = s.id
+		)
+		SELECT series.series_of, b.* FROM boards AS b
+		INNER JOIN series ON series.id = b.id
+		WHERE b.id IN (SELECT board FROM board_sessions WHERE "user" = ?)
+			OR b.team IN (SELECT team FROM team_members WHERE "user" = ?)
+		ORDER BY series.series_of, b.created_at ASC`,
+		bun.In(boardIDs), userID, userID,
+	).Scan(ctx, &members)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		series[member.SeriesOf] = append(series[member.SeriesOf], member.DatabaseBoard)
+	}
+
+	return series, nil
+}
+
+// expiredBoardsLock is the name of the advisory lock, which lets only one instance delete the expired boards at a time
+const expiredBoardsLock = "scrumlr.boards.expired"
+
+// GetExpiredBoards gets the ids of all boards that have not been modified within their retention period.
+// Boards without their own retention fall back to the given default, a retention of 0 keeps them forever.
+// Archived boards are never deleted.
+func (d *DB) GetExpiredBoards(ctx context.Context, now time.Time, defaultRetentionDays int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := d.db.NewSelect().
+		Model((*DatabaseBoard)(nil)).
+		Column("id").
+		Where("archived_at IS NULL").
+		Where("COALESCE(retention_days, ?) > 0", defaultRetentionDays).
+		Where("last_modified_at < ?::timestamptz - make_interval(days => COALESCE(retention_days, ?))", now, defaultRetentionDays).
+		Scan(ctx, &ids)
+
+	return ids, err
+}
+
+// LockExpiredBoards takes the advisory lock of the deletion of expired boards on a connection of its own,
+// unless another instance holds it. The returned function releases the lock.
+func (d *DB) LockExpiredBoards(ctx context.Context) (func(), bool, error) {
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var locked bool
+	err = conn.NewRaw("SELECT pg_try_advisory_lock(hashtext(?))", expiredBoardsLock).Scan(ctx, &locked)
+	if err != nil || !locked {
+		_ = conn.Close()
+		return nil, false, err
+	}
+
+	unlock := func() {
+		// the lock is released with the connection, if the unlock fails
+		_, _ = conn.NewRaw("SELECT pg_advisory_unlock(hashtext(?))", expiredBoardsLock).Exec(context.WithoutCancel(ctx))
+		_ = conn.Close()
+	}
+
+	return unlock, true, nil
+}
+
 func (u *LastModifiedUpdater) UpdateLastModified(ctx context.Context, boardID uuid.UUID, time time.Time) error {
 	_, err := u.database.UpdateBoard(ctx, DatabaseBoardUpdate{ID: boardID, LastModifiedAt: time})
 	return err
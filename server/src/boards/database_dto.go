@@ -1,13 +1,16 @@
 package boards
 
 import (
+	"database/sql"
 	"time"
 
 	"scrumlr.io/server/sessions"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/actionitems"
 	"scrumlr.io/server/columns"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/notes"
 	"scrumlr.io/server/reactions"
 	"scrumlr.io/server/sessionrequests"
@@ -33,6 +36,16 @@ type DatabaseBoard struct {
 	SharedNote            uuid.NullUUID
 	ShowVoting            uuid.NullUUID
 	LastModifiedAt        time.Time
+	FollowUpOf            uuid.NullUUID
+	Team                  uuid.NullUUID
+	RetentionDays         *int
+	ArchivedAt            *time.Time
+}
+
+// DatabaseBoardSeriesMember is a board of the series of the board SeriesOf
+type DatabaseBoardSeriesMember struct {
+	DatabaseBoard `bun:",extend"`
+	SeriesOf      uuid.UUID
 }
 
 type DatabaseBoardInsert struct {
@@ -42,6 +55,8 @@ type DatabaseBoardInsert struct {
 	AccessPolicy  AccessPolicy
 	Passphrase    *string
 	Salt          *string
+	FollowUpOf    uuid.NullUUID
+	Team          uuid.NullUUID
 }
 
 type DatabaseBoardTimerUpdate struct {
@@ -69,6 +84,7 @@ type DatabaseBoardUpdate struct {
 	SharedNote            uuid.NullUUID
 	ShowVoting            uuid.NullUUID
 	LastModifiedAt        time.Time
+	RetentionDays         *sql.NullInt32
 }
 
 type DatabaseFullBoard struct {
@@ -80,4 +96,7 @@ type DatabaseFullBoard struct {
 	Reactions            []reactions.DatabaseReaction
 	Votings              []votings.DatabaseVoting
 	Votes                []votings.DatabaseVote
+	ActionItems          []actionitems.DatabaseActionItem
+	Estimations          []estimations.DatabaseEstimation
+	Estimates            []estimations.DatabaseEstimate
 }
@@ -3,6 +3,7 @@ package boards
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
 	"testing"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/initialize/testDbTemplates"
 	"scrumlr.io/server/notes"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/votings"
 )
 
@@ -435,7 +437,7 @@ func (suite *DatabaseBoardTestSuite) Test_Database_GetByUser() {
 	t := suite.T()
 	userId := suite.users["Stan"].id
 
-	dbBoards, err := suite.database.GetBoards(context.Background(), userId)
+	dbBoards, err := suite.database.GetBoards(context.Background(), userId, false)
 
 	assert.Nil(t, err)
 	assert.Len(t, dbBoards, 2)
@@ -469,7 +471,7 @@ func (suite *DatabaseBoardTestSuite) Test_Database_GetByUser_NotFound() {
 	t := suite.T()
 	userId := uuid.New()
 
-	dbBoards, err := suite.database.GetBoards(context.Background(), userId)
+	dbBoards, err := suite.database.GetBoards(context.Background(), userId, false)
 
 	assert.Nil(t, err)
 	assert.Len(t, dbBoards, 0)
@@ -486,13 +488,156 @@ type TestSession struct {
 	user  uuid.UUID
 }
 
+func (suite *DatabaseBoardTestSuite) Test_Database_GetBoardSeries() {
+	t := suite.T()
+	userId := suite.users["Santa"].id
+
+	boardSeries, err := suite.database.GetBoardSeries(context.Background(), []uuid.UUID{suite.boards["Series2"].ID, suite.boards["Series3"].ID}, userId)
+
+	assert.Nil(t, err)
+	assert.Len(t, boardSeries, 2)
+	for _, board := range []string{"Series2", "Series3"} {
+		series := boardSeries[suite.boards[board].ID]
+		assert.Len(t, series, 3)
+		assert.Equal(t, suite.boards["Series1"].ID, series[0].ID)
+		assert.Equal(t, suite.boards["Series2"].ID, series[1].ID)
+		assert.Equal(t, suite.boards["Series3"].ID, series[2].ID)
+		assert.Equal(t, suite.boards["Series1"].ID, series[1].FollowUpOf.UUID)
+		assert.Equal(t, suite.boards["Series2"].ID, series[2].FollowUpOf.UUID)
+	}
+}
+
+func (suite *DatabaseBoardTestSuite) Test_Database_GetBoardSeries_TeamMember() {
+	t := suite.T()
+	userId := suite.users["Tina"].id
+
+	boardSeries, err := suite.database.GetBoardSeries(context.Background(), []uuid.UUID{suite.boards["Series2"].ID}, userId)
+
+	assert.Nil(t, err)
+	series := boardSeries[suite.boards["Series2"].ID]
+	assert.Len(t, series, 2)
+	assert.Equal(t, suite.boards["Series1"].ID, series[0].ID)
+	assert.Equal(t, suite.boards["Series2"].ID, series[1].ID)
+}
+
+func (suite *DatabaseBoardTestSuite) Test_Database_GetBoardSeries_NotParticipating() {
+	t := suite.T()
+	userId := suite.users["Stan"].id
+
+	boardSeries, err := suite.database.GetBoardSeries(context.Background(), []uuid.UUID{suite.boards["Series2"].ID}, userId)
+
+	assert.Nil(t, err)
+	assert.Len(t, boardSeries[suite.boards["Series2"].ID], 0)
+}
+
+func (suite *DatabaseBoardTestSuite) Test_Database_GetExpiredBoards_WithoutDefaultRetention() {
+	t := suite.T()
+
+	expired, err := suite.database.GetExpiredBoards(context.Background(), time.Now(), 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{suite.boards["RetentionExpired"].ID}, expired)
+}
+
+func (suite *DatabaseBoardTestSuite) Test_Database_GetExpiredBoards_WithDefaultRetention() {
+	t := suite.T()
+
+	expired, err := suite.database.GetExpiredBoards(context.Background(), time.Now(), 30)
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{suite.boards["RetentionExpired"].ID, suite.boards["RetentionDefault"].ID}, expired)
+}
+
+func (suite *DatabaseBoardTestSuite) Test_Database_LockExpiredBoards() {
+	t := suite.T()
+
+	unlock, locked, err := suite.database.LockExpiredBoards(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, locked)
+
+	// another instance can not take the lock until it is released
+	_, locked, err = suite.database.LockExpiredBoards(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, locked)
+
+	unlock()
+
+	unlock, locked, err = suite.database.LockExpiredBoards(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, locked)
+	unlock()
+}
+
+func (suite *DatabaseBoardTestSuite) Test_Database_UpdateBoard_RetentionDays() {
+	t := suite.T()
+
+	boardId := suite.boards["RetentionKept"].ID
+
+	dbBoard, err := suite.database.UpdateBoard(context.Background(), DatabaseBoardUpdate{
+		ID:            boardId,
+		RetentionDays: &sql.NullInt32{Int32: 14, Valid: true},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 14, *dbBoard.RetentionDays)
+
+	dbBoard, err = suite.database.UpdateBoard(context.Background(), DatabaseBoardUpdate{
+		ID:            boardId,
+		RetentionDays: &sql.NullInt32{Int32: 0, Valid: true},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, *dbBoard.RetentionDays)
+
+	dbBoard, err = suite.database.UpdateBoard(context.Background(), DatabaseBoardUpdate{
+		ID:            boardId,
+		RetentionDays: &sql.NullInt32{},
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, dbBoard.RetentionDays)
+}
+
+func (suite *DatabaseBoardTestSuite) Test_Database_SetArchived() {
+	t := suite.T()
+	boardId := suite.boards["Archive"].ID
+	userId := suite.users["Santa"].id
+	archivedAt := time.Now().UTC().Truncate(time.Millisecond)
+
+	dbBoard, err := suite.database.SetArchived(context.Background(), boardId, &archivedAt)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, dbBoard.ArchivedAt)
+	assert.True(t, archivedAt.Equal(*dbBoard.ArchivedAt))
+
+	archivedBoards, err := suite.database.GetBoards(context.Background(), userId, true)
+	assert.Nil(t, err)
+	assert.NotNil(t, checkDbBoardInList(archivedBoards, boardId))
+
+	activeBoards, err := suite.database.GetBoards(context.Background(), userId, false)
+	assert.Nil(t, err)
+	assert.Nil(t, checkDbBoardInList(activeBoards, boardId))
+
+	later := archivedAt.Add(time.Hour)
+	dbBoard, err = suite.database.SetArchived(context.Background(), boardId, &later)
+
+	assert.Nil(t, err)
+	assert.True(t, archivedAt.Equal(*dbBoard.ArchivedAt))
+
+	dbBoard, err = suite.database.SetArchived(context.Background(), boardId, nil)
+
+	assert.Nil(t, err)
+	assert.Nil(t, dbBoard.ArchivedAt)
+}
+
 func (suite *DatabaseBoardTestSuite) seedData(db *bun.DB) {
 	log.Println("Seeding boards database test data")
 
 	// tests users
-	suite.users = make(map[string]TestUser, 2)
+	suite.users = make(map[string]TestUser, 3)
 	suite.users["Stan"] = TestUser{id: uuid.New(), name: "Stan", accountType: common.Google}
 	suite.users["Santa"] = TestUser{id: uuid.New(), name: "Santa", accountType: common.Anonymous}
+	suite.users["Tina"] = TestUser{id: uuid.New(), name: "Tina", accountType: common.Google}
 
 	// tests boards
 	suite.boards = make(map[string]DatabaseBoard, 10)
@@ -526,11 +671,38 @@ func (suite *DatabaseBoardTestSuite) seedData(db *bun.DB) {
 	deleteName := "DeleteBoard"
 	deleteDescription := "This is a board to delete"
 	suite.boards["Delete"] = DatabaseBoard{ID: uuid.New(), Name: &deleteName, Description: &deleteDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+	seriesDescription := "This is a board of a series"
+	firstSeriesName := "Series1"
+	suite.boards["Series1"] = DatabaseBoard{ID: uuid.New(), Name: &firstSeriesName, Description: &seriesDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+	secondSeriesName := "Series2"
+	suite.boards["Series2"] = DatabaseBoard{ID: uuid.New(), Name: &secondSeriesName, Description: &seriesDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+	thirdSeriesName := "Series3"
+	suite.boards["Series3"] = DatabaseBoard{ID: uuid.New(), Name: &thirdSeriesName, Description: &seriesDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+
+	retentionDescription := "This is a board to test the retention"
+	retentionExpiredName := "RetentionExpired"
+	suite.boards["RetentionExpired"] = DatabaseBoard{ID: uuid.New(), Name: &retentionExpiredName, Description: &retentionDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+	retentionKeptName := "RetentionKept"
+	suite.boards["RetentionKept"] = DatabaseBoard{ID: uuid.New(), Name: &retentionKeptName, Description: &retentionDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+	retentionDefaultName := "RetentionDefault"
+	suite.boards["RetentionDefault"] = DatabaseBoard{ID: uuid.New(), Name: &retentionDefaultName, Description: &retentionDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+	retentionForeverName := "RetentionForever"
+	suite.boards["RetentionForever"] = DatabaseBoard{ID: uuid.New(), Name: &retentionForeverName, Description: &retentionDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+	retentionArchivedName := "RetentionArchived"
+	suite.boards["RetentionArchived"] = DatabaseBoard{ID: uuid.New(), Name: &retentionArchivedName, Description: &retentionDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
+
+	archiveName := "Archive"
+	archiveDescription := "This is a board to archive"
+	suite.boards["Archive"] = DatabaseBoard{ID: uuid.New(), Name: &archiveName, Description: &archiveDescription, Passphrase: nil, Salt: nil, AccessPolicy: Public, ShowAuthors: true, ShowNotesOfOtherUsers: true, ShowNoteReactions: true, AllowStacking: true, IsLocked: false}
 
 	// test sessions
 	suite.sessions = make(map[string]TestSession, 2)
 	suite.sessions["Read1"] = TestSession{board: suite.boards["Read1"].ID, user: suite.users["Stan"].id}
 	suite.sessions["Read2"] = TestSession{board: suite.boards["Read2"].ID, user: suite.users["Stan"].id}
+	suite.sessions["Series1"] = TestSession{board: suite.boards["Series1"].ID, user: suite.users["Santa"].id}
+	suite.sessions["Series2"] = TestSession{board: suite.boards["Series2"].ID, user: suite.users["Santa"].id}
+	suite.sessions["Series3"] = TestSession{board: suite.boards["Series3"].ID, user: suite.users["Santa"].id}
+	suite.sessions["Archive"] = TestSession{board: suite.boards["Archive"].ID, user: suite.users["Santa"].id}
 
 	for _, user := range suite.users {
 		err := testDbTemplates.InsertUser(db, user.id, user.name, string(user.accountType), nil)
@@ -546,6 +718,61 @@ func (suite *DatabaseBoardTestSuite) seedData(db *bun.DB) {
 		}
 	}
 
+	// link the series boards as follow-ups of each other
+	for i, name := range []string{"Series1", "Series2", "Series3"} {
+		update := db.NewUpdate().
+			Table("boards").
+			Set("created_at = ?", nowDate.Add(time.Duration(i)*time.Hour)).
+			Where("id = ?", suite.boards[name].ID)
+		if i > 0 {
+			update = update.Set("follow_up_of = ?", suite.boards[fmt.Sprintf("Series%d", i)].ID)
+		}
+		if _, err := update.Exec(context.Background()); err != nil {
+			log.Fatalf("Failed to link test boards %s", err)
+		}
+	}
+
+	// the first two series boards belong to a team, whose members can access them without a session
+	seriesTeam := uuid.New()
+	if err := testDbTemplates.InsertTeam(db, seriesTeam, "Series"); err != nil {
+		log.Fatalf("Failed to insert test team %s", err)
+	}
+	if err := testDbTemplates.InsertTeamMember(db, seriesTeam, suite.users["Tina"].id, string(teams.Member)); err != nil {
+		log.Fatalf("Failed to insert test team member %s", err)
+	}
+	_, err := db.NewUpdate().
+		Table("boards").
+		Set("team = ?", seriesTeam).
+		Where("id IN (?)", bun.In([]uuid.UUID{suite.boards["Series1"].ID, suite.boards["Series2"].ID})).
+		Exec(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to assign test boards to team %s", err)
+	}
+
+	// boards that have not been modified for a while, with and without their own retention
+	for name, retention := range map[string]struct {
+		days         *int
+		lastModified time.Time
+		archivedAt   *time.Time
+	}{
+		"RetentionExpired":  {days: new(1), lastModified: time.Now().AddDate(0, 0, -2)},
+		"RetentionKept":     {days: new(30), lastModified: time.Now().AddDate(0, 0, -2)},
+		"RetentionDefault":  {days: nil, lastModified: time.Now().AddDate(0, 0, -60)},
+		"RetentionForever":  {days: new(0), lastModified: time.Now().AddDate(0, 0, -60)},
+		"RetentionArchived": {days: new(1), lastModified: time.Now().AddDate(0, 0, -2), archivedAt: new(time.Now().AddDate(0, 0, -2))},
+	} {
+		_, err := db.NewUpdate().
+			Table("boards").
+			Set("retention_days = ?", retention.days).
+			Set("last_modified_at = ?", retention.lastModified).
+			Set("archived_at = ?", retention.archivedAt).
+			Where("id = ?", suite.boards[name].ID).
+			Exec(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to update test board retention %s", err)
+		}
+	}
+
 	for _, session := range suite.sessions {
 		err := testDbTemplates.InsertSession(db, session.user, session.board, string(role.ParticipantRole), false, true, true, false)
 		if err != nil {
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"scrumlr.io/server/actionitems"
 	"scrumlr.io/server/columns"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/notes"
 	"scrumlr.io/server/reactions"
 	"scrumlr.io/server/role"
@@ -48,6 +50,19 @@ type Board struct {
 
 	ShowVoting uuid.NullUUID `json:"showVoting"`
 
+	// The previous board of the series this board is a follow-up of.
+	FollowUpOf uuid.NullUUID `json:"followUpOf"`
+
+	// The team owning this board, all members of the team see the board in their board overview.
+	Team uuid.NullUUID `json:"team"`
+
+	// The number of days the board is kept after its last modification.
+	// If not set the server-wide retention applies.
+	RetentionDays *int `json:"retentionDays,omitempty"`
+
+	// The date the board was archived. Archived boards are read-only.
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+
 	Passphrase *string `json:"-"`
 	Salt       *string `json:"-"`
 
@@ -73,6 +88,10 @@ func (b *Board) From(board DatabaseBoard) *Board {
 	b.Salt = board.Salt
 	b.LastModifiedAt = board.LastModifiedAt
 	b.CreatedAt = board.CreatedAt
+	b.FollowUpOf = board.FollowUpOf
+	b.Team = board.Team
+	b.RetentionDays = board.RetentionDays
+	b.ArchivedAt = board.ArchivedAt
 	return b
 }
 
@@ -96,9 +115,28 @@ type CreateBoardRequest struct {
 	// The columns to create for the board.
 	Columns []columns.ColumnRequest `json:"columns"`
 
+	// The previous board this board is a follow-up of.
+	FollowUp *FollowUpRequest `json:"followUp"`
+
+	// The team the board is created for, the owner must be a member of that team.
+	Team *uuid.UUID `json:"team"`
+
 	Owner uuid.UUID `json:"-"`
 }
 
+// FollowUpRequest links a new board to a previous board.
+//
+// Only moderators of the previous board may create a follow-up. All action items
+// of the previous board that are not done yet are copied to the new board.
+type FollowUpRequest struct {
+	// The previous board.
+	Board uuid.UUID `json:"board"`
+
+	// A column of the previous board whose notes, including their stacks, are copied into the first column of the
+	// new board on behalf of its owner.
+	Column *uuid.UUID `json:"column"`
+}
+
 type SetTimerRequest struct {
 	Minutes uint8 `json:"minutes"`
 }
@@ -142,6 +180,10 @@ type BoardUpdateRequest struct {
 
 	ShowVoting uuid.NullUUID `json:"showVoting"`
 
+	// Set the number of days the board is kept after its last modification.
+	// Use 0 to keep the board forever. Boards without a retention fall back to the server-wide retention.
+	RetentionDays *int `json:"retentionDays"`
+
 	ID uuid.UUID `json:"-"`
 }
 
@@ -160,6 +202,30 @@ type BoardOverview struct {
 	Role         role.Role         `json:"role"`
 	Favourite    bool              `json:"favourite"`
 	NoteCount    int               `json:"noteCount"`
+
+	// All boards of the series this board belongs to, oldest first.
+	// Only contains boards the user participates in and is empty if the board is not part of a series.
+	Series []*BoardSeriesEntry `json:"series,omitempty"`
+}
+
+// BoardSeriesEntry is a board within a series of follow-up boards.
+type BoardSeriesEntry struct {
+	ID        uuid.UUID `json:"id"`
+	Name      *string   `json:"name,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func BoardSeries(boards []DatabaseBoard) []*BoardSeriesEntry {
+	if boards == nil {
+		return nil
+	}
+
+	list := make([]*BoardSeriesEntry, len(boards))
+	for index, board := range boards {
+		list[index] = &BoardSeriesEntry{ID: board.ID, Name: board.Name, CreatedAt: board.CreatedAt}
+	}
+
+	return list
 }
 
 type ImportBoardRequest struct {
@@ -187,6 +253,8 @@ type FullBoard struct {
 	Reactions            []*reactions.Reaction                  `json:"reactions"`
 	Votings              []*votings.Voting                      `json:"votings"`
 	Votes                []*votings.Vote                        `json:"votes"`
+	ActionItems          []*actionitems.ActionItem              `json:"actionItems"`
+	Estimations          []*estimations.Estimation              `json:"estimations"`
 }
 
 func (dtoFullBoard *FullBoard) From(dbFullBoard DatabaseFullBoard) *FullBoard {
@@ -198,5 +266,7 @@ func (dtoFullBoard *FullBoard) From(dbFullBoard DatabaseFullBoard) *FullBoard {
 	dtoFullBoard.Reactions = reactions.Reactions(dbFullBoard.Reactions)
 	dtoFullBoard.Votings = votings.Votings(dbFullBoard.Votings, dbFullBoard.Votes)
 	dtoFullBoard.Votes = votings.Votes(dbFullBoard.Votes)
+	dtoFullBoard.ActionItems = actionitems.ActionItems(dbFullBoard.ActionItems)
+	dtoFullBoard.Estimations = estimations.Estimations(dbFullBoard.Estimations, dbFullBoard.Estimates)
 	return dtoFullBoard
 }
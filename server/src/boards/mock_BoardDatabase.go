@@ -6,6 +6,7 @@ package boards
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -227,9 +228,83 @@ func (_c *MockBoardDatabase_GetBoard_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// GetBoardSeries provides a mock function for the type MockBoardDatabase
+func (_mock *MockBoardDatabase) GetBoardSeries(ctx context.Context, boardIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID][]DatabaseBoard, error) {
+	ret := _mock.Called(ctx, boardIDs, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardSeries")
+	}
+
+	var r0 map[uuid.UUID][]DatabaseBoard
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID) (map[uuid.UUID][]DatabaseBoard, error)); ok {
+		return returnFunc(ctx, boardIDs, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID) map[uuid.UUID][]DatabaseBoard); ok {
+		r0 = returnFunc(ctx, boardIDs, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]DatabaseBoard)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, boardIDs, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBoardDatabase_GetBoardSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBoardSeries'
+type MockBoardDatabase_GetBoardSeries_Call struct {
+	*mock.Call
+}
+
+// GetBoardSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - boardIDs []uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockBoardDatabase_Expecter) GetBoardSeries(ctx any, boardIDs any, userID any) *MockBoardDatabase_GetBoardSeries_Call {
+	return &MockBoardDatabase_GetBoardSeries_Call{Call: _e.mock.On("GetBoardSeries", ctx, boardIDs, userID)}
+}
+
+func (_c *MockBoardDatabase_GetBoardSeries_Call) Run(run func(ctx context.Context, boardIDs []uuid.UUID, userID uuid.UUID)) *MockBoardDatabase_GetBoardSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardDatabase_GetBoardSeries_Call) Return(uUIDToV map[uuid.UUID][]DatabaseBoard, err error) *MockBoardDatabase_GetBoardSeries_Call {
+	_c.Call.Return(uUIDToV, err)
+	return _c
+}
+
+func (_c *MockBoardDatabase_GetBoardSeries_Call) RunAndReturn(run func(ctx context.Context, boardIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID][]DatabaseBoard, error)) *MockBoardDatabase_GetBoardSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBoards provides a mock function for the type MockBoardDatabase
-func (_mock *MockBoardDatabase) GetBoards(ctx context.Context, userID uuid.UUID) ([]DatabaseBoard, error) {
-	ret := _mock.Called(ctx, userID)
+func (_mock *MockBoardDatabase) GetBoards(ctx context.Context, userID uuid.UUID, archived bool) ([]DatabaseBoard, error) {
+	ret := _mock.Called(ctx, userID, archived)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBoards")
@@ -237,18 +312,18 @@ func (_mock *MockBoardDatabase) GetBoards(ctx context.Context, userID uuid.UUID)
 
 	var r0 []DatabaseBoard
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]DatabaseBoard, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) ([]DatabaseBoard, error)); ok {
+		return returnFunc(ctx, userID, archived)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []DatabaseBoard); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) []DatabaseBoard); ok {
+		r0 = returnFunc(ctx, userID, archived)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]DatabaseBoard)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, userID, archived)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -263,11 +338,12 @@ type MockBoardDatabase_GetBoards_Call struct {
 // GetBoards is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *MockBoardDatabase_Expecter) GetBoards(ctx any, userID any) *MockBoardDatabase_GetBoards_Call {
-	return &MockBoardDatabase_GetBoards_Call{Call: _e.mock.On("GetBoards", ctx, userID)}
+//   - archived bool
+func (_e *MockBoardDatabase_Expecter) GetBoards(ctx any, userID any, archived any) *MockBoardDatabase_GetBoards_Call {
+	return &MockBoardDatabase_GetBoards_Call{Call: _e.mock.On("GetBoards", ctx, userID, archived)}
 }
 
-func (_c *MockBoardDatabase_GetBoards_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockBoardDatabase_GetBoards_Call {
+func (_c *MockBoardDatabase_GetBoards_Call) Run(run func(ctx context.Context, userID uuid.UUID, archived bool)) *MockBoardDatabase_GetBoards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -277,9 +353,14 @@ func (_c *MockBoardDatabase_GetBoards_Call) Run(run func(ctx context.Context, us
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -290,7 +371,221 @@ func (_c *MockBoardDatabase_GetBoards_Call) Return(databaseBoards []DatabaseBoar
 	return _c
 }
 
-func (_c *MockBoardDatabase_GetBoards_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]DatabaseBoard, error)) *MockBoardDatabase_GetBoards_Call {
+func (_c *MockBoardDatabase_GetBoards_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, archived bool) ([]DatabaseBoard, error)) *MockBoardDatabase_GetBoards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExpiredBoards provides a mock function for the type MockBoardDatabase
+func (_mock *MockBoardDatabase) GetExpiredBoards(ctx context.Context, now time.Time, defaultRetentionDays int) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, now, defaultRetentionDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiredBoards")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, now, defaultRetentionDays)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, now, defaultRetentionDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, defaultRetentionDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBoardDatabase_GetExpiredBoards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiredBoards'
+type MockBoardDatabase_GetExpiredBoards_Call struct {
+	*mock.Call
+}
+
+// GetExpiredBoards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - defaultRetentionDays int
+func (_e *MockBoardDatabase_Expecter) GetExpiredBoards(ctx any, now any, defaultRetentionDays any) *MockBoardDatabase_GetExpiredBoards_Call {
+	return &MockBoardDatabase_GetExpiredBoards_Call{Call: _e.mock.On("GetExpiredBoards", ctx, now, defaultRetentionDays)}
+}
+
+func (_c *MockBoardDatabase_GetExpiredBoards_Call) Run(run func(ctx context.Context, now time.Time, defaultRetentionDays int)) *MockBoardDatabase_GetExpiredBoards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardDatabase_GetExpiredBoards_Call) Return(uUIDs []uuid.UUID, err error) *MockBoardDatabase_GetExpiredBoards_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockBoardDatabase_GetExpiredBoards_Call) RunAndReturn(run func(ctx context.Context, now time.Time, defaultRetentionDays int) ([]uuid.UUID, error)) *MockBoardDatabase_GetExpiredBoards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockExpiredBoards provides a mock function for the type MockBoardDatabase
+func (_mock *MockBoardDatabase) LockExpiredBoards(ctx context.Context) (func(), bool, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockExpiredBoards")
+	}
+
+	var r0 func()
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (func(), bool, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) func()); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) bool); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = returnFunc(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockBoardDatabase_LockExpiredBoards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockExpiredBoards'
+type MockBoardDatabase_LockExpiredBoards_Call struct {
+	*mock.Call
+}
+
+// LockExpiredBoards is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBoardDatabase_Expecter) LockExpiredBoards(ctx any) *MockBoardDatabase_LockExpiredBoards_Call {
+	return &MockBoardDatabase_LockExpiredBoards_Call{Call: _e.mock.On("LockExpiredBoards", ctx)}
+}
+
+func (_c *MockBoardDatabase_LockExpiredBoards_Call) Run(run func(ctx context.Context)) *MockBoardDatabase_LockExpiredBoards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardDatabase_LockExpiredBoards_Call) Return(v func(), b bool, err error) *MockBoardDatabase_LockExpiredBoards_Call {
+	_c.Call.Return(v, b, err)
+	return _c
+}
+
+func (_c *MockBoardDatabase_LockExpiredBoards_Call) RunAndReturn(run func(ctx context.Context) (func(), bool, error)) *MockBoardDatabase_LockExpiredBoards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetArchived provides a mock function for the type MockBoardDatabase
+func (_mock *MockBoardDatabase) SetArchived(ctx context.Context, id uuid.UUID, archivedAt *time.Time) (DatabaseBoard, error) {
+	ret := _mock.Called(ctx, id, archivedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArchived")
+	}
+
+	var r0 DatabaseBoard
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time) (DatabaseBoard, error)); ok {
+		return returnFunc(ctx, id, archivedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time) DatabaseBoard); ok {
+		r0 = returnFunc(ctx, id, archivedAt)
+	} else {
+		r0 = ret.Get(0).(DatabaseBoard)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time) error); ok {
+		r1 = returnFunc(ctx, id, archivedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBoardDatabase_SetArchived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArchived'
+type MockBoardDatabase_SetArchived_Call struct {
+	*mock.Call
+}
+
+// SetArchived is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - archivedAt *time.Time
+func (_e *MockBoardDatabase_Expecter) SetArchived(ctx any, id any, archivedAt any) *MockBoardDatabase_SetArchived_Call {
+	return &MockBoardDatabase_SetArchived_Call{Call: _e.mock.On("SetArchived", ctx, id, archivedAt)}
+}
+
+func (_c *MockBoardDatabase_SetArchived_Call) Run(run func(ctx context.Context, id uuid.UUID, archivedAt *time.Time)) *MockBoardDatabase_SetArchived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *time.Time
+		if args[2] != nil {
+			arg2 = args[2].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardDatabase_SetArchived_Call) Return(databaseBoard DatabaseBoard, err error) *MockBoardDatabase_SetArchived_Call {
+	_c.Call.Return(databaseBoard, err)
+	return _c
+}
+
+func (_c *MockBoardDatabase_SetArchived_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, archivedAt *time.Time) (DatabaseBoard, error)) *MockBoardDatabase_SetArchived_Call {
 	_c.Call.Return(run)
 	return _c
 }
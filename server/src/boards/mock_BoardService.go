@@ -7,6 +7,7 @@ package boards
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -39,6 +40,74 @@ func (_m *MockBoardService) EXPECT() *MockBoardService_Expecter {
 	return &MockBoardService_Expecter{mock: &_m.Mock}
 }
 
+// Archive provides a mock function for the type MockBoardService
+func (_mock *MockBoardService) Archive(ctx context.Context, id uuid.UUID) (*Board, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 *Board
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Board, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Board); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Board)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBoardService_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockBoardService_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockBoardService_Expecter) Archive(ctx any, id any) *MockBoardService_Archive_Call {
+	return &MockBoardService_Archive_Call{Call: _e.mock.On("Archive", ctx, id)}
+}
+
+func (_c *MockBoardService_Archive_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockBoardService_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardService_Archive_Call) Return(board *Board, err error) *MockBoardService_Archive_Call {
+	_c.Call.Return(board, err)
+	return _c
+}
+
+func (_c *MockBoardService_Archive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*Board, error)) *MockBoardService_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BoardEditableContext provides a mock function for the type MockBoardService
 func (_mock *MockBoardService) BoardEditableContext(next http.Handler) http.Handler {
 	ret := _mock.Called(next)
@@ -291,6 +360,72 @@ func (_c *MockBoardService_Delete_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// DeleteExpired provides a mock function for the type MockBoardService
+func (_mock *MockBoardService) DeleteExpired(ctx context.Context, defaultRetentionDays int) (int, error) {
+	ret := _mock.Called(ctx, defaultRetentionDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return returnFunc(ctx, defaultRetentionDays)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = returnFunc(ctx, defaultRetentionDays)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, defaultRetentionDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBoardService_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type MockBoardService_DeleteExpired_Call struct {
+	*mock.Call
+}
+
+// DeleteExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - defaultRetentionDays int
+func (_e *MockBoardService_Expecter) DeleteExpired(ctx any, defaultRetentionDays any) *MockBoardService_DeleteExpired_Call {
+	return &MockBoardService_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", ctx, defaultRetentionDays)}
+}
+
+func (_c *MockBoardService_DeleteExpired_Call) Run(run func(ctx context.Context, defaultRetentionDays int)) *MockBoardService_DeleteExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardService_DeleteExpired_Call) Return(n int, err error) *MockBoardService_DeleteExpired_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockBoardService_DeleteExpired_Call) RunAndReturn(run func(ctx context.Context, defaultRetentionDays int) (int, error)) *MockBoardService_DeleteExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteTimer provides a mock function for the type MockBoardService
 func (_mock *MockBoardService) DeleteTimer(ctx context.Context, id uuid.UUID) (*Board, error) {
 	ret := _mock.Called(ctx, id)
@@ -496,8 +631,8 @@ func (_c *MockBoardService_Get_Call) RunAndReturn(run func(ctx context.Context,
 }
 
 // GetBoards provides a mock function for the type MockBoardService
-func (_mock *MockBoardService) GetBoards(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
-	ret := _mock.Called(ctx, userID)
+func (_mock *MockBoardService) GetBoards(ctx context.Context, userID uuid.UUID, archived bool) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, userID, archived)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBoards")
@@ -505,18 +640,18 @@ func (_mock *MockBoardService) GetBoards(ctx context.Context, userID uuid.UUID)
 
 	var r0 []uuid.UUID
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, userID, archived)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, userID, archived)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]uuid.UUID)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, userID, archived)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -531,11 +666,12 @@ type MockBoardService_GetBoards_Call struct {
 // GetBoards is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *MockBoardService_Expecter) GetBoards(ctx any, userID any) *MockBoardService_GetBoards_Call {
-	return &MockBoardService_GetBoards_Call{Call: _e.mock.On("GetBoards", ctx, userID)}
+//   - archived bool
+func (_e *MockBoardService_Expecter) GetBoards(ctx any, userID any, archived any) *MockBoardService_GetBoards_Call {
+	return &MockBoardService_GetBoards_Call{Call: _e.mock.On("GetBoards", ctx, userID, archived)}
 }
 
-func (_c *MockBoardService_GetBoards_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockBoardService_GetBoards_Call {
+func (_c *MockBoardService_GetBoards_Call) Run(run func(ctx context.Context, userID uuid.UUID, archived bool)) *MockBoardService_GetBoards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -545,9 +681,14 @@ func (_c *MockBoardService_GetBoards_Call) Run(run func(ctx context.Context, use
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -558,7 +699,7 @@ func (_c *MockBoardService_GetBoards_Call) Return(uUIDs []uuid.UUID, err error)
 	return _c
 }
 
-func (_c *MockBoardService_GetBoards_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)) *MockBoardService_GetBoards_Call {
+func (_c *MockBoardService_GetBoards_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, archived bool) ([]uuid.UUID, error)) *MockBoardService_GetBoards_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -779,6 +920,148 @@ func (_c *MockBoardService_SetTimer_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// SetTimerUntil provides a mock function for the type MockBoardService
+func (_mock *MockBoardService) SetTimerUntil(ctx context.Context, id uuid.UUID, end time.Time) (*Board, error) {
+	ret := _mock.Called(ctx, id, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTimerUntil")
+	}
+
+	var r0 *Board
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*Board, error)); ok {
+		return returnFunc(ctx, id, end)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *Board); ok {
+		r0 = returnFunc(ctx, id, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Board)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBoardService_SetTimerUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTimerUntil'
+type MockBoardService_SetTimerUntil_Call struct {
+	*mock.Call
+}
+
+// SetTimerUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - end time.Time
+func (_e *MockBoardService_Expecter) SetTimerUntil(ctx any, id any, end any) *MockBoardService_SetTimerUntil_Call {
+	return &MockBoardService_SetTimerUntil_Call{Call: _e.mock.On("SetTimerUntil", ctx, id, end)}
+}
+
+func (_c *MockBoardService_SetTimerUntil_Call) Run(run func(ctx context.Context, id uuid.UUID, end time.Time)) *MockBoardService_SetTimerUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardService_SetTimerUntil_Call) Return(board *Board, err error) *MockBoardService_SetTimerUntil_Call {
+	_c.Call.Return(board, err)
+	return _c
+}
+
+func (_c *MockBoardService_SetTimerUntil_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, end time.Time) (*Board, error)) *MockBoardService_SetTimerUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unarchive provides a mock function for the type MockBoardService
+func (_mock *MockBoardService) Unarchive(ctx context.Context, id uuid.UUID) (*Board, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unarchive")
+	}
+
+	var r0 *Board
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Board, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Board); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Board)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBoardService_Unarchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unarchive'
+type MockBoardService_Unarchive_Call struct {
+	*mock.Call
+}
+
+// Unarchive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockBoardService_Expecter) Unarchive(ctx any, id any) *MockBoardService_Unarchive_Call {
+	return &MockBoardService_Unarchive_Call{Call: _e.mock.On("Unarchive", ctx, id)}
+}
+
+func (_c *MockBoardService_Unarchive_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockBoardService_Unarchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardService_Unarchive_Call) Return(board *Board, err error) *MockBoardService_Unarchive_Call {
+	_c.Call.Return(board, err)
+	return _c
+}
+
+func (_c *MockBoardService_Unarchive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*Board, error)) *MockBoardService_Unarchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockBoardService
 func (_mock *MockBoardService) Update(ctx context.Context, body BoardUpdateRequest) (*Board, error) {
 	ret := _mock.Called(ctx, body)
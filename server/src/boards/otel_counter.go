@@ -14,6 +14,18 @@ var boardDeletedCounter, _ = meter.Int64Counter(
 	metric.WithUnit("boards"),
 )
 
+var boardArchivedCounter, _ = meter.Int64Counter(
+	"scrumlr.boards.archived.counter",
+	metric.WithDescription("Number of archived boards"),
+	metric.WithUnit("boards"),
+)
+
+var boardExpiredCounter, _ = meter.Int64Counter(
+	"scrumlr.boards.expired.counter",
+	metric.WithDescription("Number of boards deleted because their retention period expired"),
+	metric.WithUnit("boards"),
+)
+
 var boardTimerSetCounter, _ = meter.Int64Counter(
 	"scrumlr.boards.timer.created.counter",
 	metric.WithDescription("Number of created board timer"),
@@ -0,0 +1,56 @@
+package boards
+
+import (
+	"context"
+	"time"
+
+	"scrumlr.io/server/logger"
+)
+
+// RetentionJob periodically deletes boards whose retention period expired.
+//
+// Every instance runs the job, but only one of them deletes the expired boards at a time.
+type RetentionJob struct {
+	service              BoardService
+	defaultRetentionDays int
+	interval             time.Duration
+}
+
+// NewRetentionJob creates a job that deletes expired boards every interval. Boards without their
+// own retention are deleted after defaultRetentionDays, a value of 0 keeps them forever.
+func NewRetentionJob(service BoardService, defaultRetentionDays int, interval time.Duration) *RetentionJob {
+	return &RetentionJob{service: service, defaultRetentionDays: defaultRetentionDays, interval: interval}
+}
+
+// Start runs the job in the background until the context is done.
+func (job *RetentionJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(job.interval)
+		defer ticker.Stop()
+
+		for {
+			job.Run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Run deletes all currently expired boards once.
+func (job *RetentionJob) Run(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	deleted, err := job.service.DeleteExpired(ctx, job.defaultRetentionDays)
+	if err != nil {
+		log.Errorw("unable to delete all expired boards", "deleted", deleted, "err", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.Infow("deleted expired boards", "deleted", deleted)
+	}
+}
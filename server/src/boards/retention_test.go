@@ -0,0 +1,43 @@
+package boards
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRetentionJob_Run(t *testing.T) {
+	mockBoardService := NewMockBoardService(t)
+	mockBoardService.EXPECT().DeleteExpired(mock.Anything, 30).Return(2, nil).Once()
+
+	NewRetentionJob(mockBoardService, 30, time.Hour).Run(context.Background())
+}
+
+func TestRetentionJob_RunError(t *testing.T) {
+	mockBoardService := NewMockBoardService(t)
+	mockBoardService.EXPECT().DeleteExpired(mock.Anything, 0).Return(0, errors.New("failed")).Once()
+
+	NewRetentionJob(mockBoardService, 0, time.Hour).Run(context.Background())
+}
+
+func TestRetentionJob_StartRunsImmediately(t *testing.T) {
+	done := make(chan struct{})
+	mockBoardService := NewMockBoardService(t)
+	mockBoardService.EXPECT().DeleteExpired(mock.Anything, 7).
+		Run(func(_ context.Context, _ int) { close(done) }).
+		Return(0, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	NewRetentionJob(mockBoardService, 7, time.Hour).Start(ctx)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("retention job did not run")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"time"
@@ -15,9 +16,13 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/identifiers"
 	"scrumlr.io/server/role"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/technical_helper"
 	"scrumlr.io/server/users"
 
@@ -50,6 +55,10 @@ type Service struct {
 	reactionService       reactions.ReactionService
 	votingService         votings.VotingService
 	userService           users.UserService
+	actionItemService     actionitems.ActionItemService
+	estimationService     estimations.EstimationService
+	teamService           teams.TeamService
+	auditService          audit.AuditService
 }
 
 type LastModifiedUpdater struct {
@@ -63,7 +72,11 @@ type BoardDatabase interface {
 	UpdateBoard(ctx context.Context, update DatabaseBoardUpdate) (DatabaseBoard, error)
 	GetBoard(ctx context.Context, id uuid.UUID) (DatabaseBoard, error)
 	DeleteBoard(ctx context.Context, id uuid.UUID) error
-	GetBoards(ctx context.Context, userID uuid.UUID) ([]DatabaseBoard, error)
+	GetBoards(ctx context.Context, userID uuid.UUID, archived bool) ([]DatabaseBoard, error)
+	SetArchived(ctx context.Context, id uuid.UUID, archivedAt *time.Time) (DatabaseBoard, error)
+	GetBoardSeries(ctx context.Context, boardIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID][]DatabaseBoard, error)
+	GetExpiredBoards(ctx context.Context, now time.Time, defaultRetentionDays int) ([]uuid.UUID, error)
+	LockExpiredBoards(ctx context.Context) (func(), bool, error)
 }
 
 type BoardLastModifiedUpdater interface {
@@ -80,6 +93,10 @@ func NewBoardService(
 	reactionService reactions.ReactionService,
 	votingService votings.VotingService,
 	userService users.UserService,
+	actionItemService actionitems.ActionItemService,
+	estimationService estimations.EstimationService,
+	teamService teams.TeamService,
+	auditService audit.AuditService,
 	clock timeprovider.TimeProvider,
 	hash hash.Hash,
 ) BoardService {
@@ -95,6 +112,10 @@ func NewBoardService(
 	b.reactionService = reactionService
 	b.votingService = votingService
 	b.userService = userService
+	b.actionItemService = actionItemService
+	b.estimationService = estimationService
+	b.teamService = teamService
+	b.auditService = auditService
 	b.boardLastModifiedUpdater = NewLastModifiedUpdater(db, clock)
 
 	return b
@@ -118,6 +139,24 @@ func (service *Service) Create(ctx context.Context, body CreateBoardRequest) (*B
 		return nil, err
 	}
 
+	if body.FollowUp != nil {
+		if err := service.checkFollowUp(ctx, body); err != nil {
+			span.SetStatus(codes.Error, "invalid follow-up board")
+			span.RecordError(err)
+			return nil, err
+		}
+		board.FollowUpOf = uuid.NullUUID{UUID: body.FollowUp.Board, Valid: true}
+	}
+
+	if body.Team != nil {
+		if err := service.checkTeam(ctx, *body.Team, body.Owner); err != nil {
+			span.SetStatus(codes.Error, "invalid team")
+			span.RecordError(err)
+			return nil, err
+		}
+		board.Team = uuid.NullUUID{UUID: *body.Team, Valid: true}
+	}
+
 	// create the board
 	b, err := service.database.CreateBoard(ctx, board)
 	if err != nil {
@@ -142,6 +181,15 @@ func (service *Service) Create(ctx context.Context, body CreateBoardRequest) (*B
 		return nil, err
 	}
 
+	if body.FollowUp != nil {
+		// the board already exists at this point, so a failed carry-over leaves it without the copied content
+		// instead of failing the creation
+		if err := service.carryOver(ctx, b.ID, body.Owner, *body.FollowUp); err != nil {
+			span.RecordError(err)
+			log.Errorw("unable to carry over from previous board", "board", b.ID, "previous", body.FollowUp.Board, "err", err)
+		}
+	}
+
 	boardCreatedCounter.Add(ctx, 1)
 	return new(Board).From(b), nil
 }
@@ -193,16 +241,17 @@ func (service *Service) Get(ctx context.Context, id uuid.UUID) (*Board, error) {
 }
 
 // GetBoards get all associated boards of a given user
-func (service *Service) GetBoards(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+func (service *Service) GetBoards(ctx context.Context, userID uuid.UUID, archived bool) ([]uuid.UUID, error) {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.boards.service.get.all")
 	defer span.End()
 
 	span.SetAttributes(
 		attribute.String("scrumlr.boards.service.get.all.user", userID.String()),
+		attribute.Bool("scrumlr.boards.service.get.all.archived", archived),
 	)
 
-	boards, err := service.database.GetBoards(ctx, userID)
+	boards, err := service.database.GetBoards(ctx, userID, archived)
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to get boards")
 		span.RecordError(err)
@@ -227,6 +276,14 @@ func (service *Service) BoardOverview(ctx context.Context, boardIDs []uuid.UUID,
 		attribute.String("scrumlr.boards.service.board.get.overview.user", user.String()),
 	)
 
+	boardSeries, err := service.database.GetBoardSeries(ctx, boardIDs, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get board series")
+		span.RecordError(err)
+		log.Errorw("unable to get board overview", "err", err)
+		return nil, CreateBoardError(Internal, "unable to get board series", err)
+	}
+
 	overviewBoards := make([]*BoardOverview, 0, len(boardIDs))
 	for _, id := range boardIDs {
 		board, err := service.Get(ctx, id)
@@ -262,28 +319,55 @@ func (service *Service) BoardOverview(ctx context.Context, boardIDs []uuid.UUID,
 		}
 
 		participantNum := len(boardSessions)
+		var userSession *sessions.BoardSession
 		for _, session := range boardSessions {
-			// Participants should not be able to see hidden columns and their respective notes.
 			if session.UserID == user {
-				if !session.Role.CanSeeHiddenColumns() {
-					boardColumns = columns.ColumnSlice(boardColumns).FilterVisibleColumns()
-					// also filter those notes where their respective column is hidden,
-					// at this point boardColumns only contains visible columns.
-					boardNotes = technical_helper.Filter(boardNotes, func(note *notes.Note) bool {
-						return columns.ColumnSlice(boardColumns).ContainsNote(note)
-					})
-				}
-				overviewBoards = append(overviewBoards, &BoardOverview{
-					Board:        board,
-					Columns:      boardColumns,
-					CreatedAt:    board.CreatedAt,
-					Participants: participantNum,
-					Role:         session.Role,
-					Favourite:    session.Favourite,
-					NoteCount:    len(boardNotes),
-				})
+				userSession = session
+				break
+			}
+		}
+
+		// members of the owning team see the board without having joined it yet
+		if userSession == nil {
+			if !board.Team.Valid {
+				continue
 			}
+			teamRole, err := service.teamBoardRole(ctx, board, user)
+			if err != nil {
+				span.SetStatus(codes.Error, "failed to get team role")
+				span.RecordError(err)
+				log.Errorw("unable to get board overview", "board", id, "err", err)
+				return nil, err
+			}
+			userSession = &sessions.BoardSession{UserID: user, Board: id, Role: teamRole}
+		}
+
+		// Participants should not be able to see hidden columns and their respective notes.
+		if !userSession.Role.CanSeeHiddenColumns() {
+			boardColumns = columns.ColumnSlice(boardColumns).FilterVisibleColumns()
+			// also filter those notes where their respective column is hidden,
+			// at this point boardColumns only contains visible columns.
+			boardNotes = technical_helper.Filter(boardNotes, func(note *notes.Note) bool {
+				return columns.ColumnSlice(boardColumns).ContainsNote(note)
+			})
 		}
+
+		// stand-alone boards are not part of a series
+		var series []*BoardSeriesEntry
+		if len(boardSeries[id]) > 1 {
+			series = BoardSeries(boardSeries[id])
+		}
+
+		overviewBoards = append(overviewBoards, &BoardOverview{
+			Board:        board,
+			Columns:      boardColumns,
+			CreatedAt:    board.CreatedAt,
+			Participants: participantNum,
+			Role:         userSession.Role,
+			Favourite:    userSession.Favourite,
+			NoteCount:    len(boardNotes),
+			Series:       series,
+		})
 	}
 	return overviewBoards, nil
 }
@@ -361,6 +445,22 @@ func (service *Service) FullBoard(ctx context.Context, boardID uuid.UUID) (*Full
 		return nil, err
 	}
 
+	boardActionItems, err := service.actionItemService.GetAll(ctx, boardID)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get action items")
+		span.RecordError(err)
+		log.Errorw("unable to get full board", "boardID", boardID, "err", err)
+		return nil, err
+	}
+
+	boardEstimations, err := service.estimationService.GetAll(ctx, boardID)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimations")
+		span.RecordError(err)
+		log.Errorw("unable to get full board", "boardID", boardID, "err", err)
+		return nil, err
+	}
+
 	return &FullBoard{
 		Board:                board,
 		BoardSessionRequests: boardRequests,
@@ -370,6 +470,8 @@ func (service *Service) FullBoard(ctx context.Context, boardID uuid.UUID) (*Full
 		Reactions:            boardReactions,
 		Votings:              boardVotings,
 		Votes:                boardVotes,
+		ActionItems:          boardActionItems,
+		Estimations:          boardEstimations,
 	}, nil
 }
 
@@ -389,6 +491,13 @@ func (service *Service) Update(ctx context.Context, body BoardUpdateRequest) (*B
 		return nil, CreateBoardError(BadRequest, "name cannot be empty", err)
 	}
 
+	if body.RetentionDays != nil && (*body.RetentionDays < 0 || *body.RetentionDays > math.MaxInt32) {
+		err := errors.New("retention days must be a non-negative number of days")
+		span.SetStatus(codes.Error, "invalid retention days")
+		span.RecordError(err)
+		return nil, CreateBoardError(BadRequest, "retention days must be a non-negative number of days", err)
+	}
+
 	update := DatabaseBoardUpdate{
 		ID:                    body.ID,
 		Name:                  body.Name,
@@ -403,6 +512,10 @@ func (service *Service) Update(ctx context.Context, body BoardUpdateRequest) (*B
 		SharedNote:            body.SharedNote,
 	}
 
+	if body.RetentionDays != nil {
+		update.RetentionDays = &sql.NullInt32{Int32: int32(*body.RetentionDays), Valid: true}
+	}
+
 	if body.AccessPolicy != nil {
 		update.AccessPolicy = body.AccessPolicy
 		switch *body.AccessPolicy {
@@ -434,6 +547,13 @@ func (service *Service) Update(ctx context.Context, body BoardUpdateRequest) (*B
 		}
 	}
 
+	previous, err := service.Get(ctx, body.ID)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get board")
+		span.RecordError(err)
+		return nil, err
+	}
+
 	board, err := service.database.UpdateBoard(ctx, update)
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to update board")
@@ -448,7 +568,49 @@ func (service *Service) Update(ctx context.Context, body BoardUpdateRequest) (*B
 
 	service.updatedBoard(ctx, board)
 
-	return new(Board).From(board), err
+	// timer and shared note are part of the facilitation and not recorded in the audit log
+	updated := new(Board).From(board)
+	before, after := newBoardSettingsAudit(previous), newBoardSettingsAudit(updated)
+	if before != after {
+		service.auditService.Record(ctx, board.ID, audit.BoardUpdated, before, after)
+	}
+
+	return updated, err
+}
+
+// boardSettingsAudit are the settings of a board recorded in the audit log
+type boardSettingsAudit struct {
+	Name                  string       `json:"name"`
+	Description           string       `json:"description"`
+	AccessPolicy          AccessPolicy `json:"accessPolicy"`
+	ShowAuthors           bool         `json:"showAuthors"`
+	ShowNotesOfOtherUsers bool         `json:"showNotesOfOtherUsers"`
+	ShowNoteReactions     bool         `json:"showNoteReactions"`
+	AllowStacking         bool         `json:"allowStacking"`
+	IsLocked              bool         `json:"isLocked"`
+	RetentionDays         int          `json:"retentionDays,omitempty"`
+}
+
+func newBoardSettingsAudit(board *Board) boardSettingsAudit {
+	settings := boardSettingsAudit{
+		AccessPolicy:          board.AccessPolicy,
+		ShowAuthors:           board.ShowAuthors,
+		ShowNotesOfOtherUsers: board.ShowNotesOfOtherUsers,
+		ShowNoteReactions:     board.ShowNoteReactions,
+		AllowStacking:         board.AllowStacking,
+		IsLocked:              board.IsLocked,
+	}
+	if board.Name != nil {
+		settings.Name = *board.Name
+	}
+	if board.Description != nil {
+		settings.Description = *board.Description
+	}
+	if board.RetentionDays != nil {
+		settings.RetentionDays = *board.RetentionDays
+	}
+
+	return settings
 }
 
 func (service *Service) Delete(ctx context.Context, id uuid.UUID) error {
@@ -474,6 +636,110 @@ func (service *Service) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Archive makes the board read-only for all participants and hides it from the default board overview.
+func (service *Service) Archive(ctx context.Context, id uuid.UUID) (*Board, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.boards.service.board.archive")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.boards.service.board.archive.board", id.String()),
+	)
+
+	archivedAt := service.clock.Now()
+	board, err := service.database.SetArchived(ctx, id, &archivedAt)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to archive board")
+		span.RecordError(err)
+		log.Errorw("unable to archive board", "board", id, "err", err)
+		return nil, CreateBoardError(Internal, "failed to archive board", err)
+	}
+
+	service.updatedBoard(ctx, board)
+	boardArchivedCounter.Add(ctx, 1)
+
+	return new(Board).From(board), nil
+}
+
+// Unarchive restores an archived board, so that it can be edited again.
+func (service *Service) Unarchive(ctx context.Context, id uuid.UUID) (*Board, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.boards.service.board.unarchive")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.boards.service.board.unarchive.board", id.String()),
+	)
+
+	board, err := service.database.SetArchived(ctx, id, nil)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to unarchive board")
+		span.RecordError(err)
+		log.Errorw("unable to unarchive board", "board", id, "err", err)
+		return nil, CreateBoardError(Internal, "failed to unarchive board", err)
+	}
+
+	service.updatedBoard(ctx, board)
+
+	return new(Board).From(board), nil
+}
+
+// DeleteExpired deletes all boards that have not been modified within their retention period and
+// returns the number of deleted boards. Boards are deleted one by one through Delete, so that
+// participants are notified and the analytics are updated. Nothing is deleted while another instance
+// deletes the expired boards.
+func (service *Service) DeleteExpired(ctx context.Context, defaultRetentionDays int) (int, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.boards.service.board.delete_expired")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("scrumlr.boards.service.board.delete_expired.default_retention_days", defaultRetentionDays),
+	)
+
+	unlock, locked, err := service.database.LockExpiredBoards(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to lock expired boards")
+		span.RecordError(err)
+		log.Errorw("unable to lock expired boards", "err", err)
+		return 0, CreateBoardError(Internal, "failed to lock expired boards", err)
+	}
+
+	if !locked {
+		return 0, nil
+	}
+	defer unlock()
+
+	expiredBoards, err := service.database.GetExpiredBoards(ctx, service.clock.Now(), defaultRetentionDays)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get expired boards")
+		span.RecordError(err)
+		log.Errorw("unable to get expired boards", "err", err)
+		return 0, CreateBoardError(Internal, "failed to get expired boards", err)
+	}
+
+	deleted := 0
+	var errs []error
+	for _, board := range expiredBoards {
+		if err := service.Delete(ctx, board); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		deleted++
+	}
+
+	boardExpiredCounter.Add(ctx, int64(deleted))
+
+	if len(errs) > 0 {
+		err := errors.Join(errs...)
+		span.SetStatus(codes.Error, "failed to delete expired boards")
+		span.RecordError(err)
+		return deleted, err
+	}
+
+	return deleted, nil
+}
+
 func (service *Service) SetTimer(ctx context.Context, id uuid.UUID, minutes uint8) (*Board, error) {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.boards.service.board.timer.set")
@@ -506,6 +772,39 @@ func (service *Service) SetTimer(ctx context.Context, id uuid.UUID, minutes uint
 	return new(Board).From(board), err
 }
 
+// SetTimerUntil starts the board timer now and lets it run until the given end, e.g. the end of a voting.
+func (service *Service) SetTimerUntil(ctx context.Context, id uuid.UUID, end time.Time) (*Board, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.boards.service.board.timer.set_until")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.boards.service.board.timer.set_until.board", id.String()),
+		attribute.String("scrumlr.boards.service.board.timer.set_until.end", end.String()),
+	)
+
+	timerStart := service.clock.Now().Local()
+	timerEnd := end.Local()
+	update := DatabaseBoardTimerUpdate{
+		ID:         id,
+		TimerStart: &timerStart,
+		TimerEnd:   &timerEnd,
+	}
+
+	board, err := service.database.UpdateBoardTimer(ctx, update)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to update board timer")
+		span.RecordError(err)
+		log.Errorw("unable to update board timer", "err", err)
+		return nil, CreateBoardError(Internal, "failed to update board timer", err)
+	}
+
+	service.updatedBoardTimer(ctx, board)
+
+	boardTimerSetCounter.Add(ctx, 1)
+	return new(Board).From(board), err
+}
+
 func (service *Service) IncrementTimer(ctx context.Context, id uuid.UUID) (*Board, error) {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.boards.service.board.timer.increment")
@@ -663,6 +962,127 @@ func (service *Service) mapCreateBoardInsert(body CreateBoardRequest) (DatabaseB
 	return board, nil
 }
 
+// checkFollowUp makes sure the owner of the new board moderates the previous board
+// and that the column to carry over belongs to it.
+func (service *Service) checkFollowUp(ctx context.Context, body CreateBoardRequest) error {
+	isModerator, err := service.sessionService.ModeratorSessionExists(ctx, body.FollowUp.Board, body.Owner)
+	if err != nil {
+		return CreateBoardError(Internal, "unable to check previous board", err)
+	}
+	if !isModerator {
+		return CreateBoardError(Forbidden, "only moderators of the previous board can create a follow-up", errors.New("only moderators of the previous board can create a follow-up"))
+	}
+
+	if body.FollowUp.Column == nil {
+		return nil
+	}
+
+	if len(body.Columns) == 0 {
+		return CreateBoardError(BadRequest, "notes can only be carried over to a board with columns", errors.New("notes can only be carried over to a board with columns"))
+	}
+
+	_, err = service.columnService.Get(ctx, body.FollowUp.Board, *body.FollowUp.Column)
+	return err
+}
+
+// checkTeam makes sure only members of a team can create boards for it.
+func (service *Service) checkTeam(ctx context.Context, team, owner uuid.UUID) error {
+	isMember, err := service.teamService.MemberExists(ctx, team, owner)
+	if err != nil {
+		return CreateBoardError(Internal, "unable to check team membership", err)
+	}
+	if !isMember {
+		return CreateBoardError(Forbidden, "only members of the team can create boards for it", errors.New("only members of the team can create boards for it"))
+	}
+
+	return nil
+}
+
+// teamBoardRole returns the role a member of the owning team has on a board they have not joined yet.
+// Team owners and admins moderate the boards of their team, all other members participate.
+func (service *Service) teamBoardRole(ctx context.Context, board *Board, user uuid.UUID) (role.Role, error) {
+	member, err := service.teamService.GetMember(ctx, board.Team.UUID, user)
+	if err != nil {
+		return "", err
+	}
+
+	if member.Role.Includes(teams.Admin) {
+		return role.ModeratorRole, nil
+	}
+	return role.ParticipantRole, nil
+}
+
+// carryOver copies the open action items and optionally the notes of a column of the previous board to the new board.
+// Carried over notes keep their authors, but stacks are flattened.
+// carryOver copies the open action items and the notes of the chosen column of the previous board to the new board.
+// Stacks are kept together. The copied notes are attributed to the owner, since their authors may not participate
+// in the new board.
+func (service *Service) carryOver(ctx context.Context, boardID, owner uuid.UUID, followUp FollowUpRequest) error {
+	if _, err := service.actionItemService.CarryOver(ctx, followUp.Board, boardID); err != nil {
+		return err
+	}
+
+	if followUp.Column == nil {
+		return nil
+	}
+
+	boardColumns, err := service.columnService.GetAll(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	if len(boardColumns) == 0 {
+		return CreateBoardError(Internal, "no column to carry notes over to", errors.New("no column to carry notes over to"))
+	}
+
+	previousNotes, err := service.notesService.GetAll(ctx, followUp.Board, *followUp.Column)
+	if err != nil {
+		return err
+	}
+
+	var stackRoots []*notes.Note
+	stackChildren := make(map[uuid.UUID][]*notes.Note)
+	for _, note := range previousNotes {
+		if note.Position.Stack.Valid {
+			stackChildren[note.Position.Stack.UUID] = append(stackChildren[note.Position.Stack.UUID], note)
+		} else {
+			stackRoots = append(stackRoots, note)
+		}
+	}
+
+	for rank, root := range stackRoots {
+		imported, err := service.notesService.Import(ctx, notes.NoteImportRequest{
+			Text: root.Text,
+			Position: notes.NotePosition{
+				Column: boardColumns[0].ID,
+				Rank:   len(stackRoots) - rank - 1,
+			},
+			Board: boardID,
+			User:  owner,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, child := range stackChildren[root.ID] {
+			_, err := service.notesService.Import(ctx, notes.NoteImportRequest{
+				Text: child.Text,
+				Position: notes.NotePosition{
+					Column: boardColumns[0].ID,
+					Stack:  uuid.NullUUID{UUID: imported.ID, Valid: true},
+					Rank:   child.Position.Rank,
+				},
+				Board: boardID,
+				User:  owner,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (service *Service) createColumnsOnBoard(ctx context.Context, boardID uuid.UUID, owner uuid.UUID, columnsToCreate []columns.ColumnRequest) (map[uuid.UUID]uuid.UUID, error) {
 	useProvidedIndices := hasValidUniqueColumnIndices(columnsToCreate)
 	sourceToCreatedColumnMap := make(map[uuid.UUID]uuid.UUID)
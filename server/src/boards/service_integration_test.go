@@ -7,8 +7,10 @@ import (
 	"testing"
 	"time"
 
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/role"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/users"
 	"scrumlr.io/server/websocket"
 
@@ -18,8 +20,13 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go/modules/nats"
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/boardtemplates"
 	"scrumlr.io/server/columns"
+	"scrumlr.io/server/columntemplates"
 	"scrumlr.io/server/common"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/hash"
 	"scrumlr.io/server/initialize"
 	"scrumlr.io/server/initialize/testDbTemplates"
@@ -92,10 +99,9 @@ func (suite *BoardServiceIntegrationTestSuite) SetupTest() {
 
 	clock := timeprovider.NewClock()
 	generatedHash := hash.NewHashSha512()
+	auditService := audit.NewAuditService(audit.NewAuditDatabase(db))
 	reactionDatabase := reactions.NewReactionsDatabase(db)
 	reactionService := reactions.NewReactionService(reactionDatabase, broker)
-	votingDatabase := votings.NewVotingDatabase(db)
-	votingService := votings.NewVotingService(votingDatabase, broker)
 
 	ch, err := cache.NewNats(suite.natsConnectionString, "scrumlr-test-boards")
 	require.NoError(suite.T(), err, "Failed to connect to nats cache")
@@ -103,18 +109,28 @@ func (suite *BoardServiceIntegrationTestSuite) SetupTest() {
 	database := NewBoardDatabase(db, clock)
 	boardLastModifiedUpdater := NewLastModifiedUpdater(database, clock)
 	noteDatabase := notes.NewNotesDatabase(db)
-	noteService := notes.NewNotesService(noteDatabase, broker, ch, boardLastModifiedUpdater)
+	noteService := notes.NewNotesService(noteDatabase, broker, ch, boardLastModifiedUpdater, auditService)
+	votingDatabase := votings.NewVotingDatabase(db)
+	votingService := votings.NewVotingService(votingDatabase, broker, auditService, noteService, clock)
 	columnDatabase := columns.NewColumnsDatabase(db)
-	columnService := columns.NewColumnService(columnDatabase, broker, noteService, boardLastModifiedUpdater)
+	columnService := columns.NewColumnService(columnDatabase, broker, noteService, boardLastModifiedUpdater, auditService)
 	sessionDatabase := sessions.NewSessionDatabase(db)
-	sessionService := sessions.NewSessionService(sessionDatabase, broker, columnService, noteService)
+	sessionService := sessions.NewSessionService(sessionDatabase, broker, columnService, noteService, auditService)
 	wsService := websocket.NewWebSocketUpgrader()
 	ws := sessionrequests.NewSessionRequestWebsocket(wsService, broker)
 	sessionRequestDatabase := sessionrequests.NewSessionRequestDatabase(db)
 	sessionRequestService := sessionrequests.NewSessionRequestService(sessionRequestDatabase, broker, ws, sessionService)
 	userDatabase := users.NewUserDatabase(db)
-	userService := users.NewUserService(userDatabase, broker, sessionService, noteService)
-	suite.service = NewBoardService(database, broker, sessionRequestService, sessionService, columnService, noteService, reactionService, votingService, userService, clock, generatedHash)
+	teamDatabase := teams.NewTeamDatabase(db)
+	teamService := teams.NewTeamService(teamDatabase)
+	boardTemplateService := boardtemplates.NewBoardTemplateService(boardtemplates.NewBoardTemplateDatabase(db), columntemplates.NewColumnTemplateService(columntemplates.NewColumnTemplateDatabase(db)), teamService)
+	actionItemDatabase := actionitems.NewActionItemDatabase(db)
+	actionItemService := actionitems.NewActionItemService(actionItemDatabase, broker, sessionService, boardLastModifiedUpdater)
+	estimationDatabase := estimations.NewEstimationDatabase(db)
+	estimationService := estimations.NewEstimationService(estimationDatabase, broker, noteService, boardLastModifiedUpdater)
+	apiTokenService := apitokens.NewApiTokenService(apitokens.NewApiTokenDatabase(db))
+	userService := users.NewUserService(userDatabase, broker, sessionService, noteService, votingService, reactionService, boardTemplateService, actionItemService, estimationService, teamService, apiTokenService, auditService)
+	suite.service = NewBoardService(database, broker, sessionRequestService, sessionService, columnService, noteService, reactionService, votingService, userService, actionItemService, estimationService, teamService, auditService, clock, generatedHash)
 }
 
 func (suite *BoardServiceIntegrationTestSuite) initTestData() {
@@ -556,7 +572,7 @@ func (suite *BoardServiceIntegrationTestSuite) Test_GetAll() {
 
 	userId := suite.users["Stan"].ID
 
-	boards, err := suite.service.GetBoards(ctx, userId)
+	boards, err := suite.service.GetBoards(ctx, userId, false)
 
 	assert.Nil(t, err)
 	assert.Len(t, boards, 2)
@@ -589,6 +605,7 @@ func (suite *BoardServiceIntegrationTestSuite) Test_GetFullBoard() {
 	assert.Nil(t, board.Notes)
 	assert.Nil(t, board.Votes)
 	assert.Nil(t, board.Votings)
+	assert.Nil(t, board.Estimations)
 	assert.Len(t, board.BoardSessions, 1)
 }
 
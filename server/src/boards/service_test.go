@@ -2,15 +2,20 @@ package boards
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/common"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/hash"
 	"scrumlr.io/server/role"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/users"
 
 	"github.com/stretchr/testify/mock"
@@ -38,6 +43,10 @@ type BoardServiceTestSuite struct {
 	reactionMock       *reactions.MockReactionService
 	votingMock         *votings.MockVotingService
 	userService        *users.MockUserService
+	actionItemMock     *actionitems.MockActionItemService
+	estimationMock     *estimations.MockEstimationService
+	teamMock           *teams.MockTeamService
+	auditMock          *audit.MockAuditService
 
 	broker     *realtime.Broker
 	mockBroker *realtime.MockClient
@@ -67,6 +76,10 @@ func (suite *BoardServiceTestSuite) SetupTest() {
 	suite.reactionMock = reactions.NewMockReactionService(suite.T())
 	suite.votingMock = votings.NewMockVotingService(suite.T())
 	suite.userService = users.NewMockUserService(suite.T())
+	suite.actionItemMock = actionitems.NewMockActionItemService(suite.T())
+	suite.estimationMock = estimations.NewMockEstimationService(suite.T())
+	suite.teamMock = teams.NewMockTeamService(suite.T())
+	suite.auditMock = audit.NewMockAuditService(suite.T())
 
 	suite.mockBroker = realtime.NewMockClient(suite.T())
 	suite.broker = new(realtime.Broker)
@@ -75,7 +88,7 @@ func (suite *BoardServiceTestSuite) SetupTest() {
 	suite.mockClock = timeprovider.NewMockTimeProvider(suite.T())
 	suite.mockHash = hash.NewMockHash(suite.T())
 
-	suite.service = NewBoardService(suite.mockBoardDatabase, suite.broker, suite.sessionRequestMock, suite.sessionsMock, suite.columnMock, suite.noteMock, suite.reactionMock, suite.votingMock, suite.userService, suite.mockClock, suite.mockHash)
+	suite.service = NewBoardService(suite.mockBoardDatabase, suite.broker, suite.sessionRequestMock, suite.sessionsMock, suite.columnMock, suite.noteMock, suite.reactionMock, suite.votingMock, suite.userService, suite.actionItemMock, suite.estimationMock, suite.teamMock, suite.auditMock, suite.mockClock, suite.mockHash)
 
 	suite.boardID = uuid.New()
 	suite.userID = uuid.New()
@@ -164,6 +177,147 @@ func (suite *BoardServiceTestSuite) TestCreate() {
 	suite.Nil(board.Salt)
 }
 
+func (suite *BoardServiceTestSuite) TestCreate_FollowUp() {
+	accessPolicy := Public
+	index := 0
+	previousBoardID := uuid.New()
+	previousColumnID := uuid.New()
+	columnID := uuid.New()
+	authorID := uuid.New()
+	stackID := uuid.New()
+	importedStackID := uuid.New()
+
+	suite.sessionsMock.EXPECT().ModeratorSessionExists(mock.Anything, previousBoardID, suite.userID).Return(true, nil)
+	suite.columnMock.EXPECT().Get(mock.Anything, previousBoardID, previousColumnID).Return(&columns.Column{ID: previousColumnID}, nil)
+
+	suite.mockBoardDatabase.EXPECT().CreateBoard(mock.Anything, DatabaseBoardInsert{
+		Name:         &suite.boardName,
+		AccessPolicy: accessPolicy,
+		FollowUpOf:   uuid.NullUUID{UUID: previousBoardID, Valid: true},
+	}).Return(DatabaseBoard{ID: suite.boardID, Name: &suite.boardName, AccessPolicy: accessPolicy, FollowUpOf: uuid.NullUUID{UUID: previousBoardID, Valid: true}}, nil)
+
+	suite.columnMock.EXPECT().Create(mock.Anything, mock.AnythingOfType("columns.ColumnRequest")).
+		Return(&columns.Column{ID: columnID, Name: suite.columnName, Index: index}, nil)
+	suite.sessionsMock.EXPECT().Create(mock.Anything, sessions.BoardSessionCreateRequest{Board: suite.boardID, User: suite.userID, Role: role.OwnerRole}).
+		Return(&sessions.BoardSession{UserID: suite.userID, Board: suite.boardID, Role: role.OwnerRole}, nil)
+
+	suite.actionItemMock.EXPECT().CarryOver(mock.Anything, previousBoardID, suite.boardID).Return([]*actionitems.ActionItem{}, nil)
+	suite.columnMock.EXPECT().GetAll(mock.Anything, suite.boardID).Return([]*columns.Column{{ID: columnID, Index: index}}, nil)
+	// the authors of the notes do not participate in the new board, so the copies belong to its owner
+	suite.noteMock.EXPECT().GetAll(mock.Anything, previousBoardID, []uuid.UUID{previousColumnID}).Return([]*notes.Note{
+		{ID: stackID, Author: authorID, Text: "first", Position: notes.NotePosition{Column: previousColumnID, Rank: 5}},
+		{ID: uuid.New(), Author: authorID, Text: "stacked", Position: notes.NotePosition{Column: previousColumnID, Stack: uuid.NullUUID{UUID: stackID, Valid: true}, Rank: 3}},
+		{ID: uuid.New(), Author: authorID, Text: "second", Position: notes.NotePosition{Column: previousColumnID, Rank: 2}},
+	}, nil)
+	suite.noteMock.EXPECT().Import(mock.Anything, notes.NoteImportRequest{Text: "first", Position: notes.NotePosition{Column: columnID, Rank: 1}, Board: suite.boardID, User: suite.userID}).
+		Return(&notes.Note{ID: importedStackID}, nil)
+	suite.noteMock.EXPECT().Import(mock.Anything, notes.NoteImportRequest{Text: "stacked", Position: notes.NotePosition{Column: columnID, Stack: uuid.NullUUID{UUID: importedStackID, Valid: true}, Rank: 3}, Board: suite.boardID, User: suite.userID}).
+		Return(&notes.Note{}, nil)
+	suite.noteMock.EXPECT().Import(mock.Anything, notes.NoteImportRequest{Text: "second", Position: notes.NotePosition{Column: columnID, Rank: 0}, Board: suite.boardID, User: suite.userID}).
+		Return(&notes.Note{}, nil)
+
+	board, err := suite.service.Create(context.Background(),
+		CreateBoardRequest{
+			Name:         &suite.boardName,
+			Owner:        suite.userID,
+			AccessPolicy: accessPolicy,
+			Columns:      []columns.ColumnRequest{{Name: suite.columnName, Index: &index}},
+			FollowUp:     &FollowUpRequest{Board: previousBoardID, Column: &previousColumnID},
+		})
+
+	suite.Nil(err)
+	suite.Equal(suite.boardID, board.ID)
+	suite.Equal(previousBoardID, board.FollowUpOf.UUID)
+}
+
+func (suite *BoardServiceTestSuite) TestCreate_FollowUp_CarryOverFailed() {
+	accessPolicy := Public
+	previousBoardID := uuid.New()
+
+	suite.sessionsMock.EXPECT().ModeratorSessionExists(mock.Anything, previousBoardID, suite.userID).Return(true, nil)
+
+	suite.mockBoardDatabase.EXPECT().CreateBoard(mock.Anything, DatabaseBoardInsert{
+		Name:         &suite.boardName,
+		AccessPolicy: accessPolicy,
+		FollowUpOf:   uuid.NullUUID{UUID: previousBoardID, Valid: true},
+	}).Return(DatabaseBoard{ID: suite.boardID, Name: &suite.boardName, AccessPolicy: accessPolicy, FollowUpOf: uuid.NullUUID{UUID: previousBoardID, Valid: true}}, nil)
+
+	suite.sessionsMock.EXPECT().Create(mock.Anything, sessions.BoardSessionCreateRequest{Board: suite.boardID, User: suite.userID, Role: role.OwnerRole}).
+		Return(&sessions.BoardSession{UserID: suite.userID, Board: suite.boardID, Role: role.OwnerRole}, nil)
+
+	suite.actionItemMock.EXPECT().CarryOver(mock.Anything, previousBoardID, suite.boardID).
+		Return(nil, actionitems.CreateActionItemError(actionitems.Internal, "failed to get action items", errors.New("db error")))
+
+	board, err := suite.service.Create(context.Background(),
+		CreateBoardRequest{
+			Name:         &suite.boardName,
+			Owner:        suite.userID,
+			AccessPolicy: accessPolicy,
+			FollowUp:     &FollowUpRequest{Board: previousBoardID},
+		})
+
+	suite.Nil(err)
+	suite.Equal(suite.boardID, board.ID)
+}
+
+func (suite *BoardServiceTestSuite) TestCreate_FollowUp_NotModeratorOfPreviousBoard() {
+	previousBoardID := uuid.New()
+
+	suite.sessionsMock.EXPECT().ModeratorSessionExists(mock.Anything, previousBoardID, suite.userID).Return(false, nil)
+
+	board, err := suite.service.Create(context.Background(),
+		CreateBoardRequest{
+			Name:         &suite.boardName,
+			Owner:        suite.userID,
+			AccessPolicy: Public,
+			FollowUp:     &FollowUpRequest{Board: previousBoardID},
+		})
+
+	suite.Nil(board)
+	var boardErr BoardError
+	suite.ErrorAs(err, &boardErr)
+	suite.Equal(Forbidden, boardErr.Category)
+}
+
+func (suite *BoardServiceTestSuite) TestCreate_TeamNotMember() {
+	teamID := uuid.New()
+
+	suite.teamMock.EXPECT().MemberExists(mock.Anything, teamID, suite.userID).Return(false, nil)
+
+	board, err := suite.service.Create(context.Background(),
+		CreateBoardRequest{
+			Name:         &suite.boardName,
+			Owner:        suite.userID,
+			AccessPolicy: Public,
+			Team:         &teamID,
+		})
+
+	suite.Nil(board)
+	var boardErr BoardError
+	suite.ErrorAs(err, &boardErr)
+	suite.Equal(Forbidden, boardErr.Category)
+}
+
+func (suite *BoardServiceTestSuite) TestCreate_FollowUp_CarryOverColumnWithoutColumns() {
+	previousBoardID := uuid.New()
+	previousColumnID := uuid.New()
+
+	suite.sessionsMock.EXPECT().ModeratorSessionExists(mock.Anything, previousBoardID, suite.userID).Return(true, nil)
+
+	board, err := suite.service.Create(context.Background(),
+		CreateBoardRequest{
+			Name:         &suite.boardName,
+			Owner:        suite.userID,
+			AccessPolicy: Public,
+			FollowUp:     &FollowUpRequest{Board: previousBoardID, Column: &previousColumnID},
+		})
+
+	suite.Nil(board)
+	var boardErr BoardError
+	suite.ErrorAs(err, &boardErr)
+	suite.Equal(BadRequest, boardErr.Category)
+}
+
 func (suite *BoardServiceTestSuite) TestCreate_ByPassphrase() {
 	accessPolicy := ByPassphrase
 	passPhrase := "SuperStrongPassword"
@@ -392,6 +546,61 @@ func (suite *BoardServiceTestSuite) TestDelete() {
 	suite.NoError(err)
 }
 
+func (suite *BoardServiceTestSuite) TestDeleteExpired() {
+	firstBoard := uuid.New()
+	secondBoard := uuid.New()
+	unlocked := false
+
+	suite.mockBoardDatabase.EXPECT().LockExpiredBoards(mock.Anything).Return(func() { unlocked = true }, true, nil)
+	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
+	suite.mockBoardDatabase.EXPECT().GetExpiredBoards(mock.Anything, suite.updatedAt, 30).Return([]uuid.UUID{firstBoard, secondBoard}, nil)
+	suite.mockBoardDatabase.EXPECT().DeleteBoard(mock.Anything, firstBoard).Return(nil)
+	suite.mockBoardDatabase.EXPECT().DeleteBoard(mock.Anything, secondBoard).Return(errors.New("failed"))
+
+	expectedEvent := realtime.BoardEvent{Type: realtime.BoardEventBoardDeleted}
+	suite.mockBroker.EXPECT().Publish(mock.Anything, fmt.Sprintf("board.%s", firstBoard), expectedEvent).Return(nil).Once()
+
+	deleted, err := suite.service.DeleteExpired(context.Background(), 30)
+
+	suite.Error(err)
+	suite.Equal(1, deleted)
+	suite.True(unlocked)
+}
+
+func (suite *BoardServiceTestSuite) TestDeleteExpired_LockedByOtherInstance() {
+	suite.mockBoardDatabase.EXPECT().LockExpiredBoards(mock.Anything).Return(nil, false, nil)
+
+	deleted, err := suite.service.DeleteExpired(context.Background(), 30)
+
+	suite.NoError(err)
+	suite.Equal(0, deleted)
+	suite.mockBoardDatabase.AssertNotCalled(suite.T(), "GetExpiredBoards", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *BoardServiceTestSuite) TestDeleteExpired_LockError() {
+	suite.mockBoardDatabase.EXPECT().LockExpiredBoards(mock.Anything).Return(nil, false, errors.New("failed"))
+
+	deleted, err := suite.service.DeleteExpired(context.Background(), 30)
+
+	suite.Equal(0, deleted)
+	var boardErr BoardError
+	suite.ErrorAs(err, &boardErr)
+	suite.Equal(Internal, boardErr.Category)
+}
+
+func (suite *BoardServiceTestSuite) TestDeleteExpired_DatabaseError() {
+	suite.mockBoardDatabase.EXPECT().LockExpiredBoards(mock.Anything).Return(func() {}, true, nil)
+	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
+	suite.mockBoardDatabase.EXPECT().GetExpiredBoards(mock.Anything, suite.updatedAt, 0).Return(nil, errors.New("failed"))
+
+	deleted, err := suite.service.DeleteExpired(context.Background(), 0)
+
+	suite.Equal(0, deleted)
+	var boardErr BoardError
+	suite.ErrorAs(err, &boardErr)
+	suite.Equal(Internal, boardErr.Category)
+}
+
 func (suite *BoardServiceTestSuite) TestUpdate() {
 
 	updatedName := "Updated Board Name"
@@ -411,6 +620,9 @@ func (suite *BoardServiceTestSuite) TestUpdate() {
 	suite.mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
 	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
 
+	suite.expectGetBoardBeforeUpdate()
+	suite.auditMock.EXPECT().Record(mock.Anything, suite.boardID, audit.BoardUpdated, boardSettingsAudit{}, boardSettingsAudit{Name: updatedName}).Return()
+
 	board, err := suite.service.Update(context.Background(), BoardUpdateRequest{ID: suite.boardID, Name: &updatedName})
 
 	suite.Nil(err)
@@ -418,6 +630,69 @@ func (suite *BoardServiceTestSuite) TestUpdate() {
 	suite.Equal(updatedName, *board.Name)
 }
 
+func (suite *BoardServiceTestSuite) TestUpdate_BoardNotFound() {
+	suite.mockBoardDatabase.EXPECT().GetBoard(mock.Anything, suite.boardID).Return(DatabaseBoard{}, sql.ErrNoRows)
+
+	board, err := suite.service.Update(context.Background(), BoardUpdateRequest{ID: suite.boardID, Name: new("Updated Board Name")})
+
+	suite.Nil(board)
+	var boardErr BoardError
+	suite.ErrorAs(err, &boardErr)
+	suite.Equal(NotFound, boardErr.Category)
+}
+
+func (suite *BoardServiceTestSuite) expectGetBoardBeforeUpdate() {
+	suite.mockBoardDatabase.EXPECT().GetBoard(mock.Anything, suite.boardID).Return(DatabaseBoard{ID: suite.boardID}, nil)
+}
+
+func (suite *BoardServiceTestSuite) TestArchive() {
+	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
+	suite.mockBoardDatabase.EXPECT().SetArchived(mock.Anything, suite.boardID, &suite.updatedAt).
+		Return(DatabaseBoard{ID: suite.boardID, ArchivedAt: &suite.updatedAt}, nil)
+
+	suite.columnMock.EXPECT().GetAll(mock.Anything, suite.boardID).
+		Return([]*columns.Column{}, nil)
+	suite.noteMock.EXPECT().GetAll(mock.Anything, suite.boardID).
+		Return([]*notes.Note{}, nil)
+	suite.mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	board, err := suite.service.Archive(context.Background(), suite.boardID)
+
+	suite.Nil(err)
+	suite.Equal(suite.boardID, board.ID)
+	suite.Equal(suite.updatedAt, *board.ArchivedAt)
+}
+
+func (suite *BoardServiceTestSuite) TestArchive_DatabaseError() {
+	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
+	suite.mockBoardDatabase.EXPECT().SetArchived(mock.Anything, suite.boardID, &suite.updatedAt).
+		Return(DatabaseBoard{}, errors.New("failed"))
+
+	board, err := suite.service.Archive(context.Background(), suite.boardID)
+
+	suite.Nil(board)
+	var boardErr BoardError
+	suite.ErrorAs(err, &boardErr)
+	suite.Equal(Internal, boardErr.Category)
+}
+
+func (suite *BoardServiceTestSuite) TestUnarchive() {
+	suite.mockBoardDatabase.EXPECT().SetArchived(mock.Anything, suite.boardID, (*time.Time)(nil)).
+		Return(DatabaseBoard{ID: suite.boardID}, nil)
+
+	suite.columnMock.EXPECT().GetAll(mock.Anything, suite.boardID).
+		Return([]*columns.Column{}, nil)
+	suite.noteMock.EXPECT().GetAll(mock.Anything, suite.boardID).
+		Return([]*notes.Note{}, nil)
+	suite.mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	board, err := suite.service.Unarchive(context.Background(), suite.boardID)
+
+	suite.Nil(err)
+	suite.Equal(suite.boardID, board.ID)
+	suite.Nil(board.ArchivedAt)
+}
+
 func (suite *BoardServiceTestSuite) TestUpdate_EmptyName() {
 
 	board, err := suite.service.Update(context.Background(), BoardUpdateRequest{ID: suite.boardID, Name: new("")})
@@ -430,6 +705,39 @@ func (suite *BoardServiceTestSuite) TestUpdate_EmptyName() {
 	suite.Equal(boardErr.Message, "name cannot be empty")
 }
 
+func (suite *BoardServiceTestSuite) TestUpdate_KeepForever() {
+	suite.mockBoardDatabase.EXPECT().UpdateBoard(mock.Anything, DatabaseBoardUpdate{ID: suite.boardID, RetentionDays: &sql.NullInt32{Int32: 0, Valid: true}}).
+		Return(DatabaseBoard{ID: suite.boardID, RetentionDays: new(0)}, nil)
+	suite.mockBoardDatabase.EXPECT().UpdateBoard(mock.Anything, mock.MatchedBy(func(update DatabaseBoardUpdate) bool {
+		return update.ID == suite.boardID && update.LastModifiedAt.Equal(suite.updatedAt)
+	})).Return(DatabaseBoard{ID: suite.boardID}, nil)
+
+	suite.columnMock.EXPECT().GetAll(mock.Anything, suite.boardID).
+		Return([]*columns.Column{}, nil)
+	suite.noteMock.EXPECT().GetAll(mock.Anything, suite.boardID).
+		Return([]*notes.Note{}, nil)
+
+	suite.mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
+
+	// the retention days did not change, so nothing is recorded in the audit log
+	suite.expectGetBoardBeforeUpdate()
+
+	board, err := suite.service.Update(context.Background(), BoardUpdateRequest{ID: suite.boardID, RetentionDays: new(0)})
+
+	suite.Nil(err)
+	suite.Equal(0, *board.RetentionDays)
+}
+
+func (suite *BoardServiceTestSuite) TestUpdate_NegativeRetentionDays() {
+	board, err := suite.service.Update(context.Background(), BoardUpdateRequest{ID: suite.boardID, RetentionDays: new(-1)})
+
+	suite.Nil(board)
+	var boardErr BoardError
+	suite.ErrorAs(err, &boardErr)
+	suite.Equal(BadRequest, boardErr.Category)
+}
+
 func (suite *BoardServiceTestSuite) TestUpdate_ToPassphrase() {
 
 	updatedName := "Updated Board Name"
@@ -454,6 +762,9 @@ func (suite *BoardServiceTestSuite) TestUpdate_ToPassphrase() {
 	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
 	suite.mockHash.EXPECT().HashWithSalt(passphrase).Return(&passphrase, &salt, nil)
 
+	suite.expectGetBoardBeforeUpdate()
+	suite.auditMock.EXPECT().Record(mock.Anything, suite.boardID, audit.BoardUpdated, mock.Anything, mock.Anything).Return()
+
 	board, err := suite.service.Update(context.Background(), BoardUpdateRequest{ID: suite.boardID, Name: &updatedName, AccessPolicy: &accessPolicy, Passphrase: &passphrase})
 
 	suite.Nil(err)
@@ -497,6 +808,9 @@ func (suite *BoardServiceTestSuite) TestUpdate_ToPublic() {
 
 	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
 
+	suite.expectGetBoardBeforeUpdate()
+	suite.auditMock.EXPECT().Record(mock.Anything, suite.boardID, audit.BoardUpdated, mock.Anything, mock.Anything).Return()
+
 	board, err := suite.service.Update(context.Background(), BoardUpdateRequest{ID: suite.boardID, Name: &updatedName, AccessPolicy: &accessPolicy})
 
 	suite.Nil(err)
@@ -533,6 +847,9 @@ func (suite *BoardServiceTestSuite) TestUpdate_ToInvite() {
 	suite.mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
 	suite.mockClock.EXPECT().Now().Return(suite.updatedAt)
 
+	suite.expectGetBoardBeforeUpdate()
+	suite.auditMock.EXPECT().Record(mock.Anything, suite.boardID, audit.BoardUpdated, mock.Anything, mock.Anything).Return()
+
 	board, err := suite.service.Update(context.Background(), BoardUpdateRequest{ID: suite.boardID, Name: &updatedName, AccessPolicy: &accessPolicy})
 
 	suite.Nil(err)
@@ -572,6 +889,25 @@ func (suite *BoardServiceTestSuite) TestSetTimer() {
 	suite.Equal(suite.boardID, result.ID)
 }
 
+func (suite *BoardServiceTestSuite) TestSetTimerUntil() {
+
+	timerStart := time.Now().Local()
+	timerEnd := timerStart.Add(90 * time.Second)
+
+	suite.mockBoardDatabase.EXPECT().UpdateBoardTimer(mock.Anything, DatabaseBoardTimerUpdate{ID: suite.boardID, TimerStart: &timerStart, TimerEnd: &timerEnd}).
+		Return(DatabaseBoard{ID: suite.boardID, TimerStart: &timerStart, TimerEnd: &timerEnd}, nil)
+
+	suite.mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	suite.mockClock.EXPECT().Now().Return(timerStart)
+
+	result, err := suite.service.SetTimerUntil(context.Background(), suite.boardID, timerEnd)
+
+	suite.NoError(err)
+	suite.NotNil(result)
+	suite.Equal(&timerEnd, result.TimerEnd)
+}
+
 func (suite *BoardServiceTestSuite) TestDeleteTimer() {
 
 	suite.mockBoardDatabase.EXPECT().UpdateBoardTimer(mock.Anything, DatabaseBoardTimerUpdate{ID: suite.boardID, TimerStart: nil, TimerEnd: nil}).
@@ -43,12 +43,14 @@ func (db *DB) Get(ctx context.Context, id uuid.UUID) (DatabaseBoardTemplate, err
 	return tBoard, err
 }
 
+// GetAll gets all templates created by the user or shared with one of the teams of the user
 func (db *DB) GetAll(ctx context.Context, user uuid.UUID) ([]DatabaseBoardTemplateFull, error) {
 	var tBoards []DatabaseBoardTemplate
 
 	err := db.db.NewSelect().
 		Model(&tBoards).
 		Where("creator = ?", user).
+		WhereOr("team IN (?)", db.db.NewSelect().Table("team_members").Column("team").Where("\"user\" = ?", user)).
 		Order("created_at ASC").
 		Scan(ctx)
 
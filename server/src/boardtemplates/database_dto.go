@@ -15,6 +15,7 @@ type DatabaseBoardTemplate struct {
 	Name          *string
 	Description   *string
 	Favourite     *bool
+	Team          uuid.NullUUID
 	CreatedAt     time.Time
 }
 
@@ -30,6 +31,7 @@ type DatabaseBoardTemplateInsert struct {
 	Name          *string
 	Description   *string
 	Favourite     *bool
+	Team          uuid.NullUUID
 }
 
 type DatabaseBoardTemplateUpdate struct {
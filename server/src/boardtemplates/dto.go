@@ -20,6 +20,9 @@ type BoardTemplate struct {
 
 	// The favourite status of the template
 	Favourite *bool `json:"favourite"`
+
+	// The team sharing this template with all of its members
+	Team uuid.NullUUID `json:"team"`
 }
 
 func (bt *BoardTemplate) From(board DatabaseBoardTemplate) *BoardTemplate {
@@ -28,6 +31,7 @@ func (bt *BoardTemplate) From(board DatabaseBoardTemplate) *BoardTemplate {
 	bt.Name = board.Name
 	bt.Description = board.Description
 	bt.Favourite = board.Favourite
+	bt.Team = board.Team
 
 	return bt
 }
@@ -61,6 +65,9 @@ type CreateBoardTemplateRequest struct {
 	// The favourite status of the template
 	Favourite *bool `json:"favourite"`
 
+	// The team to share the template with, the creator must be a member of that team
+	Team *uuid.UUID `json:"team"`
+
 	// The column templates to create for the board template.
 	Columns []*columntemplates.ColumnTemplateRequest `json:"columnTemplates"`
 }
@@ -5,8 +5,9 @@ import "fmt"
 type BoardTemplateErrorCategory string
 
 const (
-	Internal BoardTemplateErrorCategory = "INTERNAL"
-	NotFound BoardTemplateErrorCategory = "NOT_FOUND"
+	Internal  BoardTemplateErrorCategory = "INTERNAL"
+	NotFound  BoardTemplateErrorCategory = "NOT_FOUND"
+	Forbidden BoardTemplateErrorCategory = "FORBIDDEN"
 )
 
 type BoardTemplateError struct {
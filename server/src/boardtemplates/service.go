@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"scrumlr.io/server/columntemplates"
 	"scrumlr.io/server/logger"
+	"scrumlr.io/server/teams"
 )
 
 var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/boardtemplates")
@@ -29,12 +30,14 @@ type BoardTemplateDatabase interface {
 type Service struct {
 	database              BoardTemplateDatabase
 	columnTemplateService columntemplates.ColumnTemplateService
+	teamService           teams.TeamService
 }
 
-func NewBoardTemplateService(db BoardTemplateDatabase, columnTempalteService columntemplates.ColumnTemplateService) BoardTemplateService {
+func NewBoardTemplateService(db BoardTemplateDatabase, columnTempalteService columntemplates.ColumnTemplateService, teamService teams.TeamService) BoardTemplateService {
 	service := new(Service)
 	service.database = db
 	service.columnTemplateService = columnTempalteService
+	service.teamService = teamService
 
 	return service
 }
@@ -57,6 +60,23 @@ func (service *Service) Create(ctx context.Context, body CreateBoardTemplateRequ
 		attribute.Int("scrumlr.board_templates.service.create.columns.count", len(body.Columns)),
 	)
 
+	if body.Team != nil {
+		isMember, err := service.teamService.MemberExists(ctx, *body.Team, body.Creator)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to check team membership")
+			span.RecordError(err)
+			log.Errorw("unable to check team membership", "team", *body.Team, "creator", body.Creator, "err", err)
+			return nil, CreateBoardTemplateError(Internal, "failed to check team membership", err)
+		}
+		if !isMember {
+			err := CreateBoardTemplateError(Forbidden, "only members of the team can create templates for it", errors.New("only members of the team can create templates for it"))
+			span.SetStatus(codes.Error, "not a team member")
+			span.RecordError(err)
+			return nil, err
+		}
+		board.Team = uuid.NullUUID{UUID: *body.Team, Valid: true}
+	}
+
 	// create the board template
 	b, err := service.database.Create(ctx, board)
 	if err != nil {
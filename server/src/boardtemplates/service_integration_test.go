@@ -13,6 +13,7 @@ import (
 	"scrumlr.io/server/columntemplates"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/initialize/testDbTemplates"
+	"scrumlr.io/server/teams"
 )
 
 type BoardTemplateServiceIntegrationTestSuite struct {
@@ -43,7 +44,9 @@ func (suite *BoardTemplateServiceIntegrationTestSuite) SetupTest() {
 	columnTemplateDatabase := columntemplates.NewColumnTemplateDatabase(db)
 	columnTemplateService := columntemplates.NewColumnTemplateService(columnTemplateDatabase)
 	database := NewBoardTemplateDatabase(db)
-	suite.service = NewBoardTemplateService(database, columnTemplateService)
+	teamDatabase := teams.NewTeamDatabase(db)
+	teamService := teams.NewTeamService(teamDatabase)
+	suite.service = NewBoardTemplateService(database, columnTemplateService, teamService)
 }
 
 func (suite *BoardTemplateServiceIntegrationTestSuite) initTestData() {
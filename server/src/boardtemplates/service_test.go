@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"scrumlr.io/server/columntemplates"
+	"scrumlr.io/server/teams"
 )
 
 func TestCreateBoardTemplate(t *testing.T) {
@@ -52,7 +53,7 @@ func TestCreateBoardTemplate(t *testing.T) {
 		Index:         firstColumnIndex,
 	}, nil)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	board, err := boardTemplateService.Create(context.Background(), CreateBoardTemplateRequest{
 		Creator:     userId,
@@ -97,7 +98,7 @@ func TestCreateBoardTemplate_DatabaseError(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	board, err := boardTemplateService.Create(context.Background(), CreateBoardTemplateRequest{
 		Creator:     userId,
@@ -124,6 +125,30 @@ func TestCreateBoardTemplate_DatabaseError(t *testing.T) {
 	assert.ErrorIs(t, err, dbError)
 }
 
+func TestCreateBoardTemplate_TeamNotMember(t *testing.T) {
+	userId := uuid.New()
+	teamId := uuid.New()
+	name := "Template"
+
+	mockBoardTemplateDatabase := NewMockBoardTemplateDatabase(t)
+	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
+	mockTeamService := teams.NewMockTeamService(t)
+	mockTeamService.EXPECT().MemberExists(mock.Anything, teamId, userId).Return(false, nil)
+
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, mockTeamService)
+
+	board, err := boardTemplateService.Create(context.Background(), CreateBoardTemplateRequest{
+		Creator: userId,
+		Name:    &name,
+		Team:    &teamId,
+	})
+
+	assert.Nil(t, board)
+	var templateErr BoardTemplateError
+	assert.ErrorAs(t, err, &templateErr)
+	assert.Equal(t, Forbidden, templateErr.Category)
+}
+
 func TestGetBoardTemplate(t *testing.T) {
 	boardId := uuid.New()
 	userId := uuid.New()
@@ -141,7 +166,7 @@ func TestGetBoardTemplate(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	board, err := boardTemplateService.Get(context.Background(), boardId)
 
@@ -164,7 +189,7 @@ func TestGetBoardTemplate_DatabaseError(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	board, err := boardTemplateService.Get(context.Background(), id)
 
@@ -217,7 +242,7 @@ func TestGetAllBoardTemplate(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	boards, err := boardTemplateService.GetAll(context.Background(), userId)
 
@@ -250,7 +275,7 @@ func TestGetAllBoardTemplate_DatabaseError(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	board, err := boardTemplateService.GetAll(context.Background(), userId)
 
@@ -280,7 +305,7 @@ func TestUpdateBoardTemplate(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	board, err := boardTemplateService.Update(context.Background(), BoardTemplateUpdateRequest{
 		ID:          boardId,
@@ -308,7 +333,7 @@ func TestUpdateBoardTemplate_DatabaseError(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	board, err := boardTemplateService.Update(context.Background(), BoardTemplateUpdateRequest{
 		ID:          boardId,
@@ -329,7 +354,7 @@ func TestDeleteBoardTemplate(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	err := boardTemplateService.Delete(context.Background(), id)
 
@@ -345,7 +370,7 @@ func TestDeleteBoardTemplate_DatabaseError(t *testing.T) {
 
 	mockColumnTemplateService := columntemplates.NewMockColumnTemplateService(t)
 
-	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService)
+	boardTemplateService := NewBoardTemplateService(mockBoardTemplateDatabase, mockColumnTemplateService, teams.NewMockTeamService(t))
 
 	err := boardTemplateService.Delete(context.Background(), id)
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/uptrace/bun"
 	"github.com/urfave/cli/v2"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -18,17 +19,48 @@ type Client interface {
 	Put(ctx context.Context, key string, value any) error
 	Get(ctx context.Context, key string) ([]byte, error)
 	Delete(ctx context.Context, key string) error
+
+	// Increment atomically increments the counter stored at key and returns the new value.
+	// A missing or expired counter starts at 0. The counter expires once it was not incremented
+	// for ttl, a ttl of 0 keeps it until it gets deleted.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+
+	// GetCounter returns the value of the counter stored at key.
+	// A missing counter is returned as 0.
+	GetCounter(ctx context.Context, key string) (int64, error)
+
+	// Append adds the value to the end of the list stored at key and drops the oldest
+	// entries, so that at most maxLength entries remain. The list expires once nothing was
+	// appended for ttl, entries older than ttl may be dropped before. A ttl of 0 keeps the
+	// list until it gets deleted.
+	Append(ctx context.Context, key string, value any, maxLength int, ttl time.Duration) error
+
+	// GetList returns all entries of the list stored at key, oldest first.
+	// A missing list is returned as an empty list.
+	GetList(ctx context.Context, key string) ([][]byte, error)
 }
 
 type Cache struct {
 	Con Client
 }
 
-func InitializeCache(ctx *cli.Context) (*Cache, error) {
+func InitializeCache(ctx *cli.Context, db *bun.DB) (*Cache, error) {
 	log := logger.FromContext(ctx.Context)
 
 	var cache *Cache
 
+	if ctx.Bool("in-memory") {
+		log.Info("Using in-memory cache")
+
+		return NewMemory(), nil
+	}
+
+	if ctx.Bool("postgres-broker") {
+		log.Info("Using postgres as cache")
+
+		return NewPostgres(db), nil
+	}
+
 	if ctx.String("redis-address") != "" {
 		redis := RedisServer{
 			Addr:     ctx.String("redis-address"),
@@ -23,7 +23,7 @@ func TestInitializeCache_Nats(t *testing.T) {
 	err := init.Set("nats", connection)
 	assert.NoError(t, err)
 
-	cache, err := InitializeCache(init)
+	cache, err := InitializeCache(init, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, cache)
@@ -45,7 +45,7 @@ func TestInitializeCache_Redis(t *testing.T) {
 	err := init.Set("redis-address", connection)
 	assert.NoError(t, err)
 
-	cache, err := InitializeCache(init)
+	cache, err := InitializeCache(init, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, cache)
@@ -62,7 +62,7 @@ func TestInitializeCache_NotConfigured(t *testing.T) {
 	flagset.String("nats", "", "")
 	init := cli.NewContext(nil, flagset, nil)
 
-	cache, err := InitializeCache(init)
+	cache, err := InitializeCache(init, nil)
 
 	assert.Error(t, err)
 	assert.Equal(t, errors.New("no valid cache configuration found"), err)
@@ -84,7 +84,7 @@ func TestInitializeCache_PrefereRedis(t *testing.T) {
 	err = init.Set("nats", "not valide connection")
 	assert.NoError(t, err)
 
-	cache, err := InitializeCache(init)
+	cache, err := InitializeCache(init, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, cache)
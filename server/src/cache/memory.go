@@ -0,0 +1,284 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/logger"
+)
+
+var (
+	errMemoryKeyNotFound = errors.New("key not found")
+	errMemoryKeyExists   = errors.New("key exists")
+)
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func (e memoryEntry) expired(now time.Time) bool {
+	return expired(e.expiresAt, now)
+}
+
+type memoryCounter struct {
+	value     int64
+	expiresAt time.Time
+}
+
+type memoryList struct {
+	entries   [][]byte
+	expiresAt time.Time
+}
+
+type memoryClient struct {
+	mu       sync.Mutex
+	entries  map[string]memoryEntry
+	counters map[string]memoryCounter
+	lists    map[string]memoryList
+}
+
+// NewMemory returns a Cache that keeps all entries within the process.
+// It is only suitable if a single server instance is running.
+func NewMemory() *Cache {
+	return &Cache{
+		Con: &memoryClient{
+			entries:  make(map[string]memoryEntry),
+			counters: make(map[string]memoryCounter),
+			lists:    make(map[string]memoryList),
+		},
+	}
+}
+
+// Create the entry with the given value
+// If the key exists return an error
+func (m *memoryClient) Create(ctx context.Context, key string, value any, ttl time.Duration) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.memory.create")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.memory.create.key", key),
+	)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal value")
+		span.RecordError(err)
+		log.Errorw("unable to marshal value in create", "key", key, "value", value, "err", err)
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.removeExpired(now)
+
+	if _, exists := m.entries[key]; exists {
+		return &KeyAlreadyExists{errMemoryKeyExists}
+	}
+
+	m.entries[key] = memoryEntry{value: data, expiresAt: expiresAt(now, ttl)}
+
+	return nil
+}
+
+func (m *memoryClient) Put(ctx context.Context, key string, value any) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.memory.put")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.memory.put.key", key),
+	)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal value")
+		span.RecordError(err)
+		log.Errorw("unable to marshal value in put", "key", key, "value", value, "err", err)
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, exists := m.entries[key]
+	if !exists || entry.expired(time.Now()) {
+		entry = memoryEntry{}
+	}
+	entry.value = data
+	m.entries[key] = entry
+
+	return nil
+}
+
+func (m *memoryClient) Get(ctx context.Context, key string) ([]byte, error) {
+	_, span := tracer.Start(ctx, "scrumlr.cache.memory.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.memory.get.key", key),
+	)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, exists := m.entries[key]
+	if !exists || entry.expired(time.Now()) {
+		span.SetStatus(codes.Ok, "key does not exists")
+		return nil, &KeyNotFound{errMemoryKeyNotFound}
+	}
+
+	return entry.value, nil
+}
+
+func (m *memoryClient) Delete(ctx context.Context, key string) error {
+	_, span := tracer.Start(ctx, "scrumlr.cache.memory.delete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.memory.delete.key", key),
+	)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+	delete(m.counters, key)
+	delete(m.lists, key)
+
+	return nil
+}
+
+func (m *memoryClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	_, span := tracer.Start(ctx, "scrumlr.cache.memory.increment")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.memory.increment.key", key),
+	)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.removeExpired(now)
+
+	counter := m.counters[key]
+	counter.value++
+	counter.expiresAt = expiresAt(now, ttl)
+	m.counters[key] = counter
+
+	return counter.value, nil
+}
+
+func (m *memoryClient) GetCounter(ctx context.Context, key string) (int64, error) {
+	_, span := tracer.Start(ctx, "scrumlr.cache.memory.get_counter")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.memory.get_counter.key", key),
+	)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	counter, exists := m.counters[key]
+	if !exists || expired(counter.expiresAt, time.Now()) {
+		return 0, nil
+	}
+
+	return counter.value, nil
+}
+
+func (m *memoryClient) Append(ctx context.Context, key string, value any, maxLength int, ttl time.Duration) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.memory.append")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.memory.append.key", key),
+	)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal value")
+		span.RecordError(err)
+		log.Errorw("unable to marshal value in append", "key", key, "value", value, "err", err)
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.removeExpired(now)
+
+	list := m.lists[key]
+	list.entries = append(list.entries, data)
+	if len(list.entries) > maxLength {
+		list.entries = append([][]byte(nil), list.entries[len(list.entries)-maxLength:]...)
+	}
+	list.expiresAt = expiresAt(now, ttl)
+	m.lists[key] = list
+
+	return nil
+}
+
+func (m *memoryClient) GetList(ctx context.Context, key string) ([][]byte, error) {
+	_, span := tracer.Start(ctx, "scrumlr.cache.memory.get_list")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.memory.get_list.key", key),
+	)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	list, exists := m.lists[key]
+	if !exists || expired(list.expiresAt, time.Now()) {
+		return [][]byte{}, nil
+	}
+
+	return append([][]byte{}, list.entries...), nil
+}
+
+// removeExpired drops all expired entries, counters and lists, so that keys which are never read again do not pile up.
+// The caller must hold the lock.
+func (m *memoryClient) removeExpired(now time.Time) {
+	for key, entry := range m.entries {
+		if entry.expired(now) {
+			delete(m.entries, key)
+		}
+	}
+	for key, counter := range m.counters {
+		if expired(counter.expiresAt, now) {
+			delete(m.counters, key)
+		}
+	}
+	for key, list := range m.lists {
+		if expired(list.expiresAt, now) {
+			delete(m.lists, key)
+		}
+	}
+}
+
+// expiresAt returns the expiry of a value written at now, which is zero if the value does not expire
+func expiresAt(now time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+func expired(expiresAt, now time.Time) bool {
+	return !expiresAt.IsZero() && !now.Before(expiresAt)
+}
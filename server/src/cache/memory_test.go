@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryCreate(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	err := cache.Con.Create(ctx, key, "test", time.Second)
+	assert.Nil(t, err)
+
+	ret, err := cache.Con.Get(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`"test"`), ret)
+}
+
+func TestMemoryCreateAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	err := cache.Con.Create(ctx, key, "test", 0)
+	assert.Nil(t, err)
+
+	err = cache.Con.Create(ctx, key, "this should not work", 0)
+	var keyExists *KeyAlreadyExists
+	assert.ErrorAs(t, err, &keyExists)
+}
+
+func TestMemoryCreateAfterExpiry(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	err := cache.Con.Create(ctx, key, "test", time.Millisecond)
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	_, err = cache.Con.Get(ctx, key)
+	var keyNotFound *KeyNotFound
+	assert.ErrorAs(t, err, &keyNotFound)
+
+	err = cache.Con.Create(ctx, key, "again", time.Second)
+	assert.Nil(t, err)
+}
+
+func TestMemoryPut(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	err := cache.Con.Put(ctx, key, "put")
+	assert.Nil(t, err)
+	err = cache.Con.Put(ctx, key, "override")
+	assert.Nil(t, err)
+
+	ret, err := cache.Con.Get(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`"override"`), ret)
+}
+
+func TestMemoryDelete(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	err := cache.Con.Create(ctx, key, "test", 0)
+	assert.Nil(t, err)
+	err = cache.Con.Append(ctx, key, "test", 10, 0)
+	assert.Nil(t, err)
+
+	err = cache.Con.Delete(ctx, key)
+	assert.Nil(t, err)
+
+	_, err = cache.Con.Get(ctx, key)
+	var keyNotFound *KeyNotFound
+	assert.ErrorAs(t, err, &keyNotFound)
+
+	list, err := cache.Con.GetList(ctx, key)
+	assert.Nil(t, err)
+	assert.Empty(t, list)
+}
+
+func TestMemoryIncrement(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	first, err := cache.Con.Increment(ctx, key, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), first)
+
+	second, err := cache.Con.Increment(ctx, key, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), second)
+
+	counter, err := cache.Con.GetCounter(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), counter)
+}
+
+func TestMemoryGetCounterNotFound(t *testing.T) {
+	cache := NewMemory()
+
+	counter, err := cache.Con.GetCounter(context.Background(), uuid.New().String())
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), counter)
+}
+
+func TestMemoryAppend(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	for _, value := range []string{"first", "second", "third"} {
+		err := cache.Con.Append(ctx, key, value, 2, 0)
+		assert.Nil(t, err)
+	}
+
+	list, err := cache.Con.GetList(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(`"second"`), []byte(`"third"`)}, list)
+}
+
+func TestMemoryIncrementAfterExpiry(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	_, err := cache.Con.Increment(ctx, key, time.Millisecond)
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	counter, err := cache.Con.Increment(ctx, key, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), counter)
+}
+
+func TestMemoryAppendAfterExpiry(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	err := cache.Con.Append(ctx, key, "expired", 10, time.Millisecond)
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	list, err := cache.Con.GetList(ctx, key)
+	assert.Nil(t, err)
+	assert.Empty(t, list)
+
+	err = cache.Con.Append(ctx, key, "fresh", 10, time.Second)
+	assert.Nil(t, err)
+
+	list, err = cache.Con.GetList(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(`"fresh"`)}, list)
+}
+
+func TestMemoryAppendRefreshesExpiry(t *testing.T) {
+	ctx := context.Background()
+	key := uuid.New().String()
+	cache := NewMemory()
+
+	err := cache.Con.Append(ctx, key, "first", 10, 50*time.Millisecond)
+	assert.Nil(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+
+	err = cache.Con.Append(ctx, key, "second", 10, 50*time.Millisecond)
+	assert.Nil(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+
+	list, err := cache.Con.GetList(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(`"first"`), []byte(`"second"`)}, list)
+}
@@ -38,6 +38,81 @@ func (_m *MockClient) EXPECT() *MockClient_Expecter {
 	return &MockClient_Expecter{mock: &_m.Mock}
 }
 
+// Append provides a mock function for the type MockClient
+func (_mock *MockClient) Append(ctx context.Context, key string, value any, maxLength int, ttl time.Duration) error {
+	ret := _mock.Called(ctx, key, value, maxLength, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, any, int, time.Duration) error); ok {
+		r0 = returnFunc(ctx, key, value, maxLength, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_Append_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Append'
+type MockClient_Append_Call struct {
+	*mock.Call
+}
+
+// Append is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value any
+//   - maxLength int
+//   - ttl time.Duration
+func (_e *MockClient_Expecter) Append(ctx any, key any, value any, maxLength any, ttl any) *MockClient_Append_Call {
+	return &MockClient_Append_Call{Call: _e.mock.On("Append", ctx, key, value, maxLength, ttl)}
+}
+
+func (_c *MockClient_Append_Call) Run(run func(ctx context.Context, key string, value any, maxLength int, ttl time.Duration)) *MockClient_Append_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 any
+		if args[2] != nil {
+			arg2 = args[2].(any)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 time.Duration
+		if args[4] != nil {
+			arg4 = args[4].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_Append_Call) Return(err error) *MockClient_Append_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_Append_Call) RunAndReturn(run func(ctx context.Context, key string, value any, maxLength int, ttl time.Duration) error) *MockClient_Append_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockClient
 func (_mock *MockClient) Create(ctx context.Context, key string, value any, ttl time.Duration) error {
 	ret := _mock.Called(ctx, key, value, ttl)
@@ -232,6 +307,212 @@ func (_c *MockClient_Get_Call) RunAndReturn(run func(ctx context.Context, key st
 	return _c
 }
 
+// GetCounter provides a mock function for the type MockClient
+func (_mock *MockClient) GetCounter(ctx context.Context, key string) (int64, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCounter")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetCounter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCounter'
+type MockClient_GetCounter_Call struct {
+	*mock.Call
+}
+
+// GetCounter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockClient_Expecter) GetCounter(ctx any, key any) *MockClient_GetCounter_Call {
+	return &MockClient_GetCounter_Call{Call: _e.mock.On("GetCounter", ctx, key)}
+}
+
+func (_c *MockClient_GetCounter_Call) Run(run func(ctx context.Context, key string)) *MockClient_GetCounter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GetCounter_Call) Return(n int64, err error) *MockClient_GetCounter_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockClient_GetCounter_Call) RunAndReturn(run func(ctx context.Context, key string) (int64, error)) *MockClient_GetCounter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetList provides a mock function for the type MockClient
+func (_mock *MockClient) GetList(ctx context.Context, key string) ([][]byte, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetList")
+	}
+
+	var r0 [][]byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([][]byte, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) [][]byte); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetList'
+type MockClient_GetList_Call struct {
+	*mock.Call
+}
+
+// GetList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockClient_Expecter) GetList(ctx any, key any) *MockClient_GetList_Call {
+	return &MockClient_GetList_Call{Call: _e.mock.On("GetList", ctx, key)}
+}
+
+func (_c *MockClient_GetList_Call) Run(run func(ctx context.Context, key string)) *MockClient_GetList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GetList_Call) Return(bytess [][]byte, err error) *MockClient_GetList_Call {
+	_c.Call.Return(bytess, err)
+	return _c
+}
+
+func (_c *MockClient_GetList_Call) RunAndReturn(run func(ctx context.Context, key string) ([][]byte, error)) *MockClient_GetList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Increment provides a mock function for the type MockClient
+func (_mock *MockClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	ret := _mock.Called(ctx, key, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Increment")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) (int64, error)); ok {
+		return returnFunc(ctx, key, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) int64); ok {
+		r0 = returnFunc(ctx, key, ttl)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, key, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_Increment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Increment'
+type MockClient_Increment_Call struct {
+	*mock.Call
+}
+
+// Increment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - ttl time.Duration
+func (_e *MockClient_Expecter) Increment(ctx any, key any, ttl any) *MockClient_Increment_Call {
+	return &MockClient_Increment_Call{Call: _e.mock.On("Increment", ctx, key, ttl)}
+}
+
+func (_c *MockClient_Increment_Call) Run(run func(ctx context.Context, key string, ttl time.Duration)) *MockClient_Increment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_Increment_Call) Return(n int64, err error) *MockClient_Increment_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockClient_Increment_Call) RunAndReturn(run func(ctx context.Context, key string, ttl time.Duration) (int64, error)) *MockClient_Increment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Put provides a mock function for the type MockClient
 func (_mock *MockClient) Put(ctx context.Context, key string, value any) error {
 	ret := _mock.Called(ctx, key, value)
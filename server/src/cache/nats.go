@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -14,8 +17,20 @@ import (
 	"scrumlr.io/server/logger"
 )
 
+// natsMarkerTTL is how long the persistent store keeps the markers of expired and removed keys
+const natsMarkerTTL = time.Minute
+
 type natsClient struct {
 	store jetstream.KeyValue
+
+	// persistentStore holds counters and lists, which need to outlive the ttl of the store.
+	// Every list entry is stored under its own key, see listEntryKey.
+	persistentStore jetstream.KeyValue
+
+	// js and persistentSubjectPrefix are used to write to the persistent store directly,
+	// since the key value api only sets the ttl of a key on creation
+	js                      jetstream.JetStream
+	persistentSubjectPrefix string
 }
 
 func NewNats(url, bucket string) (*Cache, error) {
@@ -39,8 +54,23 @@ func NewNats(url, bucket string) (*Cache, error) {
 		return nil, fmt.Errorf("unable to create key value store on nats server %s: %w", url, err)
 	}
 
+	persistentConfig := jetstream.KeyValueConfig{
+		Bucket:         bucket + "-persistent",
+		Storage:        jetstream.MemoryStorage,
+		LimitMarkerTTL: natsMarkerTTL,
+	}
+	persistentKv, err := js.CreateOrUpdateKeyValue(context.Background(), persistentConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create persistent key value store on nats server %s: %w", url, err)
+	}
+
 	return &Cache{
-		Con: &natsClient{store: kv},
+		Con: &natsClient{
+			store:                   kv,
+			persistentStore:         persistentKv,
+			js:                      js,
+			persistentSubjectPrefix: fmt.Sprintf("$KV.%s.", persistentConfig.Bucket),
+		},
 	}, nil
 }
 
@@ -126,5 +156,238 @@ func (n *natsClient) Delete(ctx context.Context, key string) error {
 		attribute.String("scrumlr.cache.nats.delete.key", key),
 	)
 
-	return n.store.Purge(ctx, key)
+	err := n.store.Purge(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	entries, err := n.listEntryKeys(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range append(entries, key) {
+		if err := n.persistentStore.Purge(ctx, entry, jetstream.PurgeTTL(natsMarkerTTL)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *natsClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.nats.increment")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.nats.increment.key", key),
+	)
+
+	var counter int64
+	err := n.compareAndSwap(ctx, key, ttl, func(current []byte) ([]byte, error) {
+		counter = 0
+		if current != nil {
+			if err := json.Unmarshal(current, &counter); err != nil {
+				return nil, err
+			}
+		}
+		counter++
+		return json.Marshal(counter)
+	})
+
+	return counter, err
+}
+
+func (n *natsClient) GetCounter(ctx context.Context, key string) (int64, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.nats.get_counter")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.nats.get_counter.key", key),
+	)
+
+	entry, err := n.persistentStore.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var counter int64
+	err = json.Unmarshal(entry.Value(), &counter)
+
+	return counter, err
+}
+
+// Append stores the value under its own key, since a single value holding the whole list
+// would have to be rewritten on every append and could exceed the maximum value size.
+// The key of the list holds the index of the latest entry.
+func (n *natsClient) Append(ctx context.Context, key string, value any, maxLength int, ttl time.Duration) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.nats.append")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.nats.append.key", key),
+	)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal value")
+		span.RecordError(err)
+		log.Errorw("unable to marshal value in append", "key", key, "value", value, "err", err)
+		return err
+	}
+
+	index, err := n.Increment(ctx, key, ttl)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to increment list index")
+		span.RecordError(err)
+		return err
+	}
+
+	if err := n.publish(ctx, listEntryKey(key, index), data, ttl); err != nil {
+		span.SetStatus(codes.Error, "failed to append value")
+		span.RecordError(err)
+		return err
+	}
+
+	if dropped := index - int64(maxLength); dropped > 0 {
+		err := n.persistentStore.Purge(ctx, listEntryKey(key, dropped), jetstream.PurgeTTL(natsMarkerTTL))
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to drop oldest entry")
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *natsClient) GetList(ctx context.Context, key string) ([][]byte, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.nats.get_list")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.nats.get_list.key", key),
+	)
+
+	watcher, err := n.persistentStore.WatchFiltered(ctx, []string{listEntryKey(key, -1)}, jetstream.IgnoreDeletes())
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get list for key")
+		span.RecordError(err)
+		log.Errorw("unable to get list for key", "key", key, "error", err)
+		return nil, err
+	}
+	defer watcher.Stop()
+
+	type indexedEntry struct {
+		index int64
+		value []byte
+	}
+
+	var entries []indexedEntry
+	for entry := range watcher.Updates() {
+		// the watcher sends nil once all current entries were received
+		if entry == nil {
+			break
+		}
+
+		index, err := strconv.ParseInt(strings.TrimPrefix(entry.Key(), key+"."), 10, 64)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, indexedEntry{index: index, value: entry.Value()})
+	}
+
+	if err := ctx.Err(); err != nil {
+		span.SetStatus(codes.Error, "failed to get list for key")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// concurrent appends may store their entries in a different order than they got their index
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].index < entries[j].index
+	})
+
+	list := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		list = append(list, entry.value)
+	}
+
+	return list, nil
+}
+
+// listEntryKeys returns the keys of all entries of the list stored at key
+func (n *natsClient) listEntryKeys(ctx context.Context, key string) ([]string, error) {
+	lister, err := n.persistentStore.ListKeysFiltered(ctx, listEntryKey(key, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for entry := range lister.Keys() {
+		keys = append(keys, entry)
+	}
+
+	return keys, nil
+}
+
+// listEntryKey returns the key of the entry with the given index of the list stored at key,
+// a negative index matches all entries
+func listEntryKey(key string, index int64) string {
+	if index < 0 {
+		return key + ".*"
+	}
+	return fmt.Sprintf("%s.%d", key, index)
+}
+
+// publish writes the value of key in the persistent store. Unlike the key value api
+// it sets the ttl on every write, so that the key expires once it was not written for ttl.
+func (n *natsClient) publish(ctx context.Context, key string, data []byte, ttl time.Duration, opts ...jetstream.PublishOpt) error {
+	if ttl > 0 {
+		opts = append(opts, jetstream.WithMsgTTL(ttl))
+	}
+
+	_, err := n.js.PublishMsg(ctx, &nats.Msg{Subject: n.persistentSubjectPrefix + key, Data: data}, opts...)
+	return err
+}
+
+// compareAndSwap replaces the value of key in the persistent store with the result of update.
+// The key value store has no atomic operations besides revision checks, so the update is
+// retried whenever another client changed the value in the meantime.
+func (n *natsClient) compareAndSwap(ctx context.Context, key string, ttl time.Duration, update func(current []byte) ([]byte, error)) error {
+	for {
+		entry, err := n.persistentStore.Get(ctx, key)
+		if err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+			return err
+		}
+
+		var current []byte
+		if entry != nil {
+			current = entry.Value()
+		}
+
+		data, err := update(current)
+		if err != nil {
+			return err
+		}
+
+		if entry == nil {
+			// creating handles the markers of removed keys, which a revision check would not
+			_, err = n.persistentStore.Create(ctx, key, data, jetstream.KeyTTL(ttl))
+		} else {
+			err = n.publish(ctx, key, data, ttl, jetstream.WithExpectLastSequencePerSubject(entry.Revision()))
+		}
+
+		var apiErr *jetstream.APIError
+		if errors.Is(err, jetstream.ErrKeyExists) || (errors.As(err, &apiErr) && apiErr.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence) {
+			continue
+		}
+
+		return err
+	}
 }
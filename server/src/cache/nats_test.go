@@ -192,3 +192,86 @@ func (suite *CacheNatsTestSuite) TestNatsDeleteNotFound() {
 	err = cache.Con.Delete(ctx, key.String())
 	assert.Nil(t, err)
 }
+
+func (suite *CacheNatsTestSuite) TestNatsIncrement() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New()
+
+	cache, err := NewNats(suite.natsConnectionString, fmt.Sprintf("scrumlr-%d", rand.Int()))
+	assert.Nil(t, err)
+
+	first, err := cache.Con.Increment(ctx, key.String(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), first)
+
+	second, err := cache.Con.Increment(ctx, key.String(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), second)
+
+	counter, err := cache.Con.GetCounter(ctx, key.String())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), counter)
+}
+
+func (suite *CacheNatsTestSuite) TestNatsAppend() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New()
+
+	cache, err := NewNats(suite.natsConnectionString, fmt.Sprintf("scrumlr-%d", rand.Int()))
+	assert.Nil(t, err)
+
+	for _, value := range []string{"first", "second", "third"} {
+		err = cache.Con.Append(ctx, key.String(), value, 2, 0)
+		assert.Nil(t, err)
+	}
+
+	list, err := cache.Con.GetList(ctx, key.String())
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(`"second"`), []byte(`"third"`)}, list)
+}
+
+func (suite *CacheNatsTestSuite) TestNatsDeleteList() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New()
+
+	cache, err := NewNats(suite.natsConnectionString, fmt.Sprintf("scrumlr-%d", rand.Int()))
+	assert.Nil(t, err)
+
+	err = cache.Con.Append(ctx, key.String(), "test", 10, 0)
+	assert.Nil(t, err)
+
+	err = cache.Con.Delete(ctx, key.String())
+	assert.Nil(t, err)
+
+	list, err := cache.Con.GetList(ctx, key.String())
+	assert.Nil(t, err)
+	assert.Empty(t, list)
+}
+
+func (suite *CacheNatsTestSuite) TestNatsAppendExpires() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New()
+
+	cache, err := NewNats(suite.natsConnectionString, fmt.Sprintf("scrumlr-%d", rand.Int()))
+	assert.Nil(t, err)
+
+	err = cache.Con.Append(ctx, key.String(), "test", 10, time.Second)
+	assert.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		list, err := cache.Con.GetList(ctx, key.String())
+		return err == nil && len(list) == 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// the index of the list expired as well, so the list starts over
+	err = cache.Con.Append(ctx, key.String(), "fresh", 10, time.Second)
+	assert.Nil(t, err)
+
+	list, err := cache.Con.GetList(ctx, key.String())
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(`"fresh"`)}, list)
+}
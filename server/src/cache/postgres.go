@@ -0,0 +1,296 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/logger"
+)
+
+// postgresCleanupInterval is the minimum time between two removals of expired entries
+const postgresCleanupInterval = time.Minute
+
+var (
+	errPostgresKeyNotFound = errors.New("key not found")
+	errPostgresKeyExists   = errors.New("key exists")
+)
+
+type postgresClient struct {
+	db          *bun.DB
+	lastCleanup atomic.Int64
+}
+
+// NewPostgres returns a Cache that keeps its entries in unlogged tables of the given database.
+func NewPostgres(db *bun.DB) *Cache {
+	return &Cache{
+		Con: &postgresClient{db: db},
+	}
+}
+
+// Create the entry with the given value
+// If the key exists return an error
+func (p *postgresClient) Create(ctx context.Context, key string, value any, ttl time.Duration) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.postgres.create")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.postgres.create.key", key),
+	)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal value")
+		span.RecordError(err)
+		log.Errorw("unable to marshal value in create", "key", key, "value", value, "err", err)
+		return err
+	}
+
+	p.removeExpired(ctx)
+
+	// an expired entry is replaced as if it did not exist
+	result, err := p.db.NewRaw(
+		`INSERT INTO cache_entries (key, value, expires_at) VALUES (?, ?, ?)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at
+		WHERE cache_entries.expires_at <= now()`,
+		key, data, postgresExpiresAt(ttl),
+	).Exec(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create entry")
+		span.RecordError(err)
+		return err
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return &KeyAlreadyExists{errPostgresKeyExists}
+	}
+
+	return nil
+}
+
+func (p *postgresClient) Put(ctx context.Context, key string, value any) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.postgres.put")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.postgres.put.key", key),
+	)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal value")
+		span.RecordError(err)
+		log.Errorw("unable to marshal value in put", "key", key, "value", value, "err", err)
+		return err
+	}
+
+	// keep the ttl of an existing entry, like redis does
+	_, err = p.db.NewRaw(
+		`INSERT INTO cache_entries (key, value) VALUES (?, ?)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value,
+		expires_at = CASE WHEN cache_entries.expires_at <= now() THEN NULL ELSE cache_entries.expires_at END`,
+		key, data,
+	).Exec(ctx)
+
+	return err
+}
+
+func (p *postgresClient) Get(ctx context.Context, key string) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.postgres.get")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.postgres.get.key", key),
+	)
+
+	var value []byte
+	err := p.db.QueryRowContext(ctx,
+		"SELECT value FROM cache_entries WHERE key = ? AND (expires_at IS NULL OR expires_at > now())",
+		key,
+	).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Ok, "key does not exists")
+			span.RecordError(err)
+			return nil, &KeyNotFound{errPostgresKeyNotFound}
+		}
+
+		span.SetStatus(codes.Error, "failed to get value for key")
+		span.RecordError(err)
+		log.Errorw("unable to get value for key", "key", key, "error", err)
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (p *postgresClient) Delete(ctx context.Context, key string) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.postgres.delete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.postgres.delete.key", key),
+	)
+
+	_, err := p.db.NewRaw(
+		`WITH entries AS (DELETE FROM cache_entries WHERE key = ?),
+		counters AS (DELETE FROM cache_counters WHERE key = ?)
+		DELETE FROM cache_list_entries WHERE key = ?`,
+		key, key, key,
+	).Exec(ctx)
+
+	return err
+}
+
+func (p *postgresClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.postgres.increment")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.postgres.increment.key", key),
+	)
+
+	p.removeExpired(ctx)
+
+	// an expired counter starts again as if it did not exist
+	var counter int64
+	err := p.db.NewRaw(
+		`INSERT INTO cache_counters (key, value, expires_at) VALUES (?, 1, ?)
+		ON CONFLICT (key) DO UPDATE SET
+		value = CASE WHEN cache_counters.expires_at <= now() THEN 1 ELSE cache_counters.value + 1 END,
+		expires_at = EXCLUDED.expires_at
+		RETURNING value`,
+		key, postgresExpiresAt(ttl),
+	).Scan(ctx, &counter)
+
+	return counter, err
+}
+
+func (p *postgresClient) GetCounter(ctx context.Context, key string) (int64, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.postgres.get_counter")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.postgres.get_counter.key", key),
+	)
+
+	var counter int64
+	err := p.db.QueryRowContext(ctx,
+		"SELECT value FROM cache_counters WHERE key = ? AND (expires_at IS NULL OR expires_at > now())",
+		key,
+	).Scan(&counter)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	return counter, err
+}
+
+func (p *postgresClient) Append(ctx context.Context, key string, value any, maxLength int, ttl time.Duration) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.postgres.append")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.postgres.append.key", key),
+	)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal value")
+		span.RecordError(err)
+		log.Errorw("unable to marshal value in append", "key", key, "value", value, "err", err)
+		return err
+	}
+
+	p.removeExpired(ctx)
+
+	// every entry expires on its own, so the list is gone once nothing was appended for the ttl
+	_, err = p.db.NewRaw("INSERT INTO cache_list_entries (key, value, expires_at) VALUES (?, ?, ?)", key, data, postgresExpiresAt(ttl)).Exec(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to append value")
+		span.RecordError(err)
+		return err
+	}
+
+	_, err = p.db.NewRaw(
+		`DELETE FROM cache_list_entries WHERE key = ? AND id < (
+			SELECT min(id) FROM (SELECT id FROM cache_list_entries WHERE key = ? ORDER BY id DESC LIMIT ?) AS latest
+		)`,
+		key, key, maxLength,
+	).Exec(ctx)
+
+	return err
+}
+
+func (p *postgresClient) GetList(ctx context.Context, key string) ([][]byte, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.postgres.get_list")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.postgres.get_list.key", key),
+	)
+
+	rows, err := p.db.QueryContext(ctx,
+		"SELECT value FROM cache_list_entries WHERE key = ? AND (expires_at IS NULL OR expires_at > now()) ORDER BY id ASC",
+		key,
+	)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get list for key")
+		span.RecordError(err)
+		log.Errorw("unable to get list for key", "key", key, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := [][]byte{}
+	for rows.Next() {
+		var value []byte
+		if err := rows.Scan(&value); err != nil {
+			span.SetStatus(codes.Error, "failed to scan list entry")
+			span.RecordError(err)
+			return nil, err
+		}
+		list = append(list, value)
+	}
+
+	return list, rows.Err()
+}
+
+// removeExpired deletes expired entries, counters and list entries, so that keys which are never read again do not pile up.
+// It runs at most once per cleanup interval.
+func (p *postgresClient) removeExpired(ctx context.Context) {
+	now := time.Now()
+	last := p.lastCleanup.Load()
+	if now.Sub(time.Unix(0, last)) < postgresCleanupInterval || !p.lastCleanup.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	_, err := p.db.NewRaw(
+		`WITH entries AS (DELETE FROM cache_entries WHERE expires_at <= now()),
+		counters AS (DELETE FROM cache_counters WHERE expires_at <= now())
+		DELETE FROM cache_list_entries WHERE expires_at <= now()`,
+	).Exec(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Warnw("unable to remove expired cache entries", "err", err)
+	}
+}
+
+// postgresExpiresAt returns the expiry of a value written now, which is nil if the value does not expire
+func postgresExpiresAt(ttl time.Duration) *time.Time {
+	if ttl <= 0 {
+		return nil
+	}
+	expiration := time.Now().Add(ttl)
+	return &expiration
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/initialize/testDbTemplates"
+)
+
+type CachePostgresDatabaseTestSuite struct {
+	suite.Suite
+	cache *Cache
+}
+
+func TestCachePostgresDatabaseTestSuite(t *testing.T) {
+	suite.Run(t, new(CachePostgresDatabaseTestSuite))
+}
+
+func (suite *CachePostgresDatabaseTestSuite) SetupTest() {
+	suite.cache = NewPostgres(testDbTemplates.NewBaseTestDB(suite.T(), false))
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_Create() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New().String()
+
+	err := suite.cache.Con.Create(ctx, key, "test", time.Minute)
+	assert.Nil(t, err)
+
+	ret, err := suite.cache.Con.Get(ctx, key)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `"test"`, string(ret))
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_CreateAlreadyExists() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New().String()
+
+	err := suite.cache.Con.Create(ctx, key, "test", 0)
+	assert.Nil(t, err)
+
+	err = suite.cache.Con.Create(ctx, key, "this should not work", 0)
+	var keyExists *KeyAlreadyExists
+	assert.ErrorAs(t, err, &keyExists)
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_CreateAfterExpiry() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New().String()
+
+	err := suite.cache.Con.Create(ctx, key, "test", 10*time.Millisecond)
+	assert.Nil(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+
+	_, err = suite.cache.Con.Get(ctx, key)
+	var keyNotFound *KeyNotFound
+	assert.ErrorAs(t, err, &keyNotFound)
+
+	err = suite.cache.Con.Create(ctx, key, "again", time.Minute)
+	assert.Nil(t, err)
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_Put() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New().String()
+
+	err := suite.cache.Con.Put(ctx, key, "put")
+	assert.Nil(t, err)
+	err = suite.cache.Con.Put(ctx, key, "override")
+	assert.Nil(t, err)
+
+	ret, err := suite.cache.Con.Get(ctx, key)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `"override"`, string(ret))
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_GetNotFound() {
+	t := suite.T()
+
+	_, err := suite.cache.Con.Get(context.Background(), uuid.New().String())
+	var keyNotFound *KeyNotFound
+	assert.ErrorAs(t, err, &keyNotFound)
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_Delete() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New().String()
+
+	err := suite.cache.Con.Create(ctx, key, "test", 0)
+	assert.Nil(t, err)
+	_, err = suite.cache.Con.Increment(ctx, key, 0)
+	assert.Nil(t, err)
+	err = suite.cache.Con.Append(ctx, key, "test", 10, 0)
+	assert.Nil(t, err)
+
+	err = suite.cache.Con.Delete(ctx, key)
+	assert.Nil(t, err)
+
+	_, err = suite.cache.Con.Get(ctx, key)
+	var keyNotFound *KeyNotFound
+	assert.ErrorAs(t, err, &keyNotFound)
+
+	list, err := suite.cache.Con.GetList(ctx, key)
+	assert.Nil(t, err)
+	assert.Empty(t, list)
+
+	counter, err := suite.cache.Con.Increment(ctx, key, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), counter)
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_Increment() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New().String()
+
+	first, err := suite.cache.Con.Increment(ctx, key, 0)
+	assert.Nil(t, err)
+	second, err := suite.cache.Con.Increment(ctx, key, 0)
+	assert.Nil(t, err)
+
+	assert.Equal(t, int64(1), first)
+	assert.Equal(t, int64(2), second)
+
+	counter, err := suite.cache.Con.GetCounter(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), counter)
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_AppendAndGetList() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New().String()
+
+	for i := 1; i <= 5; i++ {
+		err := suite.cache.Con.Append(ctx, key, i, 3, 0)
+		assert.Nil(t, err)
+	}
+
+	list, err := suite.cache.Con.GetList(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("3"), []byte("4"), []byte("5")}, list)
+}
+
+func (suite *CachePostgresDatabaseTestSuite) Test_AppendAndIncrementExpire() {
+	t := suite.T()
+	ctx := context.Background()
+	key := uuid.New().String()
+
+	_, err := suite.cache.Con.Increment(ctx, key, time.Millisecond)
+	assert.Nil(t, err)
+	err = suite.cache.Con.Append(ctx, key, "test", 10, time.Millisecond)
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	list, err := suite.cache.Con.GetList(ctx, key)
+	assert.Nil(t, err)
+	assert.Empty(t, list)
+
+	counter, err := suite.cache.Con.Increment(ctx, key, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), counter)
+}
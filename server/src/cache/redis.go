@@ -128,3 +128,96 @@ func (r *redisClient) Delete(ctx context.Context, key string) error {
 
 	return r.store.Del(ctx, key).Err()
 }
+
+func (r *redisClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.redis.increment")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.redis.increment.key", key),
+	)
+
+	var counter *redis.IntCmd
+	_, err := r.store.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		counter = pipe.Incr(ctx, key)
+		if ttl > 0 {
+			pipe.Expire(ctx, key, ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return counter.Val(), nil
+}
+
+func (r *redisClient) GetCounter(ctx context.Context, key string) (int64, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.redis.get_counter")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.redis.get_counter.key", key),
+	)
+
+	counter, err := r.store.Get(ctx, key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+
+	return counter, err
+}
+
+func (r *redisClient) Append(ctx context.Context, key string, value any, maxLength int, ttl time.Duration) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.redis.append")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.redis.append.key", key),
+	)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal value")
+		span.RecordError(err)
+		log.Errorw("unable to marshal value in append", "key", key, "value", value, "err", err)
+		return err
+	}
+
+	_, err = r.store.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.RPush(ctx, key, string(data))
+		pipe.LTrim(ctx, key, int64(-maxLength), -1)
+		if ttl > 0 {
+			pipe.Expire(ctx, key, ttl)
+		}
+		return nil
+	})
+
+	return err
+}
+
+func (r *redisClient) GetList(ctx context.Context, key string) ([][]byte, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.cache.redis.get_list")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.cache.redis.get_list.key", key),
+	)
+
+	values, err := r.store.LRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get list for key")
+		span.RecordError(err)
+		log.Errorw("unable to get list for key", "key", key, "error", err)
+		return nil, err
+	}
+
+	list := make([][]byte, 0, len(values))
+	for _, value := range values {
+		list = append(list, []byte(value))
+	}
+
+	return list, nil
+}
@@ -158,3 +158,60 @@ func (suite *CacheRedisTestSuite) TestRedisDeleteNotFound() {
 	err := suite.cache.Con.Delete(ctx, suite.key)
 	assert.Nil(suite.T(), err)
 }
+
+func (suite *CacheRedisTestSuite) TestRedisIncrement() {
+	ctx := context.Background()
+
+	first, err := suite.cache.Con.Increment(ctx, suite.key, 0)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), first)
+
+	second, err := suite.cache.Con.Increment(ctx, suite.key, 0)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), second)
+
+	counter, err := suite.cache.Con.GetCounter(ctx, suite.key)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), counter)
+}
+
+func (suite *CacheRedisTestSuite) TestRedisAppend() {
+	ctx := context.Background()
+
+	for _, value := range []string{"first", "second", "third"} {
+		err := suite.cache.Con.Append(ctx, suite.key, value, 2, 0)
+		assert.Nil(suite.T(), err)
+	}
+
+	list, err := suite.cache.Con.GetList(ctx, suite.key)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), [][]byte{[]byte(`"second"`), []byte(`"third"`)}, list)
+}
+
+func (suite *CacheRedisTestSuite) TestRedisGetListNotFound() {
+	ctx := context.Background()
+
+	list, err := suite.cache.Con.GetList(ctx, suite.key)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), list)
+}
+
+func (suite *CacheRedisTestSuite) TestRedisAppendExpires() {
+	ctx := context.Background()
+
+	_, err := suite.cache.Con.Increment(ctx, suite.key+"-counter", time.Second)
+	assert.Nil(suite.T(), err)
+	err = suite.cache.Con.Append(ctx, suite.key, "test", 10, time.Second)
+	assert.Nil(suite.T(), err)
+
+	time.Sleep(1500 * time.Millisecond)
+
+	list, err := suite.cache.Con.GetList(ctx, suite.key)
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), list)
+
+	counter, err := suite.cache.Con.Increment(ctx, suite.key+"-counter", time.Second)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), counter)
+}
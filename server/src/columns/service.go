@@ -14,6 +14,7 @@ import (
 	"scrumlr.io/server/notes"
 
 	"github.com/google/uuid"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/logger"
 
 	"scrumlr.io/server/realtime"
@@ -41,6 +42,7 @@ type Service struct {
 	realtime                 *realtime.Broker
 	noteService              notes.NotesService
 	boardLastModifiedUpdater BoardLastModifiedUpdater
+	auditService             audit.AuditService
 }
 
 func NewColumnService(
@@ -48,12 +50,14 @@ func NewColumnService(
 	rt *realtime.Broker,
 	noteService notes.NotesService,
 	boardLastModifiedUpdater BoardLastModifiedUpdater,
+	auditService audit.AuditService,
 ) ColumnService {
 	service := new(Service)
 	service.database = db
 	service.realtime = rt
 	service.noteService = noteService
 	service.boardLastModifiedUpdater = boardLastModifiedUpdater
+	service.auditService = auditService
 
 	return service
 }
@@ -223,6 +227,15 @@ func (service *Service) Delete(ctx context.Context, board, column, user uuid.UUI
 		attribute.String("scrumlr.columns.service.delete.column", column.String()),
 		attribute.String("scrumlr.columns.service.delete.user", user.String()),
 	)
+
+	// the deleted column is kept in the audit log
+	deletedColumn, err := service.Get(ctx, board, column)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get column")
+		span.RecordError(err)
+		return err
+	}
+
 	// notes and votes are deleted cascading from the database
 	// get all notes that are effected to send the delete event
 	notes, err := service.noteService.GetAll(ctx, board, column)
@@ -247,6 +260,8 @@ func (service *Service) Delete(ctx context.Context, board, column, user uuid.UUI
 	}
 
 	service.deletedColumn(ctx, board, column, noteIds)
+	service.auditService.Record(ctx, board, audit.ColumnDeleted, deletedColumn, nil)
+
 	columnsDeletedCounter.Add(ctx, 1)
 	return err
 }
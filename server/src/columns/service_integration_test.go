@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go/modules/nats"
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/initialize"
@@ -67,9 +68,10 @@ func (suite *ColumnServiceIntegrationTestSuite) SetupTest() {
 
 	notesDatabase := notes.NewNotesDatabase(db)
 	boardLastModifiedUpdater := common.NewSimpleBoardLastModifiedUpdater(db)
-	noteService := notes.NewNotesService(notesDatabase, broker, ch, boardLastModifiedUpdater)
+	auditService := audit.NewAuditService(audit.NewAuditDatabase(db))
+	noteService := notes.NewNotesService(notesDatabase, broker, ch, boardLastModifiedUpdater, auditService)
 	database := NewColumnsDatabase(db)
-	suite.columnService = NewColumnService(database, broker, noteService, boardLastModifiedUpdater)
+	suite.columnService = NewColumnService(database, broker, noteService, boardLastModifiedUpdater, auditService)
 }
 
 func (suite *ColumnServiceIntegrationTestSuite) initTestData() {
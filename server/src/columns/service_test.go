@@ -2,12 +2,14 @@ package columns
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/notes"
 	"scrumlr.io/server/realtime"
@@ -20,6 +22,7 @@ type ColumnServiceTestSuite struct {
 	broker                   *realtime.Broker
 	mockNoteService          *notes.MockNotesService
 	mockBoardModifiedUpdater *common.MockBoardLastModifiedUpdater
+	mockAudit                *audit.MockAuditService
 	service                  ColumnService
 	boardID                  uuid.UUID
 	columnID                 uuid.UUID
@@ -39,7 +42,8 @@ func (suite *ColumnServiceTestSuite) SetupTest() {
 	suite.broker.Con = suite.mockBrokerClient
 	suite.mockNoteService = notes.NewMockNotesService(suite.T())
 	suite.mockBoardModifiedUpdater = common.NewMockBoardLastModifiedUpdater(suite.T())
-	suite.service = NewColumnService(suite.mockDB, suite.broker, suite.mockNoteService, suite.mockBoardModifiedUpdater)
+	suite.mockAudit = audit.NewMockAuditService(suite.T())
+	suite.service = NewColumnService(suite.mockDB, suite.broker, suite.mockNoteService, suite.mockBoardModifiedUpdater, suite.mockAudit)
 	suite.boardID = uuid.New()
 	suite.columnID = uuid.New()
 	suite.userID = uuid.New()
@@ -93,18 +97,31 @@ func (suite *ColumnServiceTestSuite) TestDeleteColumn() {
 	noteText := "Hallo"
 	noteId := uuid.New()
 
+	suite.expectGetColumn()
 	suite.expectColumnDeletedAndBroadcast()
 	suite.expectGetAllNotes(&notes.Note{ID: noteId, Text: noteText, Position: notes.NotePosition{Column: suite.columnID}}, nil)
 	suite.expectBoardLastModifiedAtUpdated()
+	suite.mockAudit.EXPECT().Record(mock.Anything, suite.boardID, audit.ColumnDeleted, new(Column).From(suite.createDatabaseColumn()), nil).Return()
 
 	err := suite.service.Delete(context.Background(), suite.boardID, suite.columnID, suite.userID)
 
 	suite.Nil(err)
 }
 
+func (suite *ColumnServiceTestSuite) TestDeleteColumn_NotFound() {
+	suite.mockDB.EXPECT().Get(mock.Anything, suite.boardID, suite.columnID).Return(DatabaseColumn{}, sql.ErrNoRows)
+
+	err := suite.service.Delete(context.Background(), suite.boardID, suite.columnID, suite.userID)
+
+	var columnErr ColumnError
+	suite.ErrorAs(err, &columnErr)
+	suite.Equal(NotFound, columnErr.Category)
+}
+
 func (suite *ColumnServiceTestSuite) TestDeleteColumn_DatabaseError() {
 	dbError := errors.New("Database error")
 
+	suite.expectGetColumn()
 	suite.mockDB.EXPECT().Delete(mock.Anything, suite.boardID, suite.columnID).
 		Return(dbError)
 
@@ -122,6 +139,7 @@ func (suite *ColumnServiceTestSuite) TestDeleteColumn_NoteServiceGetAllError() {
 		Category: NotFound,
 	}
 
+	suite.expectGetColumn()
 	suite.expectGetAllNotes(nil, mockErr)
 
 	err := suite.service.Delete(context.Background(), suite.boardID, suite.columnID, suite.userID)
@@ -329,6 +347,10 @@ func (suite *ColumnServiceTestSuite) expectBoardLastModifiedAtUpdated() {
 	suite.mockBoardModifiedUpdater.EXPECT().UpdateLastModified(mock.Anything, suite.boardID, mock.AnythingOfType("time.Time")).Return(nil)
 }
 
+func (suite *ColumnServiceTestSuite) expectGetColumn() {
+	suite.mockDB.EXPECT().Get(mock.Anything, suite.boardID, suite.columnID).Return(suite.createDatabaseColumn(), nil)
+}
+
 func (suite *ColumnServiceTestSuite) expectColumnDeletedAndBroadcast() {
 	suite.mockDB.EXPECT().Delete(mock.Anything, suite.boardID, suite.columnID).Return(nil)
 	suite.expectBroadcast()
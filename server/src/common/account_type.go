@@ -30,12 +30,15 @@ const (
 
 	// TypeOIDC users registered on OIDC
 	TypeOIDC AccountType = "OIDC"
+
+	// TypeSAML users registered on a SAML identity provider
+	TypeSAML AccountType = "SAML"
 )
 
 func NewAccountType(s string) (result AccountType, err error) {
 	result = AccountType(strings.ToUpper(s))
 	switch result {
-	case Anonymous, Google, Microsoft, AzureAd, GitHub, Apple, TypeOIDC:
+	case Anonymous, Google, Microsoft, AzureAd, GitHub, Apple, TypeOIDC, TypeSAML:
 		return
 	}
 	err = errors.New("invalid account type")
@@ -41,6 +41,10 @@ func TestNewAccountType(t *testing.T) {
 			have: "OIDC",
 			want: TypeOIDC,
 		},
+		"SAML": {
+			have: "SAML",
+			want: TypeSAML,
+		},
 		"ANONYMOUS (lowercase)": {
 			have: "anonymous",
 			want: Anonymous,
@@ -69,6 +73,10 @@ func TestNewAccountType(t *testing.T) {
 			have: "oidc",
 			want: TypeOIDC,
 		},
+		"SAML (lowercase)": {
+			have: "saml",
+			want: TypeSAML,
+		},
 		"invalid enum value": {
 			have:      "FACEBOOK",
 			wantError: true,
@@ -93,7 +101,7 @@ func TestNewAccountType(t *testing.T) {
 }
 
 func TestAccountTypeEnum(t *testing.T) {
-	values := []AccountType{Anonymous, Google, GitHub, Microsoft, Apple, TypeOIDC}
+	values := []AccountType{Anonymous, Google, GitHub, Microsoft, Apple, TypeOIDC, TypeSAML}
 	for _, value := range values {
 		var accountType AccountType
 		err := accountType.UnmarshalJSON(fmt.Appendf(nil, "\"%s\"", value))
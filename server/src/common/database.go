@@ -6,12 +6,16 @@ import (
 	"github.com/uptrace/bun"
 )
 
+// voteScore is the SQL expression of the score of a vote v in its voting vg, which matches the tallying of the voting results.
+const voteScore = "CASE vg.mode WHEN 'POINTS' THEN v.weight WHEN 'RANKED' THEN vg.vote_limit - COALESCE(v.rank, vg.vote_limit) + 1 ELSE 1 END"
+
 func GetRankUpdateQueryForClosedVoting(db *bun.DB, votingQuery string) *bun.UpdateQuery {
 	newRankSelect := db.NewSelect().
 		TableExpr("notes as note").
 		ColumnExpr(fmt.Sprintf(
 			"ROW_NUMBER() OVER (PARTITION BY \"column\" ORDER BY "+
-				"(SELECT COUNT(*) FROM notes AS n INNER JOIN (SELECT * FROM VOTES WHERE voting = (SELECT id FROM \"%s\")) as v ON n.id = v.note WHERE n.id = note.id OR n.stack = note.id), rank)-1 AS new_rank",
+				"(SELECT COALESCE(SUM("+voteScore+"), 0) FROM notes AS n INNER JOIN (SELECT * FROM VOTES WHERE voting = (SELECT id FROM \"%s\")) as v ON n.id = v.note "+
+				"INNER JOIN votings AS vg ON vg.id = v.voting WHERE n.id = note.id OR n.stack = note.id), rank)-1 AS new_rank",
 			votingQuery)).
 		Column("id").
 		Where(fmt.Sprintf("stack IS NULL AND board = (SELECT board FROM \"%s\")", votingQuery)).
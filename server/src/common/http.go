@@ -44,3 +44,13 @@ func GetHostWithoutPort(r *http.Request) string {
 	}
 	return hostname
 }
+
+// IsMutatingRequest reports whether the request may change data, i.e. it does not use a safe method.
+func IsMutatingRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
@@ -76,3 +76,11 @@ func TestDomainOfSealedCookieWithPublicSuffixWithoutSubdomain(t *testing.T) {
 
 	assert.Equal(t, "", c.Domain)
 }
+
+func TestIsMutatingRequest(t *testing.T) {
+	assert.False(t, IsMutatingRequest(&http.Request{Method: http.MethodGet}))
+	assert.False(t, IsMutatingRequest(&http.Request{Method: http.MethodHead}))
+	assert.True(t, IsMutatingRequest(&http.Request{Method: http.MethodPost}))
+	assert.True(t, IsMutatingRequest(&http.Request{Method: http.MethodPut}))
+	assert.True(t, IsMutatingRequest(&http.Request{Method: http.MethodDelete}))
+}
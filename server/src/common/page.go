@@ -0,0 +1,42 @@
+package common
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 100
+)
+
+// Page selects a slice of a listing.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// ParsePage reads the page from the limit and offset query parameters.
+// The limit defaults to 50 and may be at most 100.
+func ParsePage(query url.Values) (Page, error) {
+	page := Page{Limit: defaultPageLimit}
+
+	if limit := query.Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value < 1 || value > maxPageLimit {
+			return Page{}, errors.New("limit must be a number between 1 and 100")
+		}
+		page.Limit = value
+	}
+
+	if offset := query.Get("offset"); offset != "" {
+		value, err := strconv.Atoi(offset)
+		if err != nil || value < 0 {
+			return Page{}, errors.New("offset must be a positive number")
+		}
+		page.Offset = value
+	}
+
+	return page, nil
+}
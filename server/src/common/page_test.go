@@ -0,0 +1,23 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePage(t *testing.T) {
+	page, err := ParsePage(map[string][]string{})
+	assert.Nil(t, err)
+	assert.Equal(t, Page{Limit: defaultPageLimit}, page)
+
+	page, err = ParsePage(map[string][]string{"limit": {"10"}, "offset": {"30"}})
+	assert.Nil(t, err)
+	assert.Equal(t, Page{Limit: 10, Offset: 30}, page)
+
+	_, err = ParsePage(map[string][]string{"limit": {"1000"}})
+	assert.NotNil(t, err)
+
+	_, err = ParsePage(map[string][]string{"offset": {"-1"}})
+	assert.NotNil(t, err)
+}
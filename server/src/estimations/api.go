@@ -0,0 +1,16 @@
+package estimations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type EstimationService interface {
+	Create(ctx context.Context, body EstimationCreateRequest) (*Estimation, error)
+	Get(ctx context.Context, board, id uuid.UUID) (*Estimation, error)
+	GetAll(ctx context.Context, board uuid.UUID) ([]*Estimation, error)
+	Update(ctx context.Context, body EstimationUpdateRequest) (*Estimation, error)
+	SubmitEstimate(ctx context.Context, body EstimateRequest) (*Estimate, error)
+	GetEstimatesByUser(ctx context.Context, board, user uuid.UUID) ([]*Estimate, error)
+}
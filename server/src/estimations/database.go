@@ -0,0 +1,116 @@
+package estimations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+)
+
+type DB struct {
+	db *bun.DB
+}
+
+func NewEstimationDatabase(database *bun.DB) EstimationDatabase {
+	db := new(DB)
+	db.db = database
+
+	return db
+}
+
+// Create inserts a new estimation round
+func (d *DB) Create(ctx context.Context, insert DatabaseEstimationInsert) (DatabaseEstimation, error) {
+	var estimation DatabaseEstimation
+	_, err := d.db.NewInsert().
+		Model(&insert).
+		Returning("*").
+		Exec(common.ContextWithValues(ctx, "Database", d, identifiers.BoardIdentifier, insert.Board), &estimation)
+
+	return estimation, err
+}
+
+// Get gets a specific estimation round of a board
+func (d *DB) Get(ctx context.Context, board, id uuid.UUID) (DatabaseEstimation, error) {
+	var estimation DatabaseEstimation
+	err := d.db.NewSelect().
+		Model(&estimation).
+		Where("board = ?", board).
+		Where("id = ?", id).
+		Scan(ctx)
+
+	return estimation, err
+}
+
+// GetAll gets all estimation rounds of a board, the most recent ones last
+func (d *DB) GetAll(ctx context.Context, board uuid.UUID) ([]DatabaseEstimation, error) {
+	var estimations []DatabaseEstimation
+	err := d.db.NewSelect().
+		Model(&estimations).
+		Where("board = ?", board).
+		Order("created_at ASC").
+		Scan(ctx)
+
+	return estimations, err
+}
+
+// GetInProgress gets the estimation round of a board that is not closed yet
+func (d *DB) GetInProgress(ctx context.Context, board uuid.UUID) (DatabaseEstimation, error) {
+	var estimation DatabaseEstimation
+	err := d.db.NewSelect().
+		Model(&estimation).
+		Where("board = ?", board).
+		Where("status <> ?", Closed).
+		Scan(ctx)
+
+	return estimation, err
+}
+
+// Update updates the status and the final estimate of an estimation round
+func (d *DB) Update(ctx context.Context, update DatabaseEstimationUpdate) (DatabaseEstimation, error) {
+	var estimation DatabaseEstimation
+	_, err := d.db.NewUpdate().
+		Model(&update).
+		Column("status", "estimate").
+		Where("id = ?", update.ID).
+		Where("board = ?", update.Board).
+		Returning("*").
+		Exec(common.ContextWithValues(ctx, "Database", d, identifiers.BoardIdentifier, update.Board), &estimation)
+
+	return estimation, err
+}
+
+// GetEstimates gets the estimates of all estimation rounds of a board
+func (d *DB) GetEstimates(ctx context.Context, board uuid.UUID) ([]DatabaseEstimate, error) {
+	var estimates []DatabaseEstimate
+	err := d.db.NewSelect().
+		Model(&estimates).
+		Join("JOIN estimations ON estimations.id = estimate.estimation").
+		Where("estimations.board = ?", board).
+		Order("estimate.user").
+		Scan(ctx)
+
+	return estimates, err
+}
+
+// SubmitEstimate inserts the estimate of a participant or replaces the card of their previous one
+func (d *DB) SubmitEstimate(ctx context.Context, estimate DatabaseEstimate) (DatabaseEstimate, error) {
+	var result DatabaseEstimate
+	_, err := d.db.NewInsert().
+		Model(&estimate).
+		On("CONFLICT (estimation, \"user\") DO UPDATE SET card = EXCLUDED.card").
+		Returning("*").
+		Exec(ctx, &result)
+
+	return result, err
+}
+
+// NoteExists checks whether the note is part of the board
+func (d *DB) NoteExists(ctx context.Context, board, note uuid.UUID) (bool, error) {
+	return d.db.NewSelect().
+		Table("notes").
+		Where("board = ?", board).
+		Where("id = ?", note).
+		Exists(ctx)
+}
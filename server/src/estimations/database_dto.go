@@ -0,0 +1,42 @@
+package estimations
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type DatabaseEstimation struct {
+	bun.BaseModel `bun:"table:estimations,alias:estimation"`
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	Board         uuid.UUID
+	Note          uuid.UUID
+	Deck          Deck
+	Status        EstimationStatus
+	Estimate      *string
+}
+
+type DatabaseEstimationInsert struct {
+	bun.BaseModel `bun:"table:estimations"`
+	Board         uuid.UUID
+	Note          uuid.UUID
+	Deck          Deck
+	Status        EstimationStatus
+}
+
+type DatabaseEstimationUpdate struct {
+	bun.BaseModel `bun:"table:estimations,alias:estimation"`
+	ID            uuid.UUID
+	Board         uuid.UUID
+	Status        EstimationStatus
+	Estimate      *string
+}
+
+type DatabaseEstimate struct {
+	bun.BaseModel `bun:"table:estimates,alias:estimate"`
+	Estimation    uuid.UUID
+	User          uuid.UUID
+	Card          string
+}
@@ -0,0 +1,170 @@
+package estimations
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/initialize/testDbTemplates"
+)
+
+type DatabaseEstimationTestSuite struct {
+	suite.Suite
+	db       *bun.DB
+	baseData testDbTemplates.DbBaseIDs
+}
+
+func TestDatabaseEstimationTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseEstimationTestSuite))
+}
+
+func (suite *DatabaseEstimationTestSuite) SetupSuite() {
+	suite.baseData = testDbTemplates.GetBaseIDs()
+}
+
+func (suite *DatabaseEstimationTestSuite) SetupTest() {
+	suite.db = testDbTemplates.NewBaseTestDB(suite.T(), true)
+}
+
+func (suite *DatabaseEstimationTestSuite) Test_Database_Create() {
+	t := suite.T()
+	database := NewEstimationDatabase(suite.db)
+
+	insert := DatabaseEstimationInsert{
+		Board:  suite.baseData.Boards["Write"].ID,
+		Note:   suite.baseData.Notes["WriteAdd"].ID,
+		Deck:   TShirtDeck,
+		Status: Open,
+	}
+
+	dbEstimation, err := database.Create(context.Background(), insert)
+
+	assert.Nil(t, err)
+	assert.Equal(t, insert.Board, dbEstimation.Board)
+	assert.Equal(t, insert.Note, dbEstimation.Note)
+	assert.Equal(t, TShirtDeck, dbEstimation.Deck)
+	assert.Equal(t, Open, dbEstimation.Status)
+	assert.Nil(t, dbEstimation.Estimate)
+	assert.NotNil(t, dbEstimation.CreatedAt)
+}
+
+func (suite *DatabaseEstimationTestSuite) Test_Database_Create_SecondRoundInProgress() {
+	t := suite.T()
+	database := NewEstimationDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Write"].ID
+	_, err := database.Create(context.Background(), DatabaseEstimationInsert{Board: boardId, Note: suite.baseData.Notes["WriteAdd"].ID, Deck: FibonacciDeck, Status: Open})
+	assert.Nil(t, err)
+
+	_, err = database.Create(context.Background(), DatabaseEstimationInsert{Board: boardId, Note: suite.baseData.Notes["WriteRemove"].ID, Deck: FibonacciDeck, Status: Open})
+	assert.NotNil(t, err)
+}
+
+func (suite *DatabaseEstimationTestSuite) Test_Database_GetInProgress() {
+	t := suite.T()
+	database := NewEstimationDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Read"].ID
+	_, err := database.GetInProgress(context.Background(), boardId)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	created, err := database.Create(context.Background(), DatabaseEstimationInsert{Board: boardId, Note: suite.baseData.Notes["Read1"].ID, Deck: FibonacciDeck, Status: Open})
+	assert.Nil(t, err)
+
+	inProgress, err := database.GetInProgress(context.Background(), boardId)
+	assert.Nil(t, err)
+	assert.Equal(t, created.ID, inProgress.ID)
+
+	_, err = database.Update(context.Background(), DatabaseEstimationUpdate{ID: created.ID, Board: boardId, Status: Closed})
+	assert.Nil(t, err)
+
+	_, err = database.GetInProgress(context.Background(), boardId)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseEstimationTestSuite) Test_Database_Update() {
+	t := suite.T()
+	database := NewEstimationDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Update"].ID
+	created, err := database.Create(context.Background(), DatabaseEstimationInsert{Board: boardId, Note: suite.baseData.Notes["Update1"].ID, Deck: FibonacciDeck, Status: Open})
+	assert.Nil(t, err)
+
+	estimate := "13"
+	dbEstimation, err := database.Update(context.Background(), DatabaseEstimationUpdate{ID: created.ID, Board: boardId, Status: Closed, Estimate: &estimate})
+
+	assert.Nil(t, err)
+	assert.Equal(t, Closed, dbEstimation.Status)
+	assert.Equal(t, &estimate, dbEstimation.Estimate)
+	assert.Equal(t, created.Note, dbEstimation.Note)
+}
+
+func (suite *DatabaseEstimationTestSuite) Test_Database_GetAll() {
+	t := suite.T()
+	database := NewEstimationDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Update"].ID
+	first, err := database.Create(context.Background(), DatabaseEstimationInsert{Board: boardId, Note: suite.baseData.Notes["Update1"].ID, Deck: FibonacciDeck, Status: Open})
+	assert.Nil(t, err)
+	_, err = database.Update(context.Background(), DatabaseEstimationUpdate{ID: first.ID, Board: boardId, Status: Closed})
+	assert.Nil(t, err)
+	second, err := database.Create(context.Background(), DatabaseEstimationInsert{Board: boardId, Note: suite.baseData.Notes["Update2"].ID, Deck: TShirtDeck, Status: Open})
+	assert.Nil(t, err)
+
+	dbEstimations, err := database.GetAll(context.Background(), boardId)
+
+	assert.Nil(t, err)
+	assert.Len(t, dbEstimations, 2)
+	assert.Equal(t, first.ID, dbEstimations[0].ID)
+	assert.Equal(t, second.ID, dbEstimations[1].ID)
+
+	dbEstimation, err := database.Get(context.Background(), boardId, second.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, second.ID, dbEstimation.ID)
+
+	_, err = database.Get(context.Background(), suite.baseData.Boards["Read"].ID, second.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseEstimationTestSuite) Test_Database_SubmitEstimate() {
+	t := suite.T()
+	database := NewEstimationDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Write"].ID
+	userId := suite.baseData.Users["Stan"].ID
+	estimation, err := database.Create(context.Background(), DatabaseEstimationInsert{Board: boardId, Note: suite.baseData.Notes["WriteAdd"].ID, Deck: FibonacciDeck, Status: Open})
+	assert.Nil(t, err)
+
+	estimate, err := database.SubmitEstimate(context.Background(), DatabaseEstimate{Estimation: estimation.ID, User: userId, Card: "3"})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", estimate.Card)
+
+	// submitting again replaces the previous card
+	estimate, err = database.SubmitEstimate(context.Background(), DatabaseEstimate{Estimation: estimation.ID, User: userId, Card: "8"})
+	assert.Nil(t, err)
+	assert.Equal(t, "8", estimate.Card)
+
+	estimates, err := database.GetEstimates(context.Background(), boardId)
+	assert.Nil(t, err)
+	assert.Equal(t, []DatabaseEstimate{{Estimation: estimation.ID, User: userId, Card: "8"}}, estimates)
+
+	estimates, err = database.GetEstimates(context.Background(), suite.baseData.Boards["Read"].ID)
+	assert.Nil(t, err)
+	assert.Empty(t, estimates)
+}
+
+func (suite *DatabaseEstimationTestSuite) Test_Database_NoteExists() {
+	t := suite.T()
+	database := NewEstimationDatabase(suite.db)
+
+	exists, err := database.NoteExists(context.Background(), suite.baseData.Boards["Read"].ID, suite.baseData.Notes["Read1"].ID)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	exists, err = database.NoteExists(context.Background(), suite.baseData.Boards["Write"].ID, suite.baseData.Notes["Read1"].ID)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,50 @@
+package estimations
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+// Deck is the set of cards the participants choose their estimate from.
+type Deck string
+
+const (
+	// FibonacciDeck contains story points following the Fibonacci sequence.
+	FibonacciDeck Deck = "FIBONACCI"
+
+	// TShirtDeck contains t-shirt sizes from XS to XXL.
+	TShirtDeck Deck = "T_SHIRT"
+)
+
+// unsureCard is part of every deck and lets a participant state that they cannot estimate the note.
+const unsureCard = "?"
+
+var cards = map[Deck][]string{
+	FibonacciDeck: {"0", "1", "2", "3", "5", "8", "13", "21", "34", "55", "89", unsureCard},
+	TShirtDeck:    {"XS", "S", "M", "L", "XL", "XXL", unsureCard},
+}
+
+func (deck *Deck) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	unmarshalledDeck := Deck(s)
+	switch unmarshalledDeck {
+	case FibonacciDeck, TShirtDeck:
+		*deck = unmarshalledDeck
+		return nil
+	}
+	return errors.New("invalid estimation deck")
+}
+
+// Cards returns the cards of the deck in ascending order.
+func (deck Deck) Cards() []string {
+	return slices.Clone(cards[deck])
+}
+
+// Contains checks whether the card is part of the deck.
+func (deck Deck) Contains(card string) bool {
+	return slices.Contains(cards[deck], card)
+}
@@ -0,0 +1,52 @@
+package estimations
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeckEnum(t *testing.T) {
+	values := []Deck{FibonacciDeck, TShirtDeck}
+	for _, value := range values {
+		var deck Deck
+		err := deck.UnmarshalJSON(fmt.Appendf(nil, "\"%s\"", value))
+		assert.Nil(t, err)
+		assert.Equal(t, value, deck)
+	}
+}
+
+func TestUnmarshalDeckNil(t *testing.T) {
+	var deck Deck
+	err := deck.UnmarshalJSON(nil)
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalDeckRandomValue(t *testing.T) {
+	var deck Deck
+	err := deck.UnmarshalJSON([]byte("\"SOME_RANDOM_VALUE\""))
+	assert.NotNil(t, err)
+}
+
+func TestDeckCards(t *testing.T) {
+	assert.Equal(t, []string{"0", "1", "2", "3", "5", "8", "13", "21", "34", "55", "89", "?"}, FibonacciDeck.Cards())
+	assert.Equal(t, []string{"XS", "S", "M", "L", "XL", "XXL", "?"}, TShirtDeck.Cards())
+	assert.Empty(t, Deck("UNKNOWN").Cards())
+}
+
+func TestDeckCardsCannotBeModified(t *testing.T) {
+	deckCards := FibonacciDeck.Cards()
+	deckCards[0] = "100"
+
+	assert.Equal(t, "0", FibonacciDeck.Cards()[0])
+}
+
+func TestDeckContains(t *testing.T) {
+	assert.True(t, FibonacciDeck.Contains("13"))
+	assert.True(t, FibonacciDeck.Contains("?"))
+	assert.False(t, FibonacciDeck.Contains("4"))
+	assert.False(t, FibonacciDeck.Contains("M"))
+	assert.True(t, TShirtDeck.Contains("M"))
+	assert.False(t, TShirtDeck.Contains("13"))
+}
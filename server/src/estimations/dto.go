@@ -0,0 +1,107 @@
+package estimations
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EstimationCreateRequest represents the request to start an estimation round on a note.
+type EstimationCreateRequest struct {
+	// The note to estimate.
+	Note uuid.UUID `json:"note"`
+
+	// The deck of cards to estimate with, defaults to the Fibonacci deck.
+	Deck Deck `json:"deck,omitempty"`
+
+	Board uuid.UUID `json:"-"`
+}
+
+// EstimationUpdateRequest represents the request to reveal or close an estimation round.
+type EstimationUpdateRequest struct {
+	Status EstimationStatus `json:"status"`
+
+	// The final estimate stored on the note, only allowed when closing a revealed round.
+	Estimate *string `json:"estimate,omitempty"`
+
+	ID    uuid.UUID `json:"-"`
+	Board uuid.UUID `json:"-"`
+}
+
+// EstimateRequest represents the request of a participant to submit or change their estimate.
+type EstimateRequest struct {
+	// The chosen card of the deck of the round.
+	Card string `json:"card"`
+
+	Estimation uuid.UUID `json:"-"`
+	Board      uuid.UUID `json:"-"`
+	User       uuid.UUID `json:"-"`
+}
+
+// Estimation is the response for all estimation requests.
+type Estimation struct {
+	ID        uuid.UUID        `json:"id"`
+	Note      uuid.UUID        `json:"note"`
+	Deck      Deck             `json:"deck"`
+	Cards     []string         `json:"cards"`
+	Status    EstimationStatus `json:"status"`
+	CreatedAt time.Time        `json:"createdAt"`
+
+	// The final estimate, set when the round was closed with one.
+	Estimate *string `json:"estimate,omitempty"`
+
+	// The estimates of the participants, their cards are hidden until the round is revealed.
+	Estimates []Estimate `json:"estimates"`
+}
+
+// Estimate is the estimate of a participant in an estimation round.
+type Estimate struct {
+	Estimation uuid.UUID `json:"estimation"`
+	User       uuid.UUID `json:"user"`
+
+	// The chosen card, empty while the round is open.
+	Card string `json:"card,omitempty"`
+}
+
+func (e *Estimation) From(estimation DatabaseEstimation, estimates []DatabaseEstimate) *Estimation {
+	e.ID = estimation.ID
+	e.Note = estimation.Note
+	e.Deck = estimation.Deck
+	e.Cards = estimation.Deck.Cards()
+	e.Status = estimation.Status
+	e.CreatedAt = estimation.CreatedAt
+	e.Estimate = estimation.Estimate
+
+	e.Estimates = []Estimate{}
+	for _, estimate := range estimates {
+		if estimate.Estimation != estimation.ID {
+			continue
+		}
+
+		result := new(Estimate).From(estimate)
+		if estimation.Status == Open {
+			result.Card = ""
+		}
+		e.Estimates = append(e.Estimates, *result)
+	}
+	return e
+}
+
+func Estimations(estimations []DatabaseEstimation, estimates []DatabaseEstimate) []*Estimation {
+	if estimations == nil {
+		return nil
+	}
+
+	list := make([]*Estimation, len(estimations))
+	for index, estimation := range estimations {
+		list[index] = new(Estimation).From(estimation, estimates)
+	}
+	return list
+}
+
+func (e *Estimate) From(estimate DatabaseEstimate) *Estimate {
+	e.Estimation = estimate.Estimation
+	e.User = estimate.User
+	e.Card = estimate.Card
+	return e
+}
@@ -0,0 +1,38 @@
+package estimations
+
+import "fmt"
+
+type EstimationErrorCategory string
+
+const (
+	BadRequest EstimationErrorCategory = "BAD_REQUEST"
+	NotFound   EstimationErrorCategory = "NOT_FOUND"
+	Conflict   EstimationErrorCategory = "CONFLICT"
+	Internal   EstimationErrorCategory = "INTERNAL"
+)
+
+type EstimationError struct {
+	Category EstimationErrorCategory
+	Message  string
+	Err      error
+}
+
+func (e EstimationError) Error() string {
+	return fmt.Sprintf("estimation error [%s]: %s", e.Category, e.Message)
+}
+
+func (e EstimationError) Status() string {
+	return string(e.Category)
+}
+
+func (e EstimationError) Unwrap() error {
+	return e.Err
+}
+
+func CreateEstimationError(category EstimationErrorCategory, message string, err error) error {
+	return EstimationError{
+		Category: category,
+		Message:  message,
+		Err:      err,
+	}
+}
@@ -0,0 +1,34 @@
+package estimations
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// EstimationStatus is the state of an estimation round and can be one of open, revealed or closed.
+type EstimationStatus string
+
+const (
+	// Open is the state in which the participants submit their estimates, which are hidden from everyone else.
+	Open EstimationStatus = "OPEN"
+
+	// Revealed is the state after a moderator revealed the estimates of all participants.
+	Revealed EstimationStatus = "REVEALED"
+
+	// Closed is the state of a finished round, the final estimate is stored on the note.
+	Closed EstimationStatus = "CLOSED"
+)
+
+func (status *EstimationStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	unmarshalledStatus := EstimationStatus(s)
+	switch unmarshalledStatus {
+	case Open, Revealed, Closed:
+		*status = unmarshalledStatus
+		return nil
+	}
+	return errors.New("invalid estimation status")
+}
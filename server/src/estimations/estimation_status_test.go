@@ -0,0 +1,36 @@
+package estimations
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimationStatusEnum(t *testing.T) {
+	values := []EstimationStatus{Open, Revealed, Closed}
+	for _, value := range values {
+		var status EstimationStatus
+		err := status.UnmarshalJSON(fmt.Appendf(nil, "\"%s\"", value))
+		assert.Nil(t, err)
+		assert.Equal(t, value, status)
+	}
+}
+
+func TestUnmarshalEstimationStatusNil(t *testing.T) {
+	var status EstimationStatus
+	err := status.UnmarshalJSON(nil)
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalEstimationStatusEmptyStringWithQuotation(t *testing.T) {
+	var status EstimationStatus
+	err := status.UnmarshalJSON([]byte("\"\""))
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalEstimationStatusRandomValue(t *testing.T) {
+	var status EstimationStatus
+	err := status.UnmarshalJSON([]byte("\"SOME_RANDOM_VALUE\""))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,21 @@
+package estimations
+
+import "go.opentelemetry.io/otel/metric"
+
+var estimationStartedCounter, _ = meter.Int64Counter(
+	"scrumlr.estimations.started.counter",
+	metric.WithDescription("Number of started estimation rounds"),
+	metric.WithUnit("estimations"),
+)
+
+var estimationClosedCounter, _ = meter.Int64Counter(
+	"scrumlr.estimations.closed.counter",
+	metric.WithDescription("Number of closed estimation rounds"),
+	metric.WithUnit("estimations"),
+)
+
+var estimateSubmittedCounter, _ = meter.Int64Counter(
+	"scrumlr.estimations.estimates.submitted.counter",
+	metric.WithDescription("Number of submitted estimates"),
+	metric.WithUnit("estimates"),
+)
@@ -0,0 +1,382 @@
+package estimations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/logger"
+	"scrumlr.io/server/notes"
+	"scrumlr.io/server/realtime"
+)
+
+var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/estimations")
+var meter metric.Meter = otel.Meter("scrumlr.io/server/estimations")
+
+type EstimationDatabase interface {
+	Create(ctx context.Context, insert DatabaseEstimationInsert) (DatabaseEstimation, error)
+	Get(ctx context.Context, board, id uuid.UUID) (DatabaseEstimation, error)
+	GetAll(ctx context.Context, board uuid.UUID) ([]DatabaseEstimation, error)
+	GetInProgress(ctx context.Context, board uuid.UUID) (DatabaseEstimation, error)
+	Update(ctx context.Context, update DatabaseEstimationUpdate) (DatabaseEstimation, error)
+	GetEstimates(ctx context.Context, board uuid.UUID) ([]DatabaseEstimate, error)
+	SubmitEstimate(ctx context.Context, estimate DatabaseEstimate) (DatabaseEstimate, error)
+	NoteExists(ctx context.Context, board, note uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	database                 EstimationDatabase
+	realtime                 *realtime.Broker
+	notesService             notes.NotesService
+	boardLastModifiedUpdater common.BoardLastModifiedUpdater
+}
+
+func NewEstimationService(db EstimationDatabase, rt *realtime.Broker, notesService notes.NotesService, boardLastModifiedUpdater common.BoardLastModifiedUpdater) EstimationService {
+	service := new(Service)
+	service.database = db
+	service.realtime = rt
+	service.notesService = notesService
+	service.boardLastModifiedUpdater = boardLastModifiedUpdater
+
+	return service
+}
+
+const errUnableToUpdateLastModified = "unable to update last modified"
+
+func (service *Service) Create(ctx context.Context, body EstimationCreateRequest) (*Estimation, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.estimations.service.create")
+	defer span.End()
+
+	if body.Deck == "" {
+		body.Deck = FibonacciDeck
+	}
+
+	span.SetAttributes(
+		attribute.String("scrumlr.estimations.service.create.board", body.Board.String()),
+		attribute.String("scrumlr.estimations.service.create.note", body.Note.String()),
+		attribute.String("scrumlr.estimations.service.create.deck", string(body.Deck)),
+	)
+
+	exists, err := service.database.NoteExists(ctx, body.Board, body.Note)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to check note")
+		span.RecordError(err)
+		log.Errorw("unable to check note of estimation", "board", body.Board, "note", body.Note, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to check note", err)
+	}
+	if !exists {
+		err := CreateEstimationError(NotFound, "note not found", errors.New("note not found"))
+		span.SetStatus(codes.Error, "note not found")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	_, err = service.database.GetInProgress(ctx, body.Board)
+	if err == nil {
+		err := CreateEstimationError(Conflict, "only a single estimation round can be in progress on a board", errors.New("estimation round in progress"))
+		span.SetStatus(codes.Error, "estimation round in progress")
+		span.RecordError(err)
+		return nil, err
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, "failed to get estimation round in progress")
+		span.RecordError(err)
+		log.Errorw("unable to get estimation round in progress", "board", body.Board, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to get estimation round in progress", err)
+	}
+
+	estimation, err := service.database.Create(ctx, DatabaseEstimationInsert{
+		Board:  body.Board,
+		Note:   body.Note,
+		Deck:   body.Deck,
+		Status: Open,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create estimation round")
+		span.RecordError(err)
+		log.Errorw("unable to create estimation round", "board", body.Board, "note", body.Note, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to create estimation round", err)
+	}
+
+	if err := service.boardLastModifiedUpdater.UpdateLastModified(ctx, body.Board, time.Now()); err != nil {
+		log.Warnw(errUnableToUpdateLastModified, "board", body.Board, "err", err)
+	}
+
+	result := new(Estimation).From(estimation, nil)
+	service.broadcast(ctx, body.Board, realtime.BoardEventEstimationStarted, result)
+	estimationStartedCounter.Add(ctx, 1)
+	return result, nil
+}
+
+func (service *Service) Get(ctx context.Context, board, id uuid.UUID) (*Estimation, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.estimations.service.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.estimations.service.get.board", board.String()),
+		attribute.String("scrumlr.estimations.service.get.estimation", id.String()),
+	)
+
+	estimation, err := service.get(ctx, board, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimation round")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return service.withEstimates(ctx, estimation)
+}
+
+func (service *Service) GetAll(ctx context.Context, board uuid.UUID) ([]*Estimation, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.estimations.service.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.estimations.service.get.all.board", board.String()),
+	)
+
+	estimations, err := service.database.GetAll(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimation rounds")
+		span.RecordError(err)
+		log.Errorw("unable to get estimation rounds", "board", board, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to get estimation rounds", err)
+	}
+
+	estimates, err := service.database.GetEstimates(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimates")
+		span.RecordError(err)
+		log.Errorw("unable to get estimates", "board", board, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to get estimates", err)
+	}
+
+	return Estimations(estimations, estimates), nil
+}
+
+// GetEstimatesByUser gets the cards the user picked in all estimation rounds of the board
+func (service *Service) GetEstimatesByUser(ctx context.Context, board, user uuid.UUID) ([]*Estimate, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.estimations.service.get.estimates.user")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.estimations.service.get.estimates.user.board", board.String()),
+		attribute.String("scrumlr.estimations.service.get.estimates.user.user", user.String()),
+	)
+
+	estimates, err := service.database.GetEstimates(ctx, board)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimates")
+		span.RecordError(err)
+		log.Errorw("unable to get estimates", "board", board, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to get estimates", err)
+	}
+
+	userEstimates := make([]*Estimate, 0)
+	for _, estimate := range estimates {
+		if estimate.User == user {
+			userEstimates = append(userEstimates, new(Estimate).From(estimate))
+		}
+	}
+
+	return userEstimates, nil
+}
+
+func (service *Service) Update(ctx context.Context, body EstimationUpdateRequest) (*Estimation, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.estimations.service.update")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.estimations.service.update.board", body.Board.String()),
+		attribute.String("scrumlr.estimations.service.update.estimation", body.ID.String()),
+		attribute.String("scrumlr.estimations.service.update.status", string(body.Status)),
+	)
+
+	current, err := service.get(ctx, body.Board, body.ID)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimation round")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := validateUpdate(current, body); err != nil {
+		span.SetStatus(codes.Error, "invalid update of estimation round")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if body.Estimate != nil {
+		// the estimate is stored on the note first, so the round stays revealed if that fails
+		if _, err := service.notesService.SetEstimate(ctx, body.Board, current.Note, body.Estimate); err != nil {
+			span.SetStatus(codes.Error, "failed to store estimate on note")
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	estimation, err := service.database.Update(ctx, DatabaseEstimationUpdate{
+		ID:       body.ID,
+		Board:    body.Board,
+		Status:   body.Status,
+		Estimate: body.Estimate,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to update estimation round")
+		span.RecordError(err)
+		log.Errorw("unable to update estimation round", "board", body.Board, "estimation", body.ID, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to update estimation round", err)
+	}
+
+	if err := service.boardLastModifiedUpdater.UpdateLastModified(ctx, body.Board, time.Now()); err != nil {
+		log.Warnw(errUnableToUpdateLastModified, "board", body.Board, "err", err)
+	}
+
+	result, err := service.withEstimates(ctx, estimation)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimates")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if body.Status == Revealed {
+		service.broadcast(ctx, body.Board, realtime.BoardEventEstimationRevealed, result)
+	} else {
+		service.broadcast(ctx, body.Board, realtime.BoardEventEstimationClosed, result)
+		estimationClosedCounter.Add(ctx, 1)
+	}
+	return result, nil
+}
+
+func (service *Service) SubmitEstimate(ctx context.Context, body EstimateRequest) (*Estimate, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.estimations.service.submit")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.estimations.service.submit.board", body.Board.String()),
+		attribute.String("scrumlr.estimations.service.submit.estimation", body.Estimation.String()),
+		attribute.String("scrumlr.estimations.service.submit.user", body.User.String()),
+	)
+
+	estimation, err := service.get(ctx, body.Board, body.Estimation)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get estimation round")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if estimation.Status != Open {
+		err := CreateEstimationError(BadRequest, "estimates can only be submitted while the estimation round is open", errors.New("estimation round not open"))
+		span.SetStatus(codes.Error, "estimation round not open")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if !estimation.Deck.Contains(body.Card) {
+		err := CreateEstimationError(BadRequest, "the card is not part of the deck of the estimation round", errors.New("invalid card"))
+		span.SetStatus(codes.Error, "invalid card")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	estimate, err := service.database.SubmitEstimate(ctx, DatabaseEstimate{
+		Estimation: body.Estimation,
+		User:       body.User,
+		Card:       body.Card,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to submit estimate")
+		span.RecordError(err)
+		log.Errorw("unable to submit estimate", "board", body.Board, "estimation", body.Estimation, "user", body.User, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to submit estimate", err)
+	}
+
+	if err := service.boardLastModifiedUpdater.UpdateLastModified(ctx, body.Board, time.Now()); err != nil {
+		log.Warnw(errUnableToUpdateLastModified, "board", body.Board, "err", err)
+	}
+
+	// the card stays hidden from the other participants until the round is revealed
+	service.broadcast(ctx, body.Board, realtime.BoardEventEstimateSubmitted, Estimate{Estimation: estimate.Estimation, User: estimate.User})
+	estimateSubmittedCounter.Add(ctx, 1)
+	return new(Estimate).From(estimate), nil
+}
+
+func (service *Service) get(ctx context.Context, board, id uuid.UUID) (DatabaseEstimation, error) {
+	log := logger.FromContext(ctx)
+
+	estimation, err := service.database.Get(ctx, board, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return estimation, CreateEstimationError(NotFound, "estimation round not found", err)
+		}
+
+		log.Errorw("unable to get estimation round", "board", board, "estimation", id, "err", err)
+		return estimation, CreateEstimationError(Internal, "failed to get estimation round", err)
+	}
+
+	return estimation, nil
+}
+
+func (service *Service) withEstimates(ctx context.Context, estimation DatabaseEstimation) (*Estimation, error) {
+	log := logger.FromContext(ctx)
+
+	estimates, err := service.database.GetEstimates(ctx, estimation.Board)
+	if err != nil {
+		log.Errorw("unable to get estimates", "board", estimation.Board, "err", err)
+		return nil, CreateEstimationError(Internal, "failed to get estimates", err)
+	}
+
+	return new(Estimation).From(estimation, estimates), nil
+}
+
+// validateUpdate checks that an estimation round is only revealed while open and closed at most once,
+// with a final estimate from its deck that can only be set after the estimates were revealed.
+func validateUpdate(current DatabaseEstimation, body EstimationUpdateRequest) error {
+	switch {
+	case body.Status != Revealed && body.Status != Closed:
+		return CreateEstimationError(BadRequest, "an estimation round can only be revealed or closed", errors.New("invalid status"))
+	case current.Status == Closed:
+		return CreateEstimationError(BadRequest, "the estimation round is already closed", errors.New("estimation round closed"))
+	case body.Status == Revealed && current.Status == Revealed:
+		return CreateEstimationError(BadRequest, "the estimates are already revealed", errors.New("estimation round revealed"))
+	case body.Estimate == nil:
+		return nil
+	case body.Status != Closed || current.Status != Revealed:
+		return CreateEstimationError(BadRequest, "the final estimate can only be set when closing a revealed estimation round", errors.New("estimate not allowed"))
+	case !current.Deck.Contains(*body.Estimate) || *body.Estimate == unsureCard:
+		return CreateEstimationError(BadRequest, "the final estimate has to be a card of the deck of the estimation round", errors.New("invalid estimate"))
+	}
+	return nil
+}
+
+func (service *Service) broadcast(ctx context.Context, board uuid.UUID, eventType realtime.BoardEventType, data any) {
+	ctx, span := tracer.Start(ctx, "scrumlr.estimations.service.broadcast")
+	defer span.End()
+
+	err := service.realtime.BroadcastToBoard(
+		ctx,
+		board,
+		realtime.BoardEvent{
+			Type: eventType,
+			Data: data,
+		},
+	)
+
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to send estimation message")
+		span.RecordError(err)
+		logger.FromContext(ctx).Errorw("unable to broadcast estimation", "board", board, "type", eventType, "err", err)
+	}
+}
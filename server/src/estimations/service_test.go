@@ -0,0 +1,508 @@
+package estimations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/notes"
+	"scrumlr.io/server/realtime"
+)
+
+func TestCreateEstimation(t *testing.T) {
+	boardId := uuid.New()
+	noteId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().NoteExists(mock.Anything, boardId, noteId).Return(true, nil)
+	mockDatabase.EXPECT().GetInProgress(mock.Anything, boardId).Return(DatabaseEstimation{}, sql.ErrNoRows)
+	mockDatabase.EXPECT().Create(mock.Anything, DatabaseEstimationInsert{Board: boardId, Note: noteId, Deck: TShirtDeck, Status: Open}).
+		Return(DatabaseEstimation{ID: uuid.New(), Board: boardId, Note: noteId, Deck: TShirtDeck, Status: Open}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		return event.Type == realtime.BoardEventEstimationStarted
+	})).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), mockUpdater)
+
+	estimation, err := service.Create(context.Background(), EstimationCreateRequest{Board: boardId, Note: noteId, Deck: TShirtDeck})
+
+	assert.Nil(t, err)
+	assert.Equal(t, noteId, estimation.Note)
+	assert.Equal(t, Open, estimation.Status)
+	assert.Equal(t, TShirtDeck.Cards(), estimation.Cards)
+	assert.Empty(t, estimation.Estimates)
+}
+
+func TestCreateEstimation_DefaultDeck(t *testing.T) {
+	boardId := uuid.New()
+	noteId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().NoteExists(mock.Anything, boardId, noteId).Return(true, nil)
+	mockDatabase.EXPECT().GetInProgress(mock.Anything, boardId).Return(DatabaseEstimation{}, sql.ErrNoRows)
+	mockDatabase.EXPECT().Create(mock.Anything, DatabaseEstimationInsert{Board: boardId, Note: noteId, Deck: FibonacciDeck, Status: Open}).
+		Return(DatabaseEstimation{ID: uuid.New(), Board: boardId, Note: noteId, Deck: FibonacciDeck, Status: Open}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		return event.Type == realtime.BoardEventEstimationStarted
+	})).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), mockUpdater)
+
+	estimation, err := service.Create(context.Background(), EstimationCreateRequest{Board: boardId, Note: noteId})
+
+	assert.Nil(t, err)
+	assert.Equal(t, FibonacciDeck, estimation.Deck)
+}
+
+func TestCreateEstimation_NoteOfOtherBoard(t *testing.T) {
+	boardId := uuid.New()
+	noteId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().NoteExists(mock.Anything, boardId, noteId).Return(false, nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimation, err := service.Create(context.Background(), EstimationCreateRequest{Board: boardId, Note: noteId})
+
+	assert.Nil(t, estimation)
+	var estimationErr EstimationError
+	assert.ErrorAs(t, err, &estimationErr)
+	assert.Equal(t, NotFound, estimationErr.Category)
+}
+
+func TestCreateEstimation_RoundInProgress(t *testing.T) {
+	boardId := uuid.New()
+	noteId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().NoteExists(mock.Anything, boardId, noteId).Return(true, nil)
+	mockDatabase.EXPECT().GetInProgress(mock.Anything, boardId).
+		Return(DatabaseEstimation{ID: uuid.New(), Board: boardId, Note: uuid.New(), Deck: FibonacciDeck, Status: Revealed}, nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimation, err := service.Create(context.Background(), EstimationCreateRequest{Board: boardId, Note: noteId})
+
+	assert.Nil(t, estimation)
+	var estimationErr EstimationError
+	assert.ErrorAs(t, err, &estimationErr)
+	assert.Equal(t, Conflict, estimationErr.Category)
+}
+
+func TestCreateEstimation_DatabaseError(t *testing.T) {
+	boardId := uuid.New()
+	noteId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().NoteExists(mock.Anything, boardId, noteId).Return(true, nil)
+	mockDatabase.EXPECT().GetInProgress(mock.Anything, boardId).Return(DatabaseEstimation{}, sql.ErrNoRows)
+	mockDatabase.EXPECT().Create(mock.Anything, mock.Anything).Return(DatabaseEstimation{}, errors.New("database error"))
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimation, err := service.Create(context.Background(), EstimationCreateRequest{Board: boardId, Note: noteId})
+
+	assert.Nil(t, estimation)
+	var estimationErr EstimationError
+	assert.ErrorAs(t, err, &estimationErr)
+	assert.Equal(t, Internal, estimationErr.Category)
+}
+
+func TestGetEstimation_HidesCardsWhileOpen(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Open}, nil)
+	mockDatabase.EXPECT().GetEstimates(mock.Anything, boardId).Return([]DatabaseEstimate{
+		{Estimation: estimationId, User: userId, Card: "5"},
+		{Estimation: uuid.New(), User: userId, Card: "8"},
+	}, nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimation, err := service.Get(context.Background(), boardId, estimationId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Estimate{{Estimation: estimationId, User: userId}}, estimation.Estimates)
+}
+
+func TestGetEstimation_ShowsCardsWhenRevealed(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Revealed}, nil)
+	mockDatabase.EXPECT().GetEstimates(mock.Anything, boardId).Return([]DatabaseEstimate{
+		{Estimation: estimationId, User: userId, Card: "5"},
+	}, nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimation, err := service.Get(context.Background(), boardId, estimationId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Estimate{{Estimation: estimationId, User: userId, Card: "5"}}, estimation.Estimates)
+}
+
+func TestGetEstimation_NotFound(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).Return(DatabaseEstimation{}, sql.ErrNoRows)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimation, err := service.Get(context.Background(), boardId, estimationId)
+
+	assert.Nil(t, estimation)
+	var estimationErr EstimationError
+	assert.ErrorAs(t, err, &estimationErr)
+	assert.Equal(t, NotFound, estimationErr.Category)
+}
+
+func TestGetAllEstimations(t *testing.T) {
+	boardId := uuid.New()
+	firstId := uuid.New()
+	secondId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().GetAll(mock.Anything, boardId).Return([]DatabaseEstimation{
+		{ID: firstId, Board: boardId, Deck: FibonacciDeck, Status: Closed},
+		{ID: secondId, Board: boardId, Deck: TShirtDeck, Status: Open},
+	}, nil)
+	mockDatabase.EXPECT().GetEstimates(mock.Anything, boardId).Return([]DatabaseEstimate{
+		{Estimation: firstId, User: userId, Card: "3"},
+		{Estimation: secondId, User: userId, Card: "L"},
+	}, nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimations, err := service.GetAll(context.Background(), boardId)
+
+	assert.Nil(t, err)
+	assert.Len(t, estimations, 2)
+	assert.Equal(t, "3", estimations[0].Estimates[0].Card)
+	assert.Equal(t, "", estimations[1].Estimates[0].Card)
+}
+
+func TestRevealEstimation(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Open}, nil)
+	mockDatabase.EXPECT().Update(mock.Anything, DatabaseEstimationUpdate{ID: estimationId, Board: boardId, Status: Revealed}).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Revealed}, nil)
+	mockDatabase.EXPECT().GetEstimates(mock.Anything, boardId).Return([]DatabaseEstimate{
+		{Estimation: estimationId, User: userId, Card: "13"},
+	}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		return event.Type == realtime.BoardEventEstimationRevealed
+	})).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), mockUpdater)
+
+	estimation, err := service.Update(context.Background(), EstimationUpdateRequest{ID: estimationId, Board: boardId, Status: Revealed})
+
+	assert.Nil(t, err)
+	assert.Equal(t, Revealed, estimation.Status)
+	assert.Equal(t, "13", estimation.Estimates[0].Card)
+}
+
+func TestCloseEstimation_StoresEstimateOnNote(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+	noteId := uuid.New()
+	estimate := "M"
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	mockNotes := notes.NewMockNotesService(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Note: noteId, Deck: TShirtDeck, Status: Revealed}, nil)
+	mockNotes.EXPECT().SetEstimate(mock.Anything, boardId, noteId, &estimate).Return(&notes.Note{ID: noteId, Estimate: &estimate}, nil)
+	mockDatabase.EXPECT().Update(mock.Anything, DatabaseEstimationUpdate{ID: estimationId, Board: boardId, Status: Closed, Estimate: &estimate}).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Note: noteId, Deck: TShirtDeck, Status: Closed, Estimate: &estimate}, nil)
+	mockDatabase.EXPECT().GetEstimates(mock.Anything, boardId).Return([]DatabaseEstimate{}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		return event.Type == realtime.BoardEventEstimationClosed
+	})).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewEstimationService(mockDatabase, broker, mockNotes, mockUpdater)
+
+	estimation, err := service.Update(context.Background(), EstimationUpdateRequest{ID: estimationId, Board: boardId, Status: Closed, Estimate: &estimate})
+
+	assert.Nil(t, err)
+	assert.Equal(t, Closed, estimation.Status)
+	assert.Equal(t, &estimate, estimation.Estimate)
+}
+
+func TestCloseEstimation_WithoutEstimate(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Open}, nil)
+	mockDatabase.EXPECT().Update(mock.Anything, DatabaseEstimationUpdate{ID: estimationId, Board: boardId, Status: Closed}).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Closed}, nil)
+	mockDatabase.EXPECT().GetEstimates(mock.Anything, boardId).Return([]DatabaseEstimate{}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		return event.Type == realtime.BoardEventEstimationClosed
+	})).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), mockUpdater)
+
+	estimation, err := service.Update(context.Background(), EstimationUpdateRequest{ID: estimationId, Board: boardId, Status: Closed})
+
+	assert.Nil(t, err)
+	assert.Equal(t, Closed, estimation.Status)
+	assert.Nil(t, estimation.Estimate)
+}
+
+func TestCloseEstimation_NoteError(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+	noteId := uuid.New()
+	estimate := "5"
+	noteErr := notes.CreateNoteError(notes.Internal, "failed to set estimate", errors.New("database error"))
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	mockNotes := notes.NewMockNotesService(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Note: noteId, Deck: FibonacciDeck, Status: Revealed}, nil)
+	mockNotes.EXPECT().SetEstimate(mock.Anything, boardId, noteId, &estimate).Return(nil, noteErr)
+
+	service := NewEstimationService(mockDatabase, broker, mockNotes, common.NewMockBoardLastModifiedUpdater(t))
+
+	estimation, err := service.Update(context.Background(), EstimationUpdateRequest{ID: estimationId, Board: boardId, Status: Closed, Estimate: &estimate})
+
+	assert.Nil(t, estimation)
+	assert.Equal(t, noteErr, err)
+}
+
+func TestUpdateEstimation_InvalidTransitions(t *testing.T) {
+	fibonacci := "5"
+	tShirt := "M"
+	unsure := "?"
+
+	tests := []struct {
+		name    string
+		current EstimationStatus
+		status  EstimationStatus
+		value   *string
+	}{
+		{name: "reopen", current: Revealed, status: Open},
+		{name: "reveal twice", current: Revealed, status: Revealed},
+		{name: "reveal closed round", current: Closed, status: Revealed},
+		{name: "close twice", current: Closed, status: Closed},
+		{name: "estimate when revealing", current: Open, status: Revealed, value: &fibonacci},
+		{name: "estimate before revealing", current: Open, status: Closed, value: &fibonacci},
+		{name: "estimate not in deck", current: Revealed, status: Closed, value: &tShirt},
+		{name: "unsure estimate", current: Revealed, status: Closed, value: &unsure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boardId := uuid.New()
+			estimationId := uuid.New()
+
+			mockDatabase := NewMockEstimationDatabase(t)
+			broker := new(realtime.Broker)
+			broker.Con = realtime.NewMockClient(t)
+
+			mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+				Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: tt.current}, nil)
+
+			service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+			estimation, err := service.Update(context.Background(), EstimationUpdateRequest{ID: estimationId, Board: boardId, Status: tt.status, Estimate: tt.value})
+
+			assert.Nil(t, estimation)
+			var estimationErr EstimationError
+			assert.ErrorAs(t, err, &estimationErr)
+			assert.Equal(t, BadRequest, estimationErr.Category)
+		})
+	}
+}
+
+func TestSubmitEstimate(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+	mockUpdater := common.NewMockBoardLastModifiedUpdater(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Open}, nil)
+	mockDatabase.EXPECT().SubmitEstimate(mock.Anything, DatabaseEstimate{Estimation: estimationId, User: userId, Card: "8"}).
+		Return(DatabaseEstimate{Estimation: estimationId, User: userId, Card: "8"}, nil)
+	mockBroker.EXPECT().Publish(mock.Anything, "board."+boardId.String(), mock.MatchedBy(func(event realtime.BoardEvent) bool {
+		// the card must not be sent to the other participants
+		return event.Type == realtime.BoardEventEstimateSubmitted && event.Data == Estimate{Estimation: estimationId, User: userId}
+	})).Return(nil)
+	mockUpdater.EXPECT().UpdateLastModified(mock.Anything, boardId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), mockUpdater)
+
+	estimate, err := service.SubmitEstimate(context.Background(), EstimateRequest{Estimation: estimationId, Board: boardId, User: userId, Card: "8"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "8", estimate.Card)
+}
+
+func TestSubmitEstimate_CardNotInDeck(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Open}, nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimate, err := service.SubmitEstimate(context.Background(), EstimateRequest{Estimation: estimationId, Board: boardId, User: uuid.New(), Card: "XL"})
+
+	assert.Nil(t, estimate)
+	var estimationErr EstimationError
+	assert.ErrorAs(t, err, &estimationErr)
+	assert.Equal(t, BadRequest, estimationErr.Category)
+}
+
+func TestSubmitEstimate_RoundRevealed(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).
+		Return(DatabaseEstimation{ID: estimationId, Board: boardId, Deck: FibonacciDeck, Status: Revealed}, nil)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimate, err := service.SubmitEstimate(context.Background(), EstimateRequest{Estimation: estimationId, Board: boardId, User: uuid.New(), Card: "8"})
+
+	assert.Nil(t, estimate)
+	var estimationErr EstimationError
+	assert.ErrorAs(t, err, &estimationErr)
+	assert.Equal(t, BadRequest, estimationErr.Category)
+}
+
+func TestSubmitEstimate_NotFound(t *testing.T) {
+	boardId := uuid.New()
+	estimationId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	mockDatabase.EXPECT().Get(mock.Anything, boardId, estimationId).Return(DatabaseEstimation{}, sql.ErrNoRows)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimate, err := service.SubmitEstimate(context.Background(), EstimateRequest{Estimation: estimationId, Board: boardId, User: uuid.New(), Card: "8"})
+
+	assert.Nil(t, estimate)
+	var estimationErr EstimationError
+	assert.ErrorAs(t, err, &estimationErr)
+	assert.Equal(t, NotFound, estimationErr.Category)
+}
+
+func TestGetEstimatesByUser(t *testing.T) {
+	boardId := uuid.New()
+	userId := uuid.New()
+	estimationId := uuid.New()
+
+	mockDatabase := NewMockEstimationDatabase(t)
+	mockDatabase.EXPECT().GetEstimates(mock.Anything, boardId).Return([]DatabaseEstimate{
+		{Estimation: estimationId, User: userId, Card: "5"},
+		{Estimation: estimationId, User: uuid.New(), Card: "8"},
+	}, nil)
+
+	broker := new(realtime.Broker)
+	broker.Con = realtime.NewMockClient(t)
+
+	service := NewEstimationService(mockDatabase, broker, notes.NewMockNotesService(t), common.NewMockBoardLastModifiedUpdater(t))
+
+	estimates, err := service.GetEstimatesByUser(context.Background(), boardId, userId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*Estimate{{Estimation: estimationId, User: userId, Card: "5"}}, estimates)
+}
@@ -3,21 +3,31 @@ package identifiers
 type boardIdentifier string
 type userIdentifier string
 type noteIdentifier string
+type noteRevisionIdentifier string
 type columnIdentifier string
 type reactionIdentifier string
 type votingIdentifier string
 type boardEditableIdentifier string
 type boardTemplateIdentifier string
 type columnTemplateIdentifier string
+type actionItemIdentifier string
+type estimationIdentifier string
+type teamIdentifier string
+type apiTokenIdentifier string
 
 const (
 	BoardIdentifier          boardIdentifier          = "Board"
 	UserIdentifier           userIdentifier           = "User"
 	NoteIdentifier           noteIdentifier           = "Note"
+	NoteRevisionIdentifier   noteRevisionIdentifier   = "NoteRevision"
 	ColumnIdentifier         columnIdentifier         = "Column"
 	ReactionIdentifier       reactionIdentifier       = "Reaction"
 	VotingIdentifier         votingIdentifier         = "Voting"
 	BoardEditableIdentifier  boardEditableIdentifier  = "BoardEditable"
 	BoardTemplateIdentifier  boardTemplateIdentifier  = "BoardTemplate"
 	ColumnTemplateIdentifier columnTemplateIdentifier = "ColumnTemplate"
+	ActionItemIdentifier     actionItemIdentifier     = "ActionItem"
+	EstimationIdentifier     estimationIdentifier     = "Estimation"
+	TeamIdentifier           teamIdentifier           = "Team"
+	ApiTokenIdentifier       apiTokenIdentifier       = "ApiToken"
 )
@@ -30,16 +30,25 @@ var Migrations embed.FS
 var traceProvider trace.TracerProvider = otel.GetTracerProvider()
 var meterProvider metric.MeterProvider = otel.GetMeterProvider()
 
+// DatabaseURL returns the connection url of the configured database
+func DatabaseURL(ctx *cli.Context) (string, error) {
+	if ctx.String("database") != "" {
+		return ctx.String("database"), nil
+	}
+
+	if ctx.String("database-host") != "" && ctx.String("database-username") != "" && ctx.String("database-password") != "" {
+		return fmt.Sprintf("postgresql://%s:%s@%s", ctx.String("database-username"), ctx.String("database-password"), ctx.String("database-host")), nil
+	}
+
+	return "", errors.New("no valid database connection found")
+}
+
 func InitializeDatabase(ctx *cli.Context) (*bun.DB, error) {
 	log := logger.FromContext(ctx.Context)
 
-	var databaseUrl string
-	if ctx.String("database") != "" {
-		databaseUrl = ctx.String("database")
-	} else if ctx.String("database-host") != "" && ctx.String("database-username") != "" && ctx.String("database-password") != "" {
-		databaseUrl = fmt.Sprintf("postgresql://%s:%s@%s", ctx.String("database-username"), ctx.String("database-password"), ctx.String("database-host"))
-	} else {
-		return nil, errors.New("no valid database connection found")
+	databaseUrl, err := DatabaseURL(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	db, err := sql.Open("postgres", databaseUrl)
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS action_items;
+DROP TYPE IF EXISTS action_item_status;
@@ -0,0 +1,18 @@
+/* action items are the outcome of a retrospective. they belong to a board,
+    may be assigned to one of its participants and can optionally point back
+    to the note they originated from. */
+CREATE TYPE action_item_status AS ENUM ('OPEN', 'IN_PROGRESS', 'DONE');
+
+CREATE TABLE action_items (
+    "id" UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    "board" UUID NOT NULL REFERENCES boards ON DELETE CASCADE,
+    -- keep the action item if the originating note or the assigned user is removed
+    "note" UUID REFERENCES notes ON DELETE SET NULL,
+    "assignee" UUID REFERENCES users ON DELETE SET NULL,
+    "text" VARCHAR(2048) NOT NULL CHECK ("text" <> ''),
+    "status" action_item_status NOT NULL DEFAULT 'OPEN',
+    "due_date" TIMESTAMPTZ
+);
+
+CREATE INDEX action_items_board_index ON action_items (board);
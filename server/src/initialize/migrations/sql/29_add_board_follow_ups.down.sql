@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS boards_follow_up_of_index;
+ALTER TABLE IF EXISTS boards DROP COLUMN IF EXISTS follow_up_of;
@@ -0,0 +1,3 @@
+-- a board can be the follow-up of a previous board, which chains boards into a series
+ALTER TABLE IF EXISTS boards ADD COLUMN follow_up_of UUID REFERENCES boards ON DELETE SET NULL;
+CREATE INDEX boards_follow_up_of_index ON boards (follow_up_of);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS board_templates_team_index;
+ALTER TABLE IF EXISTS board_templates DROP COLUMN IF EXISTS team;
+DROP INDEX IF EXISTS boards_team_index;
+ALTER TABLE IF EXISTS boards DROP COLUMN IF EXISTS team;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
+DROP TYPE IF EXISTS team_role;
@@ -0,0 +1,26 @@
+/* teams group users so boards and board templates can be shared with all members
+    at once instead of joining every board individually. */
+CREATE TYPE team_role AS ENUM ('OWNER', 'ADMIN', 'MEMBER');
+
+CREATE TABLE teams (
+    "id" UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    "name" VARCHAR(128) NOT NULL CHECK ("name" <> '')
+);
+
+CREATE TABLE team_members (
+    "team" UUID NOT NULL REFERENCES teams ON DELETE CASCADE,
+    "user" UUID NOT NULL REFERENCES users ON DELETE CASCADE,
+    "role" team_role NOT NULL DEFAULT 'MEMBER',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY ("team", "user")
+);
+
+CREATE INDEX team_members_user_index ON team_members ("user");
+
+-- boards and templates stay available to their creator if the team is deleted
+ALTER TABLE IF EXISTS boards ADD COLUMN team UUID REFERENCES teams ON DELETE SET NULL;
+CREATE INDEX boards_team_index ON boards (team);
+
+ALTER TABLE IF EXISTS board_templates ADD COLUMN team UUID REFERENCES teams ON DELETE SET NULL;
+CREATE INDEX board_templates_team_index ON board_templates (team);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS boards_last_modified_at_index;
+ALTER TABLE IF EXISTS boards DROP COLUMN IF EXISTS retention_days;
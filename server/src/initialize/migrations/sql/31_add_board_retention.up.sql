@@ -0,0 +1,3 @@
+-- number of days a board is kept after its last modification, NULL falls back to the server-wide setting
+ALTER TABLE IF EXISTS boards ADD COLUMN retention_days INTEGER CHECK (retention_days > 0);
+CREATE INDEX boards_last_modified_at_index ON boards (last_modified_at);
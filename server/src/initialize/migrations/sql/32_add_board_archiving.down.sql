@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS boards DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,2 @@
+-- archived boards are read-only and hidden from the board overview by default
+ALTER TABLE IF EXISTS boards ADD COLUMN archived_at timestamptz;
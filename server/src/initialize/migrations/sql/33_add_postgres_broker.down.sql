@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS cache_list_entries;
+DROP TABLE IF EXISTS cache_counters;
+DROP TABLE IF EXISTS cache_entries;
+DROP TABLE IF EXISTS realtime_events;
@@ -0,0 +1,31 @@
+/* tables for running the message broker and the cache on postgres instead of nats or redis.
+    Their content is short-lived, so the tables are unlogged and get emptied after a crash. */
+CREATE UNLOGGED TABLE realtime_events (
+    "id" BIGSERIAL PRIMARY KEY,
+    "subject" TEXT NOT NULL,
+    "payload" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX realtime_events_created_at_index ON realtime_events (created_at);
+
+CREATE UNLOGGED TABLE cache_entries (
+    "key" TEXT PRIMARY KEY,
+    "value" BYTEA NOT NULL,
+    "expires_at" TIMESTAMPTZ
+);
+
+CREATE INDEX cache_entries_expires_at_index ON cache_entries (expires_at);
+
+CREATE UNLOGGED TABLE cache_counters (
+    "key" TEXT PRIMARY KEY,
+    "value" BIGINT NOT NULL
+);
+
+CREATE UNLOGGED TABLE cache_list_entries (
+    "id" BIGSERIAL PRIMARY KEY,
+    "key" TEXT NOT NULL,
+    "value" BYTEA NOT NULL
+);
+
+CREATE INDEX cache_list_entries_key_index ON cache_list_entries (key, id);
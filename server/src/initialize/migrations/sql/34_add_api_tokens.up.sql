@@ -0,0 +1,14 @@
+/* personal access tokens let users authenticate scripts and integrations with a bearer token,
+    only the sha256 hash of the token is stored. */
+CREATE TABLE api_tokens (
+    "id" UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    "user" UUID NOT NULL REFERENCES users ON DELETE CASCADE,
+    "name" VARCHAR(64) NOT NULL CHECK ("name" <> ''),
+    "token_hash" CHAR(64) NOT NULL UNIQUE,
+    "read_only" BOOLEAN NOT NULL DEFAULT false,
+    "expires_at" TIMESTAMPTZ,
+    "last_used_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX api_tokens_user_index ON api_tokens ("user");
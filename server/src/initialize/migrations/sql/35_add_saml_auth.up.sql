@@ -0,0 +1,9 @@
+alter type account_type add value 'SAML';
+
+create table saml_users
+(
+    "user"     uuid         not null references users ON DELETE CASCADE,
+    id         varchar(256) not null unique,
+    name       varchar(64)  not null,
+    avatar_url varchar(256)
+);
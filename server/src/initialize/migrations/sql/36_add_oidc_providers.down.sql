@@ -0,0 +1,7 @@
+delete from oidc_users where provider <> 'oidc';
+
+alter table oidc_users drop constraint if exists oidc_users_issuer_id_key;
+alter table oidc_users add constraint oidc_users_id_key unique (id);
+
+alter table oidc_users drop column if exists issuer;
+alter table oidc_users drop column if exists provider;
@@ -0,0 +1,7 @@
+/* users of the OIDC providers are kept apart by the issuer of their provider, the name of the provider is only the route
+   of the login and may change. the issuer of existing users is unknown until their next login. */
+alter table oidc_users add column provider varchar(64) not null default 'oidc';
+alter table oidc_users add column issuer varchar(256) not null default '';
+
+alter table oidc_users drop constraint if exists oidc_users_id_key;
+alter table oidc_users add constraint oidc_users_issuer_id_key unique (issuer, id);
@@ -0,0 +1,7 @@
+alter table apple_users drop constraint if exists apple_users_user_key;
+alter table azure_ad_users drop constraint if exists azure_ad_users_user_key;
+alter table github_users drop constraint if exists github_users_user_key;
+alter table google_users drop constraint if exists google_users_user_key;
+alter table microsoft_users drop constraint if exists microsoft_users_user_key;
+alter table oidc_users drop constraint if exists oidc_users_user_issuer_key;
+alter table saml_users drop constraint if exists saml_users_user_key;
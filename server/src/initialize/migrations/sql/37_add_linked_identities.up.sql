@@ -0,0 +1,8 @@
+-- a user can link one account of every provider
+alter table apple_users add constraint apple_users_user_key unique ("user");
+alter table azure_ad_users add constraint azure_ad_users_user_key unique ("user");
+alter table github_users add constraint github_users_user_key unique ("user");
+alter table google_users add constraint google_users_user_key unique ("user");
+alter table microsoft_users add constraint microsoft_users_user_key unique ("user");
+alter table oidc_users add constraint oidc_users_user_issuer_key unique ("user", issuer);
+alter table saml_users add constraint saml_users_user_key unique ("user");
@@ -0,0 +1,4 @@
+drop table if exists admin_actions;
+
+alter table users drop column if exists banned;
+alter table users drop column if exists admin;
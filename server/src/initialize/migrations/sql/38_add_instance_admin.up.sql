@@ -0,0 +1,14 @@
+alter table users add column admin boolean not null default false;
+alter table users add column banned boolean not null default false;
+
+/* actions of instance administrators, which are kept after the board or user they targeted is deleted */
+create table admin_actions
+(
+    id         uuid        default gen_random_uuid() not null primary key,
+    actor      uuid        references users on delete set null,
+    action     varchar(32)                           not null,
+    target     uuid                                  not null,
+    created_at timestamptz default now()             not null
+);
+
+create index admin_actions_created_at_index on admin_actions (created_at);
@@ -0,0 +1,31 @@
+/* append-only log of moderation and board setting changes, entries are only removed together with their board */
+create table audit_log
+(
+    id         uuid        default gen_random_uuid() not null primary key,
+    board      uuid                                  not null references boards on delete cascade,
+    actor      uuid        references users on delete set null,
+    action     varchar(32)                           not null,
+    before     jsonb,
+    after      jsonb,
+    created_at timestamptz default now()             not null
+);
+
+create index audit_log_board_created_at_index on audit_log (board, created_at);
+
+/* only the actor may change, since it is cleared when the user is deleted and moved when users are merged */
+create function prevent_audit_log_update() returns trigger as
+$$
+begin
+    if (new.id, new.board, new.action, new.before, new.after, new.created_at) is distinct from
+       (old.id, old.board, old.action, old.before, old.after, old.created_at) then
+        raise exception 'audit log entries cannot be changed';
+    end if;
+    return new;
+end;
+$$ language plpgsql;
+
+create trigger audit_log_append_only
+    before update
+    on audit_log
+    for each row
+execute function prevent_audit_log_update();
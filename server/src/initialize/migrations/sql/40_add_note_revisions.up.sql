@@ -0,0 +1,19 @@
+/* every version of a note, the latest revision is the current state of the note */
+create table note_revisions
+(
+    id         uuid        default gen_random_uuid() not null primary key,
+    note       uuid                                  not null references notes on delete cascade,
+    author     uuid        references users on delete set null,
+    text       varchar(2048)                         not null,
+    "column"   uuid                                  not null,
+    stack      uuid,
+    rank       int                                   not null,
+    created_at timestamptz default now()             not null
+);
+
+create index note_revisions_note_created_at_index on note_revisions (note, created_at);
+
+/* the current state of existing notes is their first revision */
+insert into note_revisions (note, author, text, "column", stack, rank, created_at)
+select id, author, text, "column", stack, rank, created_at
+from notes;
@@ -0,0 +1,4 @@
+alter table votes drop column if exists rank;
+alter table votes drop column if exists weight;
+alter table votings drop column if exists mode;
+drop type if exists voting_mode;
@@ -0,0 +1,12 @@
+create type voting_mode as enum ('DEFAULT', 'POINTS', 'RANKED', 'SINGLE_CHOICE');
+
+alter table votings
+    add column mode voting_mode not null default 'DEFAULT';
+
+/* the points given with a vote of a point budget voting, 1 for all other modes */
+alter table votes
+    add column weight int not null default 1 check (weight > 0);
+
+/* the position of the note on the ballot of a ranked-choice voting, starting with 1 for the first choice */
+alter table votes
+    add column rank int check (rank > 0);
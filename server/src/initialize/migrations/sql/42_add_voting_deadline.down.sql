@@ -0,0 +1,2 @@
+drop index if exists votings_open_ends_at_idx;
+alter table votings drop column if exists ends_at;
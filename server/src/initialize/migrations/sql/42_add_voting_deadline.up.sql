@@ -0,0 +1,5 @@
+/* open votings are closed automatically once their deadline passed */
+alter table votings
+    add column ends_at timestamptz;
+
+create index votings_open_ends_at_idx on votings (ends_at) where status = 'OPEN' and ends_at is not null;
@@ -0,0 +1,2 @@
+alter table votings drop column if exists scope_notes;
+alter table votings drop column if exists scope_columns;
@@ -0,0 +1,4 @@
+/* a voting can be restricted to the notes of some columns and to single notes, no scope covers the whole board */
+alter table votings
+    add column scope_columns uuid[],
+    add column scope_notes uuid[];
@@ -0,0 +1,5 @@
+ALTER TABLE notes DROP COLUMN IF EXISTS "estimate";
+DROP TABLE IF EXISTS estimates;
+DROP TABLE IF EXISTS estimations;
+DROP TYPE IF EXISTS estimation_status;
+DROP TYPE IF EXISTS estimation_deck;
@@ -0,0 +1,27 @@
+/* estimations are planning poker rounds on a note of a board. the participants
+    pick a card of the deck of the round, which stays hidden until a moderator
+    reveals all estimates. the final estimate is stored on the note. */
+CREATE TYPE estimation_deck AS ENUM ('FIBONACCI', 'T_SHIRT');
+CREATE TYPE estimation_status AS ENUM ('OPEN', 'REVEALED', 'CLOSED');
+
+CREATE TABLE estimations (
+    "id" UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    "board" UUID NOT NULL REFERENCES boards ON DELETE CASCADE,
+    "note" UUID NOT NULL REFERENCES notes ON DELETE CASCADE,
+    "deck" estimation_deck NOT NULL,
+    "status" estimation_status NOT NULL DEFAULT 'OPEN',
+    "estimate" VARCHAR(8)
+);
+
+-- only a single round can be in progress on a board at a time
+CREATE UNIQUE INDEX estimations_board_in_progress_index ON estimations (board) WHERE status <> 'CLOSED';
+
+CREATE TABLE estimates (
+    "estimation" UUID NOT NULL REFERENCES estimations ON DELETE CASCADE,
+    "user" UUID NOT NULL REFERENCES users ON DELETE CASCADE,
+    "card" VARCHAR(8) NOT NULL,
+    PRIMARY KEY ("estimation", "user")
+);
+
+ALTER TABLE notes ADD COLUMN "estimate" VARCHAR(8);
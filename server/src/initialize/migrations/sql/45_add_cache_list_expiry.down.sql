@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS cache_list_entries_expires_at_index;
+DROP INDEX IF EXISTS cache_counters_expires_at_index;
+
+ALTER TABLE cache_list_entries DROP COLUMN IF EXISTS "expires_at";
+ALTER TABLE cache_counters DROP COLUMN IF EXISTS "expires_at";
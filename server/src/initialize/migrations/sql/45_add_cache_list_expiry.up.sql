@@ -0,0 +1,7 @@
+/* counters and lists of the postgres cache expire like the entries,
+    so that the event logs of boards which are not used anymore do not pile up */
+ALTER TABLE cache_counters ADD COLUMN "expires_at" TIMESTAMPTZ;
+ALTER TABLE cache_list_entries ADD COLUMN "expires_at" TIMESTAMPTZ;
+
+CREATE INDEX cache_counters_expires_at_index ON cache_counters (expires_at);
+CREATE INDEX cache_list_entries_expires_at_index ON cache_list_entries (expires_at);
@@ -73,3 +73,18 @@ func InsertVote(db *bun.DB, board uuid.UUID, voting uuid.UUID, user uuid.UUID, n
 	_, err := db.Exec("INSERT INTO \"votes\" (\"board\", \"voting\", \"user\", \"note\") VALUES (?, ?, ?, ?);", board, voting, user, note)
 	return err
 }
+
+func InsertActionItem(db *bun.DB, id uuid.UUID, board uuid.UUID, note uuid.NullUUID, assignee uuid.NullUUID, text string, status string) error {
+	_, err := db.Exec("INSERT INTO \"action_items\" (\"id\", \"board\", \"note\", \"assignee\", \"text\", \"status\") VALUES (?, ?, ?, ?, ?, ?);", id, board, note, assignee, text, status)
+	return err
+}
+
+func InsertTeam(db *bun.DB, id uuid.UUID, name string) error {
+	_, err := db.Exec("INSERT INTO \"teams\" (\"id\", \"name\") VALUES (?, ?);", id, name)
+	return err
+}
+
+func InsertTeamMember(db *bun.DB, team uuid.UUID, user uuid.UUID, role string) error {
+	_, err := db.Exec("INSERT INTO \"team_members\" (\"team\", \"user\", \"role\") VALUES (?, ?, ?);", team, user, role)
+	return err
+}
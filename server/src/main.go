@@ -6,17 +6,23 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"scrumlr.io/server/api"
+	"scrumlr.io/server/boards"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/initialize"
 	"scrumlr.io/server/serviceinitialize"
+	"scrumlr.io/server/votings"
 
 	"scrumlr.io/server/auth"
 
+	"github.com/BurntSushi/toml"
+	"github.com/google/uuid"
 	"github.com/urfave/cli/v2"
 	"github.com/urfave/cli/v2/altsrc"
 	"scrumlr.io/server/logger"
@@ -76,6 +82,18 @@ func main() {
 				Usage:   "the redis password (if required)",
 				Value:   "",
 			}),
+			altsrc.NewBoolFlag(&cli.BoolFlag{
+				Name:    "in-memory",
+				EnvVars: []string{"SCRUMLR_SERVER_IN_MEMORY"},
+				Usage:   "keep the message broker and cache within the process instead of using nats or redis. Only suitable for a single server instance",
+				Value:   false,
+			}),
+			altsrc.NewBoolFlag(&cli.BoolFlag{
+				Name:    "postgres-broker",
+				EnvVars: []string{"SCRUMLR_SERVER_POSTGRES_BROKER"},
+				Usage:   "use LISTEN/NOTIFY and unlogged tables of the database as message broker and cache instead of using nats or redis",
+				Value:   false,
+			}),
 			altsrc.NewBoolFlag(&cli.BoolFlag{
 				Name:    "insecure",
 				Aliases: []string{"i"},
@@ -265,6 +283,57 @@ func main() {
 				Usage:   "JWT claim to request for the user name",
 				Value:   "profile",
 			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "auth-oidc-admin-group",
+				EnvVars: []string{"SCRUMLR_AUTH_OIDC_ADMIN_GROUP"},
+				Usage:   "`group` whose members become instance administrators, the admin role is not synced with the provider if empty",
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "auth-oidc-admin-group-claim",
+				EnvVars: []string{"SCRUMLR_AUTH_OIDC_ADMIN_GROUP_CLAIM"},
+				Usage:   "JWT claim holding the groups of the user",
+				Value:   "groups",
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:     "auth-saml-metadata-url",
+				EnvVars:  []string{"SCRUMLR_AUTH_SAML_METADATA_URL"},
+				Usage:    "URL hosting the metadata of the SAML identity provider",
+				Required: false,
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:     "auth-saml-certificate",
+				EnvVars:  []string{"SCRUMLR_AUTH_SAML_CERTIFICATE"},
+				Usage:    "PEM encoded `certificate` of the SAML service provider",
+				Required: false,
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:     "auth-saml-private-key",
+				EnvVars:  []string{"SCRUMLR_AUTH_SAML_PRIVATE_KEY"},
+				Usage:    "PEM encoded RSA private `key` of the SAML service provider",
+				Required: false,
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "auth-saml-user-ident-attribute",
+				EnvVars: []string{"SCRUMLR_AUTH_SAML_USER_IDENT_ATTRIBUTE"},
+				Usage:   "SAML attribute for the user identifier, the name id of the subject is used if empty",
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "auth-saml-user-name-attribute",
+				EnvVars: []string{"SCRUMLR_AUTH_SAML_USER_NAME_ATTRIBUTE"},
+				Usage:   "SAML attribute for the user name",
+				Value:   "displayName",
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "auth-saml-admin-group",
+				EnvVars: []string{"SCRUMLR_AUTH_SAML_ADMIN_GROUP"},
+				Usage:   "`group` whose members become instance administrators, the admin role is not synced with the provider if empty",
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "auth-saml-admin-group-attribute",
+				EnvVars: []string{"SCRUMLR_AUTH_SAML_ADMIN_GROUP_ATTRIBUTE"},
+				Usage:   "SAML attribute holding the groups of the user",
+				Value:   "groups",
+			}),
 			altsrc.NewStringFlag(&cli.StringFlag{
 				Name:     "session-secret",
 				EnvVars:  []string{"SESSION_SECRET"},
@@ -309,6 +378,39 @@ func main() {
 				Value:    false,
 				Required: false,
 			}),
+			altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+				Name:    "admin-users",
+				EnvVars: []string{"SCRUMLR_ADMIN_USERS"},
+				Usage:   "`ids` of the users who are instance administrators regardless of the groups of their identity provider",
+			}),
+			altsrc.NewIntFlag(&cli.IntFlag{
+				Name:     "retention-days",
+				EnvVars:  []string{"SCRUMLR_RETENTION_DAYS"},
+				Usage:    "delete boards that have not been modified for the given number of days, unless the board has its own retention. 0 keeps boards forever",
+				Value:    0,
+				Required: false,
+			}),
+			altsrc.NewDurationFlag(&cli.DurationFlag{
+				Name:     "retention-interval",
+				EnvVars:  []string{"SCRUMLR_RETENTION_INTERVAL"},
+				Usage:    "the interval in which expired boards are deleted",
+				Value:    time.Hour,
+				Required: false,
+			}),
+			altsrc.NewDurationFlag(&cli.DurationFlag{
+				Name:     "voting-close-interval",
+				EnvVars:  []string{"SCRUMLR_VOTING_CLOSE_INTERVAL"},
+				Usage:    "the interval in which votings whose end passed are closed",
+				Value:    5 * time.Second,
+				Required: false,
+			}),
+			altsrc.NewDurationFlag(&cli.DurationFlag{
+				Name:     "session-lifetime",
+				EnvVars:  []string{"SCRUMLR_SESSION_LIFETIME"},
+				Usage:    "the lifetime of a session, sessions are renewed on use once half of their lifetime has passed",
+				Value:    90 * 24 * time.Hour,
+				Required: false,
+			}),
 			&cli.StringFlag{
 				Name:     "config",
 				EnvVars:  []string{"SCRUMLR_CONFIG_PATH"},
@@ -347,18 +449,20 @@ func run(ctx *cli.Context) error {
 		return errors.New("you may not start the application without a private key. Use 'insecure' flag with caution if you want to use default keypair to sign jwt's")
 	}
 
-	rt, err := realtime.InitializeRealtime(ctx)
+	rt, err := realtime.InitializeRealtime(ctx, db)
 	if err != nil {
 		log.Fatalf("failed to connect to message broker: %v", err)
 		return err
 	}
 
-	c, err := cache.InitializeCache(ctx)
+	c, err := cache.InitializeCache(ctx, db)
 	if err != nil {
 		log.Fatalf("failed to connect to cache: %v", err)
 		return err
 	}
 
+	rt.EventLog = realtime.NewEventLog(c, realtime.DefaultEventLogSize, realtime.DefaultEventLogTTL)
+
 	basePath := "/"
 	if ctx.IsSet("base-path") {
 		basePath = ctx.String("base-path")
@@ -387,34 +491,70 @@ func run(ctx *cli.Context) error {
 	boardReactionService := initializer.InitializeBoardReactionService()
 	reactionService := initializer.InitializeReactionService()
 
+	teamService := initializer.InitializeTeamService()
+
 	columnTemplateService := initializer.InitializeColumnTemplateService()
-	boardTemplateService := initializer.InitializeBoardTemplateService(columnTemplateService)
+	boardTemplateService := initializer.InitializeBoardTemplateService(columnTemplateService, teamService)
 
-	votingService := initializer.InitializeVotingService()
-	noteService := initializer.InitializeNotesService()
-	columnService := initializer.InitializeColumnService(noteService)
+	auditService := initializer.InitializeAuditService()
+	noteService := initializer.InitializeNotesService(auditService)
+	votingService := initializer.InitializeVotingService(auditService, noteService)
+	columnService := initializer.InitializeColumnService(noteService, auditService)
 
-	sessionService := initializer.InitializeSessionService(columnService, noteService)
+	sessionService := initializer.InitializeSessionService(columnService, noteService, auditService)
 	sessionRequestService := initializer.InitializeSessionRequestService(websocket, sessionService)
 
-	userService := initializer.InitializeUserService(sessionService, noteService)
+	actionItemService := initializer.InitializeActionItemService(sessionService)
+	estimationService := initializer.InitializeEstimationService(noteService)
+	apiTokenService := initializer.InitializeApiTokenService()
+	userService := initializer.InitializeUserService(sessionService, noteService, votingService, reactionService, boardTemplateService, actionItemService, estimationService, teamService, apiTokenService, auditService)
+
+	sessionLifetime := ctx.Duration("session-lifetime")
+	if sessionLifetime <= 0 {
+		return errors.New("session lifetime must be positive")
+	}
 
 	keyWithNewlines := strings.ReplaceAll(ctx.String("key"), "\\n", "\n")
 	unsafeKeyWithNewlines := strings.ReplaceAll(ctx.String("unsafe-key"), "\\n", "\n")
-	authConfig, err := auth.NewAuthConfiguration(providersMap, unsafeKeyWithNewlines, keyWithNewlines, db, userService)
+	authConfig, err := auth.NewAuthConfiguration(providersMap, unsafeKeyWithNewlines, keyWithNewlines, db, userService, apiTokenService, auth.NewRevocations(c, sessionLifetime), sessionLifetime)
 	if err != nil {
 		return fmt.Errorf("unable to setup authentication: %w", err)
 	}
 
-	boardService := initializer.InitializeBoardService(sessionRequestService, sessionService, columnService, noteService, reactionService, votingService, userService)
+	boardService := initializer.InitializeBoardService(sessionRequestService, sessionService, columnService, noteService, reactionService, votingService, userService, actionItemService, estimationService, teamService, auditService)
+
+	if ctx.Int("retention-days") < 0 {
+		return errors.New("retention days must not be negative")
+	}
+	if ctx.Duration("retention-interval") <= 0 {
+		return errors.New("retention interval must be positive")
+	}
+	boards.NewRetentionJob(boardService, ctx.Int("retention-days"), ctx.Duration("retention-interval")).Start(ctx.Context)
+
+	if ctx.Duration("voting-close-interval") <= 0 {
+		return errors.New("voting close interval must be positive")
+	}
+	votings.NewClosingJob(votingService, ctx.Duration("voting-close-interval")).Start(ctx.Context)
+
+	admins, err := parseAdminUsers(ctx.StringSlice("admin-users"))
+	if err != nil {
+		return err
+	}
+	adminService := initializer.InitializeAdminService(boardService, authConfig, admins)
 
 	apiInitializer := serviceinitialize.NewApiInitializer(basePath)
 	sessionApi := apiInitializer.InitializeSessionApi(sessionService)
-	userApi := apiInitializer.InitializeUserApi(userService, sessionService, ctx.Bool("allow-anonymous-board-creation"), ctx.Bool("allow-anonymous-custom-templates"))
+	teamsApi := apiInitializer.InitializeTeamsApi(teamService)
+	apiTokensApi := apiInitializer.InitializeApiTokensApi(apiTokenService)
+	adminApi := apiInitializer.InitializeAdminApi(adminService)
+	userApi := apiInitializer.InitializeUserApi(userService, sessionService, authConfig, ctx.Bool("allow-anonymous-board-creation"), ctx.Bool("allow-anonymous-custom-templates"))
 
 	routesInitializer := serviceinitialize.NewRoutesInitializer()
 	userRoutes := routesInitializer.InitializeUserRoutes(userApi, sessionApi)
 	sessionRoutes := routesInitializer.InitializeSessionRoutes(sessionApi)
+	teamRoutes := routesInitializer.InitializeTeamRoutes(teamsApi)
+	tokenRoutes := routesInitializer.InitializeApiTokenRoutes(apiTokensApi)
+	adminRoutes := routesInitializer.InitializeAdminRoutes(adminApi)
 	swaggerRoutes := routesInitializer.InitializeSwaggerRoutes(basePath)
 
 	s := api.New(
@@ -425,6 +565,9 @@ func run(ctx *cli.Context) error {
 
 		userRoutes,
 		sessionRoutes,
+		teamRoutes,
+		tokenRoutes,
+		adminRoutes,
 		swaggerRoutes,
 
 		boardService,
@@ -440,6 +583,10 @@ func run(ctx *cli.Context) error {
 		boardReactionService,
 		boardTemplateService,
 		columnTemplateService,
+		actionItemService,
+		estimationService,
+		teamService,
+		auditService,
 
 		logger.GetLogLevel() == zap.DebugLevel,
 		!ctx.Bool("disable-check-origin"),
@@ -517,22 +664,136 @@ func configureAuthProvider(ctx *cli.Context, basePath string) (map[string]auth.A
 	if ctx.String("auth-oidc-discovery-url") != "" && ctx.String("auth-oidc-client-id") != "" && ctx.String("auth-oidc-client-secret") != "" {
 		log.Info("Using oidc authentication.")
 		providersMap[(string)(common.TypeOIDC)] = auth.AuthProviderConfiguration{
-			ClientId:       ctx.String("auth-oidc-client-id"),
-			ClientSecret:   ctx.String("auth-oidc-client-secret"),
-			RedirectUri:    fmt.Sprintf("%s%s/login/oidc/callback", strings.TrimSuffix(callbackHost, "/"), strings.TrimSuffix(basePath, "/")),
-			DiscoveryUri:   ctx.String("auth-oidc-discovery-url"),
-			UserIdentScope: ctx.String("auth-oidc-user-ident-scope"),
-			UserNameScope:  ctx.String("auth-oidc-user-name-scope"),
+			ClientId:        ctx.String("auth-oidc-client-id"),
+			ClientSecret:    ctx.String("auth-oidc-client-secret"),
+			RedirectUri:     fmt.Sprintf("%s%s/login/oidc/callback", strings.TrimSuffix(callbackHost, "/"), strings.TrimSuffix(basePath, "/")),
+			DiscoveryUri:    ctx.String("auth-oidc-discovery-url"),
+			UserIdentScope:  ctx.String("auth-oidc-user-ident-scope"),
+			UserNameScope:   ctx.String("auth-oidc-user-name-scope"),
+			AdminGroup:      ctx.String("auth-oidc-admin-group"),
+			AdminGroupClaim: ctx.String("auth-oidc-admin-group-claim"),
 		}
 	}
 
+	oidcProviders, err := configureOIDCProviders(ctx, callbackHost, basePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, provider := range oidcProviders {
+		log.Infow("Using additional oidc authentication.", "provider", provider.Name)
+		providersMap[provider.Name] = provider
+	}
+
 	// session secret is used by the auth lib github.com/markbates/goth
 	// the lib takes the session secret from the env var
 	if ctx.String("session-secret") == "" && len(providersMap) != 0 {
 		return nil, errors.New("you may not start the application without a session secret if an authentication provider is configured")
 	}
 
+	// the saml provider is not handled by goth and does not need the session secret
+	if ctx.String("auth-saml-metadata-url") != "" && ctx.String("auth-saml-certificate") != "" && ctx.String("auth-saml-private-key") != "" {
+		log.Info("Using saml authentication.")
+		providersMap[(string)(common.TypeSAML)] = auth.AuthProviderConfiguration{
+			RedirectUri:        fmt.Sprintf("%s%s/login/saml/acs", strings.TrimSuffix(callbackHost, "/"), strings.TrimSuffix(basePath, "/")),
+			IdpMetadataUri:     ctx.String("auth-saml-metadata-url"),
+			Certificate:        ctx.String("auth-saml-certificate"),
+			PrivateKey:         ctx.String("auth-saml-private-key"),
+			UserIdentAttribute: ctx.String("auth-saml-user-ident-attribute"),
+			UserNameAttribute:  ctx.String("auth-saml-user-name-attribute"),
+			AdminGroup:         ctx.String("auth-saml-admin-group"),
+			AdminGroupClaim:    ctx.String("auth-saml-admin-group-attribute"),
+		}
+	}
+
 	log.Debugf("Configured %d auth provider", len(providersMap))
 
 	return providersMap, nil
 }
+
+// parseAdminUsers parses the ids of the configured instance administrators
+func parseAdminUsers(values []string) ([]uuid.UUID, error) {
+	admins := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q of admin user: %w", value, err)
+		}
+		admins = append(admins, id)
+	}
+
+	return admins, nil
+}
+
+// oidcProviderConfiguration is an additional OIDC provider, which is configured in the auth-oidc-providers tables of the config file
+type oidcProviderConfiguration struct {
+	Name            string   `toml:"name"`
+	ClientId        string   `toml:"client-id"`
+	ClientSecret    string   `toml:"client-secret"`
+	DiscoveryUrl    string   `toml:"discovery-url"`
+	Scopes          []string `toml:"scopes"`
+	UserIdentClaim  string   `toml:"user-ident-claim"`
+	UserNameClaim   string   `toml:"user-name-claim"`
+	AdminGroup      string   `toml:"admin-group"`
+	AdminGroupClaim string   `toml:"admin-group-claim"`
+}
+
+var oidcProviderNamePattern = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+func configureOIDCProviders(ctx *cli.Context, callbackHost, basePath string) ([]auth.AuthProviderConfiguration, error) {
+	if ctx.String("config") == "" {
+		return nil, nil
+	}
+
+	var config struct {
+		Providers []oidcProviderConfiguration `toml:"auth-oidc-providers"`
+	}
+	if _, err := toml.DecodeFile(ctx.String("config"), &config); err != nil {
+		return nil, fmt.Errorf("unable to read the oidc providers of the config file: %w", err)
+	}
+
+	// the names of the built-in providers are taken, since all providers share the login routes
+	names := map[string]bool{}
+	for _, accountType := range []common.AccountType{common.Anonymous, common.Google, common.Microsoft, common.AzureAd, common.GitHub, common.Apple, common.TypeOIDC, common.TypeSAML} {
+		names[strings.ToLower(string(accountType))] = true
+	}
+
+	providers := []auth.AuthProviderConfiguration{}
+	for _, provider := range config.Providers {
+		if !oidcProviderNamePattern.MatchString(provider.Name) {
+			return nil, fmt.Errorf("invalid name %q of oidc provider, only lowercase letters, digits, '-' and '_' are allowed", provider.Name)
+		}
+		if names[provider.Name] {
+			return nil, fmt.Errorf("the name %q of the oidc provider is already taken", provider.Name)
+		}
+		names[provider.Name] = true
+
+		if provider.ClientId == "" || provider.ClientSecret == "" || provider.DiscoveryUrl == "" {
+			return nil, fmt.Errorf("the oidc provider %q requires a client id, a client secret and a discovery url", provider.Name)
+		}
+
+		adminGroupClaim := provider.AdminGroupClaim
+		if adminGroupClaim == "" {
+			adminGroupClaim = "groups"
+		}
+
+		scopes := provider.Scopes
+		if len(scopes) == 0 {
+			scopes = []string{"openid", "profile"}
+		}
+
+		providers = append(providers, auth.AuthProviderConfiguration{
+			Name:            provider.Name,
+			ClientId:        provider.ClientId,
+			ClientSecret:    provider.ClientSecret,
+			RedirectUri:     fmt.Sprintf("%s%s/login/%s/callback", strings.TrimSuffix(callbackHost, "/"), strings.TrimSuffix(basePath, "/"), provider.Name),
+			DiscoveryUri:    provider.DiscoveryUrl,
+			Scopes:          scopes,
+			UserIdentClaim:  provider.UserIdentClaim,
+			UserNameClaim:   provider.UserNameClaim,
+			AdminGroup:      provider.AdminGroup,
+			AdminGroupClaim: adminGroupClaim,
+		})
+	}
+
+	return providers, nil
+}
@@ -16,6 +16,10 @@ type NotesService interface {
 	GetByUserAndBoard(ctx context.Context, userID uuid.UUID, boardID uuid.UUID) ([]*Note, error)
 	GetStack(ctx context.Context, note uuid.UUID) ([]*Note, error)
 	Update(ctx context.Context, userID uuid.UUID, body NoteUpdateRequest) (*Note, error)
+	GetRevisions(ctx context.Context, board uuid.UUID, note uuid.UUID) ([]*NoteRevision, error)
+	GetRevisionsByAuthor(ctx context.Context, board uuid.UUID, author uuid.UUID) ([]*NoteRevision, error)
+	RestoreRevision(ctx context.Context, userID uuid.UUID, board uuid.UUID, note uuid.UUID, revision uuid.UUID) (*Note, error)
+	SetEstimate(ctx context.Context, board, id uuid.UUID, estimate *string) (*Note, error)
 	Delete(ctx context.Context, userID uuid.UUID, body NoteDeleteRequest) error
 	DeleteUserNotesFromBoard(ctx context.Context, userID uuid.UUID, boardID uuid.UUID) error
 	AcquireLock(ctx context.Context, noteID, userID, boardID uuid.UUID) bool
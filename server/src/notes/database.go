@@ -190,6 +190,20 @@ func (d *DB) DeleteNote(ctx context.Context, caller uuid.UUID, boardID uuid.UUID
 	return err
 }
 
+// SetEstimate stores the final estimate of an estimation round on a note
+func (d *DB) SetEstimate(ctx context.Context, board, id uuid.UUID, estimate *string) (DatabaseNote, error) {
+	var note DatabaseNote
+	_, err := d.db.NewUpdate().
+		Model((*DatabaseNote)(nil)).
+		Set("estimate = ?", estimate).
+		Where("id = ?", id).
+		Where("board = ?", board).
+		Returning("*").
+		Exec(common.ContextWithValues(ctx, "Database", d, identifiers.BoardIdentifier, board), &note)
+
+	return note, err
+}
+
 func (d *DB) updateNoteText(ctx context.Context, update DatabaseNoteUpdate) (DatabaseNote, error) {
 	var note DatabaseNote
 	_, err := d.db.NewUpdate().
@@ -497,3 +511,53 @@ func (d *DB) GetByUserAndBoard(ctx context.Context, user uuid.UUID, board uuid.U
 
 	return notes, err
 }
+
+// CreateRevision records the current state of a note as a new revision
+func (d *DB) CreateRevision(ctx context.Context, insert DatabaseNoteRevisionInsert) (DatabaseNoteRevision, error) {
+	var revision DatabaseNoteRevision
+	_, err := d.db.NewInsert().
+		Model(&insert).
+		Returning("*").
+		Exec(ctx, &revision)
+
+	return revision, err
+}
+
+// GetRevisions gets the revisions of a note on the board, newest first
+func (d *DB) GetRevisions(ctx context.Context, board uuid.UUID, note uuid.UUID) ([]DatabaseNoteRevision, error) {
+	var revisions []DatabaseNoteRevision
+	err := d.db.NewSelect().
+		Model(&revisions).
+		Where("note = ?", note).
+		Where("note IN (?)", d.db.NewSelect().Model((*DatabaseNote)(nil)).Column("id").Where("board = ?", board)).
+		Order("created_at DESC", "id").
+		Scan(ctx)
+
+	return revisions, err
+}
+
+// GetRevisionsByAuthor gets the revisions the user made to notes on the board, newest first
+func (d *DB) GetRevisionsByAuthor(ctx context.Context, board uuid.UUID, author uuid.UUID) ([]DatabaseNoteRevision, error) {
+	var revisions []DatabaseNoteRevision
+	err := d.db.NewSelect().
+		Model(&revisions).
+		Where("author = ?", author).
+		Where("note IN (?)", d.db.NewSelect().Model((*DatabaseNote)(nil)).Column("id").Where("board = ?", board)).
+		Order("created_at DESC", "id").
+		Scan(ctx)
+
+	return revisions, err
+}
+
+// GetRevision gets a revision of a note on the board
+func (d *DB) GetRevision(ctx context.Context, board uuid.UUID, note uuid.UUID, id uuid.UUID) (DatabaseNoteRevision, error) {
+	var revision DatabaseNoteRevision
+	err := d.db.NewSelect().
+		Model(&revision).
+		Where("id = ?", id).
+		Where("note = ?", note).
+		Where("note IN (?)", d.db.NewSelect().Model((*DatabaseNote)(nil)).Column("id").Where("board = ?", board)).
+		Scan(ctx)
+
+	return revision, err
+}
@@ -19,6 +19,7 @@ type DatabaseNote struct {
 	Stack         uuid.NullUUID
 	Rank          int
 	Edited        bool
+	Estimate      *string
 }
 
 type DatabaseNoteInsert struct {
@@ -46,6 +47,28 @@ type DatabaseNoteUpdate struct {
 	Edited        bool
 }
 
+type DatabaseNoteRevision struct {
+	bun.BaseModel `bun:"table:note_revisions,alias:revision"`
+	ID            uuid.UUID
+	Note          uuid.UUID
+	Author        uuid.NullUUID
+	Text          string
+	Column        uuid.UUID
+	Stack         uuid.NullUUID
+	Rank          int
+	CreatedAt     time.Time
+}
+
+type DatabaseNoteRevisionInsert struct {
+	bun.BaseModel `bun:"table:note_revisions"`
+	Note          uuid.UUID
+	Author        uuid.NullUUID
+	Text          string
+	Column        uuid.UUID
+	Stack         uuid.NullUUID
+	Rank          int
+}
+
 type Precondition struct {
 	StackingAllowed bool
 	CallerRole      role.Role
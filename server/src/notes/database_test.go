@@ -2,6 +2,7 @@ package notes
 
 import (
 	"context"
+	"database/sql"
 	"log"
 	"testing"
 
@@ -752,6 +753,79 @@ type TestColumn struct {
 	index   int
 }
 
+func (suite *DatabaseNoteTestSuite) Test_Database_Revisions() {
+	t := suite.T()
+	database := NewNotesDatabase(suite.db)
+
+	note := suite.notes[23]
+	author := uuid.NullUUID{UUID: suite.users["Santa"].id, Valid: true}
+
+	first, err := database.CreateRevision(context.Background(), DatabaseNoteRevisionInsert{Note: note.ID, Author: author, Text: "First text", Column: note.Column, Rank: note.Rank})
+	assert.Nil(t, err)
+	second, err := database.CreateRevision(context.Background(), DatabaseNoteRevisionInsert{Note: note.ID, Author: author, Text: "Second text", Column: note.Column, Rank: note.Rank})
+	assert.Nil(t, err)
+
+	revisions, err := database.GetRevisions(context.Background(), note.Board, note.ID)
+
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, second.ID, revisions[0].ID)
+	assert.Equal(t, "Second text", revisions[0].Text)
+	assert.Equal(t, author, revisions[0].Author)
+	assert.Equal(t, note.Column, revisions[0].Column)
+	assert.Equal(t, first.ID, revisions[1].ID)
+
+	revision, err := database.GetRevision(context.Background(), note.Board, note.ID, first.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "First text", revision.Text)
+}
+
+func (suite *DatabaseNoteTestSuite) Test_Database_RevisionsByAuthor() {
+	t := suite.T()
+	database := NewNotesDatabase(suite.db)
+
+	note := suite.notes[23]
+	author := uuid.NullUUID{UUID: suite.users["Santa"].id, Valid: true}
+	other := uuid.NullUUID{UUID: suite.users["Stan"].id, Valid: true}
+
+	own, err := database.CreateRevision(context.Background(), DatabaseNoteRevisionInsert{Note: note.ID, Author: author, Text: "Own text", Column: note.Column, Rank: note.Rank})
+	assert.Nil(t, err)
+	_, err = database.CreateRevision(context.Background(), DatabaseNoteRevisionInsert{Note: note.ID, Author: other, Text: "Other text", Column: note.Column, Rank: note.Rank})
+	assert.Nil(t, err)
+
+	revisions, err := database.GetRevisionsByAuthor(context.Background(), note.Board, author.UUID)
+
+	assert.Nil(t, err)
+	assert.Contains(t, revisions, own)
+	for _, revision := range revisions {
+		assert.Equal(t, author, revision.Author)
+	}
+
+	revisions, err = database.GetRevisionsByAuthor(context.Background(), uuid.New(), author.UUID)
+
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 0)
+}
+
+func (suite *DatabaseNoteTestSuite) Test_Database_Revisions_OtherBoard() {
+	t := suite.T()
+	database := NewNotesDatabase(suite.db)
+
+	note := suite.notes[24]
+	revision, err := database.CreateRevision(context.Background(), DatabaseNoteRevisionInsert{Note: note.ID, Author: uuid.NullUUID{UUID: note.Author, Valid: true}, Text: note.Text, Column: note.Column, Rank: note.Rank})
+	assert.Nil(t, err)
+
+	revisions, err := database.GetRevisions(context.Background(), suite.boards["Write"].id, note.ID)
+
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 0)
+
+	_, err = database.GetRevision(context.Background(), suite.boards["Write"].id, note.ID, revision.ID)
+
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func (suite *DatabaseNoteTestSuite) seedData(db *bun.DB) {
 	// tests users
 	suite.users = make(map[string]TestUser, 2)
@@ -1,6 +1,8 @@
 package notes
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -74,6 +76,9 @@ type Note struct {
 
 	// The position of the note.
 	Position NotePosition `json:"position"`
+
+	// The final estimate of the latest estimation round on the note.
+	Estimate *string `json:"estimate,omitempty"`
 }
 
 type NotePosition struct {
@@ -104,6 +109,7 @@ func (n *Note) From(note DatabaseNote) *Note {
 		Rank:   note.Rank,
 	}
 	n.Edited = note.Edited
+	n.Estimate = note.Estimate
 	return n
 }
 
@@ -118,3 +124,47 @@ func Notes(notes []DatabaseNote) []*Note {
 	}
 	return list
 }
+
+// NoteRevision is a version of a note, recorded whenever its text or position changes.
+type NoteRevision struct {
+	ID uuid.UUID `json:"id"`
+
+	// The note of the revision.
+	Note uuid.UUID `json:"note"`
+
+	// The user who made the change, empty if the user was deleted since.
+	Author *uuid.UUID `json:"author,omitempty"`
+
+	// The text of the note in this revision.
+	Text string `json:"text"`
+
+	// The position of the note in this revision.
+	Position NotePosition `json:"position"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (r *NoteRevision) From(revision DatabaseNoteRevision) *NoteRevision {
+	r.ID = revision.ID
+	r.Note = revision.Note
+	if revision.Author.Valid {
+		author := revision.Author.UUID
+		r.Author = &author
+	}
+	r.Text = revision.Text
+	r.Position = NotePosition{
+		Column: revision.Column,
+		Stack:  revision.Stack,
+		Rank:   revision.Rank,
+	}
+	r.CreatedAt = revision.CreatedAt
+	return r
+}
+
+func NoteRevisions(revisions []DatabaseNoteRevision) []*NoteRevision {
+	list := make([]*NoteRevision, len(revisions))
+	for index, revision := range revisions {
+		list[index] = new(NoteRevision).From(revision)
+	}
+	return list
+}
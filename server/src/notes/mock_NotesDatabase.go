@@ -104,6 +104,72 @@ func (_c *MockNotesDatabase_CreateNote_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// CreateRevision provides a mock function for the type MockNotesDatabase
+func (_mock *MockNotesDatabase) CreateRevision(ctx context.Context, insert DatabaseNoteRevisionInsert) (DatabaseNoteRevision, error) {
+	ret := _mock.Called(ctx, insert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRevision")
+	}
+
+	var r0 DatabaseNoteRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DatabaseNoteRevisionInsert) (DatabaseNoteRevision, error)); ok {
+		return returnFunc(ctx, insert)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DatabaseNoteRevisionInsert) DatabaseNoteRevision); ok {
+		r0 = returnFunc(ctx, insert)
+	} else {
+		r0 = ret.Get(0).(DatabaseNoteRevision)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, DatabaseNoteRevisionInsert) error); ok {
+		r1 = returnFunc(ctx, insert)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesDatabase_CreateRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRevision'
+type MockNotesDatabase_CreateRevision_Call struct {
+	*mock.Call
+}
+
+// CreateRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - insert DatabaseNoteRevisionInsert
+func (_e *MockNotesDatabase_Expecter) CreateRevision(ctx any, insert any) *MockNotesDatabase_CreateRevision_Call {
+	return &MockNotesDatabase_CreateRevision_Call{Call: _e.mock.On("CreateRevision", ctx, insert)}
+}
+
+func (_c *MockNotesDatabase_CreateRevision_Call) Run(run func(ctx context.Context, insert DatabaseNoteRevisionInsert)) *MockNotesDatabase_CreateRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DatabaseNoteRevisionInsert
+		if args[1] != nil {
+			arg1 = args[1].(DatabaseNoteRevisionInsert)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesDatabase_CreateRevision_Call) Return(databaseNoteRevision DatabaseNoteRevision, err error) *MockNotesDatabase_CreateRevision_Call {
+	_c.Call.Return(databaseNoteRevision, err)
+	return _c
+}
+
+func (_c *MockNotesDatabase_CreateRevision_Call) RunAndReturn(run func(ctx context.Context, insert DatabaseNoteRevisionInsert) (DatabaseNoteRevision, error)) *MockNotesDatabase_CreateRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteNote provides a mock function for the type MockNotesDatabase
 func (_mock *MockNotesDatabase) DeleteNote(ctx context.Context, caller uuid.UUID, board uuid.UUID, id uuid.UUID, deleteStack bool) error {
 	ret := _mock.Called(ctx, caller, board, id, deleteStack)
@@ -548,6 +614,232 @@ func (_c *MockNotesDatabase_GetPrecondition_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetRevision provides a mock function for the type MockNotesDatabase
+func (_mock *MockNotesDatabase) GetRevision(ctx context.Context, board uuid.UUID, note uuid.UUID, id uuid.UUID) (DatabaseNoteRevision, error) {
+	ret := _mock.Called(ctx, board, note, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 DatabaseNoteRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (DatabaseNoteRevision, error)); ok {
+		return returnFunc(ctx, board, note, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) DatabaseNoteRevision); ok {
+		r0 = returnFunc(ctx, board, note, id)
+	} else {
+		r0 = ret.Get(0).(DatabaseNoteRevision)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, board, note, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesDatabase_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockNotesDatabase_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+//   - note uuid.UUID
+//   - id uuid.UUID
+func (_e *MockNotesDatabase_Expecter) GetRevision(ctx any, board any, note any, id any) *MockNotesDatabase_GetRevision_Call {
+	return &MockNotesDatabase_GetRevision_Call{Call: _e.mock.On("GetRevision", ctx, board, note, id)}
+}
+
+func (_c *MockNotesDatabase_GetRevision_Call) Run(run func(ctx context.Context, board uuid.UUID, note uuid.UUID, id uuid.UUID)) *MockNotesDatabase_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesDatabase_GetRevision_Call) Return(databaseNoteRevision DatabaseNoteRevision, err error) *MockNotesDatabase_GetRevision_Call {
+	_c.Call.Return(databaseNoteRevision, err)
+	return _c
+}
+
+func (_c *MockNotesDatabase_GetRevision_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, note uuid.UUID, id uuid.UUID) (DatabaseNoteRevision, error)) *MockNotesDatabase_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisions provides a mock function for the type MockNotesDatabase
+func (_mock *MockNotesDatabase) GetRevisions(ctx context.Context, board uuid.UUID, note uuid.UUID) ([]DatabaseNoteRevision, error) {
+	ret := _mock.Called(ctx, board, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 []DatabaseNoteRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]DatabaseNoteRevision, error)); ok {
+		return returnFunc(ctx, board, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []DatabaseNoteRevision); ok {
+		r0 = returnFunc(ctx, board, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DatabaseNoteRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, board, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesDatabase_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type MockNotesDatabase_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+//   - note uuid.UUID
+func (_e *MockNotesDatabase_Expecter) GetRevisions(ctx any, board any, note any) *MockNotesDatabase_GetRevisions_Call {
+	return &MockNotesDatabase_GetRevisions_Call{Call: _e.mock.On("GetRevisions", ctx, board, note)}
+}
+
+func (_c *MockNotesDatabase_GetRevisions_Call) Run(run func(ctx context.Context, board uuid.UUID, note uuid.UUID)) *MockNotesDatabase_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesDatabase_GetRevisions_Call) Return(databaseNoteRevisions []DatabaseNoteRevision, err error) *MockNotesDatabase_GetRevisions_Call {
+	_c.Call.Return(databaseNoteRevisions, err)
+	return _c
+}
+
+func (_c *MockNotesDatabase_GetRevisions_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, note uuid.UUID) ([]DatabaseNoteRevision, error)) *MockNotesDatabase_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisionsByAuthor provides a mock function for the type MockNotesDatabase
+func (_mock *MockNotesDatabase) GetRevisionsByAuthor(ctx context.Context, board uuid.UUID, author uuid.UUID) ([]DatabaseNoteRevision, error) {
+	ret := _mock.Called(ctx, board, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisionsByAuthor")
+	}
+
+	var r0 []DatabaseNoteRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]DatabaseNoteRevision, error)); ok {
+		return returnFunc(ctx, board, author)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []DatabaseNoteRevision); ok {
+		r0 = returnFunc(ctx, board, author)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DatabaseNoteRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, board, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesDatabase_GetRevisionsByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisionsByAuthor'
+type MockNotesDatabase_GetRevisionsByAuthor_Call struct {
+	*mock.Call
+}
+
+// GetRevisionsByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+//   - author uuid.UUID
+func (_e *MockNotesDatabase_Expecter) GetRevisionsByAuthor(ctx any, board any, author any) *MockNotesDatabase_GetRevisionsByAuthor_Call {
+	return &MockNotesDatabase_GetRevisionsByAuthor_Call{Call: _e.mock.On("GetRevisionsByAuthor", ctx, board, author)}
+}
+
+func (_c *MockNotesDatabase_GetRevisionsByAuthor_Call) Run(run func(ctx context.Context, board uuid.UUID, author uuid.UUID)) *MockNotesDatabase_GetRevisionsByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesDatabase_GetRevisionsByAuthor_Call) Return(databaseNoteRevisions []DatabaseNoteRevision, err error) *MockNotesDatabase_GetRevisionsByAuthor_Call {
+	_c.Call.Return(databaseNoteRevisions, err)
+	return _c
+}
+
+func (_c *MockNotesDatabase_GetRevisionsByAuthor_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, author uuid.UUID) ([]DatabaseNoteRevision, error)) *MockNotesDatabase_GetRevisionsByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStack provides a mock function for the type MockNotesDatabase
 func (_mock *MockNotesDatabase) GetStack(ctx context.Context, noteID uuid.UUID) ([]DatabaseNote, error) {
 	ret := _mock.Called(ctx, noteID)
@@ -682,6 +974,84 @@ func (_c *MockNotesDatabase_ImportNote_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// SetEstimate provides a mock function for the type MockNotesDatabase
+func (_mock *MockNotesDatabase) SetEstimate(ctx context.Context, board uuid.UUID, id uuid.UUID, estimate *string) (DatabaseNote, error) {
+	ret := _mock.Called(ctx, board, id, estimate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEstimate")
+	}
+
+	var r0 DatabaseNote
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *string) (DatabaseNote, error)); ok {
+		return returnFunc(ctx, board, id, estimate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *string) DatabaseNote); ok {
+		r0 = returnFunc(ctx, board, id, estimate)
+	} else {
+		r0 = ret.Get(0).(DatabaseNote)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *string) error); ok {
+		r1 = returnFunc(ctx, board, id, estimate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesDatabase_SetEstimate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEstimate'
+type MockNotesDatabase_SetEstimate_Call struct {
+	*mock.Call
+}
+
+// SetEstimate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+//   - id uuid.UUID
+//   - estimate *string
+func (_e *MockNotesDatabase_Expecter) SetEstimate(ctx any, board any, id any, estimate any) *MockNotesDatabase_SetEstimate_Call {
+	return &MockNotesDatabase_SetEstimate_Call{Call: _e.mock.On("SetEstimate", ctx, board, id, estimate)}
+}
+
+func (_c *MockNotesDatabase_SetEstimate_Call) Run(run func(ctx context.Context, board uuid.UUID, id uuid.UUID, estimate *string)) *MockNotesDatabase_SetEstimate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesDatabase_SetEstimate_Call) Return(databaseNote DatabaseNote, err error) *MockNotesDatabase_SetEstimate_Call {
+	_c.Call.Return(databaseNote, err)
+	return _c
+}
+
+func (_c *MockNotesDatabase_SetEstimate_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, id uuid.UUID, estimate *string) (DatabaseNote, error)) *MockNotesDatabase_SetEstimate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateNote provides a mock function for the type MockNotesDatabase
 func (_mock *MockNotesDatabase) UpdateNote(ctx context.Context, caller uuid.UUID, update DatabaseNoteUpdate) (DatabaseNote, error) {
 	ret := _mock.Called(ctx, caller, update)
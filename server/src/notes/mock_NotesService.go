@@ -596,6 +596,154 @@ func (_c *MockNotesService_GetLock_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// GetRevisions provides a mock function for the type MockNotesService
+func (_mock *MockNotesService) GetRevisions(ctx context.Context, board uuid.UUID, note uuid.UUID) ([]*NoteRevision, error) {
+	ret := _mock.Called(ctx, board, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 []*NoteRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]*NoteRevision, error)); ok {
+		return returnFunc(ctx, board, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []*NoteRevision); ok {
+		r0 = returnFunc(ctx, board, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*NoteRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, board, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesService_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type MockNotesService_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+//   - note uuid.UUID
+func (_e *MockNotesService_Expecter) GetRevisions(ctx any, board any, note any) *MockNotesService_GetRevisions_Call {
+	return &MockNotesService_GetRevisions_Call{Call: _e.mock.On("GetRevisions", ctx, board, note)}
+}
+
+func (_c *MockNotesService_GetRevisions_Call) Run(run func(ctx context.Context, board uuid.UUID, note uuid.UUID)) *MockNotesService_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesService_GetRevisions_Call) Return(noteRevisions []*NoteRevision, err error) *MockNotesService_GetRevisions_Call {
+	_c.Call.Return(noteRevisions, err)
+	return _c
+}
+
+func (_c *MockNotesService_GetRevisions_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, note uuid.UUID) ([]*NoteRevision, error)) *MockNotesService_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisionsByAuthor provides a mock function for the type MockNotesService
+func (_mock *MockNotesService) GetRevisionsByAuthor(ctx context.Context, board uuid.UUID, author uuid.UUID) ([]*NoteRevision, error) {
+	ret := _mock.Called(ctx, board, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisionsByAuthor")
+	}
+
+	var r0 []*NoteRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]*NoteRevision, error)); ok {
+		return returnFunc(ctx, board, author)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []*NoteRevision); ok {
+		r0 = returnFunc(ctx, board, author)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*NoteRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, board, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesService_GetRevisionsByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisionsByAuthor'
+type MockNotesService_GetRevisionsByAuthor_Call struct {
+	*mock.Call
+}
+
+// GetRevisionsByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+//   - author uuid.UUID
+func (_e *MockNotesService_Expecter) GetRevisionsByAuthor(ctx any, board any, author any) *MockNotesService_GetRevisionsByAuthor_Call {
+	return &MockNotesService_GetRevisionsByAuthor_Call{Call: _e.mock.On("GetRevisionsByAuthor", ctx, board, author)}
+}
+
+func (_c *MockNotesService_GetRevisionsByAuthor_Call) Run(run func(ctx context.Context, board uuid.UUID, author uuid.UUID)) *MockNotesService_GetRevisionsByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesService_GetRevisionsByAuthor_Call) Return(noteRevisions []*NoteRevision, err error) *MockNotesService_GetRevisionsByAuthor_Call {
+	_c.Call.Return(noteRevisions, err)
+	return _c
+}
+
+func (_c *MockNotesService_GetRevisionsByAuthor_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, author uuid.UUID) ([]*NoteRevision, error)) *MockNotesService_GetRevisionsByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStack provides a mock function for the type MockNotesService
 func (_mock *MockNotesService) GetStack(ctx context.Context, note uuid.UUID) ([]*Note, error) {
 	ret := _mock.Called(ctx, note)
@@ -922,6 +1070,172 @@ func (_c *MockNotesService_ReleaseLock_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// RestoreRevision provides a mock function for the type MockNotesService
+func (_mock *MockNotesService) RestoreRevision(ctx context.Context, userID uuid.UUID, board uuid.UUID, note uuid.UUID, revision uuid.UUID) (*Note, error) {
+	ret := _mock.Called(ctx, userID, board, note, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreRevision")
+	}
+
+	var r0 *Note
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (*Note, error)); ok {
+		return returnFunc(ctx, userID, board, note, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) *Note); ok {
+		r0 = returnFunc(ctx, userID, board, note, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Note)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, board, note, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesService_RestoreRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRevision'
+type MockNotesService_RestoreRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - board uuid.UUID
+//   - note uuid.UUID
+//   - revision uuid.UUID
+func (_e *MockNotesService_Expecter) RestoreRevision(ctx any, userID any, board any, note any, revision any) *MockNotesService_RestoreRevision_Call {
+	return &MockNotesService_RestoreRevision_Call{Call: _e.mock.On("RestoreRevision", ctx, userID, board, note, revision)}
+}
+
+func (_c *MockNotesService_RestoreRevision_Call) Run(run func(ctx context.Context, userID uuid.UUID, board uuid.UUID, note uuid.UUID, revision uuid.UUID)) *MockNotesService_RestoreRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(uuid.UUID)
+		}
+		var arg4 uuid.UUID
+		if args[4] != nil {
+			arg4 = args[4].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesService_RestoreRevision_Call) Return(note *Note, err error) *MockNotesService_RestoreRevision_Call {
+	_c.Call.Return(note, err)
+	return _c
+}
+
+func (_c *MockNotesService_RestoreRevision_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, board uuid.UUID, note uuid.UUID, revision uuid.UUID) (*Note, error)) *MockNotesService_RestoreRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEstimate provides a mock function for the type MockNotesService
+func (_mock *MockNotesService) SetEstimate(ctx context.Context, board uuid.UUID, id uuid.UUID, estimate *string) (*Note, error) {
+	ret := _mock.Called(ctx, board, id, estimate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEstimate")
+	}
+
+	var r0 *Note
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *string) (*Note, error)); ok {
+		return returnFunc(ctx, board, id, estimate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *string) *Note); ok {
+		r0 = returnFunc(ctx, board, id, estimate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Note)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *string) error); ok {
+		r1 = returnFunc(ctx, board, id, estimate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesService_SetEstimate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEstimate'
+type MockNotesService_SetEstimate_Call struct {
+	*mock.Call
+}
+
+// SetEstimate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+//   - id uuid.UUID
+//   - estimate *string
+func (_e *MockNotesService_Expecter) SetEstimate(ctx any, board any, id any, estimate any) *MockNotesService_SetEstimate_Call {
+	return &MockNotesService_SetEstimate_Call{Call: _e.mock.On("SetEstimate", ctx, board, id, estimate)}
+}
+
+func (_c *MockNotesService_SetEstimate_Call) Run(run func(ctx context.Context, board uuid.UUID, id uuid.UUID, estimate *string)) *MockNotesService_SetEstimate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesService_SetEstimate_Call) Return(note *Note, err error) *MockNotesService_SetEstimate_Call {
+	_c.Call.Return(note, err)
+	return _c
+}
+
+func (_c *MockNotesService_SetEstimate_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, id uuid.UUID, estimate *string) (*Note, error)) *MockNotesService_SetEstimate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockNotesService
 func (_mock *MockNotesService) Update(ctx context.Context, userID uuid.UUID, body NoteUpdateRequest) (*Note, error) {
 	ret := _mock.Called(ctx, userID, body)
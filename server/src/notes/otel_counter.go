@@ -19,3 +19,9 @@ var notesImportCounter, _ = meter.Int64Counter(
 	metric.WithDescription("Number of imported notes"),
 	metric.WithUnit("notes"),
 )
+
+var notesRevisionRestoredCounter, _ = meter.Int64Counter(
+	"scrumlr.notes.revision.restored.counter",
+	metric.WithDescription("Number of notes restored to an earlier revision"),
+	metric.WithUnit("notes"),
+)
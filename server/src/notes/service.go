@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/google/uuid"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/logger"
 	"scrumlr.io/server/realtime"
@@ -30,6 +31,7 @@ type Service struct {
 	realtime                 *realtime.Broker
 	boardLastModifiedUpdater BoardLastModifiedUpdater
 	cache                    *cache.Cache
+	auditService             audit.AuditService
 }
 
 type NotesDatabase interface {
@@ -43,6 +45,11 @@ type NotesDatabase interface {
 	GetStack(ctx context.Context, noteID uuid.UUID) ([]DatabaseNote, error)
 	GetPrecondition(ctx context.Context, id uuid.UUID, board uuid.UUID, caller uuid.UUID) (Precondition, error)
 	GetByUserAndBoard(ctx context.Context, userID uuid.UUID, boardID uuid.UUID) ([]DatabaseNote, error)
+	CreateRevision(ctx context.Context, insert DatabaseNoteRevisionInsert) (DatabaseNoteRevision, error)
+	GetRevisions(ctx context.Context, board uuid.UUID, note uuid.UUID) ([]DatabaseNoteRevision, error)
+	GetRevisionsByAuthor(ctx context.Context, board uuid.UUID, author uuid.UUID) ([]DatabaseNoteRevision, error)
+	GetRevision(ctx context.Context, board uuid.UUID, note uuid.UUID, id uuid.UUID) (DatabaseNoteRevision, error)
+	SetEstimate(ctx context.Context, board, id uuid.UUID, estimate *string) (DatabaseNote, error)
 }
 
 type BoardLastModifiedUpdater interface {
@@ -54,12 +61,14 @@ func NewNotesService(
 	rt *realtime.Broker,
 	cache *cache.Cache,
 	boardLastModifiedUpdater BoardLastModifiedUpdater,
+	auditService audit.AuditService,
 ) NotesService {
 	service := new(Service)
 	service.database = db
 	service.realtime = rt
 	service.cache = cache
 	service.boardLastModifiedUpdater = boardLastModifiedUpdater
+	service.auditService = auditService
 
 	return service
 }
@@ -92,6 +101,7 @@ func (service *Service) Create(ctx context.Context, body NoteCreateRequest) (*No
 		return nil, CreateNoteError(Internal, "failed to create note", err)
 	}
 
+	service.recordRevision(ctx, body.User, note)
 	service.updatedNotes(ctx, body.Board)
 
 	notesCreatedCounter.Add(ctx, 1)
@@ -133,6 +143,8 @@ func (service *Service) Import(ctx context.Context, body NoteImportRequest) (*No
 		return nil, CreateNoteError(Internal, "failed to import note", err)
 	}
 
+	service.recordRevision(ctx, body.User, note)
+
 	notesImportCounter.Add(ctx, 1)
 	if err := service.boardLastModifiedUpdater.UpdateLastModified(ctx, body.Board, time.Now()); err != nil {
 		log.Warnw(errUnableToUpdateLastModified, "board", body.Board, "err", err)
@@ -323,10 +335,135 @@ func (service *Service) Update(ctx context.Context, user uuid.UUID, body NoteUpd
 		return nil, CreateNoteError(Internal, "failed to update note", err)
 	}
 
+	if body.Text != nil || body.Position != nil {
+		service.recordRevision(ctx, user, note)
+	}
+
 	service.updatedNotes(ctx, body.Board)
 	return new(Note).From(note), err
 }
 
+func (service *Service) GetRevisions(ctx context.Context, board uuid.UUID, note uuid.UUID) ([]*NoteRevision, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.notes.service.get.revisions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.notes.service.get.revisions.board", board.String()),
+		attribute.String("scrumlr.notes.service.get.revisions.note", note.String()),
+	)
+
+	revisions, err := service.database.GetRevisions(ctx, board, note)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get revisions")
+		span.RecordError(err)
+		log.Errorw("unable to get revisions", "board", board, "note", note, "err", err)
+		return nil, CreateNoteError(Internal, "failed to get revisions", err)
+	}
+
+	// every note has at least the revision of its creation
+	if len(revisions) == 0 {
+		err := CreateNoteError(NotFound, "note not found", errors.New("note not found"))
+		span.SetStatus(codes.Error, "note not found")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return NoteRevisions(revisions), nil
+}
+
+// GetRevisionsByAuthor gets all revisions the user made to notes on the board, newest first.
+func (service *Service) GetRevisionsByAuthor(ctx context.Context, board uuid.UUID, author uuid.UUID) ([]*NoteRevision, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.notes.service.get.revisions.author")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.notes.service.get.revisions.author.board", board.String()),
+		attribute.String("scrumlr.notes.service.get.revisions.author.user", author.String()),
+	)
+
+	revisions, err := service.database.GetRevisionsByAuthor(ctx, board, author)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get revisions")
+		span.RecordError(err)
+		log.Errorw("unable to get revisions of author", "board", board, "author", author, "err", err)
+		return nil, CreateNoteError(Internal, "failed to get revisions", err)
+	}
+
+	return NoteRevisions(revisions), nil
+}
+
+// RestoreRevision changes the text of the note back to the text of an earlier revision.
+// The restore is an update of the note by the user and is recorded as a new revision.
+func (service *Service) RestoreRevision(ctx context.Context, user uuid.UUID, board uuid.UUID, note uuid.UUID, revision uuid.UUID) (*Note, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.notes.service.restore.revision")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.notes.service.restore.revision.board", board.String()),
+		attribute.String("scrumlr.notes.service.restore.revision.note", note.String()),
+		attribute.String("scrumlr.notes.service.restore.revision.revision", revision.String()),
+	)
+
+	restored, err := service.database.GetRevision(ctx, board, note, revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "revision not found")
+			span.RecordError(err)
+			return nil, CreateNoteError(NotFound, "revision not found", err)
+		}
+
+		span.SetStatus(codes.Error, "failed to get revision")
+		span.RecordError(err)
+		log.Errorw("unable to get revision", "note", note, "revision", revision, "err", err)
+		return nil, CreateNoteError(Internal, "failed to get revision", err)
+	}
+
+	updated, err := service.Update(ctx, user, NoteUpdateRequest{
+		ID:    note,
+		Board: board,
+		Text:  &restored.Text,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to restore revision")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	notesRevisionRestoredCounter.Add(ctx, 1)
+	return updated, nil
+}
+
+func (service *Service) SetEstimate(ctx context.Context, board uuid.UUID, id uuid.UUID, estimate *string) (*Note, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.notes.service.set.estimate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.notes.service.set.estimate.board", board.String()),
+		attribute.String("scrumlr.notes.service.set.estimate.note", id.String()),
+	)
+
+	note, err := service.database.SetEstimate(ctx, board, id, estimate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "note not found")
+			span.RecordError(err)
+			return nil, CreateNoteError(NotFound, "note not found", err)
+		}
+
+		span.SetStatus(codes.Error, "failed to set estimate")
+		span.RecordError(err)
+		log.Errorw("unable to set estimate of note", "board", board, "note", id, "err", err)
+		return nil, CreateNoteError(Internal, "failed to set estimate", err)
+	}
+
+	service.updatedNotes(ctx, board)
+	return new(Note).From(note), nil
+}
+
 func (service *Service) Delete(ctx context.Context, user uuid.UUID, body NoteDeleteRequest) error {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.notes.service.delete")
@@ -389,6 +526,19 @@ func (service *Service) Delete(ctx context.Context, user uuid.UUID, body NoteDel
 		}
 	}
 
+	// moderators deleting notes of other users is recorded in the audit log
+	var moderatedNote *Note
+	if preconditions.Author != user {
+		note, err := service.database.Get(ctx, body.ID)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to get note")
+			span.RecordError(err)
+			log.Errorw("unable to get note", "note", body.ID, "err", err)
+			return CreateNoteError(Internal, "failed to get note", err)
+		}
+		moderatedNote = new(Note).From(note)
+	}
+
 	err = service.database.DeleteNote(ctx, user, body.Board, body.ID, body.DeleteStack)
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to delete note")
@@ -398,6 +548,9 @@ func (service *Service) Delete(ctx context.Context, user uuid.UUID, body NoteDel
 	}
 
 	service.deletedNote(ctx, body.Board, stackIds...)
+	if moderatedNote != nil {
+		service.auditService.Record(ctx, body.Board, audit.NoteDeleted, moderatedNote, nil)
+	}
 
 	notesDeletedCounter.Add(ctx, 1)
 	return nil
@@ -628,6 +781,32 @@ func (service *Service) updatedNotes(ctx context.Context, board uuid.UUID) {
 	})
 }
 
+// recordRevision records the state of a note after a change as a new revision.
+// Failing to record the revision does not undo the change of the note.
+func (service *Service) recordRevision(ctx context.Context, user uuid.UUID, note DatabaseNote) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.notes.service.record.revision")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.notes.service.record.revision.note", note.ID.String()),
+	)
+
+	_, err := service.database.CreateRevision(ctx, DatabaseNoteRevisionInsert{
+		Note:   note.ID,
+		Author: uuid.NullUUID{UUID: user, Valid: true},
+		Text:   note.Text,
+		Column: note.Column,
+		Stack:  note.Stack,
+		Rank:   note.Rank,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to record revision")
+		span.RecordError(err)
+		log.Errorw("unable to record revision of note", "note", note.ID, "err", err)
+	}
+}
+
 func (service *Service) deletedNote(ctx context.Context, board uuid.UUID, notes ...uuid.UUID) {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.notes.service.delete")
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go/modules/nats"
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/initialize"
@@ -70,7 +71,8 @@ func (suite *NoteServiceIntegrationTestSuite) SetupTest() {
 
 	database := NewNotesDatabase(db)
 	boardLastModifiedUpdater := common.NewSimpleBoardLastModifiedUpdater(db)
-	suite.noteService = NewNotesService(database, broker, ch, boardLastModifiedUpdater)
+	auditService := audit.NewAuditService(audit.NewAuditDatabase(db))
+	suite.noteService = NewNotesService(database, broker, ch, boardLastModifiedUpdater, auditService)
 }
 
 func (suite *NoteServiceIntegrationTestSuite) initTestData() {
@@ -219,6 +221,46 @@ func (suite *NoteServiceIntegrationTestSuite) Test_Update() {
 	assert.Len(t, *noteData, 15)
 }
 
+func (suite *NoteServiceIntegrationTestSuite) Test_RestoreRevision() {
+	t := suite.T()
+	ctx := context.Background()
+
+	userId := suite.baseData.Users["Stan"].ID
+	boardId := suite.boards["Update"].ID
+	columnId := suite.columns["UpdateUp"].ID
+	text := "This note will be restored"
+	changedText := "This note was changed"
+
+	created, err := suite.noteService.Create(ctx, NoteCreateRequest{Board: boardId, Column: columnId, User: userId, Text: text})
+	require.NoError(t, err)
+	_, err = suite.noteService.Update(ctx, userId, NoteUpdateRequest{ID: created.ID, Board: boardId, Text: &changedText})
+	require.NoError(t, err)
+
+	revisions, err := suite.noteService.GetRevisions(ctx, boardId, created.ID)
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, changedText, revisions[0].Text)
+	assert.Equal(t, text, revisions[1].Text)
+	assert.Equal(t, userId, *revisions[1].Author)
+
+	events, err := suite.broker.GetBoardChannel(ctx, boardId)
+	require.NoError(t, err, "Failed to subscribe to board channel")
+
+	note, err := suite.noteService.RestoreRevision(ctx, userId, boardId, created.ID, revisions[1].ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, text, note.Text)
+	assert.True(t, note.Edited)
+
+	msg := <-events
+	assert.Equal(t, realtime.BoardEventNotesUpdated, msg.Type)
+
+	revisions, err = suite.noteService.GetRevisions(ctx, boardId, created.ID)
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 3)
+	assert.Equal(t, text, revisions[0].Text)
+}
+
 func (suite *NoteServiceIntegrationTestSuite) Test_Delete() {
 	t := suite.T()
 	ctx := context.Background()
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/realtime"
@@ -31,6 +32,7 @@ type NotesServiceTestSuite struct {
 	stackID                  uuid.NullUUID
 	mockCache                *cache.MockClient
 	mockBoardModifiedUpdater *common.MockBoardLastModifiedUpdater
+	mockAudit                *audit.MockAuditService
 	pos                      NotePosition
 	posUpdate                NoteUpdatePosition
 	ctx                      context.Context
@@ -53,7 +55,8 @@ func (suite *NotesServiceTestSuite) SetupTest() {
 	suite.mockCache = mockCache
 
 	suite.mockBoardModifiedUpdater = common.NewMockBoardLastModifiedUpdater(suite.T())
-	suite.service = NewNotesService(suite.mockDB, suite.broker, c, suite.mockBoardModifiedUpdater)
+	suite.mockAudit = audit.NewMockAuditService(suite.T())
+	suite.service = NewNotesService(suite.mockDB, suite.broker, c, suite.mockBoardModifiedUpdater, suite.mockAudit)
 
 	suite.authorID = uuid.New()
 	suite.boardID = uuid.New()
@@ -96,6 +99,12 @@ func (suite *NotesServiceTestSuite) expectBoardLastModifiedAtTouched() {
 	suite.mockBoardModifiedUpdater.EXPECT().UpdateLastModified(mock.Anything, suite.boardID, mock.AnythingOfType("time.Time")).Return(nil)
 }
 
+func (suite *NotesServiceTestSuite) expectRevision() {
+	suite.mockDB.EXPECT().CreateRevision(mock.Anything, mock.MatchedBy(func(insert DatabaseNoteRevisionInsert) bool {
+		return insert.Note == suite.noteID && insert.Author == uuid.NullUUID{UUID: suite.authorID, Valid: true}
+	})).Return(DatabaseNoteRevision{}, nil)
+}
+
 func (suite *NotesServiceTestSuite) expectPrecondition(stackingAllowed bool, callerRole role.Role) {
 	suite.mockDB.EXPECT().
 		GetPrecondition(mock.Anything, suite.noteID, suite.boardID, suite.authorID).
@@ -128,6 +137,7 @@ func (suite *NotesServiceTestSuite) Test_Create() {
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Create(context.Background(), NoteCreateRequest{User: suite.authorID, Board: suite.boardID, Column: suite.columnID, Text: text})
 
@@ -171,6 +181,7 @@ func (suite *NotesServiceTestSuite) Test_Import() {
 	suite.mockDB.EXPECT().ImportNote(mock.Anything, DatabaseNoteImport{Author: suite.authorID, Board: suite.boardID, Text: text, Position: &NoteUpdatePosition{Column: suite.columnID}}).
 		Return(DatabaseNote{ID: suite.noteID, Author: suite.authorID, Board: suite.boardID, Column: suite.columnID, Text: text, Stack: uuid.NullUUID{}, Rank: suite.rank, Edited: edited}, nil)
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Import(context.Background(), NoteImportRequest{User: suite.authorID, Board: suite.boardID, Text: text, Position: NotePosition{Column: suite.columnID}})
 
@@ -224,6 +235,7 @@ func (suite *NotesServiceTestSuite) Test_Update_Text_Owner() {
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Update(context.Background(), suite.authorID, NoteUpdateRequest{
 		Text:     &text,
@@ -255,6 +267,7 @@ func (suite *NotesServiceTestSuite) Test_Update_Position_Owner() {
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Update(context.Background(), suite.authorID, NoteUpdateRequest{
 		ID:       suite.noteID,
@@ -286,6 +299,7 @@ func (suite *NotesServiceTestSuite) Test_Update_Text_Moderator() {
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Update(context.Background(), suite.authorID, NoteUpdateRequest{
 		Text:     &text,
@@ -317,6 +331,7 @@ func (suite *NotesServiceTestSuite) Test_Update_Position_Moderator() {
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Update(context.Background(), suite.authorID, NoteUpdateRequest{
 		Text:     nil,
@@ -348,6 +363,7 @@ func (suite *NotesServiceTestSuite) Test_Update_Text_Participant() {
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Update(context.Background(), suite.authorID, NoteUpdateRequest{
 		Text:     &text,
@@ -407,6 +423,7 @@ func (suite *NotesServiceTestSuite) Test_Update_Position_Participant() {
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Update(context.Background(), suite.authorID, NoteUpdateRequest{
 		Text:     nil,
@@ -511,6 +528,7 @@ func (suite *NotesServiceTestSuite) Test_Import_UpdateLastModifiedError() {
 	suite.mockDB.EXPECT().ImportNote(mock.Anything, DatabaseNoteImport{Author: suite.authorID, Board: suite.boardID, Text: text, Position: &NoteUpdatePosition{Column: suite.columnID}}).
 		Return(DatabaseNote{ID: suite.noteID, Author: suite.authorID, Board: suite.boardID, Column: suite.columnID, Text: text, Stack: uuid.NullUUID{}, Rank: suite.rank, Edited: edited}, nil)
 	suite.mockBoardModifiedUpdater.EXPECT().UpdateLastModified(mock.Anything, suite.boardID, mock.AnythingOfType("time.Time")).Return(errors.New("cannot update board"))
+	suite.expectRevision()
 
 	note, err := suite.service.Import(context.Background(), NoteImportRequest{User: suite.authorID, Board: suite.boardID, Text: text, Position: NotePosition{Column: suite.columnID}})
 
@@ -594,6 +612,7 @@ func (suite *NotesServiceTestSuite) Test_Update_NegativeRankIsResetToZero() {
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
 	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
 
 	note, err := suite.service.Update(context.Background(), suite.authorID, NoteUpdateRequest{
 		ID:       suite.noteID,
@@ -658,6 +677,27 @@ func (suite *NotesServiceTestSuite) Test_DeleteNote_Moderator() {
 	suite.Nil(err)
 }
 
+func (suite *NotesServiceTestSuite) Test_DeleteNote_ModeratorOfOtherUser() {
+	moderatorID := uuid.New()
+
+	suite.expectNoLock()
+	suite.mockDB.EXPECT().GetPrecondition(mock.Anything, suite.noteID, suite.boardID, moderatorID).
+		Return(Precondition{StackingAllowed: true, CallerRole: role.ModeratorRole, Author: suite.authorID}, nil)
+	suite.mockDB.EXPECT().Get(mock.Anything, suite.noteID).
+		Return(DatabaseNote{ID: suite.noteID, Author: suite.authorID, Board: suite.boardID, Text: "Off topic"}, nil)
+	suite.mockDB.EXPECT().DeleteNote(mock.Anything, moderatorID, suite.boardID, suite.noteID, false).
+		Return(nil)
+	suite.expectPublish()
+	suite.expectBoardLastModifiedAtTouched()
+	suite.mockAudit.EXPECT().Record(mock.Anything, suite.boardID, audit.NoteDeleted, mock.MatchedBy(func(note *Note) bool {
+		return note.ID == suite.noteID && note.Text == "Off topic"
+	}), nil).Return()
+
+	err := suite.service.Delete(suite.ctx, moderatorID, NoteDeleteRequest{ID: suite.noteID, Board: suite.boardID, DeleteStack: false})
+
+	suite.Nil(err)
+}
+
 func (suite *NotesServiceTestSuite) Test_DeleteNote_NotAllowed() {
 	callerID := uuid.New()
 	callerRole := role.ParticipantRole
@@ -1176,6 +1216,7 @@ func (suite *NotesServiceTestSuite) Test_Update_BoardLastModifiedUpdateError() {
 	suite.mockBoardModifiedUpdater.EXPECT().UpdateLastModified(mock.Anything, suite.boardID, mock.AnythingOfType("time.Time")).Return(errors.New("cannot update board last modified"))
 	suite.expectGetAllEmpty()
 	suite.expectPublish()
+	suite.expectRevision()
 
 	note, err := suite.service.Update(context.Background(), suite.authorID, NoteUpdateRequest{
 		Text:  &text,
@@ -1372,3 +1413,140 @@ func (suite *NotesServiceTestSuite) Test_IsLocked_False() {
 
 	suite.False(locked)
 }
+
+func (suite *NotesServiceTestSuite) Test_GetRevisions() {
+	revisionID := uuid.New()
+	suite.mockDB.EXPECT().GetRevisions(mock.Anything, suite.boardID, suite.noteID).
+		Return([]DatabaseNoteRevision{{ID: revisionID, Note: suite.noteID, Author: uuid.NullUUID{UUID: suite.authorID, Valid: true}, Text: "first", Column: suite.columnID}}, nil)
+
+	revisions, err := suite.service.GetRevisions(suite.ctx, suite.boardID, suite.noteID)
+
+	suite.Nil(err)
+	suite.Len(revisions, 1)
+	suite.Equal(revisionID, revisions[0].ID)
+	suite.Equal(suite.authorID, *revisions[0].Author)
+	suite.Equal("first", revisions[0].Text)
+	suite.Equal(suite.columnID, revisions[0].Position.Column)
+}
+
+func (suite *NotesServiceTestSuite) Test_GetRevisionsByAuthor() {
+	revisionID := uuid.New()
+	suite.mockDB.EXPECT().GetRevisionsByAuthor(mock.Anything, suite.boardID, suite.authorID).
+		Return([]DatabaseNoteRevision{{ID: revisionID, Note: suite.noteID, Author: uuid.NullUUID{UUID: suite.authorID, Valid: true}, Text: "first", Column: suite.columnID}}, nil)
+
+	revisions, err := suite.service.GetRevisionsByAuthor(suite.ctx, suite.boardID, suite.authorID)
+
+	suite.Nil(err)
+	suite.Len(revisions, 1)
+	suite.Equal(revisionID, revisions[0].ID)
+}
+
+func (suite *NotesServiceTestSuite) Test_GetRevisions_NotFound() {
+	suite.mockDB.EXPECT().GetRevisions(mock.Anything, suite.boardID, suite.noteID).Return([]DatabaseNoteRevision{}, nil)
+
+	revisions, err := suite.service.GetRevisions(suite.ctx, suite.boardID, suite.noteID)
+
+	suite.Nil(revisions)
+
+	var noteErr NoteError
+	suite.ErrorAs(err, &noteErr)
+	suite.Equal(NotFound, noteErr.Category)
+}
+
+func (suite *NotesServiceTestSuite) Test_GetRevisions_DatabaseError() {
+	dbError := errors.New("database error")
+	suite.mockDB.EXPECT().GetRevisions(mock.Anything, suite.boardID, suite.noteID).Return(nil, dbError)
+
+	revisions, err := suite.service.GetRevisions(suite.ctx, suite.boardID, suite.noteID)
+
+	suite.Nil(revisions)
+	suite.ErrorIs(err, dbError)
+}
+
+func (suite *NotesServiceTestSuite) Test_RestoreRevision() {
+	revisionID := uuid.New()
+	text := "Earlier text"
+
+	suite.mockDB.EXPECT().GetRevision(mock.Anything, suite.boardID, suite.noteID, revisionID).
+		Return(DatabaseNoteRevision{ID: revisionID, Note: suite.noteID, Text: text, Column: uuid.New()}, nil)
+	suite.expectNoLock()
+	suite.expectPrecondition(true, role.ParticipantRole)
+	suite.mockDB.EXPECT().UpdateNote(mock.Anything, suite.authorID, DatabaseNoteUpdate{
+		ID:     suite.noteID,
+		Board:  suite.boardID,
+		Text:   &text,
+		Edited: true,
+	}).Return(DatabaseNote{ID: suite.noteID, Author: suite.authorID, Board: suite.boardID, Column: suite.columnID, Text: text, Edited: true}, nil)
+	suite.expectGetAllEmpty()
+	suite.expectPublish()
+	suite.expectBoardLastModifiedAtTouched()
+	suite.expectRevision()
+
+	note, err := suite.service.RestoreRevision(suite.ctx, suite.authorID, suite.boardID, suite.noteID, revisionID)
+
+	suite.Nil(err)
+	suite.assertNoteMatches(text, note)
+	suite.True(note.Edited)
+}
+
+func (suite *NotesServiceTestSuite) Test_RestoreRevision_NotFound() {
+	revisionID := uuid.New()
+	suite.mockDB.EXPECT().GetRevision(mock.Anything, suite.boardID, suite.noteID, revisionID).
+		Return(DatabaseNoteRevision{}, sql.ErrNoRows)
+
+	note, err := suite.service.RestoreRevision(suite.ctx, suite.authorID, suite.boardID, suite.noteID, revisionID)
+
+	suite.Nil(note)
+
+	var noteErr NoteError
+	suite.ErrorAs(err, &noteErr)
+	suite.Equal(NotFound, noteErr.Category)
+}
+
+func (suite *NotesServiceTestSuite) Test_RestoreRevision_Forbidden() {
+	revisionID := uuid.New()
+	otherUser := uuid.New()
+	text := "Earlier text"
+
+	suite.mockDB.EXPECT().GetRevision(mock.Anything, suite.boardID, suite.noteID, revisionID).
+		Return(DatabaseNoteRevision{ID: revisionID, Note: suite.noteID, Text: text}, nil)
+	suite.mockDB.EXPECT().GetPrecondition(mock.Anything, suite.noteID, suite.boardID, otherUser).
+		Return(Precondition{CallerRole: role.ParticipantRole, Author: suite.authorID}, nil)
+
+	note, err := suite.service.RestoreRevision(suite.ctx, otherUser, suite.boardID, suite.noteID, revisionID)
+
+	suite.Nil(note)
+
+	var noteErr NoteError
+	suite.ErrorAs(err, &noteErr)
+	suite.Equal(Forbidden, noteErr.Category)
+}
+
+func (suite *NotesServiceTestSuite) Test_SetEstimate() {
+	estimate := "8"
+	suite.mockDB.EXPECT().SetEstimate(mock.Anything, suite.boardID, suite.noteID, &estimate).
+		Return(DatabaseNote{ID: suite.noteID, Author: suite.authorID, Board: suite.boardID, Column: suite.columnID, Text: "Story", Estimate: &estimate}, nil)
+	suite.expectGetAllEmpty()
+	suite.expectPublish()
+	suite.expectBoardLastModifiedAtTouched()
+
+	note, err := suite.service.SetEstimate(suite.ctx, suite.boardID, suite.noteID, &estimate)
+
+	suite.Nil(err)
+	suite.assertNoteMatches("Story", note)
+	suite.Equal(&estimate, note.Estimate)
+}
+
+func (suite *NotesServiceTestSuite) Test_SetEstimate_NotFound() {
+	estimate := "8"
+	suite.mockDB.EXPECT().SetEstimate(mock.Anything, suite.boardID, suite.noteID, &estimate).
+		Return(DatabaseNote{}, sql.ErrNoRows)
+
+	note, err := suite.service.SetEstimate(suite.ctx, suite.boardID, suite.noteID, &estimate)
+
+	suite.Nil(note)
+
+	var noteErr NoteError
+	suite.ErrorAs(err, &noteErr)
+	suite.Equal(NotFound, noteErr.Category)
+}
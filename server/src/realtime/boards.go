@@ -39,11 +39,20 @@ const (
 	BoardEventBoardReactionAdded    BoardEventType = "BOARD_REACTION_ADDED"
 	BoardEventNoteDragStart         BoardEventType = "NOTE_DRAG_START"
 	BoardEventNoteDragEnd           BoardEventType = "NOTE_DRAG_END"
+	BoardEventActionItemCreated     BoardEventType = "ACTION_ITEM_CREATED"
+	BoardEventActionItemUpdated     BoardEventType = "ACTION_ITEM_UPDATED"
+	BoardEventActionItemDeleted     BoardEventType = "ACTION_ITEM_DELETED"
+	BoardEventEstimationStarted     BoardEventType = "ESTIMATION_STARTED"
+	BoardEventEstimateSubmitted     BoardEventType = "ESTIMATE_SUBMITTED"
+	BoardEventEstimationRevealed    BoardEventType = "ESTIMATION_REVEALED"
+	BoardEventEstimationClosed      BoardEventType = "ESTIMATION_CLOSED"
 )
 
 type BoardEvent struct {
 	Type BoardEventType `json:"type"`
-	Data any            `json:"data,omitempty"`
+	// Sequence increases with every event of a board, it is 0 if the event was not logged
+	Sequence uint64 `json:"sequence,omitempty"`
+	Data     any    `json:"data,omitempty"`
 }
 
 func (b *Broker) BroadcastToBoard(ctx context.Context, boardID uuid.UUID, msg BoardEvent) error {
@@ -51,7 +60,20 @@ func (b *Broker) BroadcastToBoard(ctx context.Context, boardID uuid.UUID, msg Bo
 	defer span.End()
 	log := logger.FromContext(ctx)
 
-	log.Debugw("broadcasting to board", "board", boardID, "msg", msg.Type)
+	if b.EventLog != nil {
+		if msg.Type == BoardEventBoardDeleted {
+			if err := b.EventLog.Delete(ctx, boardID); err != nil {
+				log.Warnw("failed to delete event log of board", "board", boardID, "err", err)
+			}
+		} else if err := b.EventLog.Append(ctx, boardID, &msg); err != nil {
+			// clients still receive the event, but have to fetch the whole board after a reconnect
+			span.SetStatus(codes.Error, "failed to log board event")
+			span.RecordError(err)
+			log.Warnw("failed to log board event", "board", boardID, "msg", msg.Type, "err", err)
+		}
+	}
+
+	log.Debugw("broadcasting to board", "board", boardID, "msg", msg.Type, "sequence", msg.Sequence)
 	return b.Con.Publish(ctx, boardsSubject(boardID), msg)
 }
 
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 
+	"github.com/uptrace/bun"
 	"github.com/urfave/cli/v2"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/initialize"
 	"scrumlr.io/server/logger"
 )
 
@@ -33,13 +35,34 @@ type Client interface {
 // The Broker enables a user to broadcast and receive events
 type Broker struct {
 	Con Client
+
+	// EventLog numbers and keeps the board events for replays, events are not logged if it is nil
+	EventLog *EventLog
 }
 
-func InitializeRealtime(ctx *cli.Context) (*Broker, error) {
+func InitializeRealtime(ctx *cli.Context, db *bun.DB) (*Broker, error) {
 	log := logger.FromContext(ctx.Context)
 
 	var broker *Broker
 
+	if ctx.Bool("in-memory") {
+		log.Info("Using in-memory message broker")
+
+		return NewMemory(), nil
+	}
+
+	if ctx.Bool("postgres-broker") {
+		databaseUrl, err := initialize.DatabaseURL(ctx)
+		if err != nil {
+			return broker, err
+		}
+
+		log.Info("Using postgres as message broker")
+
+		broker, err := NewPostgres(db, databaseUrl)
+		return broker, err
+	}
+
 	if ctx.String("redis-address") != "" {
 		redis := RedisServer{
 			Addr:     ctx.String("redis-address"),
@@ -23,7 +23,7 @@ func TestInitializeRealtime_Nats(t *testing.T) {
 	err := init.Set("nats", connection)
 	assert.NoError(t, err)
 
-	broker, err := InitializeRealtime(init)
+	broker, err := InitializeRealtime(init, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, broker)
@@ -45,7 +45,7 @@ func TestInitializeRealtime_Redis(t *testing.T) {
 	err := init.Set("redis-address", connection)
 	assert.NoError(t, err)
 
-	broker, err := InitializeRealtime(init)
+	broker, err := InitializeRealtime(init, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, broker)
@@ -62,7 +62,7 @@ func TestInitializeRealtime_NotConfigured(t *testing.T) {
 	flagset.String("nats", "", "")
 	init := cli.NewContext(nil, flagset, nil)
 
-	broker, err := InitializeRealtime(init)
+	broker, err := InitializeRealtime(init, nil)
 
 	assert.Error(t, err)
 	assert.Equal(t, errors.New("no valid message broker configuration found"), err)
@@ -84,7 +84,7 @@ func TestInitializeRealtime_PrefereRedis(t *testing.T) {
 	err = init.Set("nats", "not valide connection")
 	assert.NoError(t, err)
 
-	broker, err := InitializeRealtime(init)
+	broker, err := InitializeRealtime(init, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, broker)
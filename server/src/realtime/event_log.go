@@ -0,0 +1,174 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"scrumlr.io/server/cache"
+	"scrumlr.io/server/logger"
+)
+
+// DefaultEventLogSize is the number of events that are kept per board for replays.
+const DefaultEventLogSize = 500
+
+// DefaultEventLogTTL is how long the events of a board are kept after its latest event.
+// Clients that reconnect later on fetch the whole board again. The sequence of the board is kept
+// until the board is deleted, so that a new log never reuses sequence numbers of an expired one.
+const DefaultEventLogTTL = 6 * time.Hour
+
+// The EventLog numbers the events of a board and keeps the latest of them,
+// so that reconnecting clients only need to receive the events they missed.
+type EventLog struct {
+	cache *cache.Cache
+	size  int
+	ttl   time.Duration
+}
+
+func NewEventLog(cache *cache.Cache, size int, ttl time.Duration) *EventLog {
+	return &EventLog{cache: cache, size: size, ttl: ttl}
+}
+
+// Append assigns the next sequence number of the board to the event and stores it in the log.
+func (l *EventLog) Append(ctx context.Context, boardID uuid.UUID, event *BoardEvent) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.event_log.append")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.event_log.append.board", boardID.String()),
+	)
+
+	sequence, err := l.cache.Con.Increment(ctx, eventSequenceKey(boardID), 0)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to increment sequence")
+		span.RecordError(err)
+		return err
+	}
+
+	event.Sequence = uint64(sequence)
+
+	err = l.cache.Con.Append(ctx, eventLogKey(boardID), event, l.size, l.ttl)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to append event")
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// Since returns all events of the board after the given sequence number ordered by their sequence.
+// If not all of these events are still part of the log, ok is false and the client has to
+// fetch the whole board again.
+func (l *EventLog) Since(ctx context.Context, boardID uuid.UUID, sequence uint64) (events []*BoardEvent, ok bool, err error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.event_log.since")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.event_log.since.board", boardID.String()),
+		attribute.Int64("scrumlr.realtime.event_log.since.sequence", int64(sequence)),
+	)
+
+	loggedEvents, err := l.events(ctx, boardID)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get event log")
+		span.RecordError(err)
+		return nil, false, err
+	}
+
+	current := uint64(0)
+	if len(loggedEvents) > 0 {
+		current = loggedEvents[len(loggedEvents)-1].Sequence
+	}
+	if sequence > current {
+		// the sequence was issued before the log got reset
+		return nil, false, nil
+	}
+
+	events = make([]*BoardEvent, 0, current-sequence)
+	for _, event := range loggedEvents {
+		if event.Sequence > sequence {
+			events = append(events, event)
+		}
+	}
+
+	// events are numbered without gaps, so any missing number was either dropped from the log
+	// or has not been appended yet
+	for i, event := range events {
+		if event.Sequence != sequence+uint64(i)+1 {
+			return nil, false, nil
+		}
+	}
+
+	return events, true, nil
+}
+
+// Current returns the sequence number of the latest event of the board or 0 if there is none.
+func (l *EventLog) Current(ctx context.Context, boardID uuid.UUID) (uint64, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.event_log.current")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.event_log.current.board", boardID.String()),
+	)
+
+	sequence, err := l.cache.Con.GetCounter(ctx, eventSequenceKey(boardID))
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get event sequence")
+		span.RecordError(err)
+		return 0, err
+	}
+
+	return uint64(sequence), nil
+}
+
+// events returns the logged events of the board ordered by their sequence. Events of concurrent
+// broadcasts may be appended in a different order than they were numbered.
+func (l *EventLog) events(ctx context.Context, boardID uuid.UUID) ([]*BoardEvent, error) {
+	log := logger.FromContext(ctx)
+
+	entries, err := l.cache.Con.GetList(ctx, eventLogKey(boardID))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*BoardEvent, 0, len(entries))
+	for _, entry := range entries {
+		var event BoardEvent
+		if err := json.Unmarshal(entry, &event); err != nil {
+			log.Errorw("unable to unmarshal event of event log", "board", boardID, "err", err)
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Sequence < events[j].Sequence
+	})
+
+	return events, nil
+}
+
+// Delete removes the sequence and the log of the board.
+func (l *EventLog) Delete(ctx context.Context, boardID uuid.UUID) error {
+	err := l.cache.Con.Delete(ctx, eventSequenceKey(boardID))
+	if err != nil {
+		return err
+	}
+
+	return l.cache.Con.Delete(ctx, eventLogKey(boardID))
+}
+
+func eventSequenceKey(boardID uuid.UUID) string {
+	return fmt.Sprintf("board-events-sequence.%s", boardID)
+}
+
+func eventLogKey(boardID uuid.UUID) string {
+	return fmt.Sprintf("board-events.%s", boardID)
+}
@@ -0,0 +1,170 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"scrumlr.io/server/cache"
+)
+
+func loggedEvents(t *testing.T, sequences ...uint64) [][]byte {
+	entries := make([][]byte, 0, len(sequences))
+	for _, sequence := range sequences {
+		entry, err := json.Marshal(BoardEvent{Type: BoardEventNotesUpdated, Sequence: sequence})
+		assert.Nil(t, err)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestEventLogAppend(t *testing.T) {
+	boardID := uuid.New()
+	mockCache := cache.NewMockClient(t)
+	mockCache.EXPECT().Increment(mock.Anything, fmt.Sprintf("board-events-sequence.%s", boardID), time.Duration(0)).Return(int64(7), nil)
+	mockCache.EXPECT().Append(mock.Anything, fmt.Sprintf("board-events.%s", boardID), mock.MatchedBy(func(event *BoardEvent) bool {
+		return event.Sequence == 7
+	}), 10, time.Hour).Return(nil)
+
+	eventLog := NewEventLog(&cache.Cache{Con: mockCache}, 10, time.Hour)
+	event := BoardEvent{Type: BoardEventNotesUpdated}
+
+	err := eventLog.Append(context.Background(), boardID, &event)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), event.Sequence)
+}
+
+func TestEventLogAppend_IncrementFails(t *testing.T) {
+	boardID := uuid.New()
+	mockCache := cache.NewMockClient(t)
+	mockCache.EXPECT().Increment(mock.Anything, mock.Anything, mock.Anything).Return(0, errors.New("failed"))
+
+	eventLog := NewEventLog(&cache.Cache{Con: mockCache}, 10, time.Hour)
+	event := BoardEvent{Type: BoardEventNotesUpdated}
+
+	err := eventLog.Append(context.Background(), boardID, &event)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(0), event.Sequence)
+}
+
+func TestEventLogSince(t *testing.T) {
+	tests := []struct {
+		name              string
+		logged            []uint64
+		sequence          uint64
+		expectedOk        bool
+		expectedSequences []uint64
+	}{
+		{name: "missed events", logged: []uint64{3, 4, 5, 6}, sequence: 4, expectedOk: true, expectedSequences: []uint64{5, 6}},
+		{name: "out of order", logged: []uint64{3, 5, 4}, sequence: 3, expectedOk: true, expectedSequences: []uint64{4, 5}},
+		{name: "nothing missed", logged: []uint64{3, 4}, sequence: 4, expectedOk: true, expectedSequences: []uint64{}},
+		{name: "empty log", logged: []uint64{}, sequence: 0, expectedOk: true, expectedSequences: []uint64{}},
+		{name: "dropped from log", logged: []uint64{5, 6}, sequence: 2, expectedOk: false},
+		{name: "not yet appended", logged: []uint64{4, 6}, sequence: 3, expectedOk: false},
+		{name: "log was reset", logged: []uint64{1, 2}, sequence: 8, expectedOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boardID := uuid.New()
+			mockCache := cache.NewMockClient(t)
+			mockCache.EXPECT().GetList(mock.Anything, fmt.Sprintf("board-events.%s", boardID)).Return(loggedEvents(t, tt.logged...), nil)
+
+			eventLog := NewEventLog(&cache.Cache{Con: mockCache}, 10, time.Hour)
+
+			events, ok, err := eventLog.Since(context.Background(), boardID, tt.sequence)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedOk, ok)
+			if tt.expectedOk {
+				sequences := make([]uint64, 0, len(events))
+				for _, event := range events {
+					sequences = append(sequences, event.Sequence)
+				}
+				assert.Equal(t, tt.expectedSequences, sequences)
+			}
+		})
+	}
+}
+
+func TestEventLogSince_AfterLogExpired(t *testing.T) {
+	boardID := uuid.New()
+	eventLog := NewEventLog(cache.NewMemory(), 100, 50*time.Millisecond)
+
+	for range 50 {
+		assert.Nil(t, eventLog.Append(context.Background(), boardID, &BoardEvent{Type: BoardEventNotesUpdated}))
+	}
+
+	// the client disconnects after the 50th event and the log expires
+	time.Sleep(100 * time.Millisecond)
+
+	for range 60 {
+		assert.Nil(t, eventLog.Append(context.Background(), boardID, &BoardEvent{Type: BoardEventNotesUpdated}))
+	}
+
+	events, ok, err := eventLog.Since(context.Background(), boardID, 50)
+
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Len(t, events, 60)
+	assert.Equal(t, uint64(51), events[0].Sequence)
+	assert.Equal(t, uint64(110), events[59].Sequence)
+
+	// clients that missed events of the expired log fetch the whole board again
+	_, ok, err = eventLog.Since(context.Background(), boardID, 40)
+
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestEventLogCurrent(t *testing.T) {
+	boardID := uuid.New()
+	mockCache := cache.NewMockClient(t)
+	mockCache.EXPECT().GetCounter(mock.Anything, fmt.Sprintf("board-events-sequence.%s", boardID)).Return(int64(4), nil)
+
+	eventLog := NewEventLog(&cache.Cache{Con: mockCache}, 10, time.Hour)
+
+	current, err := eventLog.Current(context.Background(), boardID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), current)
+}
+
+func TestBroadcastToBoard_LogsEvent(t *testing.T) {
+	boardID := uuid.New()
+	mockCache := cache.NewMockClient(t)
+	mockCache.EXPECT().Increment(mock.Anything, mock.Anything, DefaultEventLogTTL).Return(int64(1), nil)
+	mockCache.EXPECT().Append(mock.Anything, mock.Anything, mock.Anything, DefaultEventLogSize, DefaultEventLogTTL).Return(nil)
+	mockClient := NewMockClient(t)
+	mockClient.EXPECT().Publish(mock.Anything, fmt.Sprintf("board.%s", boardID), BoardEvent{Type: BoardEventNotesUpdated, Sequence: 1}).Return(nil)
+
+	broker := Broker{Con: mockClient, EventLog: NewEventLog(&cache.Cache{Con: mockCache}, DefaultEventLogSize, DefaultEventLogTTL)}
+
+	err := broker.BroadcastToBoard(context.Background(), boardID, BoardEvent{Type: BoardEventNotesUpdated})
+
+	assert.Nil(t, err)
+}
+
+func TestBroadcastToBoard_DeletesLogOfDeletedBoard(t *testing.T) {
+	boardID := uuid.New()
+	mockCache := cache.NewMockClient(t)
+	mockCache.EXPECT().Delete(mock.Anything, fmt.Sprintf("board-events-sequence.%s", boardID)).Return(nil)
+	mockCache.EXPECT().Delete(mock.Anything, fmt.Sprintf("board-events.%s", boardID)).Return(nil)
+	mockClient := NewMockClient(t)
+	mockClient.EXPECT().Publish(mock.Anything, fmt.Sprintf("board.%s", boardID), BoardEvent{Type: BoardEventBoardDeleted}).Return(nil)
+
+	broker := Broker{Con: mockClient, EventLog: NewEventLog(&cache.Cache{Con: mockCache}, DefaultEventLogSize, DefaultEventLogTTL)}
+
+	err := broker.BroadcastToBoard(context.Background(), boardID, BoardEvent{Type: BoardEventBoardDeleted})
+
+	assert.Nil(t, err)
+}
@@ -0,0 +1,172 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/logger"
+)
+
+// memorySubscriptionBuffer is the number of events a subscriber may fall behind before its events are dropped
+const memorySubscriptionBuffer = 256
+
+type memoryClient struct {
+	subscribers *localSubscribers
+}
+
+// NewMemory returns a Broker that delivers the events within the process.
+// It is only suitable if a single server instance is running.
+func NewMemory() *Broker {
+	return &Broker{
+		Con: &memoryClient{subscribers: newLocalSubscribers()},
+	}
+}
+
+// Publish the given event to all subscribers of the given subject
+func (m *memoryClient) Publish(ctx context.Context, subject string, event any) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.memory.publish")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.memory.publish.subject", subject),
+	)
+
+	// every subscriber decodes its own copy, just like with an external message broker
+	data, err := json.Marshal(event)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal event")
+		span.RecordError(err)
+		log.Errorw("unable to marshal event in publish", "subject", subject, "event", event, "err", err)
+		return err
+	}
+
+	m.subscribers.deliver(ctx, subject, data)
+
+	return nil
+}
+
+// SubscribeToBoardSessionEvents subscribes to the given subject
+func (m *memoryClient) SubscribeToBoardSessionEvents(ctx context.Context, subject string) (chan *BoardSessionRequestEventType, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.memory.subscribe.session")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.memory.subscribe.session.subject", subject),
+	)
+
+	receiverChan := make(chan *BoardSessionRequestEventType)
+	go forwardEvents(ctx, m.subscribers.subscribe(ctx, subject), receiverChan)
+
+	return receiverChan, nil
+}
+
+// SubscribeToBoardEvents subscribes to the given subject
+func (m *memoryClient) SubscribeToBoardEvents(ctx context.Context, subject string) (chan *BoardEvent, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.memory.subscribe.board")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.memory.subscribe.board.subject", subject),
+	)
+
+	receiverChan := make(chan *BoardEvent)
+	go forwardEvents(ctx, m.subscribers.subscribe(ctx, subject), receiverChan)
+
+	return receiverChan, nil
+}
+
+// localSubscribers keeps the subscriptions of this server instance by subject
+type localSubscribers struct {
+	mu          sync.RWMutex
+	subscribers map[string][]chan []byte
+}
+
+func newLocalSubscribers() *localSubscribers {
+	return &localSubscribers{subscribers: make(map[string][]chan []byte)}
+}
+
+// subscribe adds a subscription to the subject, which is removed again once the context is done
+func (l *localSubscribers) subscribe(ctx context.Context, subject string) chan []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	messages := make(chan []byte, memorySubscriptionBuffer)
+	l.subscribers[subject] = append(l.subscribers[subject], messages)
+
+	if ctx.Done() != nil {
+		go func() {
+			<-ctx.Done()
+			l.unsubscribe(subject, messages)
+		}()
+	}
+
+	return messages
+}
+
+func (l *localSubscribers) unsubscribe(subject string, messages chan []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	remaining := slices.DeleteFunc(slices.Clone(l.subscribers[subject]), func(subscriber chan []byte) bool {
+		return subscriber == messages
+	})
+	if len(remaining) == 0 {
+		delete(l.subscribers, subject)
+		return
+	}
+	l.subscribers[subject] = remaining
+}
+
+func (l *localSubscribers) has(subject string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return len(l.subscribers[subject]) > 0
+}
+
+// deliver passes the data to all subscribers of the subject without waiting for them.
+// Subscribers that fell too far behind miss the event instead of blocking the publisher.
+func (l *localSubscribers) deliver(ctx context.Context, subject string, data []byte) {
+	l.mu.RLock()
+	subscribers := slices.Clone(l.subscribers[subject])
+	l.mu.RUnlock()
+
+	for _, subscriber := range subscribers {
+		select {
+		case subscriber <- data:
+		default:
+			logger.FromContext(ctx).Warnw("dropped event of subscriber that fell behind", "subject", subject)
+		}
+	}
+}
+
+// forwardEvents decodes the published messages and passes them to the receiver
+// until the context of the subscription is done. The receiver is closed afterwards.
+func forwardEvents[T any](ctx context.Context, messages chan []byte, receiver chan *T) {
+	log := logger.FromContext(ctx)
+	defer close(receiver)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case data := <-messages:
+			var event T
+			if err := json.Unmarshal(data, &event); err != nil {
+				log.Errorw("unable to unmarshal event of subscription", "err", err)
+				continue
+			}
+
+			select {
+			case receiver <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
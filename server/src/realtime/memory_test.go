@@ -0,0 +1,117 @@
+package realtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveWithTimeout[T any](t *testing.T, channel chan *T) *T {
+	select {
+	case event := <-channel:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func TestMemoryBroadcastToBoard(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	broker := NewMemory()
+
+	firstChannel, err := broker.GetBoardChannel(ctx, boardID)
+	require.NoError(t, err)
+	secondChannel, err := broker.GetBoardChannel(ctx, boardID)
+	require.NoError(t, err)
+	otherChannel, err := broker.GetBoardChannel(ctx, uuid.New())
+	require.NoError(t, err)
+
+	err = broker.BroadcastToBoard(ctx, boardID, BoardEvent{Type: BoardEventBoardUpdated, Data: "data"})
+	assert.Nil(t, err)
+
+	for _, channel := range []chan *BoardEvent{firstChannel, secondChannel} {
+		event := receiveWithTimeout(t, channel)
+		assert.Equal(t, BoardEventBoardUpdated, event.Type)
+		assert.Equal(t, "data", event.Data)
+	}
+
+	select {
+	case event := <-otherChannel:
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestMemoryBroadcastToBoardSessionRequest(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	userID := uuid.New()
+	broker := NewMemory()
+
+	channel, err := broker.GetBoardSessionRequestChannel(ctx, boardID, userID)
+	require.NoError(t, err)
+
+	err = broker.BroadcastUpdateOnBoardSessionRequest(ctx, boardID, userID, RequestAccepted)
+	assert.Nil(t, err)
+
+	event := receiveWithTimeout(t, channel)
+	assert.Equal(t, RequestAccepted, *event)
+}
+
+func TestMemoryPublishDoesNotWaitForSubscriber(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	broker := NewMemory()
+
+	// the channel is never read, so the subscriber falls behind
+	_, err := broker.GetBoardChannel(ctx, boardID)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		for range memorySubscriptionBuffer + 10 {
+			_ = broker.BroadcastToBoard(ctx, boardID, BoardEvent{Type: BoardEventBoardUpdated})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publishing waited for the subscriber")
+	}
+}
+
+func TestMemoryUnsubscribeOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	boardID := uuid.New()
+	broker := NewMemory()
+	client := broker.Con.(*memoryClient)
+
+	channel, err := broker.GetBoardChannel(ctx, boardID)
+	require.NoError(t, err)
+	assert.True(t, client.subscribers.has(boardsSubject(boardID)))
+
+	cancel()
+
+	assert.Eventually(t, func() bool {
+		return !client.subscribers.has(boardsSubject(boardID))
+	}, time.Second, time.Millisecond)
+
+	select {
+	case _, open := <-channel:
+		assert.False(t, open)
+	case <-time.After(time.Second):
+		t.Fatal("channel of the subscription was not closed")
+	}
+}
+
+func TestMemoryIsHealthy(t *testing.T) {
+	assert.True(t, NewMemory().IsHealthy(context.Background()))
+}
@@ -0,0 +1,225 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/logger"
+)
+
+const (
+	// postgresChannel is the single notification channel, because subjects exceed the length of channel names
+	postgresChannel = "scrumlr_realtime"
+
+	// postgresEventRetention is how long published events are kept for the listeners to read them
+	postgresEventRetention = time.Minute
+)
+
+type postgresClient struct {
+	db          *bun.DB
+	listener    *pq.Listener
+	subscribers *localSubscribers
+	lastCleanup atomic.Int64
+}
+
+// NewPostgres returns a Broker that uses LISTEN/NOTIFY of the given database.
+// Notification payloads are limited in size, so the events are stored in the realtime_events
+// table and only their id is sent with the notification.
+func NewPostgres(db *bun.DB, databaseUrl string) (*Broker, error) {
+	listener := pq.NewListener(databaseUrl, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Get().Warnw("postgres listener connection changed", "event", event, "err", err)
+		}
+	})
+
+	if err := listener.Listen(postgresChannel); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("unable to listen on postgres channel %s: %w", postgresChannel, err)
+	}
+
+	// events published before listening are not delivered, only the ones missed during a reconnect
+	var lastEvent int64
+	if err := db.QueryRow("SELECT COALESCE(MAX(id), 0) FROM realtime_events").Scan(&lastEvent); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("unable to get latest realtime event: %w", err)
+	}
+
+	client := &postgresClient{
+		db:          db,
+		listener:    listener,
+		subscribers: newLocalSubscribers(),
+	}
+	go client.receive(lastEvent)
+
+	return &Broker{Con: client}, nil
+}
+
+// Publish the given event to the given subject
+func (p *postgresClient) Publish(ctx context.Context, subject string, event any) error {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.postgres.publish")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.postgres.publish.subject", subject),
+	)
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to marshal event")
+		span.RecordError(err)
+		log.Errorw("unable to marshal event in publish", "subject", subject, "event", event, "err", err)
+		return err
+	}
+
+	_, err = p.db.NewRaw(
+		`WITH event AS (INSERT INTO realtime_events (subject, payload) VALUES (?, ?) RETURNING id)
+		SELECT pg_notify(?, event.id || ' ' || ?) FROM event`,
+		subject, string(data), postgresChannel, subject,
+	).Exec(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to publish event")
+		span.RecordError(err)
+		log.Errorw("failed to publish event", "subject", subject, "err", err)
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	p.removeOldEvents(ctx)
+
+	return nil
+}
+
+// SubscribeToBoardSessionEvents subscribes to the given subject
+func (p *postgresClient) SubscribeToBoardSessionEvents(ctx context.Context, subject string) (chan *BoardSessionRequestEventType, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.postgres.subscribe.session")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.postgres.subscribe.session.subject", subject),
+	)
+
+	receiverChan := make(chan *BoardSessionRequestEventType)
+	go forwardEvents(ctx, p.subscribers.subscribe(ctx, subject), receiverChan)
+
+	return receiverChan, nil
+}
+
+// SubscribeToBoardEvents subscribes to the given subject
+func (p *postgresClient) SubscribeToBoardEvents(ctx context.Context, subject string) (chan *BoardEvent, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.realtime.postgres.subscribe.board")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.realtime.postgres.subscribe.board.subject", subject),
+	)
+
+	receiverChan := make(chan *BoardEvent)
+	go forwardEvents(ctx, p.subscribers.subscribe(ctx, subject), receiverChan)
+
+	return receiverChan, nil
+}
+
+// receive loads the events of all notifications with local subscribers and delivers them.
+// Notifications sent while the listener reconnects are lost, so the events published
+// in the meantime are read from the table after every reconnect.
+func (p *postgresClient) receive(lastEvent int64) {
+	log := logger.Get()
+
+	// events up to caughtUp were already delivered after a reconnect
+	var caughtUp int64
+
+	for {
+		select {
+		case notification := <-p.listener.Notify:
+			// a nil notification signals a reconnect
+			if notification == nil {
+				last, err := p.deliverMissed(context.Background(), lastEvent)
+				if err != nil {
+					log.Errorw("unable to load events published during reconnect", "after", lastEvent, "err", err)
+					continue
+				}
+				lastEvent, caughtUp = last, last
+				continue
+			}
+
+			id, subject, found := strings.Cut(notification.Extra, " ")
+			if !found {
+				continue
+			}
+
+			eventID, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				log.Errorw("invalid postgres notification", "payload", notification.Extra, "err", err)
+				continue
+			}
+
+			lastEvent = max(lastEvent, eventID)
+			if eventID <= caughtUp || !p.subscribers.has(subject) {
+				continue
+			}
+
+			var payload string
+			err = p.db.QueryRowContext(context.Background(), "SELECT payload FROM realtime_events WHERE id = ?", eventID).Scan(&payload)
+			if err != nil {
+				log.Errorw("unable to load published event", "id", eventID, "subject", subject, "err", err)
+				continue
+			}
+
+			p.subscribers.deliver(context.Background(), subject, []byte(payload))
+		case <-time.After(90 * time.Second):
+			// check the connection in case no notification was received for a while
+			go func() {
+				_ = p.listener.Ping()
+			}()
+		}
+	}
+}
+
+type postgresEvent struct {
+	ID      int64
+	Subject string
+	Payload string
+}
+
+// deliverMissed delivers the stored events after the given id to the local subscribers
+// and returns the id of the latest event.
+func (p *postgresClient) deliverMissed(ctx context.Context, after int64) (int64, error) {
+	var events []postgresEvent
+	err := p.db.NewRaw("SELECT id, subject, payload FROM realtime_events WHERE id > ? ORDER BY id", after).Scan(ctx, &events)
+	if err != nil {
+		return after, err
+	}
+
+	last := after
+	for _, event := range events {
+		last = event.ID
+		if p.subscribers.has(event.Subject) {
+			p.subscribers.deliver(ctx, event.Subject, []byte(event.Payload))
+		}
+	}
+
+	return last, nil
+}
+
+// removeOldEvents deletes events all listeners had enough time to read, at most once per retention period.
+func (p *postgresClient) removeOldEvents(ctx context.Context) {
+	now := time.Now()
+	last := p.lastCleanup.Load()
+	if now.Sub(time.Unix(0, last)) < postgresEventRetention || !p.lastCleanup.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	_, err := p.db.NewRaw("DELETE FROM realtime_events WHERE created_at < ?", now.Add(-postgresEventRetention)).Exec(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Warnw("unable to remove old realtime events", "err", err)
+	}
+}
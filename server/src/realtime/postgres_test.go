@@ -0,0 +1,96 @@
+package realtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"scrumlr.io/server/initialize/testDbTemplates"
+)
+
+type RealtimePostgresDatabaseTestSuite struct {
+	suite.Suite
+	broker *Broker
+}
+
+func TestRealtimePostgresDatabaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RealtimePostgresDatabaseTestSuite))
+}
+
+func (suite *RealtimePostgresDatabaseTestSuite) SetupTest() {
+	db := testDbTemplates.NewBaseTestDB(suite.T(), false)
+
+	// the listener has to connect to the same database the test instance was cloned into
+	config := testDbTemplates.InitPgTestDB(suite.T())
+	err := db.QueryRow("SELECT current_database()").Scan(&config.Database)
+	require.NoError(suite.T(), err)
+
+	broker, err := NewPostgres(db, config.URL())
+	require.NoError(suite.T(), err)
+	suite.broker = broker
+}
+
+func (suite *RealtimePostgresDatabaseTestSuite) Test_BroadcastToBoard() {
+	t := suite.T()
+	ctx := context.Background()
+	boardID := uuid.New()
+
+	channel, err := suite.broker.GetBoardChannel(ctx, boardID)
+	require.NoError(t, err)
+
+	err = suite.broker.BroadcastToBoard(ctx, boardID, BoardEvent{Type: BoardEventBoardUpdated, Data: "data"})
+	assert.Nil(t, err)
+
+	event := receiveWithTimeout(t, channel)
+	assert.Equal(t, BoardEventBoardUpdated, event.Type)
+	assert.Equal(t, "data", event.Data)
+}
+
+func (suite *RealtimePostgresDatabaseTestSuite) Test_BroadcastToBoardSessionRequest() {
+	t := suite.T()
+	ctx := context.Background()
+	boardID := uuid.New()
+	userID := uuid.New()
+
+	channel, err := suite.broker.GetBoardSessionRequestChannel(ctx, boardID, userID)
+	require.NoError(t, err)
+
+	err = suite.broker.BroadcastUpdateOnBoardSessionRequest(ctx, boardID, userID, RequestAccepted)
+	assert.Nil(t, err)
+
+	event := receiveWithTimeout(t, channel)
+	assert.Equal(t, RequestAccepted, *event)
+}
+
+func (suite *RealtimePostgresDatabaseTestSuite) Test_DeliverMissed() {
+	t := suite.T()
+	ctx := context.Background()
+	boardID := uuid.New()
+	client := suite.broker.Con.(*postgresClient)
+
+	channel, err := suite.broker.GetBoardChannel(ctx, boardID)
+	require.NoError(t, err)
+
+	var before int64
+	err = client.db.QueryRow("SELECT COALESCE(MAX(id), 0) FROM realtime_events").Scan(&before)
+	require.NoError(t, err)
+
+	// an event published while the listener was disconnected, so no notification arrives
+	var missed int64
+	err = client.db.QueryRow(
+		"INSERT INTO realtime_events (subject, payload) VALUES (?, ?) RETURNING id",
+		boardsSubject(boardID), `{"type":"BOARD_UPDATED","data":"missed"}`,
+	).Scan(&missed)
+	require.NoError(t, err)
+
+	last, err := client.deliverMissed(ctx, before)
+	assert.Nil(t, err)
+	assert.Equal(t, missed, last)
+
+	event := receiveWithTimeout(t, channel)
+	assert.Equal(t, BoardEventBoardUpdated, event.Type)
+	assert.Equal(t, "missed", event.Data)
+}
@@ -1,7 +1,10 @@
 package serviceinitialize
 
 import (
+	"scrumlr.io/server/admin"
+	"scrumlr.io/server/apitokens"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/users"
 )
 
@@ -65,8 +68,23 @@ func (init *ApiInitializer) InitializeSessionRequestApi() {
 	panic("Not implemented")
 }
 
-func (init *ApiInitializer) InitializeUserApi(userService users.UserService, sessionService sessions.SessionService, allowAnonymousBoardCreation, allowAnonymousCustomTemplates bool) users.UsersApi {
-	usersApi := users.NewUserApi(userService, sessionService, allowAnonymousBoardCreation, allowAnonymousCustomTemplates)
+func (init *ApiInitializer) InitializeApiTokensApi(apiTokenService apitokens.ApiTokenService) apitokens.ApiTokensApi {
+	apiTokensApi := apitokens.NewApiTokensApi(apiTokenService)
+	return apiTokensApi
+}
+
+func (init *ApiInitializer) InitializeAdminApi(adminService admin.AdminService) admin.AdminApi {
+	adminApi := admin.NewAdminApi(adminService)
+	return adminApi
+}
+
+func (init *ApiInitializer) InitializeTeamsApi(teamService teams.TeamService) teams.TeamsApi {
+	teamsApi := teams.NewTeamsApi(teamService)
+	return teamsApi
+}
+
+func (init *ApiInitializer) InitializeUserApi(userService users.UserService, sessionService sessions.SessionService, sessionRevoker users.SessionRevoker, allowAnonymousBoardCreation, allowAnonymousCustomTemplates bool) users.UsersApi {
+	usersApi := users.NewUserApi(userService, sessionService, sessionRevoker, allowAnonymousBoardCreation, allowAnonymousCustomTemplates)
 	return usersApi
 }
 
@@ -2,8 +2,11 @@ package serviceinitialize
 
 import (
 	"github.com/go-chi/chi/v5"
+	"scrumlr.io/server/admin"
+	"scrumlr.io/server/apitokens"
 	"scrumlr.io/server/sessions"
 	"scrumlr.io/server/swagger"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/users"
 )
 
@@ -66,6 +69,21 @@ func (init *RoutesInitializer) InitializeSessionRequestRoutes() {
 	panic("Not implemented")
 }
 
+func (init *RoutesInitializer) InitializeApiTokenRoutes(apiTokensApi apitokens.ApiTokensApi) chi.Router {
+	router := apitokens.NewApiTokensRouter(apiTokensApi).RegisterRoutes()
+	return router
+}
+
+func (init *RoutesInitializer) InitializeAdminRoutes(adminApi admin.AdminApi) chi.Router {
+	router := admin.NewAdminRouter(adminApi).RegisterRoutes()
+	return router
+}
+
+func (init *RoutesInitializer) InitializeTeamRoutes(teamsApi teams.TeamsApi) chi.Router {
+	router := teams.NewTeamsRouter(teamsApi).RegisterRoutes()
+	return router
+}
+
 func (init *RoutesInitializer) InitializeUserRoutes(userApi users.UsersApi, sessionApi sessions.SessionApi) chi.Router {
 	router := users.NewUsersRouter(userApi, sessionApi).RegisterRoutes()
 	return router
@@ -3,10 +3,14 @@ package serviceinitialize
 import (
 	"net/http"
 
+	"scrumlr.io/server/admin"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/boards"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/hash"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/timeprovider"
 	"scrumlr.io/server/users"
 	"scrumlr.io/server/websocket"
@@ -16,9 +20,12 @@ import (
 	"scrumlr.io/server/boardtemplates"
 	"scrumlr.io/server/columns"
 	"scrumlr.io/server/columntemplates"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/notes"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/actionitems"
 	"scrumlr.io/server/boardreactions"
 	"scrumlr.io/server/feedback"
 	"scrumlr.io/server/health"
@@ -50,18 +57,18 @@ func NewServiceInitializer(db *bun.DB, broker *realtime.Broker, cache *cache.Cac
 	return *initializer
 }
 
-func (init *ServiceInitializer) InitializeBoardService(sessionRequestService sessionrequests.SessionRequestService, sessionService sessions.SessionService, columnService columns.ColumnService, noteService notes.NotesService, reactionService reactions.ReactionService, votingService votings.VotingService, userService users.UserService) boards.BoardService {
+func (init *ServiceInitializer) InitializeBoardService(sessionRequestService sessionrequests.SessionRequestService, sessionService sessions.SessionService, columnService columns.ColumnService, noteService notes.NotesService, reactionService reactions.ReactionService, votingService votings.VotingService, userService users.UserService, actionItemService actionitems.ActionItemService, estimationService estimations.EstimationService, teamService teams.TeamService, auditService audit.AuditService) boards.BoardService {
 	boardDB := boards.NewBoardDatabase(init.db, init.clock)
-	boardService := boards.NewBoardService(boardDB, init.broker, sessionRequestService, sessionService, columnService, noteService, reactionService, votingService, userService, init.clock, init.hash)
+	boardService := boards.NewBoardService(boardDB, init.broker, sessionRequestService, sessionService, columnService, noteService, reactionService, votingService, userService, actionItemService, estimationService, teamService, auditService, init.clock, init.hash)
 
 	return boardService
 }
 
-func (init *ServiceInitializer) InitializeColumnService(noteService notes.NotesService) columns.ColumnService {
+func (init *ServiceInitializer) InitializeColumnService(noteService notes.NotesService, auditService audit.AuditService) columns.ColumnService {
 	columnDb := columns.NewColumnsDatabase(init.db)
 	boardsDB := boards.NewBoardDatabase(init.db, init.clock)
 	boardLastModifiedUpdater := boards.NewLastModifiedUpdater(boardsDB, init.clock)
-	columnService := columns.NewColumnService(columnDb, init.broker, noteService, boardLastModifiedUpdater)
+	columnService := columns.NewColumnService(columnDb, init.broker, noteService, boardLastModifiedUpdater, auditService)
 
 	return columnService
 }
@@ -72,9 +79,9 @@ func (init *ServiceInitializer) InitializeBoardReactionService() boardreactions.
 	return boardreactionService
 }
 
-func (init *ServiceInitializer) InitializeBoardTemplateService(columnTemplateService columntemplates.ColumnTemplateService) boardtemplates.BoardTemplateService {
+func (init *ServiceInitializer) InitializeBoardTemplateService(columnTemplateService columntemplates.ColumnTemplateService, teamService teams.TeamService) boardtemplates.BoardTemplateService {
 	boardTemplateDb := boardtemplates.NewBoardTemplateDatabase(init.db)
-	boardTemplateService := boardtemplates.NewBoardTemplateService(boardTemplateDb, columnTemplateService)
+	boardTemplateService := boardtemplates.NewBoardTemplateService(boardTemplateDb, columnTemplateService, teamService)
 
 	return boardTemplateService
 }
@@ -92,6 +99,13 @@ func (init *ServiceInitializer) InitializeFeedbackService(webhookUrl string) fee
 	return feedbackService
 }
 
+func (init *ServiceInitializer) InitializeAuditService() audit.AuditService {
+	auditDB := audit.NewAuditDatabase(init.db)
+	auditService := audit.NewAuditService(auditDB)
+
+	return auditService
+}
+
 func (init *ServiceInitializer) InitializeHealthService() health.HealthService {
 	healthDb := health.NewHealthDatabaseChecker(init.db)
 	healthService := health.NewHealthService(healthDb, init.broker)
@@ -106,9 +120,9 @@ func (init *ServiceInitializer) InitializeReactionService() reactions.ReactionSe
 	return reactionService
 }
 
-func (init *ServiceInitializer) InitializeSessionService(columnService columns.ColumnService, noteService notes.NotesService) sessions.SessionService {
+func (init *ServiceInitializer) InitializeSessionService(columnService columns.ColumnService, noteService notes.NotesService, auditService audit.AuditService) sessions.SessionService {
 	sessionDb := sessions.NewSessionDatabase(init.db)
-	sessionService := sessions.NewSessionService(sessionDb, init.broker, columnService, noteService)
+	sessionService := sessions.NewSessionService(sessionDb, init.broker, columnService, noteService, auditService)
 
 	return sessionService
 }
@@ -128,24 +142,63 @@ func (init *ServiceInitializer) InitializeSessionRequestWebsocket(wsService webs
 	return sessionrequests.NewSessionRequestWebsocket(wsService, init.broker)
 }
 
-func (init *ServiceInitializer) InitializeUserService(sessionService sessions.SessionService, noteService notes.NotesService) users.UserService {
+func (init *ServiceInitializer) InitializeUserService(sessionService sessions.SessionService, noteService notes.NotesService, votingService votings.VotingService, reactionService reactions.ReactionService, boardTemplateService boardtemplates.BoardTemplateService, actionItemService actionitems.ActionItemService, estimationService estimations.EstimationService, teamService teams.TeamService, apiTokenService apitokens.ApiTokenService, auditService audit.AuditService) users.UserService {
 	userDb := users.NewUserDatabase(init.db)
-	userService := users.NewUserService(userDb, init.broker, sessionService, noteService)
+	userService := users.NewUserService(userDb, init.broker, sessionService, noteService, votingService, reactionService, boardTemplateService, actionItemService, estimationService, teamService, apiTokenService, auditService)
 	return userService
 }
 
-func (init *ServiceInitializer) InitializeNotesService() notes.NotesService {
+func (init *ServiceInitializer) InitializeNotesService(auditService audit.AuditService) notes.NotesService {
 	notesDB := notes.NewNotesDatabase(init.db)
 	boardsDB := boards.NewBoardDatabase(init.db, init.clock)
 	boardLastModifiedUpdater := boards.NewLastModifiedUpdater(boardsDB, init.clock)
-	notesService := notes.NewNotesService(notesDB, init.broker, init.cache, boardLastModifiedUpdater)
+	notesService := notes.NewNotesService(notesDB, init.broker, init.cache, boardLastModifiedUpdater, auditService)
 
 	return notesService
 }
 
-func (init *ServiceInitializer) InitializeVotingService() votings.VotingService {
+func (init *ServiceInitializer) InitializeActionItemService(sessionService sessions.SessionService) actionitems.ActionItemService {
+	actionItemDB := actionitems.NewActionItemDatabase(init.db)
+	boardsDB := boards.NewBoardDatabase(init.db, init.clock)
+	boardLastModifiedUpdater := boards.NewLastModifiedUpdater(boardsDB, init.clock)
+	actionItemService := actionitems.NewActionItemService(actionItemDB, init.broker, sessionService, boardLastModifiedUpdater)
+
+	return actionItemService
+}
+
+func (init *ServiceInitializer) InitializeEstimationService(noteService notes.NotesService) estimations.EstimationService {
+	estimationDB := estimations.NewEstimationDatabase(init.db)
+	boardsDB := boards.NewBoardDatabase(init.db, init.clock)
+	boardLastModifiedUpdater := boards.NewLastModifiedUpdater(boardsDB, init.clock)
+	estimationService := estimations.NewEstimationService(estimationDB, init.broker, noteService, boardLastModifiedUpdater)
+
+	return estimationService
+}
+
+func (init *ServiceInitializer) InitializeApiTokenService() apitokens.ApiTokenService {
+	apiTokenDB := apitokens.NewApiTokenDatabase(init.db)
+	apiTokenService := apitokens.NewApiTokenService(apiTokenDB)
+
+	return apiTokenService
+}
+
+func (init *ServiceInitializer) InitializeTeamService() teams.TeamService {
+	teamDB := teams.NewTeamDatabase(init.db)
+	teamService := teams.NewTeamService(teamDB)
+
+	return teamService
+}
+
+func (init *ServiceInitializer) InitializeAdminService(boardService boards.BoardService, sessionRevoker admin.SessionRevoker, admins []uuid.UUID) admin.AdminService {
+	adminDB := admin.NewAdminDatabase(init.db)
+	adminService := admin.NewAdminService(adminDB, boardService, sessionRevoker, admins)
+
+	return adminService
+}
+
+func (init *ServiceInitializer) InitializeVotingService(auditService audit.AuditService, noteService notes.NotesService) votings.VotingService {
 	votingDB := votings.NewVotingDatabase(init.db)
-	votingService := votings.NewVotingService(votingDB, init.broker)
+	votingService := votings.NewVotingService(votingDB, init.broker, auditService, noteService, init.clock)
 
 	return votingService
 }
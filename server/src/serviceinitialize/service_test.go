@@ -3,17 +3,25 @@ package serviceinitialize
 import (
 	"testing"
 
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/boards"
+	"scrumlr.io/server/boardtemplates"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/columns"
 	"scrumlr.io/server/columntemplates"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/notes"
 	"scrumlr.io/server/reactions"
 	"scrumlr.io/server/realtime"
 	"scrumlr.io/server/sessionrequests"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/users"
 	"scrumlr.io/server/votings"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -44,23 +52,34 @@ func TestServiceInitializer_InitializeServices(t *testing.T) {
 	sessionRequestService := sessionrequests.NewMockSessionRequestService(t)
 	sessionRequestWebsocket := sessionrequests.NewMockSessionRequestWebsocket(t)
 	columnTemplateService := columntemplates.NewMockColumnTemplateService(t)
+	actionItemService := actionitems.NewMockActionItemService(t)
+	estimationService := estimations.NewMockEstimationService(t)
+	teamService := teams.NewMockTeamService(t)
+	auditService := audit.NewMockAuditService(t)
 
-	assert.NotNil(t, initializer.InitializeBoardService(sessionRequestService, sessionService, columnService, noteService, reactionService, votingService, userSession))
-	assert.NotNil(t, initializer.InitializeColumnService(noteService))
+	assert.NotNil(t, initializer.InitializeBoardService(sessionRequestService, sessionService, columnService, noteService, reactionService, votingService, userSession, actionItemService, estimationService, teamService, auditService))
+	assert.NotNil(t, initializer.InitializeColumnService(noteService, auditService))
 	assert.NotNil(t, initializer.InitializeBoardReactionService())
-	assert.NotNil(t, initializer.InitializeBoardTemplateService(columnTemplateService))
+	assert.NotNil(t, initializer.InitializeBoardTemplateService(columnTemplateService, teamService))
 	assert.NotNil(t, initializer.InitializeColumnTemplateService())
 	assert.NotNil(t, initializer.InitializeFeedbackService("https://example.com/webhook"))
+	assert.NotNil(t, initializer.InitializeAuditService())
 	assert.NotNil(t, initializer.InitializeHealthService())
 	assert.NotNil(t, initializer.InitializeReactionService())
-	assert.NotNil(t, initializer.InitializeSessionService(columnService, noteService))
+	assert.NotNil(t, initializer.InitializeSessionService(columnService, noteService, auditService))
 	assert.NotNil(t, initializer.InitializeSessionRequestService(sessionRequestWebsocket, sessionService))
 
 	wsService := initializer.InitializeWebSocketService()
 	assert.NotNil(t, wsService)
 	assert.NotNil(t, initializer.InitializeSessionRequestWebsocket(wsService))
 
-	assert.NotNil(t, initializer.InitializeUserService(sessionService, noteService))
-	assert.NotNil(t, initializer.InitializeNotesService())
-	assert.NotNil(t, initializer.InitializeVotingService())
+	assert.NotNil(t, initializer.InitializeUserService(sessionService, noteService, votingService, reactionService, boardtemplates.NewMockBoardTemplateService(t), actionitems.NewMockActionItemService(t), estimations.NewMockEstimationService(t), teams.NewMockTeamService(t), apitokens.NewMockApiTokenService(t), auditService))
+	assert.NotNil(t, initializer.InitializeNotesService(auditService))
+	assert.NotNil(t, initializer.InitializeVotingService(auditService, noteService))
+	assert.NotNil(t, initializer.InitializeActionItemService(sessionService))
+	assert.NotNil(t, initializer.InitializeEstimationService(noteService))
+	assert.NotNil(t, initializer.InitializeTeamService())
+
+	boardService := boards.NewMockBoardService(t)
+	assert.NotNil(t, initializer.InitializeAdminService(boardService, nil, []uuid.UUID{uuid.New()}))
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go/modules/nats"
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/columns"
 	"scrumlr.io/server/common"
@@ -70,11 +71,12 @@ func (suite *SessionRequestServiceIntegrationTestSuite) SetupTest() {
 
 	boardLastModifiedUpdater := common.NewSimpleBoardLastModifiedUpdater(db)
 	noteDatabase := notes.NewNotesDatabase(db)
-	noteService := notes.NewNotesService(noteDatabase, broker, ch, boardLastModifiedUpdater)
+	auditService := audit.NewAuditService(audit.NewAuditDatabase(db))
+	noteService := notes.NewNotesService(noteDatabase, broker, ch, boardLastModifiedUpdater, auditService)
 	columnDatabase := columns.NewColumnsDatabase(db)
-	columnService := columns.NewColumnService(columnDatabase, broker, noteService, boardLastModifiedUpdater)
+	columnService := columns.NewColumnService(columnDatabase, broker, noteService, boardLastModifiedUpdater, auditService)
 	sessionDatabase := sessions.NewSessionDatabase(db)
-	sessionService := sessions.NewSessionService(sessionDatabase, broker, columnService, noteService)
+	sessionService := sessions.NewSessionService(sessionDatabase, broker, columnService, noteService, auditService)
 	suite.service = NewSessionRequestService(database, broker, sessionRequestWebsocket, sessionService)
 }
 
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/columns"
 	"scrumlr.io/server/notes"
 	"scrumlr.io/server/role"
@@ -44,14 +45,16 @@ type BoardSessionService struct {
 	realtime      *realtime.Broker
 	columnService columns.ColumnService
 	noteService   notes.NotesService
+	auditService  audit.AuditService
 }
 
-func NewSessionService(db SessionDatabase, rt *realtime.Broker, columnService columns.ColumnService, noteService notes.NotesService) SessionService {
+func NewSessionService(db SessionDatabase, rt *realtime.Broker, columnService columns.ColumnService, noteService notes.NotesService, auditService audit.AuditService) SessionService {
 	service := new(BoardSessionService)
 	service.database = db
 	service.realtime = rt
 	service.columnService = columnService
 	service.noteService = noteService
+	service.auditService = auditService
 
 	return service
 }
@@ -326,6 +329,12 @@ func (service *BoardSessionService) Update(ctx context.Context, body BoardSessio
 
 	service.updatedSession(ctx, body.Board, body.User)
 
+	// changes of the role or ban of a participant are moderation and recorded in the audit log
+	before, after := newSessionAudit(sessionOfUserToModify), newSessionAudit(session)
+	if before != after {
+		service.auditService.Record(ctx, body.Board, audit.SessionUpdated, before, after)
+	}
+
 	if body.Banned != nil {
 		if *body.Banned {
 			bannedSessionsCounter.Add(ctx, 1)
@@ -470,6 +479,17 @@ func (service *BoardSessionService) Delete(ctx context.Context, callerID, boardI
 	return err
 }
 
+// sessionAudit is the part of a session recorded in the audit log
+type sessionAudit struct {
+	User   uuid.UUID `json:"user"`
+	Role   role.Role `json:"role"`
+	Banned bool      `json:"banned"`
+}
+
+func newSessionAudit(session DatabaseBoardSession) sessionAudit {
+	return sessionAudit{User: session.User, Role: session.Role, Banned: session.Banned}
+}
+
 func (service *BoardSessionService) createdSession(ctx context.Context, board uuid.UUID, session DatabaseBoardSession) {
 	ctx, span := tracer.Start(ctx, "scrumlr.sessions.service.create")
 	defer span.End()
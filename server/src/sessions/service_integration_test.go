@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go/modules/nats"
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/columns"
 	"scrumlr.io/server/common"
@@ -73,11 +74,12 @@ func (suite *SessionServiceIntegrationTestSuite) SetupTest() {
 
 	boardLastModifiedUpdater := common.NewSimpleBoardLastModifiedUpdater(db)
 	noteDatabase := notes.NewNotesDatabase(db)
-	noteService := notes.NewNotesService(noteDatabase, broker, ch, boardLastModifiedUpdater)
+	auditService := audit.NewAuditService(audit.NewAuditDatabase(db))
+	noteService := notes.NewNotesService(noteDatabase, broker, ch, boardLastModifiedUpdater, auditService)
 	columnDatabase := columns.NewColumnsDatabase(db)
-	columnService := columns.NewColumnService(columnDatabase, broker, noteService, boardLastModifiedUpdater)
+	columnService := columns.NewColumnService(columnDatabase, broker, noteService, boardLastModifiedUpdater, auditService)
 	sessionDatabase := NewSessionDatabase(db)
-	suite.sessionService = NewSessionService(sessionDatabase, broker, columnService, noteService)
+	suite.sessionService = NewSessionService(sessionDatabase, broker, columnService, noteService, auditService)
 }
 
 func (suite *SessionServiceIntegrationTestSuite) initTestData() {
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/columns"
 	"scrumlr.io/server/notes"
 	"scrumlr.io/server/realtime"
@@ -30,7 +31,7 @@ func TestGetSession(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Get(context.Background(), boardId, userId)
 
@@ -54,7 +55,7 @@ func TestGetSession_NotFound(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Get(context.Background(), boardId, userId)
 
@@ -81,7 +82,7 @@ func TestGetSession_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Get(context.Background(), boardId, userId)
 
@@ -110,7 +111,7 @@ func TestGetSessions(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	boardSessions, err := sessionService.GetAll(context.Background(), boardId, filter)
 
@@ -144,7 +145,7 @@ func TestGetUserBoardSessions_ConnectedOnly(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	sessions, err := sessionService.GetUserBoardSessions(context.Background(), userId, true)
 
@@ -172,7 +173,7 @@ func TestGetUserBoardSessions_ConnectedOnly_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	sessions, err := sessionService.GetUserBoardSessions(context.Background(), userId, true)
 
@@ -200,7 +201,7 @@ func TestListSessions_WithFilterConnected(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	boardSessions, err := sessionService.GetAll(context.Background(), boardId, filter)
 
@@ -234,7 +235,7 @@ func TestListSessions_WithFilterReady(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	boardSessions, err := sessionService.GetAll(context.Background(), boardId, filter)
 
@@ -268,7 +269,7 @@ func TestListSessions_WithFilterRaisedHand(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	boardSessions, err := sessionService.GetAll(context.Background(), boardId, filter)
 
@@ -302,7 +303,7 @@ func TestListSessions_WithFilterRole(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	boardSessions, err := sessionService.GetAll(context.Background(), boardId, filter)
 
@@ -333,7 +334,7 @@ func TestListSessions_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	boardSessions, err := sessionService.GetAll(context.Background(), boardId, filter)
 
@@ -359,7 +360,7 @@ func TestCreateSession(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Create(context.Background(), BoardSessionCreateRequest{Board: boardId, User: userId, Role: role})
 
@@ -388,7 +389,7 @@ func TestCreateSession_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Create(context.Background(), BoardSessionCreateRequest{Board: boardId, User: userId, Role: role})
 
@@ -436,7 +437,13 @@ func TestUpdateSession_Role(t *testing.T) {
 			{ID: uuid.New(), Position: notes.NotePosition{Column: secondColumnId, Rank: 2}},
 		}, nil)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	mockAuditService := audit.NewMockAuditService(t)
+	mockAuditService.EXPECT().Record(mock.Anything, boardId, audit.SessionUpdated,
+		sessionAudit{User: userId, Role: role.ParticipantRole},
+		sessionAudit{User: userId, Role: role.ModeratorRole},
+	).Return()
+
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, mockAuditService)
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:  boardId,
@@ -464,7 +471,7 @@ func TestUpdateSession_RaiseHand(t *testing.T) {
 	mockSessiondb.EXPECT().Get(mock.Anything, boardId, userId).
 		Return(DatabaseBoardSession{Board: boardId, User: userId, Role: role.ParticipantRole}, nil)
 	mockSessiondb.EXPECT().Update(mock.Anything, DatabaseBoardSessionUpdate{Board: boardId, User: userId, RaisedHand: &raisedHand}).
-		Return(DatabaseBoardSession{Board: boardId, User: userId, RaisedHand: raisedHand}, nil)
+		Return(DatabaseBoardSession{Board: boardId, User: userId, Role: role.ParticipantRole, RaisedHand: raisedHand}, nil)
 	mockSessiondb.EXPECT().GetUserBoardSessions(mock.Anything, userId, true).
 		Return([]DatabaseBoardSession{{Board: boardId, User: userId}}, nil)
 
@@ -489,7 +496,7 @@ func TestUpdateSession_RaiseHand(t *testing.T) {
 			{ID: uuid.New(), Position: notes.NotePosition{Column: secondColumnId, Rank: 2}},
 		}, nil)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:      boardId,
@@ -522,7 +529,7 @@ func TestUpdateSession_DatbaseErrorGetModerator(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:  boardId,
@@ -555,7 +562,7 @@ func TestUpdateSession_DatbaseErrorGetUserToPromote(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:  boardId,
@@ -591,7 +598,7 @@ func TestUpdateSession_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:  boardId,
@@ -621,7 +628,7 @@ func TestUpdateSession_ErrorPromotingUserPermission(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:  boardId,
@@ -654,7 +661,7 @@ func TestUpdateSession_ErrorPromoting(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:  boardId,
@@ -687,7 +694,7 @@ func TestUpdateSession_ErrorChangingOwner(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:  boardId,
@@ -720,7 +727,7 @@ func TestUpdateSession_ErrorPromotingToOwner(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	session, err := sessionService.Update(context.Background(), BoardSessionUpdateRequest{
 		Board:  boardId,
@@ -759,7 +766,7 @@ func TestUpdateAllSessions(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	boardSessions, err := sessionService.UpdateAll(context.Background(), BoardSessionsUpdateRequest{Board: boardId, Ready: &ready})
 
@@ -792,7 +799,7 @@ func TestUpdateAllSessions_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	boardSessions, err := sessionService.UpdateAll(context.Background(), BoardSessionsUpdateRequest{Board: boardId, Ready: &ready})
 
@@ -837,7 +844,7 @@ func TestConnectSession(t *testing.T) {
 			{ID: uuid.New(), Position: notes.NotePosition{Column: secondColumnId, Rank: 2}},
 		}, nil)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Connect(context.Background(), boardId, userId)
 
@@ -860,7 +867,7 @@ func TestConnectSession_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Connect(context.Background(), boardId, userId)
 
@@ -904,7 +911,7 @@ func TestDisconnectSession(t *testing.T) {
 			{ID: uuid.New(), Position: notes.NotePosition{Column: secondColumnId, Rank: 2}},
 		}, nil)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Disconnect(context.Background(), boardId, userId)
 
@@ -927,7 +934,7 @@ func TestDisconnectSession_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Disconnect(context.Background(), boardId, userId)
 
@@ -949,7 +956,7 @@ func TestSessionExists(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	exists, err := sessionService.Exists(context.Background(), boardId, userId)
 
@@ -972,7 +979,7 @@ func TestSessionExists_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	exists, err := sessionService.Exists(context.Background(), boardId, userId)
 
@@ -995,7 +1002,7 @@ func TestModeratorSessionExists(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	exists, err := sessionService.ModeratorSessionExists(context.Background(), boardId, userId)
 
@@ -1018,7 +1025,7 @@ func TestModeratorSessionExists_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	exists, err := sessionService.ModeratorSessionExists(context.Background(), boardId, userId)
 
@@ -1041,7 +1048,7 @@ func TestOwnerSessionExists(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	exists, err := sessionService.OwnerSessionExists(context.Background(), boardId, userId)
 
@@ -1064,7 +1071,7 @@ func TestOwnerSessionExists_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	exists, err := sessionService.OwnerSessionExists(context.Background(), boardId, userId)
 
@@ -1087,7 +1094,7 @@ func TestIsParticipantBanned(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	banned, err := sessionService.IsParticipantBanned(context.Background(), boardId, userId)
 
@@ -1110,7 +1117,7 @@ func TestIsParticipantBanned_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	banned, err := sessionService.IsParticipantBanned(context.Background(), boardId, userId)
 
@@ -1137,7 +1144,7 @@ func TestDeleteSession(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Delete(context.Background(), userId, boardId, userId)
 
@@ -1181,7 +1188,7 @@ func TestDeleteConnectedSession(t *testing.T) {
 			{ID: uuid.New(), Position: notes.NotePosition{Column: secondColumnId, Rank: 2}},
 		}, nil)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Delete(context.Background(), userId, boardId, userId)
 
@@ -1202,7 +1209,7 @@ func TestDeleteSession_DifferentCaller(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Delete(context.Background(), callerId, boardId, userId)
 
@@ -1228,7 +1235,7 @@ func TestDeleteSession_SessionDoesNotExist(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Delete(context.Background(), userId, boardId, userId)
 
@@ -1253,7 +1260,7 @@ func TestDeleteSession_OwnerSession(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Delete(context.Background(), userId, boardId, userId)
 
@@ -1281,7 +1288,7 @@ func TestDeleteSession_DatabaseError(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	err := sessionService.Delete(context.Background(), userId, boardId, userId)
 
@@ -1301,7 +1308,7 @@ func TestFilterfromQueryString_EmptyQuery(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	query := url.Values{}
 	filter := sessionService.BoardSessionFilterTypeFromQueryString(query)
@@ -1319,7 +1326,7 @@ func TestFilterfromQueryString_Connected(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	connected := true
 	query := url.Values{}
@@ -1339,7 +1346,7 @@ func TestFilterfromQueryString_Ready(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	ready := true
 	query := url.Values{}
@@ -1359,7 +1366,7 @@ func TestFilterfromQueryString_Raisedhand(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	raisedHand := true
 	query := url.Values{}
@@ -1379,7 +1386,7 @@ func TestFilterfromQueryString_Role(t *testing.T) {
 	mockColumnService := columns.NewMockColumnService(t)
 	mockNoteService := notes.NewMockNotesService(t)
 
-	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService)
+	sessionService := NewSessionService(mockSessiondb, broker, mockColumnService, mockNoteService, audit.NewMockAuditService(t))
 
 	role := role.OwnerRole
 	query := url.Values{}
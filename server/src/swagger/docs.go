@@ -22,9 +22,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/boards": {
+        "/admin/actions": {
             "get": {
-                "description": "Get all board",
+                "description": "Get the logged actions of all instance administrators, newest first. Requires the instance admin role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -32,9 +32,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "admin"
                 ],
-                "summary": "Get all board",
+                "summary": "Get the actions of instance administrators",
                 "parameters": [
                     {
                         "type": "string",
@@ -42,13 +42,28 @@ const docTemplate = `{
                         "name": "Cookie",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of actions, defaults to 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of actions to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/boards.BoardOverview"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/admin.Action"
+                            }
                         }
                     },
                     "400": {
@@ -57,6 +72,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -64,9 +85,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new board",
+            }
+        },
+        "/admin/boards": {
+            "get": {
+                "description": "Search all boards of the instance by name or id, requires the instance admin role",
                 "consumes": [
                     "application/json"
                 ],
@@ -74,9 +97,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "admin"
                 ],
-                "summary": "Create a new board",
+                "summary": "Search the boards of the instance",
                 "parameters": [
                     {
                         "type": "string",
@@ -86,20 +109,32 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "board to create",
-                        "name": "board",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/boards.CreateBoardRequest"
-                        }
+                        "type": "string",
+                        "description": "part of the board name or the board id",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of boards, defaults to 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of boards to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/boards.Board"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/admin.Board"
+                            }
                         }
                     },
                     "400": {
@@ -107,23 +142,29 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
             }
         },
-        "/boards/{boardId}/columns": {
-            "get": {
-                "description": "Get all columns for a board",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+        "/admin/boards/{board}": {
+            "delete": {
+                "description": "Delete an abusive board, requires the instance admin role. The action is logged.",
                 "tags": [
-                    "columns"
+                    "admin"
                 ],
-                "summary": "Get all columns for a board",
+                "summary": "Delete a board of the instance",
                 "parameters": [
                     {
                         "type": "string",
@@ -135,20 +176,14 @@ const docTemplate = `{
                     {
                         "type": "string",
                         "description": "id of the board",
-                        "name": "boardId",
+                        "name": "board",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/columns.Column"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -175,9 +210,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new column for a board",
+            }
+        },
+        "/admin/statistics/deleted-boards": {
+            "get": {
+                "description": "Get the statistics recorded when boards were deleted, most recently deleted first. Requires the instance admin role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -185,9 +222,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "admin"
                 ],
-                "summary": "Create a new column for a board",
+                "summary": "Get the statistics of deleted boards",
                 "parameters": [
                     {
                         "type": "string",
@@ -197,27 +234,26 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "id of the board",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "maximum number of entries, defaults to 50",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "description": "column to create",
-                        "name": "column",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/columns.ColumnRequest"
-                        }
+                        "type": "integer",
+                        "description": "number of entries to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/columns.Column"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/admin.DeletedBoard"
+                            }
                         }
                     },
                     "400": {
@@ -232,12 +268,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -247,9 +277,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/columns/{id}": {
+        "/admin/users": {
             "get": {
-                "description": "Get a column for a board",
+                "description": "Search all users of the instance by name or id, requires the instance admin role",
                 "consumes": [
                     "application/json"
                 ],
@@ -257,9 +287,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "admin"
                 ],
-                "summary": "Get a column for a board",
+                "summary": "Search the users of the instance",
                 "parameters": [
                     {
                         "type": "string",
@@ -270,24 +300,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
+                        "description": "part of the user name or the user id",
+                        "name": "query",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "id of the column",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "maximum number of users, defaults to 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of users to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/columns.Column"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/admin.User"
+                            }
                         }
                     },
                     "400": {
@@ -302,12 +339,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -315,19 +346,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/users/{user}/ban": {
             "put": {
-                "description": "Update a column for a board",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Ban a user instance-wide, requires the instance admin role. All sessions and api tokens of the user are revoked and further logins are refused. The action is logged.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "admin"
                 ],
-                "summary": "Update a column for a board",
+                "summary": "Ban a user from the instance",
                 "parameters": [
                     {
                         "type": "string",
@@ -338,33 +368,17 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "id of the column to update",
-                        "name": "id",
+                        "description": "id of the user",
+                        "name": "user",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "values to update the column",
-                        "name": "column",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/columns.ColumnUpdateRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/columns.Column"
+                            "$ref": "#/definitions/admin.User"
                         }
                     },
                     "400": {
@@ -394,17 +408,14 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a column for a board",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lift the instance-wide ban of a user, requires the instance admin role. The action is logged.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "admin"
                 ],
-                "summary": "Delete a column for a board",
+                "summary": "Unban a user",
                 "parameters": [
                     {
                         "type": "string",
@@ -415,22 +426,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "id of the column to delete",
-                        "name": "id",
+                        "description": "id of the user",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/admin.User"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -444,6 +451,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -453,9 +466,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/notes": {
+        "/boards": {
             "get": {
-                "description": "Get all notes on a board",
+                "description": "Get all board, archived boards are only returned if requested",
                 "consumes": [
                     "application/json"
                 ],
@@ -463,9 +476,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "boards"
                 ],
-                "summary": "Get all notes on a board",
+                "summary": "Get all board",
                 "parameters": [
                     {
                         "type": "string",
@@ -475,21 +488,17 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "id of the board",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "get archived boards instead of the active ones",
+                        "name": "archived",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/notes.Note"
-                            }
+                            "$ref": "#/definitions/boards.BoardOverview"
                         }
                     },
                     "400": {
@@ -498,18 +507,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -519,7 +516,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new note on a board",
+                "description": "Create a new board",
                 "consumes": [
                     "application/json"
                 ],
@@ -527,9 +524,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "boards"
                 ],
-                "summary": "Create a new note on a board",
+                "summary": "Create a new board",
                 "parameters": [
                     {
                         "type": "string",
@@ -539,19 +536,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "id of the board",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "note to create",
-                        "name": "note",
+                        "description": "board to create",
+                        "name": "board",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/notes.NoteCreateRequest"
+                            "$ref": "#/definitions/boards.CreateBoardRequest"
                         }
                     }
                 ],
@@ -559,7 +549,119 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/notes.Note"
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{boardId}/action-items": {
+            "get": {
+                "description": "Get all action items of a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "action items"
+                ],
+                "summary": "Get all action items of a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/actionitems.ActionItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new action item on a board, optionally assigned to a participant and linked to the originating note",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "action items"
+                ],
+                "summary": "Create a new action item on a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "action item to create",
+                        "name": "actionItem",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/actionitems.ActionItemCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/actionitems.ActionItem"
                         }
                     },
                     "400": {
@@ -589,9 +691,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/notes/{id}": {
+        "/boards/{boardId}/action-items/{id}": {
             "get": {
-                "description": "Create a new note on a board",
+                "description": "Get an action item of a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -599,9 +701,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "action items"
                 ],
-                "summary": "Create a new note on a board",
+                "summary": "Get an action item of a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -619,7 +721,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the note",
+                        "description": "id of the action item",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -629,7 +731,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/notes.Note"
+                            "$ref": "#/definitions/actionitems.ActionItem"
                         }
                     },
                     "400": {
@@ -659,7 +761,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update a note on a board",
+                "description": "Update text, assignee, due date and status of an action item",
                 "consumes": [
                     "application/json"
                 ],
@@ -667,9 +769,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "action items"
                 ],
-                "summary": "Update a note on a board",
+                "summary": "Update an action item of a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -687,18 +789,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the note",
+                        "description": "id of the action item",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "values to update a note",
-                        "name": "note",
+                        "description": "values to update the action item",
+                        "name": "actionItem",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/notes.NoteUpdateRequest"
+                            "$ref": "#/definitions/actionitems.ActionItemUpdateRequest"
                         }
                     }
                 ],
@@ -706,7 +808,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/notes.Note"
+                            "$ref": "#/definitions/actionitems.ActionItem"
                         }
                     },
                     "400": {
@@ -736,7 +838,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a note from a board",
+                "description": "Delete an action item of a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -744,9 +846,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "action items"
                 ],
-                "summary": "Delete a note from a board",
+                "summary": "Delete an action item of a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -764,7 +866,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the note",
+                        "description": "id of the action item",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -801,9 +903,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/participants": {
+        "/boards/{boardId}/columns": {
             "get": {
-                "description": "Get all sessions for a board",
+                "description": "Get all columns for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -811,9 +913,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sessions"
+                    "columns"
                 ],
-                "summary": "Get all sessions for a board",
+                "summary": "Get all columns for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -828,56 +930,15 @@ const docTemplate = `{
                         "name": "boardId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "true",
-                            "false"
-                        ],
-                        "type": "string",
-                        "description": "only select connected sessions",
-                        "name": "connected",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "true",
-                            "false"
-                        ],
-                        "type": "string",
-                        "description": "only select sessions that are ready",
-                        "name": "ready",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "true",
-                            "false"
-                        ],
-                        "type": "string",
-                        "description": "only select sessions that raised their hand",
-                        "name": "raisedHand",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "OWNER",
-                            "MODERATOR",
-                            "PARTICIPANT"
-                        ],
-                        "type": "string",
-                        "description": "only select sessions with the requested role",
-                        "name": "role",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "sessions for the board",
+                        "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/sessions.BoardSession"
+                                "$ref": "#/definitions/columns.Column"
                             }
                         }
                     },
@@ -893,6 +954,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -901,8 +968,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update all sessions for a board",
+            "post": {
+                "description": "Create a new column for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -910,9 +977,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sessions"
+                    "columns"
                 ],
-                "summary": "Update all sessions for a board",
+                "summary": "Create a new column for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -929,20 +996,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "values to update the session",
-                        "name": "session",
+                        "description": "column to create",
+                        "name": "column",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/sessions.BoardSessionUpdateRequest"
+                            "$ref": "#/definitions/columns.ColumnRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "updated session for the board",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/sessions.BoardSession"
+                            "$ref": "#/definitions/columns.Column"
                         }
                     },
                     "400": {
@@ -957,6 +1024,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -966,9 +1039,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/participants/{id}": {
+        "/boards/{boardId}/columns/{id}": {
             "get": {
-                "description": "Get a sessions for a board",
+                "description": "Get a column for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -976,9 +1049,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sessions"
+                    "columns"
                 ],
-                "summary": "Get a sessions for a board",
+                "summary": "Get a column for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -996,7 +1069,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the session",
+                        "description": "id of the column",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1004,9 +1077,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "session for the board",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/sessions.BoardSession"
+                            "$ref": "#/definitions/columns.Column"
                         }
                     },
                     "400": {
@@ -1021,6 +1094,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1030,7 +1109,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update a sessions for a board",
+                "description": "Update a column for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1038,9 +1117,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sessions"
+                    "columns"
                 ],
-                "summary": "Update a sessions for a board",
+                "summary": "Update a column for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1058,26 +1137,26 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the session",
+                        "description": "id of the column to update",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "values to update the session",
-                        "name": "session",
+                        "description": "values to update the column",
+                        "name": "column",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/sessions.BoardSessionUpdateRequest"
+                            "$ref": "#/definitions/columns.ColumnUpdateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "session for the board",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/sessions.BoardSession"
+                            "$ref": "#/definitions/columns.Column"
                         }
                     },
                     "400": {
@@ -1092,6 +1171,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1101,7 +1186,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a participant from a board",
+                "description": "Delete a column for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1109,9 +1194,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sessions"
+                    "columns"
                 ],
-                "summary": "Delete a participant from a board",
+                "summary": "Delete a column for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1129,7 +1214,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the session",
+                        "description": "id of the column to delete",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1151,12 +1236,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1166,9 +1245,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/reactions": {
+        "/boards/{boardId}/estimations": {
             "get": {
-                "description": "Get all reaction on a board",
+                "description": "Get all estimation rounds of a board, the cards of an open round are hidden",
                 "consumes": [
                     "application/json"
                 ],
@@ -1176,9 +1255,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "estimations"
                 ],
-                "summary": "Get all reaction on a board",
+                "summary": "Get all estimation rounds of a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1201,7 +1280,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/reactions.Reaction"
+                                "$ref": "#/definitions/estimations.Estimation"
                             }
                         }
                     },
@@ -1217,12 +1296,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1232,7 +1305,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new reaction on a board",
+                "description": "Start a planning poker round on a note, only a single round can be in progress on a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1240,9 +1313,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "estimations"
                 ],
-                "summary": "Create a new reaction on a board",
+                "summary": "Start an estimation round on a note",
                 "parameters": [
                     {
                         "type": "string",
@@ -1259,12 +1332,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "reaction to create",
-                        "name": "reaction",
+                        "description": "note and deck of the estimation round",
+                        "name": "estimation",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/reactions.ReactionCreateRequest"
+                            "$ref": "#/definitions/estimations.EstimationCreateRequest"
                         }
                     }
                 ],
@@ -1272,7 +1345,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/reactions.Reaction"
+                            "$ref": "#/definitions/estimations.Estimation"
                         }
                     },
                     "400": {
@@ -1293,6 +1366,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1302,9 +1381,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/reactions/{id}": {
+        "/boards/{boardId}/estimations/{id}": {
             "get": {
-                "description": "Get a reaction on a board",
+                "description": "Get an estimation round of a board, the cards are hidden while the round is open",
                 "consumes": [
                     "application/json"
                 ],
@@ -1312,9 +1391,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "estimations"
                 ],
-                "summary": "Get a reaction on a board",
+                "summary": "Get an estimation round of a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1332,7 +1411,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the reaction",
+                        "description": "id of the estimation round",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1342,7 +1421,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/reactions.Reaction"
+                            "$ref": "#/definitions/estimations.Estimation"
                         }
                     },
                     "400": {
@@ -1372,7 +1451,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update a reaction on a board",
+                "description": "Reveal the estimates of all participants or close the round, optionally storing the final estimate on the note",
                 "consumes": [
                     "application/json"
                 ],
@@ -1380,9 +1459,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "estimations"
                 ],
-                "summary": "Update a reaction on a board",
+                "summary": "Reveal or close an estimation round",
                 "parameters": [
                     {
                         "type": "string",
@@ -1400,24 +1479,27 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the reaction",
+                        "description": "id of the estimation round",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "values to update the reaction",
-                        "name": "reaction",
+                        "description": "new status and final estimate of the estimation round",
+                        "name": "estimation",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/reactions.ReactionUpdateTypeRequest"
+                            "$ref": "#/definitions/estimations.EstimationUpdateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/estimations.Estimation"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1444,9 +1526,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove a reaction from a board",
+            }
+        },
+        "/boards/{boardId}/estimations/{id}/estimate": {
+            "put": {
+                "description": "Submit or change the estimate of the calling participant, which stays hidden until the round is revealed",
                 "consumes": [
                     "application/json"
                 ],
@@ -1454,9 +1538,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "estimations"
                 ],
-                "summary": "Remove a reaction from a board",
+                "summary": "Submit an estimate in an estimation round",
                 "parameters": [
                     {
                         "type": "string",
@@ -1474,15 +1558,27 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the reaction",
+                        "description": "id of the estimation round",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "chosen card of the deck",
+                        "name": "estimate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/estimations.EstimateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/estimations.Estimate"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1511,9 +1607,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/requests": {
+        "/boards/{boardId}/notes": {
             "get": {
-                "description": "Get all board session request for a board",
+                "description": "Get all notes on a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1521,9 +1617,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "board session requests"
+                    "notes"
                 ],
-                "summary": "Get all board session request for a board",
+                "summary": "Get all notes on a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1534,16 +1630,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "boarId for the session requests",
+                        "description": "id of the board",
                         "name": "boardId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "status to filter the requests",
-                        "name": "status",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1552,7 +1642,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/sessionrequests.BoardSessionRequest"
+                                "$ref": "#/definitions/notes.Note"
                             }
                         }
                     },
@@ -1567,13 +1657,23 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
-            }
-        },
-        "/boards/{boardId}/requests/{userId}": {
-            "get": {
-                "description": "Get a board session request for a user",
+            },
+            "post": {
+                "description": "Create a new note on a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1581,9 +1681,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "board session requests"
+                    "notes"
                 ],
-                "summary": "Get a board session request for a user",
+                "summary": "Create a new note on a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1594,24 +1694,26 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "boarId for the session request",
+                        "description": "id of the board",
                         "name": "boardId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "userId for the session request",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "description": "note to create",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notes.NoteCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/sessionrequests.BoardSessionRequest"
+                            "$ref": "#/definitions/notes.Note"
                         }
                     },
                     "400": {
@@ -1631,11 +1733,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a board session request for a user",
+            }
+        },
+        "/boards/{boardId}/notes/{id}": {
+            "get": {
+                "description": "Create a new note on a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1643,9 +1753,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "board session requests"
+                    "notes"
                 ],
-                "summary": "Update a board session request for a user",
+                "summary": "Create a new note on a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1656,33 +1766,24 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "boarId for the session request",
+                        "description": "id of the board",
                         "name": "boardId",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "userId for the session request",
-                        "name": "userId",
+                        "description": "id of the note",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "values to update the board session request",
-                        "name": "sessionRequestUpdate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/sessionrequests.BoardSessionRequestUpdate"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/sessionrequests.BoardSessionRequest"
+                            "$ref": "#/definitions/notes.Note"
                         }
                     },
                     "400": {
@@ -1702,13 +1803,17 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
-            }
-        },
-        "/boards/{boardId}/votes": {
-            "get": {
-                "description": "Get all votes from a board",
+            },
+            "put": {
+                "description": "Update a note on a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1716,9 +1821,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "votes"
+                    "notes"
                 ],
-                "summary": "Get all votes from a board",
+                "summary": "Update a note on a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1736,25 +1841,26 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of a voting to filter the votes",
-                        "name": "voting",
-                        "in": "query"
+                        "description": "id of the note",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "id of a note to filter the votes",
+                        "description": "values to update a note",
                         "name": "note",
-                        "in": "query"
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notes.NoteUpdateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/votings.Vote"
-                            }
+                            "$ref": "#/definitions/notes.Note"
                         }
                     },
                     "400": {
@@ -1783,8 +1889,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Add a new vote to a board",
+            "delete": {
+                "description": "Delete a note from a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1792,9 +1898,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "votes"
+                    "notes"
                 ],
-                "summary": "Add a new vote to a board",
+                "summary": "Delete a note from a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -1811,21 +1917,16 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "vote to add",
-                        "name": "vote",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/votings.VoteRequest"
-                        }
+                        "type": "string",
+                        "description": "id of the note",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/votings.Vote"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1852,9 +1953,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove a vote from a board",
+            }
+        },
+        "/boards/{boardId}/notes/{id}/revisions": {
+            "get": {
+                "description": "Get every version of the text and position of a note, newest first. Participants only get the revisions of notes they can see on the board.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1862,9 +1965,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "votes"
+                    "notes"
                 ],
-                "summary": "Remove a vote from a board",
+                "summary": "Get the revisions of a note",
                 "parameters": [
                     {
                         "type": "string",
@@ -1881,18 +1984,22 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "vote to remove",
-                        "name": "vote",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/votings.VoteRequest"
-                        }
+                        "type": "string",
+                        "description": "id of the note",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/notes.NoteRevision"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1921,9 +2028,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{boardId}/votings": {
-            "get": {
-                "description": "Get all votings on a board",
+        "/boards/{boardId}/notes/{id}/revisions/{revision}/restore": {
+            "post": {
+                "description": "Change the text of a note back to the text of an earlier revision. The position of the note is not changed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1931,9 +2038,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "votings"
+                    "notes"
                 ],
-                "summary": "Get all votings on a board",
+                "summary": "Restore a revision of a note",
                 "parameters": [
                     {
                         "type": "string",
@@ -1948,16 +2055,27 @@ const docTemplate = `{
                         "name": "boardId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the note",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the revision",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/votings.Voting"
-                            }
+                            "$ref": "#/definitions/notes.Note"
                         }
                     },
                     "400": {
@@ -1978,6 +2096,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1985,9 +2109,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new voting on a board",
+            }
+        },
+        "/boards/{boardId}/participants": {
+            "get": {
+                "description": "Get all sessions for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -1995,9 +2121,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "votings"
+                    "sessions"
                 ],
-                "summary": "Create a new voting on a board",
+                "summary": "Get all sessions for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2014,20 +2140,55 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "voting to create",
-                        "name": "voting",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/votings.VotingCreateRequest"
-                        }
+                        "enum": [
+                            "true",
+                            "false"
+                        ],
+                        "type": "string",
+                        "description": "only select connected sessions",
+                        "name": "connected",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true",
+                            "false"
+                        ],
+                        "type": "string",
+                        "description": "only select sessions that are ready",
+                        "name": "ready",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true",
+                            "false"
+                        ],
+                        "type": "string",
+                        "description": "only select sessions that raised their hand",
+                        "name": "raisedHand",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "OWNER",
+                            "MODERATOR",
+                            "PARTICIPANT"
+                        ],
+                        "type": "string",
+                        "description": "only select sessions with the requested role",
+                        "name": "role",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "sessions for the board",
                         "schema": {
-                            "$ref": "#/definitions/votings.Voting"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/sessions.BoardSession"
+                            }
                         }
                     },
                     "400": {
@@ -2042,12 +2203,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2055,11 +2210,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/boards/{boardId}/votings/{id}": {
-            "get": {
-                "description": "Get a voting on a board",
+            },
+            "put": {
+                "description": "Update all sessions for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2067,9 +2220,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "votings"
+                    "sessions"
                 ],
-                "summary": "Get a voting on a board",
+                "summary": "Update all sessions for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2086,18 +2239,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "id of the voting",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "values to update the session",
+                        "name": "session",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/sessions.BoardSessionUpdateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "updated session for the board",
                         "schema": {
-                            "$ref": "#/definitions/votings.Voting"
+                            "$ref": "#/definitions/sessions.BoardSession"
                         }
                     },
                     "400": {
@@ -2112,12 +2267,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2125,9 +2274,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a voting on a board to closed",
+            }
+        },
+        "/boards/{boardId}/participants/{id}": {
+            "get": {
+                "description": "Get a sessions for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2135,9 +2286,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "votings"
+                    "sessions"
                 ],
-                "summary": "Update a voting on a board to closed",
+                "summary": "Get a sessions for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2155,7 +2306,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the voting",
+                        "description": "id of the session",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2163,9 +2314,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "session for the board",
                         "schema": {
-                            "$ref": "#/definitions/votings.Voting"
+                            "$ref": "#/definitions/sessions.BoardSession"
                         }
                     },
                     "400": {
@@ -2180,12 +2331,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2193,11 +2338,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/boards/{id}": {
-            "get": {
-                "description": "Get a board",
+            },
+            "put": {
+                "description": "Update a sessions for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2205,9 +2348,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "sessions"
                 ],
-                "summary": "Get a board",
+                "summary": "Update a sessions for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2218,17 +2361,33 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board to get",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the session",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "values to update the session",
+                        "name": "session",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/sessions.BoardSessionUpdateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "session for the board",
                         "schema": {
-                            "$ref": "#/definitions/boards.Board"
+                            "$ref": "#/definitions/sessions.BoardSession"
                         }
                     },
                     "400": {
@@ -2243,12 +2402,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2257,8 +2410,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update a board",
+            "delete": {
+                "description": "Delete a participant from a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2266,9 +2419,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "sessions"
                 ],
-                "summary": "Update a board",
+                "summary": "Delete a participant from a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2279,27 +2432,22 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board to update",
-                        "name": "id",
+                        "description": "id of the board",
+                        "name": "boardId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "values to update the board",
-                        "name": "board",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/boards.BoardUpdateRequest"
-                        }
+                        "type": "string",
+                        "description": "id of the session",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/boards.Board"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2326,9 +2474,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a board",
+            }
+        },
+        "/boards/{boardId}/reactions": {
+            "get": {
+                "description": "Get all reaction on a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2336,9 +2486,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "reactions"
                 ],
-                "summary": "Delete a board",
+                "summary": "Get all reaction on a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2349,15 +2499,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board to delete",
-                        "name": "id",
+                        "description": "id of the board",
+                        "name": "boardId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/reactions.Reaction"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2371,56 +2527,22 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
-                    }
-                }
-            }
-        },
-        "/boards/{id}/board-reactions": {
-            "post": {
-                "description": "Create a board reaction",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "board reactions"
-                ],
-                "summary": "Create a board reaction",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "jwt token to authenticate",
-                        "name": "Cookie",
-                        "in": "header",
-                        "required": true
                     },
-                    {
-                        "description": "Board reaction to create",
-                        "name": "boardreaction",
-                        "in": "body",
-                        "required": true,
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/boardreactions.BoardReactionCreateRequest"
+                            "$ref": "#/definitions/common.APIError"
                         }
                     }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created"
-                    }
                 }
-            }
-        },
-        "/boards/{id}/participants": {
+            },
             "post": {
-                "description": "Join an existing board",
+                "description": "Create a new reaction on a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2428,9 +2550,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "reactions"
                 ],
-                "summary": "Join an existing board",
+                "summary": "Create a new reaction on a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2441,23 +2563,27 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board to join",
-                        "name": "id",
+                        "description": "id of the board",
+                        "name": "boardId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "join request for the board",
-                        "name": "join",
+                        "description": "reaction to create",
+                        "name": "reaction",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/boards.JoinBoardRequest"
+                            "$ref": "#/definitions/reactions.ReactionCreateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "303": {
-                        "description": "See Other"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/reactions.Reaction"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2477,9 +2603,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests"
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2489,19 +2612,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards{id}/export": {
+        "/boards/{boardId}/reactions/{id}": {
             "get": {
-                "description": "Export a board",
+                "description": "Get a reaction on a board",
                 "consumes": [
-                    "json text/csv"
+                    "application/json"
                 ],
                 "produces": [
-                    "json text/csv"
+                    "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "reactions"
                 ],
-                "summary": "Export a board",
+                "summary": "Get a reaction on a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2512,7 +2635,14 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board to export",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the reaction",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2522,7 +2652,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/boards.Board"
+                            "$ref": "#/definitions/reactions.Reaction"
                         }
                     },
                     "400": {
@@ -2543,9 +2673,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2553,11 +2680,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/boards{id}/timer": {
-            "post": {
-                "description": "Set a new timer for a board",
+            },
+            "put": {
+                "description": "Update a reaction on a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2565,9 +2690,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "reactions"
                 ],
-                "summary": "Set a new timer for a board",
+                "summary": "Update a reaction on a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2578,27 +2703,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board to set the timer",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the reaction",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "timer request",
-                        "name": "timer",
+                        "description": "values to update the reaction",
+                        "name": "reaction",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/boards.SetTimerRequest"
+                            "$ref": "#/definitions/reactions.ReactionUpdateTypeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/boards.Board"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2627,7 +2756,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a timer for a board",
+                "description": "Remove a reaction from a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2635,9 +2764,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "reactions"
                 ],
-                "summary": "Delete a timer for a board",
+                "summary": "Remove a reaction from a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2648,18 +2777,22 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board to delete the timer from",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the reaction",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/boards.Board"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2688,9 +2821,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards{id}/timer/increment": {
-            "post": {
-                "description": "Increment a timer for a board by one minute",
+        "/boards/{boardId}/requests": {
+            "get": {
+                "description": "Get all board session request for a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2698,9 +2831,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "board session requests"
                 ],
-                "summary": "Increment a timer for a board",
+                "summary": "Get all board session request for a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -2711,17 +2844,26 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board to increment the timer",
-                        "name": "id",
+                        "description": "boarId for the session requests",
+                        "name": "boardId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "status to filter the requests",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/boards.Board"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/sessionrequests.BoardSessionRequest"
+                            }
                         }
                     },
                     "400": {
@@ -2735,25 +2877,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
                     }
                 }
             }
         },
-        "/feedback": {
-            "post": {
-                "description": "Send feedback for scrumlr",
+        "/boards/{boardId}/requests/{userId}": {
+            "get": {
+                "description": "Get a board session request for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -2761,53 +2891,61 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "feedback"
+                    "board session requests"
                 ],
-                "summary": "Send feedback for scrumlr",
+                "summary": "Get a board session request for a user",
                 "parameters": [
                     {
-                        "description": "Feedback to send",
-                        "name": "feedback",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/feedback.FeedbackRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Internal Server Error"
+                    {
+                        "type": "string",
+                        "description": "boarId for the session request",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "userId for the session request",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
                     }
-                }
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "Get the health status of the scrumlr backend",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "health"
                 ],
-                "summary": "Get the health status",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "503": {
-                        "description": "Service Unavailable"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/sessionrequests.BoardSessionRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
-            }
-        },
-        "/import": {
-            "post": {
-                "description": "Import a board",
+            },
+            "put": {
+                "description": "Update a board session request for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -2815,9 +2953,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "board session requests"
                 ],
-                "summary": "Import a board",
+                "summary": "Update a board session request for a user",
                 "parameters": [
                     {
                         "type": "string",
@@ -2827,20 +2965,34 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "board to import",
-                        "name": "board",
+                        "type": "string",
+                        "description": "boarId for the session request",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "userId for the session request",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "values to update the board session request",
+                        "name": "sessionRequestUpdate",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/boards.ImportBoardRequest"
+                            "$ref": "#/definitions/sessionrequests.BoardSessionRequestUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/boards.Board"
+                            "$ref": "#/definitions/sessionrequests.BoardSessionRequest"
                         }
                     },
                     "400": {
@@ -2855,8 +3007,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
@@ -2864,49 +3016,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/info": {
+        "/boards/{boardId}/votes": {
             "get": {
-                "description": "Get the server info with the configured options",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "info"
-                ],
-                "summary": "Show server info",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/api.Info"
-                        }
-                    }
-                }
-            }
-        },
-        "/login": {
-            "delete": {
-                "description": "Log the current user out",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Log the current user out",
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
-            }
-        },
-        "/login/anonymous": {
-            "post": {
-                "description": "Create a new anonymous user",
+                "description": "Get all votes from a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2914,25 +3026,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "votes"
                 ],
-                "summary": "Create a new anonymous user",
+                "summary": "Get all votes from a board",
                 "parameters": [
                     {
-                        "description": "user to create",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.AnonymousSignUpRequest"
-                        }
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of a voting to filter the votes",
+                        "name": "voting",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of a note to filter the votes",
+                        "name": "note",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/users.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/votings.Vote"
+                            }
                         }
                     },
                     "400": {
@@ -2947,6 +3079,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2954,11 +3092,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/login/{provider}": {
-            "get": {
-                "description": "Redirect the user to the specified auth provider consent page",
+            },
+            "post": {
+                "description": "Add a new vote to a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -2966,68 +3102,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "votes"
                 ],
-                "summary": "Redirect the user to the specified auth provider consent page",
+                "summary": "Add a new vote to a board",
                 "parameters": [
                     {
-                        "enum": [
-                            "GOOGLE",
-                            "MICROSOFT",
-                            "AZURE_AD",
-                            "GITHUB",
-                            "APPLE",
-                            "OIDC"
-                        ],
                         "type": "string",
-                        "description": "provider to use to login",
-                        "name": "provider",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "307": {
-                        "description": "Temporary Redirect"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
-                        }
-                    }
-                }
-            }
-        },
-        "/login/{provider}/callback": {
-            "get": {
-                "description": "Verify the auth provider call and create or update a user. Redirect to the page provider with the state",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Verify the auth provider call and create or update a user",
-                "parameters": [
                     {
-                        "description": "user to create",
-                        "name": "user",
+                        "description": "vote to add",
+                        "name": "vote",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.AnonymousSignUpRequest"
+                            "$ref": "#/definitions/votings.VoteRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "303": {
-                        "description": "See Other",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/users.User"
+                            "$ref": "#/definitions/votings.Vote"
                         }
                     },
                     "400": {
@@ -3042,6 +3149,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3049,11 +3162,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/templates": {
-            "get": {
-                "description": "Get all board templates for a user",
+            },
+            "delete": {
+                "description": "Remove a vote from a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -3061,9 +3172,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "board templates"
+                    "votes"
                 ],
-                "summary": "Get all board templates",
+                "summary": "Remove a vote from a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -3071,15 +3182,2224 @@ const docTemplate = `{
                         "name": "Cookie",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "vote to remove",
+                        "name": "vote",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/votings.VoteRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{boardId}/votings": {
+            "get": {
+                "description": "Get all votings on a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "votings"
+                ],
+                "summary": "Get all votings on a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/votings.Voting"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new voting on a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "votings"
+                ],
+                "summary": "Create a new voting on a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "voting to create",
+                        "name": "voting",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/votings.VotingCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/votings.Voting"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{boardId}/votings/analytics": {
+            "get": {
+                "description": "Compare the results of all closed votings on a board, including the rank movement of the notes and the participation per voting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "votings"
+                ],
+                "summary": "Get the analytics of the votings on a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/votings.VotingAnalytics"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{boardId}/votings/{id}": {
+            "get": {
+                "description": "Get a voting on a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "votings"
+                ],
+                "summary": "Get a voting on a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the voting",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/votings.Voting"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a voting on a board to closed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "votings"
+                ],
+                "summary": "Update a voting on a board to closed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the voting",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/votings.Voting"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}": {
+            "get": {
+                "description": "Get a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Get a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to get",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "sequence of the last received event, to only receive the missed events when reconnecting to the websocket",
+                        "name": "lastSequence",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Update a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to update",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "values to update the board",
+                        "name": "board",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/boards.BoardUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Delete a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to delete",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/archive": {
+            "put": {
+                "description": "Archive a board, archived boards are read-only for all participants",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Archive a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to archive",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Restore an archived board, so that it can be edited again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Unarchive a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to unarchive",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/audit": {
+            "get": {
+                "description": "Get the changes of board settings and the moderation of a board, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Get the audit log of a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of entries, defaults to 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of entries to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/audit.Entry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/board-reactions": {
+            "post": {
+                "description": "Create a board reaction",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board reactions"
+                ],
+                "summary": "Create a board reaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Board reaction to create",
+                        "name": "boardreaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/boardreactions.BoardReactionCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            }
+        },
+        "/boards/{id}/participants": {
+            "post": {
+                "description": "Join an existing board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Join an existing board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to join",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "join request for the board",
+                        "name": "join",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/boards.JoinBoardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "303": {
+                        "description": "See Other"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards{id}/export": {
+            "get": {
+                "description": "Export a board as json, csv, markdown, html or pdf depending on the Accept header",
+                "consumes": [
+                    "application/json",
+                    "text/csv",
+                    "text/markdown",
+                    "text/html",
+                    "application/pdf"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "text/markdown",
+                    "text/html",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Export a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to export",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards{id}/timer": {
+            "post": {
+                "description": "Set a new timer for a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Set a new timer for a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to set the timer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "timer request",
+                        "name": "timer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/boards.SetTimerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a timer for a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Delete a timer for a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to delete the timer from",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards{id}/timer/increment": {
+            "post": {
+                "description": "Increment a timer for a board by one minute",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Increment a timer for a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board to increment the timer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/feedback": {
+            "post": {
+                "description": "Send feedback for scrumlr",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feedback"
+                ],
+                "summary": "Send feedback for scrumlr",
+                "parameters": [
+                    {
+                        "description": "Feedback to send",
+                        "name": "feedback",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/feedback.FeedbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Get the health status of the scrumlr backend",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Get the health status",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/import": {
+            "post": {
+                "description": "Import a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Import a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "board to import",
+                        "name": "board",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/boards.ImportBoardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/boards.Board"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/info": {
+            "get": {
+                "description": "Get the server info with the configured options",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "info"
+                ],
+                "summary": "Show server info",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.Info"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "delete": {
+                "description": "Log the current user out and revoke the session, so that its token can not be used anymore",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Log the current user out",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/login/anonymous": {
+            "post": {
+                "description": "Create a new anonymous user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Create a new anonymous user",
+                "parameters": [
+                    {
+                        "description": "user to create",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.AnonymousSignUpRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/users.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/saml": {
+            "get": {
+                "description": "Redirect the user to the SAML identity provider",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Redirect the user to the SAML identity provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "location to redirect to after the login",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "set to true to link the account of the provider to the logged in user",
+                        "name": "link",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/saml/acs": {
+            "post": {
+                "description": "Assertion consumer service of the SAML service provider. Verify the response of the identity provider and create or update a user. The account is linked to the user who started the login with link=true, otherwise an anonymous user who started the login is merged into this user. Redirect to the location given when the login started",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Verify the response of the SAML identity provider and create or update a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "response of the identity provider",
+                        "name": "SAMLResponse",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "relay state of the request",
+                        "name": "RelayState",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "303": {
+                        "description": "See Other"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/login/saml/metadata": {
+            "get": {
+                "description": "Get the metadata of the SAML service provider, which has to be registered at the identity provider",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Get the SAML service provider metadata",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/{provider}": {
+            "get": {
+                "description": "Redirect the user to the specified auth provider consent page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Redirect the user to the specified auth provider consent page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "provider to use to login, or the name of an additional OIDC provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "set to true to link the account of the provider to the logged in user",
+                        "name": "link",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "307": {
+                        "description": "Temporary Redirect"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/{provider}/callback": {
+            "get": {
+                "description": "Verify the auth provider call and create or update a user. The account is linked to the user who started the login with link=true, otherwise an anonymous user who started the login is merged into this user. Redirect to the page provider with the state",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Verify the auth provider call and create or update a user",
+                "parameters": [
+                    {
+                        "description": "user to create",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.AnonymousSignUpRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "303": {
+                        "description": "See Other",
+                        "schema": {
+                            "$ref": "#/definitions/users.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams": {
+            "get": {
+                "description": "Get all teams the user is a member of, including the role of the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get all teams of the user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/teams.Team"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a team, the creating user becomes its first owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Create a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "team to create",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teams.TeamCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/teams.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}": {
+            "get": {
+                "description": "Get a team the user is a member of",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the team",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teams.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a team, requires at least the admin role",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Update a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the team",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "team update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teams.TeamUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teams.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a team, requires the owner role. Boards and templates of the team are kept for their creators.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Delete a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the team",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/members": {
+            "get": {
+                "description": "Get all members of a team the user is a member of",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get all members of a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the team",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/teams.TeamMember"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a user to a team, requires at least the admin role. Only owners can add other owners.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Add a member to a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the team",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "member to add",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teams.TeamMemberCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/teams.TeamMember"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/members/{user}": {
+            "put": {
+                "description": "Change the role of a team member, requires at least the admin role. Only owners can grant or revoke the owner role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Update a member of a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the team",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the member",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "member update",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teams.TeamMemberUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teams.TeamMember"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a user from a team. Members can always leave a team, removing others requires at least the admin role.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Remove a member from a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the team",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the member",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates": {
+            "get": {
+                "description": "Get all board templates for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board templates"
+                ],
+                "summary": "Get all board templates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/boardtemplates.BoardTemplateFull"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a board template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board templates"
+                ],
+                "summary": "Create a board template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Board template to create",
+                        "name": "boardtemplate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/boardtemplates.CreateBoardTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/boardtemplates.BoardTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
+                    }
+                }
+            }
+        },
+        "/templates/{boardid}/columns": {
+            "get": {
+                "description": "Get all column templates from a board template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "column templates"
+                ],
+                "summary": "Get all column templates from a board template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board template",
+                        "name": "boardid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/boardtemplates.BoardTemplateFull"
+                                "$ref": "#/definitions/columntemplates.ColumnTemplate"
                             }
                         }
                     },
@@ -3089,13 +5409,252 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests"
                     }
                 }
             },
             "post": {
-                "description": "Create a board template",
+                "description": "Create a column template for an existing board template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "column templates"
+                ],
+                "summary": "Create a column template for a board template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board template",
+                        "name": "boardid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "column template to create",
+                        "name": "columntemplate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/columntemplates.ColumnTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/columntemplates.ColumnTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
+                    }
+                }
+            }
+        },
+        "/templates/{boardid}/columns/{id}": {
+            "get": {
+                "description": "Get a column template from a board template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "column templates"
+                ],
+                "summary": "Get a column template from a board template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board template",
+                        "name": "boardid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the column template",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/columntemplates.ColumnTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a column template from a board template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "column templates"
+                ],
+                "summary": "Update a column template from a board template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board template",
+                        "name": "boardid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the column template to update",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/columntemplates.ColumnTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a column template from a board template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "column templates"
+                ],
+                "summary": "Delete a column template from a board template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the board template",
+                        "name": "boardid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the column template to delete",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
+                    }
+                }
+            }
+        },
+        "/templates/{id}": {
+            "get": {
+                "description": "Get a board template by its id",
                 "consumes": [
                     "application/json"
                 ],
@@ -3105,7 +5664,7 @@ const docTemplate = `{
                 "tags": [
                     "board templates"
                 ],
-                "summary": "Create a board template",
+                "summary": "Get a board template",
                 "parameters": [
                     {
                         "type": "string",
@@ -3115,37 +5674,42 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Board template to create",
-                        "name": "boardtemplate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/boardtemplates.CreateBoardTemplateRequest"
-                        }
+                        "type": "string",
+                        "description": "Id of the template to get",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/boardtemplates.BoardTemplate"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
                     "429": {
                         "description": "Too Many Requests"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
-            }
-        },
-        "/templates/{boardid}/columns": {
-            "get": {
-                "description": "Get all column templates from a board template",
+            },
+            "put": {
+                "description": "Update a board template for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -3153,9 +5717,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "column templates"
+                    "board templates"
                 ],
-                "summary": "Get all column templates from a board template",
+                "summary": "Update a board template",
                 "parameters": [
                     {
                         "type": "string",
@@ -3166,20 +5730,26 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board template",
-                        "name": "boardid",
+                        "description": "Id of the template to update",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Board template to update",
+                        "name": "boardtemplate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/boardtemplates.BoardTemplateUpdateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/columntemplates.ColumnTemplate"
-                            }
+                            "$ref": "#/definitions/boardtemplates.BoardTemplate"
                         }
                     },
                     "400": {
@@ -3199,8 +5769,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Create a column template for an existing board template",
+            "delete": {
+                "description": "Delete a board template for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -3208,9 +5778,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "column templates"
+                    "board templates"
                 ],
-                "summary": "Create a column template for a board template",
+                "summary": "Delete a board template",
                 "parameters": [
                     {
                         "type": "string",
@@ -3221,27 +5791,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board template",
-                        "name": "boardid",
+                        "description": "Id of the template to update",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "column template to create",
-                        "name": "columntemplate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/columntemplates.ColumnTemplateRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/columntemplates.ColumnTemplate"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3251,13 +5809,16 @@ const docTemplate = `{
                     },
                     "429": {
                         "description": "Too Many Requests"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/templates/{boardid}/columns/{id}": {
+        "/tokens": {
             "get": {
-                "description": "Get a column template from a board template",
+                "description": "Get all personal access tokens of the user, the tokens themselves are not included",
                 "consumes": [
                     "application/json"
                 ],
@@ -3265,9 +5826,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "column templates"
+                    "tokens"
                 ],
-                "summary": "Get a column template from a board template",
+                "summary": "Get all personal access tokens",
                 "parameters": [
                     {
                         "type": "string",
@@ -3275,48 +5836,34 @@ const docTemplate = `{
                         "name": "Cookie",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "id of the board template",
-                        "name": "boardid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "id of the column template",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/columntemplates.ColumnTemplate"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/apitokens.ApiToken"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
-                    },
-                    "429": {
-                        "description": "Too Many Requests"
                     }
                 }
             },
-            "put": {
-                "description": "Update a column template from a board template",
+            "post": {
+                "description": "Create a personal access token for scripts and integrations. The token is only returned once and is sent as bearer token in the Authorization header.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3324,9 +5871,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "column templates"
+                    "tokens"
                 ],
-                "summary": "Update a column template from a board template",
+                "summary": "Create a personal access token",
                 "parameters": [
                     {
                         "type": "string",
@@ -3336,25 +5883,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "id of the board template",
-                        "name": "boardid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "id of the column template to update",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "token to create",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apitokens.ApiTokenCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/columntemplates.ColumnTemplate"
+                            "$ref": "#/definitions/apitokens.ApiTokenCreated"
                         }
                     },
                     "400": {
@@ -3363,29 +5905,28 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests"
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
-            },
+            }
+        },
+        "/tokens/{token}": {
             "delete": {
-                "description": "Delete a column template from a board template",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Revoke a personal access token of the user, it can not be used anymore afterwards",
                 "tags": [
-                    "column templates"
+                    "tokens"
                 ],
-                "summary": "Delete a column template from a board template",
+                "summary": "Revoke a personal access token",
                 "parameters": [
                     {
                         "type": "string",
@@ -3396,15 +5937,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board template",
-                        "name": "boardid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "id of the column template to delete",
-                        "name": "id",
+                        "description": "id of the token",
+                        "name": "token",
                         "in": "path",
                         "required": true
                     }
@@ -3419,21 +5953,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests"
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
             }
         },
-        "/templates/{id}": {
+        "/users": {
             "get": {
-                "description": "Get a board template by its id",
+                "description": "Get the loged in user",
                 "consumes": [
                     "application/json"
                 ],
@@ -3441,9 +5984,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "board templates"
+                    "users"
                 ],
-                "summary": "Get a board template",
+                "summary": "Get the loged in user",
                 "parameters": [
                     {
                         "type": "string",
@@ -3451,24 +5994,26 @@ const docTemplate = `{
                         "name": "Cookie",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Id of the template to get",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/boardtemplates.BoardTemplate"
+                            "$ref": "#/definitions/users.User"
                         }
                     },
                     "400": {
-                        "description": "Bad Request"
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -3476,9 +6021,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests"
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3488,7 +6030,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update a board template for a user",
+                "description": "Update the logged in user",
                 "consumes": [
                     "application/json"
                 ],
@@ -3496,9 +6038,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "board templates"
+                    "users"
                 ],
-                "summary": "Update a board template",
+                "summary": "Update the logged in user",
                 "parameters": [
                     {
                         "type": "string",
@@ -3508,19 +6050,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Id of the template to update",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Board template to update",
-                        "name": "boardtemplate",
+                        "description": "values to update the user",
+                        "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/boardtemplates.BoardTemplateUpdateRequest"
+                            "$ref": "#/definitions/users.UserUpdateRequest"
                         }
                     }
                 ],
@@ -3528,7 +6063,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/boardtemplates.BoardTemplate"
+                            "$ref": "#/definitions/users.User"
                         }
                     },
                     "400": {
@@ -3537,19 +6072,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
-                    },
-                    "429": {
-                        "description": "Too Many Requests"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a board template for a user",
+            }
+        },
+        "/users/board/{boardId}": {
+            "get": {
+                "description": "Get all users from a board",
                 "consumes": [
                     "application/json"
                 ],
@@ -3557,9 +6103,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "board templates"
+                    "users"
                 ],
-                "summary": "Delete a board template",
+                "summary": "Get all users from a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -3570,15 +6116,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Id of the template to update",
-                        "name": "id",
+                        "description": "id of the board",
+                        "name": "boardId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/users.User"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3586,28 +6138,37 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests"
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error"
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.APIError"
+                        }
                     }
                 }
             }
         },
-        "/users": {
+        "/users/identities": {
             "get": {
-                "description": "Get the loged in user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the accounts of the identity providers the logged in user can log in with. Further accounts are linked by logging in with the query parameter link=true.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "users"
                 ],
-                "summary": "Get the loged in user",
+                "summary": "Get the identities of the logged in user",
                 "parameters": [
                     {
                         "type": "string",
@@ -3621,17 +6182,50 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/users.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/users.Identity"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
+                    }
+                }
+            }
+        },
+        "/users/identities/{provider}": {
+            "delete": {
+                "description": "Remove the account of the identity provider from the logged in user. The last account can not be removed.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unlink an identity of the logged in user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "jwt token to authenticate",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    {
+                        "type": "string",
+                        "description": "provider of the account, or the name of an additional OIDC provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
@@ -3649,9 +6243,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update the logged in user",
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "Get a user by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -3661,7 +6257,7 @@ const docTemplate = `{
                 "tags": [
                     "users"
                 ],
-                "summary": "Update the logged in user",
+                "summary": "Get a user by id",
                 "parameters": [
                     {
                         "type": "string",
@@ -3671,13 +6267,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "values to update the user",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/users.UserUpdateRequest"
-                        }
+                        "type": "string",
+                        "description": "id of the user",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3712,11 +6306,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/board/{boardId}": {
-            "get": {
-                "description": "Get all users from a board",
+            },
+            "delete": {
+                "description": "Delete the logged in user",
                 "consumes": [
                     "application/json"
                 ],
@@ -3726,7 +6318,7 @@ const docTemplate = `{
                 "tags": [
                     "users"
                 ],
-                "summary": "Get all users from a board",
+                "summary": "Delete the logged in user",
                 "parameters": [
                     {
                         "type": "string",
@@ -3737,21 +6329,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the board",
-                        "name": "boardId",
+                        "description": "id of the user to delete",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/users.User"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3780,19 +6366,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/users/{id}/export": {
             "get": {
-                "description": "Get a user by id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Export everything stored about the logged in user across all boards that hold data of the user, including boards the user left, i.e. the profile, the accounts of the identity providers, the team memberships, the api tokens without their secrets, the board sessions, the authored notes and their revisions, votes, reactions, estimates, assigned action items and audit log entries and the created board templates. Feedback is not part of the export, since it is forwarded to the feedback webhook and not stored.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "users"
                 ],
-                "summary": "Get a user by id",
+                "summary": "Export all data of the logged in user",
                 "parameters": [
                     {
                         "type": "string",
@@ -3813,13 +6396,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/users.User"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
+                            "$ref": "#/definitions/users.Export"
                         }
                     },
                     "403": {
@@ -3841,19 +6418,15 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/users/{id}/sessions": {
             "delete": {
-                "description": "Delete the logged in user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Log the user out everywhere by revoking all tokens issued so far, including the one of this request",
                 "tags": [
                     "users"
                 ],
-                "summary": "Delete the logged in user",
+                "summary": "Revoke all sessions of the logged in user",
                 "parameters": [
                     {
                         "type": "string",
@@ -3864,7 +6437,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "id of the user to delete",
+                        "description": "id of the user",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3880,29 +6453,262 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/common.APIError"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "actionitems.ActionItem": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "description": "The board participant responsible for this action item, if any.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/uuid.NullUUID"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "description": "The date this action item should be done by.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The action item id.",
+                    "type": "string"
+                },
+                "note": {
+                    "description": "The note this action item originated from, if any.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/uuid.NullUUID"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "The progress of the action item.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/actionitems.ActionItemStatus"
+                        }
+                    ]
+                },
+                "text": {
+                    "description": "What needs to be done.",
+                    "type": "string"
+                }
+            }
+        },
+        "actionitems.ActionItemCreateRequest": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "$ref": "#/definitions/uuid.NullUUID"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "note": {
+                    "$ref": "#/definitions/uuid.NullUUID"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "actionitems.ActionItemStatus": {
+            "type": "string",
+            "enum": [
+                "OPEN",
+                "IN_PROGRESS",
+                "DONE"
+            ],
+            "x-enum-varnames": [
+                "Open",
+                "InProgress",
+                "Done"
+            ]
+        },
+        "actionitems.ActionItemUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "$ref": "#/definitions/uuid.NullUUID"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/actionitems.ActionItemStatus"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.Action": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "The kind of action.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/admin.ActionType"
+                        }
+                    ]
+                },
+                "actor": {
+                    "description": "The administrator who performed the action, empty if the user was deleted since.",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "The id of the board or user the action targeted.",
+                    "type": "string"
+                }
+            }
+        },
+        "admin.ActionType": {
+            "type": "string",
+            "enum": [
+                "DELETE_BOARD",
+                "BAN_USER",
+                "UNBAN_USER"
+            ],
+            "x-enum-varnames": [
+                "ActionDeleteBoard",
+                "ActionBanUser",
+                "ActionUnbanUser"
+            ]
+        },
+        "admin.Board": {
+            "type": "object",
+            "properties": {
+                "accessPolicy": {
+                    "description": "The access policy of the board.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/boards.AccessPolicy"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.APIError"
+                    ]
+                },
+                "archivedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastModifiedAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The board name.",
+                    "type": "string"
+                },
+                "participants": {
+                    "description": "The number of participants of the board.",
+                    "type": "integer"
+                }
+            }
+        },
+        "admin.DeletedBoard": {
+            "type": "object",
+            "properties": {
+                "accessPolicy": {
+                    "$ref": "#/definitions/boards.AccessPolicy"
+                },
+                "avgCharsPerNote": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "firstNoteCreated": {
+                    "type": "string"
+                },
+                "hiddenColumns": {
+                    "type": "integer"
+                },
+                "hiddenNotes": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastNoteCreated": {
+                    "type": "string"
+                },
+                "totalColumns": {
+                    "type": "integer"
+                },
+                "totalModerators": {
+                    "type": "integer"
+                },
+                "totalNotes": {
+                    "type": "integer"
+                },
+                "totalParticipants": {
+                    "type": "integer"
+                },
+                "totalVotes": {
+                    "type": "integer"
+                },
+                "totalVotings": {
+                    "type": "integer"
+                }
+            }
+        },
+        "admin.User": {
+            "type": "object",
+            "properties": {
+                "accountType": {
+                    "description": "The account type of the user.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/common.AccountType"
                         }
-                    }
+                    ]
+                },
+                "admin": {
+                    "description": "Whether the user is an instance administrator.",
+                    "type": "boolean"
+                },
+                "banned": {
+                    "description": "Whether the user is banned from the instance.",
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The user name.",
+                    "type": "string"
                 }
             }
-        }
-    },
-    "definitions": {
+        },
         "api.AnonymousSignUpRequest": {
             "type": "object",
             "properties": {
@@ -3936,11 +6742,149 @@ const docTemplate = `{
                 "feedbackEnabled": {
                     "type": "boolean"
                 },
+                "oidcProviders": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "serverTime": {
                     "type": "string"
                 }
             }
         },
+        "apitokens.ApiToken": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "The date the token expires, the token does not expire if not set.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The token id.",
+                    "type": "string"
+                },
+                "lastUsedAt": {
+                    "description": "The date the token was last used to authenticate a request.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The name to recognize the token by.",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "Read-only tokens may only be used for GET requests.",
+                    "type": "boolean"
+                },
+                "user": {
+                    "description": "The id of the user the token belongs to.",
+                    "type": "string"
+                }
+            }
+        },
+        "apitokens.ApiTokenCreateRequest": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "readOnly": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "apitokens.ApiTokenCreated": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "The date the token expires, the token does not expire if not set.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The token id.",
+                    "type": "string"
+                },
+                "lastUsedAt": {
+                    "description": "The date the token was last used to authenticate a request.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The name to recognize the token by.",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "Read-only tokens may only be used for GET requests.",
+                    "type": "boolean"
+                },
+                "token": {
+                    "description": "The token to send in the Authorization header as bearer token.",
+                    "type": "string"
+                },
+                "user": {
+                    "description": "The id of the user the token belongs to.",
+                    "type": "string"
+                }
+            }
+        },
+        "audit.Action": {
+            "type": "string",
+            "enum": [
+                "BOARD_UPDATED",
+                "SESSION_UPDATED",
+                "COLUMN_DELETED",
+                "VOTING_CREATED",
+                "VOTING_CLOSED",
+                "NOTE_DELETED"
+            ],
+            "x-enum-varnames": [
+                "BoardUpdated",
+                "SessionUpdated",
+                "ColumnDeleted",
+                "VotingCreated",
+                "VotingClosed",
+                "NoteDeleted"
+            ]
+        },
+        "audit.Entry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "The kind of change.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/audit.Action"
+                        }
+                    ]
+                },
+                "actor": {
+                    "description": "The user who made the change, empty if the user was deleted since.",
+                    "type": "string"
+                },
+                "after": {
+                    "description": "The changed object after the change, empty if it was deleted.",
+                    "type": "object"
+                },
+                "before": {
+                    "description": "The changed object before the change, empty if it was created.",
+                    "type": "object"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
         "avatar.AccessoriesType": {
             "type": "string",
             "enum": [
@@ -4374,6 +7318,10 @@ const docTemplate = `{
                 "allowStacking": {
                     "type": "boolean"
                 },
+                "archivedAt": {
+                    "description": "The date the board was archived. Archived boards are read-only.",
+                    "type": "string"
+                },
                 "createdAt": {
                     "type": "string"
                 },
@@ -4381,6 +7329,14 @@ const docTemplate = `{
                     "description": "Description of the board",
                     "type": "string"
                 },
+                "followUpOf": {
+                    "description": "The previous board of the series this board is a follow-up of.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/uuid.NullUUID"
+                        }
+                    ]
+                },
                 "id": {
                     "type": "string"
                 },
@@ -4394,6 +7350,10 @@ const docTemplate = `{
                     "description": "The board name",
                     "type": "string"
                 },
+                "retentionDays": {
+                    "description": "The number of days the board is kept after its last modification.\nIf not set the server-wide retention applies.",
+                    "type": "integer"
+                },
                 "sharedNote": {
                     "description": "The id of a note to share with other users.",
                     "allOf": [
@@ -4417,6 +7377,14 @@ const docTemplate = `{
                 "showVoting": {
                     "$ref": "#/definitions/uuid.NullUUID"
                 },
+                "team": {
+                    "description": "The team owning this board, all members of the team see the board in their board overview.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/uuid.NullUUID"
+                        }
+                    ]
+                },
                 "timerEnd": {
                     "type": "string"
                 },
@@ -4451,6 +7419,27 @@ const docTemplate = `{
                 },
                 "role": {
                     "$ref": "#/definitions/role.Role"
+                },
+                "series": {
+                    "description": "All boards of the series this board belongs to, oldest first.\nOnly contains boards the user participates in and is empty if the board is not part of a series.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/boards.BoardSeriesEntry"
+                    }
+                }
+            }
+        },
+        "boards.BoardSeriesEntry": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
@@ -4485,6 +7474,10 @@ const docTemplate = `{
                     "description": "The passphrase of the board.",
                     "type": "string"
                 },
+                "retentionDays": {
+                    "description": "Set the number of days the board is kept after its last modification.\nUse 0 to keep the board forever. Boards without a retention fall back to the server-wide retention.",
+                    "type": "integer"
+                },
                 "sharedNote": {
                     "description": "Set the note id of the note to share with other users.",
                     "allOf": [
@@ -4535,6 +7528,14 @@ const docTemplate = `{
                     "description": "Description of the board",
                     "type": "string"
                 },
+                "followUp": {
+                    "description": "The previous board this board is a follow-up of.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/boards.FollowUpRequest"
+                        }
+                    ]
+                },
                 "name": {
                     "description": "The name of the board.",
                     "type": "string"
@@ -4542,6 +7543,23 @@ const docTemplate = `{
                 "passphrase": {
                     "description": "The passphrase must be set if access policy is defined as by passphrase.",
                     "type": "string"
+                },
+                "team": {
+                    "description": "The team the board is created for, the owner must be a member of that team.",
+                    "type": "string"
+                }
+            }
+        },
+        "boards.FollowUpRequest": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "description": "The previous board.",
+                    "type": "string"
+                },
+                "column": {
+                    "description": "A column of the previous board whose notes, including their stacks, are copied into the first column of the\nnew board on behalf of its owner.",
+                    "type": "string"
                 }
             }
         },
@@ -4610,6 +7628,14 @@ const docTemplate = `{
                 "name": {
                     "description": "The board template name",
                     "type": "string"
+                },
+                "team": {
+                    "description": "The team sharing this template with all of its members",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/uuid.NullUUID"
+                        }
+                    ]
                 }
             }
         },
@@ -4674,6 +7700,10 @@ const docTemplate = `{
                 "name": {
                     "description": "The name of the board template.",
                     "type": "string"
+                },
+                "team": {
+                    "description": "The team to share the template with, the creator must be a member of that team",
+                    "type": "string"
                 }
             }
         },
@@ -4854,7 +7884,8 @@ const docTemplate = `{
                 "AZURE_AD",
                 "GITHUB",
                 "APPLE",
-                "OIDC"
+                "OIDC",
+                "SAML"
             ],
             "x-enum-varnames": [
                 "Anonymous",
@@ -4863,7 +7894,8 @@ const docTemplate = `{
                 "AzureAd",
                 "GitHub",
                 "Apple",
-                "TypeOIDC"
+                "TypeOIDC",
+                "TypeSAML"
             ]
         },
         "common.Avatar": {
@@ -4931,6 +7963,120 @@ const docTemplate = `{
                 "ColorYieldingYellow"
             ]
         },
+        "estimations.Deck": {
+            "type": "string",
+            "enum": [
+                "FIBONACCI",
+                "T_SHIRT"
+            ],
+            "x-enum-varnames": [
+                "FibonacciDeck",
+                "TShirtDeck"
+            ]
+        },
+        "estimations.Estimate": {
+            "type": "object",
+            "properties": {
+                "card": {
+                    "description": "The chosen card, empty while the round is open.",
+                    "type": "string"
+                },
+                "estimation": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "estimations.EstimateRequest": {
+            "type": "object",
+            "properties": {
+                "card": {
+                    "description": "The chosen card of the deck of the round.",
+                    "type": "string"
+                }
+            }
+        },
+        "estimations.Estimation": {
+            "type": "object",
+            "properties": {
+                "cards": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deck": {
+                    "$ref": "#/definitions/estimations.Deck"
+                },
+                "estimate": {
+                    "description": "The final estimate, set when the round was closed with one.",
+                    "type": "string"
+                },
+                "estimates": {
+                    "description": "The estimates of the participants, their cards are hidden until the round is revealed.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/estimations.Estimate"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/estimations.EstimationStatus"
+                }
+            }
+        },
+        "estimations.EstimationCreateRequest": {
+            "type": "object",
+            "properties": {
+                "deck": {
+                    "description": "The deck of cards to estimate with, defaults to the Fibonacci deck.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/estimations.Deck"
+                        }
+                    ]
+                },
+                "note": {
+                    "description": "The note to estimate.",
+                    "type": "string"
+                }
+            }
+        },
+        "estimations.EstimationStatus": {
+            "type": "string",
+            "enum": [
+                "OPEN",
+                "REVEALED",
+                "CLOSED"
+            ],
+            "x-enum-varnames": [
+                "Open",
+                "Revealed",
+                "Closed"
+            ]
+        },
+        "estimations.EstimationUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "estimate": {
+                    "description": "The final estimate stored on the note, only allowed when closing a revealed round.",
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/estimations.EstimationStatus"
+                }
+            }
+        },
         "feedback.FeedbackRequest": {
             "type": "object",
             "properties": {
@@ -4968,6 +8114,10 @@ const docTemplate = `{
                 "edited": {
                     "type": "boolean"
                 },
+                "estimate": {
+                    "description": "The final estimate of the latest estimation round on the note.",
+                    "type": "string"
+                },
                 "id": {
                     "description": "The id of the note",
                     "type": "string"
@@ -5020,6 +8170,37 @@ const docTemplate = `{
                 }
             }
         },
+        "notes.NoteRevision": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "The user who made the change, empty if the user was deleted since.",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "description": "The note of the revision.",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "The position of the note in this revision.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notes.NotePosition"
+                        }
+                    ]
+                },
+                "text": {
+                    "description": "The text of the note in this revision.",
+                    "type": "string"
+                }
+            }
+        },
         "notes.NoteUpdateRequest": {
             "type": "object",
             "properties": {
@@ -5118,100 +8299,350 @@ const docTemplate = `{
                 }
             }
         },
-        "sessionrequests.BoardSessionRequestUpdate": {
+        "sessionrequests.BoardSessionRequestUpdate": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/sessionrequests.RequestStatus"
+                }
+            }
+        },
+        "sessionrequests.RequestStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "ACCEPTED",
+                "REJECTED"
+            ],
+            "x-enum-varnames": [
+                "RequestPending",
+                "RequestAccepted",
+                "RequestRejected"
+            ]
+        },
+        "sessions.BoardSession": {
+            "type": "object",
+            "properties": {
+                "banned": {
+                    "description": "Flag indicates whether the user is banned",
+                    "type": "boolean"
+                },
+                "connected": {
+                    "description": "Flag indicates whether user is online and connected to the board.",
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "description": "Reference for when board_session has been created",
+                    "type": "string"
+                },
+                "favourite": {
+                    "description": "Flag indicates whether user has marked the board as one of their favourites.",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "raisedHand": {
+                    "description": "Flag indicates whether user has raised his hand (...virtually).",
+                    "type": "boolean"
+                },
+                "ready": {
+                    "description": "Flag indicates whether user is marked as ready.",
+                    "type": "boolean"
+                },
+                "role": {
+                    "description": "The role of the participant.\n\nCan be one of 'PARTICIPANT', 'MODERATOR' or 'OWNER'. Participants\ncan only view data, add notes and votes while the users with the other\nroles are able to promote users, change board settings, edit columns,\nstart voting sessions etc.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/role.Role"
+                        }
+                    ]
+                },
+                "showHiddenColumns": {
+                    "description": "The configuration of visibility of columns.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "sessions.BoardSessionUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "banned": {
+                    "description": "The banned state of the participant",
+                    "type": "boolean"
+                },
+                "favourite": {
+                    "type": "boolean"
+                },
+                "raisedHand": {
+                    "description": "The raised hand state of the participant.",
+                    "type": "boolean"
+                },
+                "ready": {
+                    "description": "The ready state of the participant.",
+                    "type": "boolean"
+                },
+                "role": {
+                    "description": "The role of the participant.\n\nCan be either 'PARTICIPANT', 'MODERATOR' or 'OWNER'.\nOnly moderators and owners can promote other participants. A regular participant is not\nallowed to change the role.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/role.Role"
+                        }
+                    ]
+                },
+                "showHiddenColumns": {
+                    "description": "The configuration of visibility of columns.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "teams.Team": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The team id.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The team name.",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "The role of the requesting user within the team, only set when listing the teams of a user.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/teams.TeamRole"
+                        }
+                    ]
+                }
+            }
+        },
+        "teams.TeamCreateRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "teams.TeamMember": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "description": "The date the user joined the team.",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "The role of the user within the team.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/teams.TeamRole"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "The id of the user.",
+                    "type": "string"
+                }
+            }
+        },
+        "teams.TeamMemberCreateRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "$ref": "#/definitions/teams.TeamRole"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "teams.TeamMemberUpdateRequest": {
             "type": "object",
             "properties": {
-                "status": {
-                    "$ref": "#/definitions/sessionrequests.RequestStatus"
+                "role": {
+                    "$ref": "#/definitions/teams.TeamRole"
                 }
             }
         },
-        "sessionrequests.RequestStatus": {
+        "teams.TeamRole": {
             "type": "string",
             "enum": [
-                "PENDING",
-                "ACCEPTED",
-                "REJECTED"
+                "OWNER",
+                "ADMIN",
+                "MEMBER"
             ],
             "x-enum-varnames": [
-                "RequestPending",
-                "RequestAccepted",
-                "RequestRejected"
+                "Owner",
+                "Admin",
+                "Member"
             ]
         },
-        "sessions.BoardSession": {
+        "teams.TeamUpdateRequest": {
             "type": "object",
             "properties": {
-                "banned": {
-                    "description": "Flag indicates whether the user is banned",
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "users.BoardExport": {
+            "type": "object",
+            "properties": {
+                "actionItems": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/actionitems.ActionItem"
+                    }
                 },
-                "connected": {
-                    "description": "Flag indicates whether user is online and connected to the board.",
-                    "type": "boolean"
+                "auditLog": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/audit.Entry"
+                    }
                 },
-                "createdAt": {
-                    "description": "Reference for when board_session has been created",
+                "board": {
                     "type": "string"
                 },
-                "favourite": {
-                    "description": "Flag indicates whether user has marked the board as one of their favourites.",
-                    "type": "boolean"
+                "estimates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/estimations.Estimate"
+                    }
                 },
-                "id": {
+                "noteRevisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/notes.NoteRevision"
+                    }
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/notes.Note"
+                    }
+                },
+                "reactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/reactions.Reaction"
+                    }
+                },
+                "session": {
+                    "$ref": "#/definitions/sessions.BoardSession"
+                },
+                "votes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/votings.Vote"
+                    }
+                }
+            }
+        },
+        "users.Export": {
+            "type": "object",
+            "properties": {
+                "apiTokens": {
+                    "description": "The api tokens of the user, without their secrets",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apitokens.ApiToken"
+                    }
+                },
+                "boards": {
+                    "description": "The data of the user on every board, including boards the user no longer participates in",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/users.BoardExport"
+                    }
+                },
+                "exportedAt": {
                     "type": "string"
                 },
-                "raisedHand": {
-                    "description": "Flag indicates whether user has raised his hand (...virtually).",
-                    "type": "boolean"
+                "identities": {
+                    "description": "The accounts of the identity providers the user can log in with",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/users.ExportedIdentity"
+                    }
                 },
-                "ready": {
-                    "description": "Flag indicates whether user is marked as ready.",
-                    "type": "boolean"
+                "teams": {
+                    "description": "The teams the user is a member of, including the role of the user",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teams.Team"
+                    }
                 },
-                "role": {
-                    "description": "The role of the participant.\n\nCan be one of 'PARTICIPANT', 'MODERATOR' or 'OWNER'. Participants\ncan only view data, add notes and votes while the users with the other\nroles are able to promote users, change board settings, edit columns,\nstart voting sessions etc.",
+                "templates": {
+                    "description": "The board templates created by the user",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/boardtemplates.BoardTemplateFull"
+                    }
+                },
+                "user": {
+                    "description": "The profile of the user",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/role.Role"
+                            "$ref": "#/definitions/users.User"
                         }
                     ]
-                },
-                "showHiddenColumns": {
-                    "description": "The configuration of visibility of columns.",
-                    "type": "boolean"
                 }
             }
         },
-        "sessions.BoardSessionUpdateRequest": {
+        "users.ExportedIdentity": {
             "type": "object",
             "properties": {
-                "banned": {
-                    "description": "The banned state of the participant",
-                    "type": "boolean"
+                "accountType": {
+                    "description": "The account type of the provider",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/common.AccountType"
+                        }
+                    ]
                 },
-                "favourite": {
-                    "type": "boolean"
+                "avatarUrl": {
+                    "description": "The avatar of the user at the provider",
+                    "type": "string"
                 },
-                "raisedHand": {
-                    "description": "The raised hand state of the participant.",
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
-                "ready": {
-                    "description": "The ready state of the participant.",
-                    "type": "boolean"
+                "name": {
+                    "description": "The name of the user at the provider",
+                    "type": "string"
                 },
-                "role": {
-                    "description": "The role of the participant.\n\nCan be either 'PARTICIPANT', 'MODERATOR' or 'OWNER'.\nOnly moderators and owners can promote other participants. A regular participant is not\nallowed to change the role.",
+                "provider": {
+                    "description": "The name of the provider, which distinguishes the OIDC providers",
+                    "type": "string"
+                }
+            }
+        },
+        "users.Identity": {
+            "type": "object",
+            "properties": {
+                "accountType": {
+                    "description": "The account type of the provider",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/role.Role"
+                            "$ref": "#/definitions/common.AccountType"
                         }
                     ]
                 },
-                "showHiddenColumns": {
-                    "description": "The configuration of visibility of columns.",
-                    "type": "boolean"
+                "avatarUrl": {
+                    "description": "The avatar of the user at the provider",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The name of the user at the provider",
+                    "type": "string"
+                },
+                "provider": {
+                    "description": "The name of the provider, which distinguishes the OIDC providers",
+                    "type": "string"
                 }
             }
         },
@@ -5267,17 +8698,65 @@ const docTemplate = `{
                 }
             }
         },
+        "votings.NoteAnalytics": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "rounds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/votings.NoteRoundResult"
+                    }
+                }
+            }
+        },
+        "votings.NoteRoundResult": {
+            "type": "object",
+            "properties": {
+                "rank": {
+                    "description": "The position of the note in the round, notes with the same total share a rank.\nNot set if the note received no votes.",
+                    "type": "integer"
+                },
+                "rankChange": {
+                    "description": "The number of positions the note moved up compared to the previous round, negative if it moved down.\nOnly set if the note was ranked in both rounds.",
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "userVotes": {
+                    "description": "The votes per user, only set for votings that are not anonymous.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/votings.VotingResultsPerUser"
+                    }
+                },
+                "voting": {
+                    "type": "string"
+                }
+            }
+        },
         "votings.Vote": {
             "type": "object",
             "properties": {
                 "note": {
                     "type": "string"
                 },
+                "rank": {
+                    "description": "The position of the note on the ballot of a ranked-choice voting.",
+                    "type": "integer"
+                },
                 "user": {
                     "type": "string"
                 },
                 "voting": {
                     "type": "string"
+                },
+                "weight": {
+                    "description": "The points given with the vote in a point budget voting, 1 in all other modes.",
+                    "type": "integer"
                 }
             }
         },
@@ -5286,6 +8765,14 @@ const docTemplate = `{
             "properties": {
                 "note": {
                     "type": "string"
+                },
+                "rank": {
+                    "description": "The position of the note on the ballot of a ranked-choice voting, starting with 1 for the first choice.",
+                    "type": "integer"
+                },
+                "weight": {
+                    "description": "The points to give in a point budget voting, defaults to 1.",
+                    "type": "integer"
                 }
             }
         },
@@ -5295,12 +8782,21 @@ const docTemplate = `{
                 "allowMultipleVotes": {
                     "type": "boolean"
                 },
+                "endsAt": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "isAnonymous": {
                     "type": "boolean"
                 },
+                "mode": {
+                    "$ref": "#/definitions/votings.VotingMode"
+                },
+                "scope": {
+                    "$ref": "#/definitions/votings.VotingScope"
+                },
                 "showVotesOfOthers": {
                     "type": "boolean"
                 },
@@ -5315,23 +8811,84 @@ const docTemplate = `{
                 }
             }
         },
+        "votings.VotingAnalytics": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "description": "The notes that received votes in at least one round, ordered by their combined totals.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/votings.NoteAnalytics"
+                    }
+                },
+                "participants": {
+                    "description": "The number of participants of the board.",
+                    "type": "integer"
+                },
+                "rounds": {
+                    "description": "The closed votings of the board, oldest first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/votings.VotingRound"
+                    }
+                }
+            }
+        },
         "votings.VotingCreateRequest": {
             "type": "object",
             "properties": {
                 "allowMultipleVotes": {
                     "type": "boolean"
                 },
+                "duration": {
+                    "description": "The number of seconds after which the voting is closed automatically.",
+                    "type": "integer"
+                },
+                "endsAt": {
+                    "description": "The point in time at which the voting is closed automatically, an alternative to the duration.",
+                    "type": "string"
+                },
                 "isAnonymous": {
                     "type": "boolean"
                 },
+                "mode": {
+                    "$ref": "#/definitions/votings.VotingMode"
+                },
+                "scope": {
+                    "description": "The columns and notes the voting is restricted to, the whole board if not set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/votings.VotingScope"
+                        }
+                    ]
+                },
                 "showVotesOfOthers": {
                     "type": "boolean"
                 },
+                "startTimer": {
+                    "description": "Whether the board timer is set to run until the end of the voting.",
+                    "type": "boolean"
+                },
                 "voteLimit": {
                     "type": "integer"
                 }
             }
         },
+        "votings.VotingMode": {
+            "type": "string",
+            "enum": [
+                "DEFAULT",
+                "POINTS",
+                "RANKED",
+                "SINGLE_CHOICE"
+            ],
+            "x-enum-varnames": [
+                "DefaultMode",
+                "PointsMode",
+                "RankedMode",
+                "SingleChoiceMode"
+            ]
+        },
         "votings.VotingResults": {
             "type": "object",
             "properties": {
@@ -5371,6 +8928,53 @@ const docTemplate = `{
                 }
             }
         },
+        "votings.VotingRound": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "isAnonymous": {
+                    "type": "boolean"
+                },
+                "mode": {
+                    "$ref": "#/definitions/votings.VotingMode"
+                },
+                "participationRate": {
+                    "description": "The share of the participants of the board that voted, between 0 and 1.",
+                    "type": "number"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "voters": {
+                    "description": "The number of participants that voted.",
+                    "type": "integer"
+                },
+                "voting": {
+                    "type": "string"
+                }
+            }
+        },
+        "votings.VotingScope": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "description": "The columns whose notes can be voted on.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notes": {
+                    "description": "The notes that can be voted on, including the notes stacked on them.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "votings.VotingStatus": {
             "type": "string",
             "enum": [
@@ -0,0 +1,480 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
+)
+
+type TeamService interface {
+	Create(ctx context.Context, body TeamCreateRequest) (*Team, error)
+	Get(ctx context.Context, id uuid.UUID) (*Team, error)
+	GetAll(ctx context.Context, user uuid.UUID) ([]*Team, error)
+	Update(ctx context.Context, body TeamUpdateRequest) (*Team, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	AddMember(ctx context.Context, body TeamMemberCreateRequest) (*TeamMember, error)
+	GetMember(ctx context.Context, team, user uuid.UUID) (*TeamMember, error)
+	GetMembers(ctx context.Context, team uuid.UUID) ([]*TeamMember, error)
+	UpdateMember(ctx context.Context, body TeamMemberUpdateRequest) (*TeamMember, error)
+	RemoveMember(ctx context.Context, team, user, caller uuid.UUID) error
+	MemberExists(ctx context.Context, team, user uuid.UUID) (bool, error)
+}
+
+type API struct {
+	service TeamService
+}
+
+func NewTeamsApi(service TeamService) TeamsApi {
+	api := new(API)
+	api.service = service
+	return api
+}
+
+// Create a new team
+//
+//	@Summary		Create a team
+//	@Description	Create a team, the creating user becomes its first owner
+//	@Tags			teams
+//	@Accept			json
+//	@Param			Cookie	header	string				true	"jwt token to authenticate"
+//	@Param			team	body	TeamCreateRequest	true	"team to create"
+//	@Produce		json
+//	@Success		201	{object}	Team
+//	@Failure		400	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams [post]
+func (api *API) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.create")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	var body TeamCreateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+	body.Owner = user
+
+	team, err := api.service.Create(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create team")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, team)
+}
+
+// Get all teams of the user
+//
+//	@Summary		Get all teams of the user
+//	@Description	Get all teams the user is a member of, including the role of the user
+//	@Tags			teams
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Produce		json
+//	@Success		200	{object}	[]Team
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams [get]
+func (api *API) GetTeams(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.get.all")
+	defer span.End()
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	teams, err := api.service.GetAll(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get teams")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, teams)
+}
+
+// Get a team
+//
+//	@Summary		Get a team
+//	@Description	Get a team the user is a member of
+//	@Tags			teams
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			team	path	string	true	"id of the team"
+//	@Produce		json
+//	@Success		200	{object}	Team
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams/{team} [get]
+func (api *API) GetTeam(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.get")
+	defer span.End()
+
+	id := ctx.Value(identifiers.TeamIdentifier).(uuid.UUID)
+
+	team, err := api.service.Get(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get team")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, team)
+}
+
+// Update a team
+//
+//	@Summary		Update a team
+//	@Description	Rename a team, requires at least the admin role
+//	@Tags			teams
+//	@Accept			json
+//	@Param			Cookie	header	string				true	"jwt token to authenticate"
+//	@Param			team	path	string				true	"id of the team"
+//	@Param			body	body	TeamUpdateRequest	true	"team update"
+//	@Produce		json
+//	@Success		200	{object}	Team
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams/{team} [put]
+func (api *API) UpdateTeam(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.update")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	id := ctx.Value(identifiers.TeamIdentifier).(uuid.UUID)
+
+	var body TeamUpdateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+	body.ID = id
+
+	team, err := api.service.Update(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to update team")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, team)
+}
+
+// Delete a team
+//
+//	@Summary		Delete a team
+//	@Description	Delete a team, requires the owner role. Boards and templates of the team are kept for their creators.
+//	@Tags			teams
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			team	path	string	true	"id of the team"
+//	@Success		204
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams/{team} [delete]
+func (api *API) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.delete")
+	defer span.End()
+
+	id := ctx.Value(identifiers.TeamIdentifier).(uuid.UUID)
+
+	if err := api.service.Delete(ctx, id); err != nil {
+		span.SetStatus(codes.Error, "failed to delete team")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.Respond(w, r, nil)
+}
+
+// Get all members of a team
+//
+//	@Summary		Get all members of a team
+//	@Description	Get all members of a team the user is a member of
+//	@Tags			teams
+//	@Accept			json
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			team	path	string	true	"id of the team"
+//	@Produce		json
+//	@Success		200	{object}	[]TeamMember
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams/{team}/members [get]
+func (api *API) GetMembers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.members.get.all")
+	defer span.End()
+
+	team := ctx.Value(identifiers.TeamIdentifier).(uuid.UUID)
+
+	members, err := api.service.GetMembers(ctx, team)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get team members")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, members)
+}
+
+// Add a member to a team
+//
+//	@Summary		Add a member to a team
+//	@Description	Add a user to a team, requires at least the admin role. Only owners can add other owners.
+//	@Tags			teams
+//	@Accept			json
+//	@Param			Cookie	header	string					true	"jwt token to authenticate"
+//	@Param			team	path	string					true	"id of the team"
+//	@Param			member	body	TeamMemberCreateRequest	true	"member to add"
+//	@Produce		json
+//	@Success		201	{object}	TeamMember
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		409	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams/{team}/members [post]
+func (api *API) AddMember(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.members.add")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	team := ctx.Value(identifiers.TeamIdentifier).(uuid.UUID)
+	caller := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	var body TeamMemberCreateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+	body.Team = team
+	body.Caller = caller
+
+	member, err := api.service.AddMember(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to add team member")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, member)
+}
+
+// Update a member of a team
+//
+//	@Summary		Update a member of a team
+//	@Description	Change the role of a team member, requires at least the admin role. Only owners can grant or revoke the owner role.
+//	@Tags			teams
+//	@Accept			json
+//	@Param			Cookie	header	string					true	"jwt token to authenticate"
+//	@Param			team	path	string					true	"id of the team"
+//	@Param			user	path	string					true	"id of the member"
+//	@Param			member	body	TeamMemberUpdateRequest	true	"member update"
+//	@Produce		json
+//	@Success		200	{object}	TeamMember
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		409	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams/{team}/members/{user} [put]
+func (api *API) UpdateMember(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.members.update")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	team := ctx.Value(identifiers.TeamIdentifier).(uuid.UUID)
+	caller := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+	user, err := uuid.Parse(chi.URLParam(r, "user"))
+	if err != nil {
+		span.SetStatus(codes.Error, "unable to parse user id")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(errors.New("invalid user id")))
+		return
+	}
+
+	var body TeamMemberUpdateRequest
+	if err := render.Decode(r, &body); err != nil {
+		span.SetStatus(codes.Error, "unable to decode body")
+		span.RecordError(err)
+		log.Errorw("unable to decode body", "err", err)
+		common.Throw(w, r, common.BadRequestError(err))
+		return
+	}
+	body.Team = team
+	body.User = user
+	body.Caller = caller
+
+	member, err := api.service.UpdateMember(ctx, body)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to update team member")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, member)
+}
+
+// Remove a member from a team
+//
+//	@Summary		Remove a member from a team
+//	@Description	Remove a user from a team. Members can always leave a team, removing others requires at least the admin role.
+//	@Tags			teams
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			team	path	string	true	"id of the team"
+//	@Param			user	path	string	true	"id of the member"
+//	@Success		204
+//	@Failure		400	{object}	common.APIError
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		409	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/teams/{team}/members/{user} [delete]
+func (api *API) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.members.remove")
+	defer span.End()
+
+	team := ctx.Value(identifiers.TeamIdentifier).(uuid.UUID)
+	caller := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+	user, err := uuid.Parse(chi.URLParam(r, "user"))
+	if err != nil {
+		span.SetStatus(codes.Error, "unable to parse user id")
+		span.RecordError(err)
+		common.Throw(w, r, common.BadRequestError(errors.New("invalid user id")))
+		return
+	}
+
+	if err := api.service.RemoveMember(ctx, team, user, caller); err != nil {
+		span.SetStatus(codes.Error, "failed to remove team member")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.Respond(w, r, nil)
+}
+
+// TeamMemberContext allows all members of the team
+func (api *API) TeamMemberContext(next http.Handler) http.Handler {
+	return api.teamRoleContext(next, Member)
+}
+
+// TeamAdminContext allows both team admins and owners
+func (api *API) TeamAdminContext(next http.Handler) http.Handler {
+	return api.teamRoleContext(next, Admin)
+}
+
+// TeamOwnerContext allows only team owners
+func (api *API) TeamOwnerContext(next http.Handler) http.Handler {
+	return api.teamRoleContext(next, Owner)
+}
+
+func (api *API) teamRoleContext(next http.Handler, required TeamRole) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.Start(r.Context(), "scrumlr.teams.api.context.role")
+		defer span.End()
+		log := logger.FromContext(ctx)
+
+		team, err := uuid.Parse(chi.URLParam(r, "team"))
+		if err != nil {
+			span.SetStatus(codes.Error, "unable to parse team id")
+			span.RecordError(err)
+			common.Throw(w, r, common.BadRequestError(errors.New("invalid team id")))
+			return
+		}
+		user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+		span.SetAttributes(
+			attribute.String("scrumlr.teams.api.context.role.team", team.String()),
+			attribute.String("scrumlr.teams.api.context.role.user", user.String()),
+			attribute.String("scrumlr.teams.api.context.role.required", string(required)),
+		)
+
+		member, err := api.service.GetMember(ctx, team, user)
+		if err != nil {
+			var teamErr TeamError
+			if errors.As(err, &teamErr) && teamErr.Category == NotFound {
+				span.SetStatus(codes.Error, "not a member of the team")
+				span.RecordError(err)
+				common.Throw(w, r, common.ForbiddenError(errors.New("not a member of the team")))
+				return
+			}
+			span.SetStatus(codes.Error, "unable to check team membership")
+			span.RecordError(err)
+			log.Errorw("unable to check team membership", "team", team, "user", user, "err", err)
+			common.Throw(w, r, common.InternalServerError)
+			return
+		}
+
+		if !member.Role.Includes(required) {
+			err := errors.New("user does not have sufficient team role")
+			span.SetStatus(codes.Error, "insufficient team role")
+			span.RecordError(err)
+			common.Throw(w, r, common.ForbiddenError(err))
+			return
+		}
+
+		teamContext := context.WithValue(ctx, identifiers.TeamIdentifier, team)
+		next.ServeHTTP(w, r.WithContext(teamContext))
+	})
+}
+
+// apiError translates team errors to HTTP API errors.
+func apiError(err error) error {
+	var teamErr TeamError
+	if errors.As(err, &teamErr) {
+		switch teamErr.Category {
+		case BadRequest:
+			return common.BadRequestError(err)
+		case Forbidden:
+			return common.ForbiddenError(err)
+		case NotFound:
+			return common.NotFoundError
+		case Conflict:
+			return common.ConflictError(err)
+		}
+	}
+
+	return common.InternalServerError
+}
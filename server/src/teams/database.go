@@ -0,0 +1,169 @@
+package teams
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type DB struct {
+	db *bun.DB
+}
+
+func NewTeamDatabase(database *bun.DB) TeamDatabase {
+	db := new(DB)
+	db.db = database
+
+	return db
+}
+
+// CreateTeam inserts a new team and adds the owner as its first member
+func (d *DB) CreateTeam(ctx context.Context, insert DatabaseTeamInsert, owner uuid.UUID) (DatabaseTeam, error) {
+	var team DatabaseTeam
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().
+			Model(&insert).
+			Returning("*").
+			Exec(ctx, &team)
+		if err != nil {
+			return err
+		}
+
+		member := DatabaseTeamMemberInsert{Team: team.ID, User: owner, Role: Owner}
+		_, err = tx.NewInsert().
+			Model(&member).
+			Exec(ctx)
+
+		return err
+	})
+
+	team.Role = Owner
+	return team, err
+}
+
+// GetTeam gets a specific team
+func (d *DB) GetTeam(ctx context.Context, id uuid.UUID) (DatabaseTeam, error) {
+	var team DatabaseTeam
+	err := d.db.NewSelect().
+		Model(&team).
+		Where("id = ?", id).
+		Scan(ctx)
+
+	return team, err
+}
+
+// GetTeams gets all teams the user is a member of including the role of the user
+func (d *DB) GetTeams(ctx context.Context, user uuid.UUID) ([]DatabaseTeam, error) {
+	var teams []DatabaseTeam
+	err := d.db.NewSelect().
+		Model(&teams).
+		ColumnExpr("team.*").
+		ColumnExpr("m.role").
+		Join("INNER JOIN team_members AS m ON m.team = team.id").
+		Where("m.user = ?", user).
+		Order("team.created_at ASC").
+		Scan(ctx)
+
+	return teams, err
+}
+
+// UpdateTeam updates the name of a team
+func (d *DB) UpdateTeam(ctx context.Context, update DatabaseTeamUpdate) (DatabaseTeam, error) {
+	var team DatabaseTeam
+	_, err := d.db.NewUpdate().
+		Model(&update).
+		Column("name").
+		Where("id = ?", update.ID).
+		Returning("*").
+		Exec(ctx, &team)
+
+	return team, err
+}
+
+// DeleteTeam deletes a team together with its memberships
+func (d *DB) DeleteTeam(ctx context.Context, id uuid.UUID) error {
+	_, err := d.db.NewDelete().
+		Model((*DatabaseTeam)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	return err
+}
+
+// CreateMember adds a user to a team
+func (d *DB) CreateMember(ctx context.Context, insert DatabaseTeamMemberInsert) (DatabaseTeamMember, error) {
+	var member DatabaseTeamMember
+	_, err := d.db.NewInsert().
+		Model(&insert).
+		Returning("*").
+		Exec(ctx, &member)
+
+	return member, err
+}
+
+// GetMember gets the membership of a user in a team
+func (d *DB) GetMember(ctx context.Context, team, user uuid.UUID) (DatabaseTeamMember, error) {
+	var member DatabaseTeamMember
+	err := d.db.NewSelect().
+		Model(&member).
+		Where("team = ?", team).
+		Where("\"user\" = ?", user).
+		Scan(ctx)
+
+	return member, err
+}
+
+// GetMembers gets all members of a team, the longest standing ones first
+func (d *DB) GetMembers(ctx context.Context, team uuid.UUID) ([]DatabaseTeamMember, error) {
+	var members []DatabaseTeamMember
+	err := d.db.NewSelect().
+		Model(&members).
+		Where("team = ?", team).
+		Order("created_at ASC").
+		Scan(ctx)
+
+	return members, err
+}
+
+// UpdateMember changes the role of a team member
+func (d *DB) UpdateMember(ctx context.Context, update DatabaseTeamMemberUpdate) (DatabaseTeamMember, error) {
+	var member DatabaseTeamMember
+	_, err := d.db.NewUpdate().
+		Model(&update).
+		Column("role").
+		Where("team = ?", update.Team).
+		Where("\"user\" = ?", update.User).
+		Returning("*").
+		Exec(ctx, &member)
+
+	return member, err
+}
+
+// DeleteMember removes a user from a team
+func (d *DB) DeleteMember(ctx context.Context, team, user uuid.UUID) error {
+	_, err := d.db.NewDelete().
+		Model((*DatabaseTeamMember)(nil)).
+		Where("team = ?", team).
+		Where("\"user\" = ?", user).
+		Exec(ctx)
+
+	return err
+}
+
+// CountOwners counts the owners of a team
+func (d *DB) CountOwners(ctx context.Context, team uuid.UUID) (int, error) {
+	return d.db.NewSelect().
+		Model((*DatabaseTeamMember)(nil)).
+		Where("team = ?", team).
+		Where("role = ?", Owner).
+		Count(ctx)
+}
+
+// UserExists checks whether the user exists at all
+func (d *DB) UserExists(ctx context.Context, user uuid.UUID) (bool, error) {
+	return d.db.NewSelect().
+		Table("users").
+		Where("id = ?", user).
+		Exists(ctx)
+}
@@ -0,0 +1,51 @@
+package teams
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type DatabaseTeam struct {
+	bun.BaseModel `bun:"table:teams,alias:team"`
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	Name          string
+
+	// Role is only selected when listing the teams of a user.
+	Role TeamRole `bun:",scanonly"`
+}
+
+type DatabaseTeamInsert struct {
+	bun.BaseModel `bun:"table:teams"`
+	Name          string
+}
+
+type DatabaseTeamUpdate struct {
+	bun.BaseModel `bun:"table:teams,alias:team"`
+	ID            uuid.UUID
+	Name          string
+}
+
+type DatabaseTeamMember struct {
+	bun.BaseModel `bun:"table:team_members,alias:team_member"`
+	Team          uuid.UUID
+	User          uuid.UUID
+	Role          TeamRole
+	CreatedAt     time.Time
+}
+
+type DatabaseTeamMemberInsert struct {
+	bun.BaseModel `bun:"table:team_members"`
+	Team          uuid.UUID
+	User          uuid.UUID
+	Role          TeamRole
+}
+
+type DatabaseTeamMemberUpdate struct {
+	bun.BaseModel `bun:"table:team_members,alias:team_member"`
+	Team          uuid.UUID
+	User          uuid.UUID
+	Role          TeamRole
+}
@@ -0,0 +1,164 @@
+package teams
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/uptrace/bun"
+	"scrumlr.io/server/common"
+	"scrumlr.io/server/initialize/testDbTemplates"
+)
+
+type DatabaseTeamTestSuite struct {
+	suite.Suite
+	db    *bun.DB
+	users map[string]TestUser
+	teams map[string]uuid.UUID
+}
+
+func TestDatabaseTeamTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseTeamTestSuite))
+}
+
+func (suite *DatabaseTeamTestSuite) SetupTest() {
+	suite.db = testDbTemplates.NewBaseTestDB(
+		suite.T(),
+		false,
+		testDbTemplates.AdditionalSeed{
+			Name: "teams_database_test_data",
+			Func: suite.seedData,
+		},
+	)
+}
+
+func (suite *DatabaseTeamTestSuite) Test_Database_CreateTeam() {
+	t := suite.T()
+	database := NewTeamDatabase(suite.db)
+
+	dbTeam, err := database.CreateTeam(context.Background(), DatabaseTeamInsert{Name: "Created team"}, suite.users["Stan"].id)
+
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, dbTeam.ID)
+	assert.Equal(t, "Created team", dbTeam.Name)
+	assert.Equal(t, Owner, dbTeam.Role)
+
+	member, err := database.GetMember(context.Background(), dbTeam.ID, suite.users["Stan"].id)
+	assert.Nil(t, err)
+	assert.Equal(t, Owner, member.Role)
+}
+
+func (suite *DatabaseTeamTestSuite) Test_Database_GetTeams() {
+	t := suite.T()
+	database := NewTeamDatabase(suite.db)
+
+	dbTeams, err := database.GetTeams(context.Background(), suite.users["Santa"].id)
+
+	assert.Nil(t, err)
+	assert.Len(t, dbTeams, 1)
+	assert.Equal(t, suite.teams["Read"], dbTeams[0].ID)
+	assert.Equal(t, Member, dbTeams[0].Role)
+}
+
+func (suite *DatabaseTeamTestSuite) Test_Database_UpdateTeam() {
+	t := suite.T()
+	database := NewTeamDatabase(suite.db)
+
+	dbTeam, err := database.UpdateTeam(context.Background(), DatabaseTeamUpdate{ID: suite.teams["Write"], Name: "Renamed team"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Renamed team", dbTeam.Name)
+}
+
+func (suite *DatabaseTeamTestSuite) Test_Database_DeleteTeam() {
+	t := suite.T()
+	database := NewTeamDatabase(suite.db)
+
+	err := database.DeleteTeam(context.Background(), suite.teams["Delete"])
+	assert.Nil(t, err)
+
+	_, err = database.GetTeam(context.Background(), suite.teams["Delete"])
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseTeamTestSuite) Test_Database_Members() {
+	t := suite.T()
+	database := NewTeamDatabase(suite.db)
+	teamId := suite.teams["Write"]
+	userId := suite.users["Santa"].id
+
+	member, err := database.CreateMember(context.Background(), DatabaseTeamMemberInsert{Team: teamId, User: userId, Role: Member})
+	assert.Nil(t, err)
+	assert.Equal(t, Member, member.Role)
+
+	member, err = database.UpdateMember(context.Background(), DatabaseTeamMemberUpdate{Team: teamId, User: userId, Role: Admin})
+	assert.Nil(t, err)
+	assert.Equal(t, Admin, member.Role)
+
+	members, err := database.GetMembers(context.Background(), teamId)
+	assert.Nil(t, err)
+	assert.Len(t, members, 2)
+
+	owners, err := database.CountOwners(context.Background(), teamId)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, owners)
+
+	err = database.DeleteMember(context.Background(), teamId, userId)
+	assert.Nil(t, err)
+
+	_, err = database.GetMember(context.Background(), teamId, userId)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func (suite *DatabaseTeamTestSuite) Test_Database_UserExists() {
+	t := suite.T()
+	database := NewTeamDatabase(suite.db)
+
+	exists, err := database.UserExists(context.Background(), suite.users["Stan"].id)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	exists, err = database.UserExists(context.Background(), uuid.New())
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+type TestUser struct {
+	id          uuid.UUID
+	name        string
+	accountType common.AccountType
+}
+
+func (suite *DatabaseTeamTestSuite) seedData(db *bun.DB) {
+	suite.users = make(map[string]TestUser, 2)
+	suite.users["Stan"] = TestUser{id: uuid.New(), name: "Stan", accountType: common.Anonymous}
+	suite.users["Santa"] = TestUser{id: uuid.New(), name: "Santa", accountType: common.Anonymous}
+
+	suite.teams = make(map[string]uuid.UUID, 3)
+	suite.teams["Write"] = uuid.New()
+	suite.teams["Read"] = uuid.New()
+	suite.teams["Delete"] = uuid.New()
+
+	for _, user := range suite.users {
+		if err := testDbTemplates.InsertUser(db, user.id, user.name, string(user.accountType), nil); err != nil {
+			log.Fatalf("Failed to insert test user %s", err)
+		}
+	}
+
+	for name, team := range suite.teams {
+		if err := testDbTemplates.InsertTeam(db, team, name+" Team"); err != nil {
+			log.Fatalf("Failed to insert test team %s", err)
+		}
+		if err := testDbTemplates.InsertTeamMember(db, team, suite.users["Stan"].id, string(Owner)); err != nil {
+			log.Fatalf("Failed to insert test team member %s", err)
+		}
+	}
+
+	if err := testDbTemplates.InsertTeamMember(db, suite.teams["Read"], suite.users["Santa"].id, string(Member)); err != nil {
+		log.Fatalf("Failed to insert test team member %s", err)
+	}
+}
@@ -0,0 +1,111 @@
+package teams
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Team is the response for all team requests.
+type Team struct {
+	// The team id.
+	ID uuid.UUID `json:"id"`
+
+	// The team name.
+	Name string `json:"name"`
+
+	// The role of the requesting user within the team, only set when listing the teams of a user.
+	Role TeamRole `json:"role,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TeamMember is the response for all team member requests.
+type TeamMember struct {
+	// The id of the user.
+	User uuid.UUID `json:"user"`
+
+	// The role of the user within the team.
+	Role TeamRole `json:"role"`
+
+	// The date the user joined the team.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TeamCreateRequest represents the request to create a new team.
+// The user creating the team becomes its first owner.
+type TeamCreateRequest struct {
+	Name string `json:"name"`
+
+	Owner uuid.UUID `json:"-"`
+}
+
+// TeamUpdateRequest represents the request to rename a team.
+type TeamUpdateRequest struct {
+	Name string `json:"name"`
+
+	ID uuid.UUID `json:"-"`
+}
+
+// TeamMemberCreateRequest represents the request to add a user to a team.
+// The role defaults to member and only owners may add other owners.
+type TeamMemberCreateRequest struct {
+	User uuid.UUID `json:"user"`
+	Role TeamRole  `json:"role"`
+
+	Team   uuid.UUID `json:"-"`
+	Caller uuid.UUID `json:"-"`
+}
+
+// TeamMemberUpdateRequest represents the request to change the role of a team member.
+// Only owners may grant or revoke the owner role.
+type TeamMemberUpdateRequest struct {
+	Role TeamRole `json:"role"`
+
+	Team   uuid.UUID `json:"-"`
+	User   uuid.UUID `json:"-"`
+	Caller uuid.UUID `json:"-"`
+}
+
+func (t *Team) From(team DatabaseTeam) *Team {
+	t.ID = team.ID
+	t.Name = team.Name
+	t.Role = team.Role
+	t.CreatedAt = team.CreatedAt
+
+	return t
+}
+
+func Teams(teams []DatabaseTeam) []*Team {
+	if teams == nil {
+		return nil
+	}
+
+	list := make([]*Team, len(teams))
+	for index, team := range teams {
+		list[index] = new(Team).From(team)
+	}
+
+	return list
+}
+
+func (m *TeamMember) From(member DatabaseTeamMember) *TeamMember {
+	m.User = member.User
+	m.Role = member.Role
+	m.CreatedAt = member.CreatedAt
+
+	return m
+}
+
+func TeamMembers(members []DatabaseTeamMember) []*TeamMember {
+	if members == nil {
+		return nil
+	}
+
+	list := make([]*TeamMember, len(members))
+	for index, member := range members {
+		list[index] = new(TeamMember).From(member)
+	}
+
+	return list
+}
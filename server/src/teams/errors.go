@@ -0,0 +1,39 @@
+package teams
+
+import "fmt"
+
+type TeamErrorCategory string
+
+const (
+	BadRequest TeamErrorCategory = "BAD_REQUEST"
+	Forbidden  TeamErrorCategory = "FORBIDDEN"
+	NotFound   TeamErrorCategory = "NOT_FOUND"
+	Conflict   TeamErrorCategory = "CONFLICT"
+	Internal   TeamErrorCategory = "INTERNAL"
+)
+
+type TeamError struct {
+	Category TeamErrorCategory
+	Message  string
+	Err      error
+}
+
+func (e TeamError) Error() string {
+	return fmt.Sprintf("team error [%s]: %s", e.Category, e.Message)
+}
+
+func (e TeamError) Status() string {
+	return string(e.Category)
+}
+
+func (e TeamError) Unwrap() error {
+	return e.Err
+}
+
+func CreateTeamError(category TeamErrorCategory, message string, err error) error {
+	return TeamError{
+		Category: category,
+		Message:  message,
+		Err:      err,
+	}
+}
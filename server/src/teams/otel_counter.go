@@ -0,0 +1,27 @@
+package teams
+
+import "go.opentelemetry.io/otel/metric"
+
+var teamCreatedCounter, _ = meter.Int64Counter(
+	"scrumlr.teams.created.counter",
+	metric.WithDescription("Number of created teams"),
+	metric.WithUnit("teams"),
+)
+
+var teamDeletedCounter, _ = meter.Int64Counter(
+	"scrumlr.teams.deleted.counter",
+	metric.WithDescription("Number of deleted teams"),
+	metric.WithUnit("teams"),
+)
+
+var teamMemberAddedCounter, _ = meter.Int64Counter(
+	"scrumlr.teams.members.added.counter",
+	metric.WithDescription("Number of members added to teams"),
+	metric.WithUnit("members"),
+)
+
+var teamMemberRemovedCounter, _ = meter.Int64Counter(
+	"scrumlr.teams.members.removed.counter",
+	metric.WithDescription("Number of members removed from teams"),
+	metric.WithUnit("members"),
+)
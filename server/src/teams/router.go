@@ -0,0 +1,52 @@
+package teams
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type TeamsApi interface {
+	CreateTeam(w http.ResponseWriter, r *http.Request)
+	GetTeams(w http.ResponseWriter, r *http.Request)
+	GetTeam(w http.ResponseWriter, r *http.Request)
+	UpdateTeam(w http.ResponseWriter, r *http.Request)
+	DeleteTeam(w http.ResponseWriter, r *http.Request)
+	GetMembers(w http.ResponseWriter, r *http.Request)
+	AddMember(w http.ResponseWriter, r *http.Request)
+	UpdateMember(w http.ResponseWriter, r *http.Request)
+	RemoveMember(w http.ResponseWriter, r *http.Request)
+	TeamMemberContext(next http.Handler) http.Handler
+	TeamAdminContext(next http.Handler) http.Handler
+	TeamOwnerContext(next http.Handler) http.Handler
+}
+
+type Router struct {
+	teamsAPI TeamsApi
+}
+
+func (r *Router) RegisterRoutes() chi.Router {
+	router := chi.NewRouter()
+	router.Post("/", r.teamsAPI.CreateTeam)
+	router.Get("/", r.teamsAPI.GetTeams)
+
+	router.Route("/{team}", func(router chi.Router) {
+		router.With(r.teamsAPI.TeamMemberContext).Get("/", r.teamsAPI.GetTeam)
+		router.With(r.teamsAPI.TeamAdminContext).Put("/", r.teamsAPI.UpdateTeam)
+		router.With(r.teamsAPI.TeamOwnerContext).Delete("/", r.teamsAPI.DeleteTeam)
+
+		router.Route("/members", func(router chi.Router) {
+			router.With(r.teamsAPI.TeamMemberContext).Get("/", r.teamsAPI.GetMembers)
+			router.With(r.teamsAPI.TeamAdminContext).Post("/", r.teamsAPI.AddMember)
+			router.With(r.teamsAPI.TeamAdminContext).Put("/{user}", r.teamsAPI.UpdateMember)
+			router.With(r.teamsAPI.TeamMemberContext).Delete("/{user}", r.teamsAPI.RemoveMember)
+		})
+	})
+	return router
+}
+
+func NewTeamsRouter(teamsApi TeamsApi) *Router {
+	r := new(Router)
+	r.teamsAPI = teamsApi
+	return r
+}
@@ -0,0 +1,457 @@
+package teams
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/logger"
+)
+
+var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/teams")
+var meter metric.Meter = otel.Meter("scrumlr.io/server/teams")
+
+const maxTeamNameLength = 128
+
+type TeamDatabase interface {
+	CreateTeam(ctx context.Context, insert DatabaseTeamInsert, owner uuid.UUID) (DatabaseTeam, error)
+	GetTeam(ctx context.Context, id uuid.UUID) (DatabaseTeam, error)
+	GetTeams(ctx context.Context, user uuid.UUID) ([]DatabaseTeam, error)
+	UpdateTeam(ctx context.Context, update DatabaseTeamUpdate) (DatabaseTeam, error)
+	DeleteTeam(ctx context.Context, id uuid.UUID) error
+	CreateMember(ctx context.Context, insert DatabaseTeamMemberInsert) (DatabaseTeamMember, error)
+	GetMember(ctx context.Context, team, user uuid.UUID) (DatabaseTeamMember, error)
+	GetMembers(ctx context.Context, team uuid.UUID) ([]DatabaseTeamMember, error)
+	UpdateMember(ctx context.Context, update DatabaseTeamMemberUpdate) (DatabaseTeamMember, error)
+	DeleteMember(ctx context.Context, team, user uuid.UUID) error
+	CountOwners(ctx context.Context, team uuid.UUID) (int, error)
+	UserExists(ctx context.Context, user uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	database TeamDatabase
+}
+
+func NewTeamService(db TeamDatabase) TeamService {
+	service := new(Service)
+	service.database = db
+
+	return service
+}
+
+func (service *Service) Create(ctx context.Context, body TeamCreateRequest) (*Team, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.create")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.create.owner", body.Owner.String()),
+	)
+
+	name, err := validateName(body.Name)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid team name")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	team, err := service.database.CreateTeam(ctx, DatabaseTeamInsert{Name: name}, body.Owner)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create team")
+		span.RecordError(err)
+		log.Errorw("unable to create team", "owner", body.Owner, "err", err)
+		return nil, CreateTeamError(Internal, "failed to create team", err)
+	}
+
+	teamCreatedCounter.Add(ctx, 1)
+	return new(Team).From(team), nil
+}
+
+func (service *Service) Get(ctx context.Context, id uuid.UUID) (*Team, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.get.team", id.String()),
+	)
+
+	team, err := service.database.GetTeam(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "team not found")
+			span.RecordError(err)
+			return nil, CreateTeamError(NotFound, "team not found", err)
+		}
+		span.SetStatus(codes.Error, "failed to get team")
+		span.RecordError(err)
+		log.Errorw("unable to get team", "team", id, "err", err)
+		return nil, CreateTeamError(Internal, "failed to get team", err)
+	}
+
+	return new(Team).From(team), nil
+}
+
+func (service *Service) GetAll(ctx context.Context, user uuid.UUID) ([]*Team, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.get.all.user", user.String()),
+	)
+
+	teams, err := service.database.GetTeams(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get teams")
+		span.RecordError(err)
+		log.Errorw("unable to get teams", "user", user, "err", err)
+		return nil, CreateTeamError(Internal, "failed to get teams", err)
+	}
+
+	return Teams(teams), nil
+}
+
+func (service *Service) Update(ctx context.Context, body TeamUpdateRequest) (*Team, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.update")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.update.team", body.ID.String()),
+	)
+
+	name, err := validateName(body.Name)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid team name")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	team, err := service.database.UpdateTeam(ctx, DatabaseTeamUpdate{ID: body.ID, Name: name})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "team not found")
+			span.RecordError(err)
+			return nil, CreateTeamError(NotFound, "team not found", err)
+		}
+		span.SetStatus(codes.Error, "failed to update team")
+		span.RecordError(err)
+		log.Errorw("unable to update team", "team", body.ID, "err", err)
+		return nil, CreateTeamError(Internal, "failed to update team", err)
+	}
+
+	return new(Team).From(team), nil
+}
+
+func (service *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.delete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.delete.team", id.String()),
+	)
+
+	err := service.database.DeleteTeam(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to delete team")
+		span.RecordError(err)
+		log.Errorw("unable to delete team", "team", id, "err", err)
+		return CreateTeamError(Internal, "failed to delete team", err)
+	}
+
+	teamDeletedCounter.Add(ctx, 1)
+	return nil
+}
+
+func (service *Service) AddMember(ctx context.Context, body TeamMemberCreateRequest) (*TeamMember, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.member.add")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.member.add.team", body.Team.String()),
+		attribute.String("scrumlr.teams.service.member.add.user", body.User.String()),
+	)
+
+	teamRole := body.Role
+	if teamRole == "" {
+		teamRole = Member
+	}
+
+	if err := service.checkCaller(ctx, body.Team, body.Caller, teamRole); err != nil {
+		span.SetStatus(codes.Error, "insufficient team role")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	exists, err := service.database.UserExists(ctx, body.User)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to check user")
+		span.RecordError(err)
+		log.Errorw("unable to check user", "user", body.User, "err", err)
+		return nil, CreateTeamError(Internal, "failed to check user", err)
+	}
+	if !exists {
+		err := CreateTeamError(NotFound, "user not found", errors.New("user not found"))
+		span.SetStatus(codes.Error, "user not found")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	isMember, err := service.MemberExists(ctx, body.Team, body.User)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to check team membership")
+		span.RecordError(err)
+		return nil, err
+	}
+	if isMember {
+		err := CreateTeamError(Conflict, "user is already a member of the team", errors.New("user is already a member of the team"))
+		span.SetStatus(codes.Error, "already a member")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	member, err := service.database.CreateMember(ctx, DatabaseTeamMemberInsert{Team: body.Team, User: body.User, Role: teamRole})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to add team member")
+		span.RecordError(err)
+		log.Errorw("unable to add team member", "team", body.Team, "user", body.User, "err", err)
+		return nil, CreateTeamError(Internal, "failed to add team member", err)
+	}
+
+	teamMemberAddedCounter.Add(ctx, 1)
+	return new(TeamMember).From(member), nil
+}
+
+func (service *Service) GetMember(ctx context.Context, team, user uuid.UUID) (*TeamMember, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.member.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.member.get.team", team.String()),
+		attribute.String("scrumlr.teams.service.member.get.user", user.String()),
+	)
+
+	member, err := service.database.GetMember(ctx, team, user)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "team member not found")
+			span.RecordError(err)
+			return nil, CreateTeamError(NotFound, "team member not found", err)
+		}
+		span.SetStatus(codes.Error, "failed to get team member")
+		span.RecordError(err)
+		log.Errorw("unable to get team member", "team", team, "user", user, "err", err)
+		return nil, CreateTeamError(Internal, "failed to get team member", err)
+	}
+
+	return new(TeamMember).From(member), nil
+}
+
+func (service *Service) GetMembers(ctx context.Context, team uuid.UUID) ([]*TeamMember, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.member.get.all")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.member.get.all.team", team.String()),
+	)
+
+	members, err := service.database.GetMembers(ctx, team)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get team members")
+		span.RecordError(err)
+		log.Errorw("unable to get team members", "team", team, "err", err)
+		return nil, CreateTeamError(Internal, "failed to get team members", err)
+	}
+
+	return TeamMembers(members), nil
+}
+
+func (service *Service) UpdateMember(ctx context.Context, body TeamMemberUpdateRequest) (*TeamMember, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.member.update")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.member.update.team", body.Team.String()),
+		attribute.String("scrumlr.teams.service.member.update.user", body.User.String()),
+	)
+
+	if body.Role == "" {
+		err := CreateTeamError(BadRequest, "role must be set", errors.New("role must be set"))
+		span.SetStatus(codes.Error, "missing role")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	current, err := service.GetMember(ctx, body.Team, body.User)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get team member")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	required := body.Role
+	if current.Role == Owner {
+		required = Owner
+	}
+	if err := service.checkCaller(ctx, body.Team, body.Caller, required); err != nil {
+		span.SetStatus(codes.Error, "insufficient team role")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if current.Role == Owner && body.Role != Owner {
+		if err := service.checkRemainingOwners(ctx, body.Team); err != nil {
+			span.SetStatus(codes.Error, "last owner")
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	member, err := service.database.UpdateMember(ctx, DatabaseTeamMemberUpdate{Team: body.Team, User: body.User, Role: body.Role})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to update team member")
+		span.RecordError(err)
+		log.Errorw("unable to update team member", "team", body.Team, "user", body.User, "err", err)
+		return nil, CreateTeamError(Internal, "failed to update team member", err)
+	}
+
+	return new(TeamMember).From(member), nil
+}
+
+// RemoveMember removes a user from a team. Every member may leave a team on their own,
+// removing others requires at least the admin role and removing owners the owner role.
+func (service *Service) RemoveMember(ctx context.Context, team, user, caller uuid.UUID) error {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.member.remove")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.member.remove.team", team.String()),
+		attribute.String("scrumlr.teams.service.member.remove.user", user.String()),
+	)
+
+	current, err := service.GetMember(ctx, team, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get team member")
+		span.RecordError(err)
+		return err
+	}
+
+	if caller != user {
+		required := Admin
+		if current.Role == Owner {
+			required = Owner
+		}
+		if err := service.checkCaller(ctx, team, caller, required); err != nil {
+			span.SetStatus(codes.Error, "insufficient team role")
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	if current.Role == Owner {
+		if err := service.checkRemainingOwners(ctx, team); err != nil {
+			span.SetStatus(codes.Error, "last owner")
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	err = service.database.DeleteMember(ctx, team, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to remove team member")
+		span.RecordError(err)
+		log.Errorw("unable to remove team member", "team", team, "user", user, "err", err)
+		return CreateTeamError(Internal, "failed to remove team member", err)
+	}
+
+	teamMemberRemovedCounter.Add(ctx, 1)
+	return nil
+}
+
+func (service *Service) MemberExists(ctx context.Context, team, user uuid.UUID) (bool, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.teams.service.member.exists")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.teams.service.member.exists.team", team.String()),
+		attribute.String("scrumlr.teams.service.member.exists.user", user.String()),
+	)
+
+	_, err := service.GetMember(ctx, team, user)
+	if err != nil {
+		var teamErr TeamError
+		if errors.As(err, &teamErr) && teamErr.Category == NotFound {
+			return false, nil
+		}
+		span.SetStatus(codes.Error, "failed to check team membership")
+		span.RecordError(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// checkCaller makes sure the calling user holds at least the required role, admins being the least
+// privileged role able to manage members.
+func (service *Service) checkCaller(ctx context.Context, team, caller uuid.UUID, required TeamRole) error {
+	if !required.Includes(Admin) {
+		required = Admin
+	}
+
+	member, err := service.GetMember(ctx, team, caller)
+	if err != nil {
+		var teamErr TeamError
+		if errors.As(err, &teamErr) && teamErr.Category == NotFound {
+			return CreateTeamError(Forbidden, "not a member of the team", err)
+		}
+		return err
+	}
+
+	if !member.Role.Includes(required) {
+		return CreateTeamError(Forbidden, "insufficient team role", errors.New("insufficient team role"))
+	}
+
+	return nil
+}
+
+func (service *Service) checkRemainingOwners(ctx context.Context, team uuid.UUID) error {
+	owners, err := service.database.CountOwners(ctx, team)
+	if err != nil {
+		logger.FromContext(ctx).Errorw("unable to count team owners", "team", team, "err", err)
+		return CreateTeamError(Internal, "failed to count team owners", err)
+	}
+
+	if owners <= 1 {
+		return CreateTeamError(Conflict, "a team needs at least one owner", errors.New("a team needs at least one owner"))
+	}
+
+	return nil
+}
+
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", CreateTeamError(BadRequest, "name must not be empty", errors.New("name must not be empty"))
+	}
+
+	if utf8.RuneCountInString(name) > maxTeamNameLength {
+		return "", CreateTeamError(BadRequest, "name is too long", errors.New("name is too long"))
+	}
+
+	return name, nil
+}
@@ -0,0 +1,266 @@
+package teams
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func assertTeamError(t *testing.T, err error, category TeamErrorCategory) {
+	var teamErr TeamError
+	assert.ErrorAs(t, err, &teamErr)
+	assert.Equal(t, category, teamErr.Category)
+}
+
+func TestCreateTeam(t *testing.T) {
+	ownerId := uuid.New()
+	teamId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().CreateTeam(mock.Anything, DatabaseTeamInsert{Name: "Platform"}, ownerId).
+		Return(DatabaseTeam{ID: teamId, Name: "Platform", Role: Owner}, nil)
+
+	service := NewTeamService(mockDatabase)
+	team, err := service.Create(context.Background(), TeamCreateRequest{Name: " Platform  ", Owner: ownerId})
+
+	assert.Nil(t, err)
+	assert.Equal(t, teamId, team.ID)
+	assert.Equal(t, "Platform", team.Name)
+	assert.Equal(t, Owner, team.Role)
+}
+
+func TestCreateTeam_EmptyName(t *testing.T) {
+	service := NewTeamService(NewMockTeamDatabase(t))
+
+	team, err := service.Create(context.Background(), TeamCreateRequest{Name: "  ", Owner: uuid.New()})
+
+	assert.Nil(t, team)
+	assertTeamError(t, err, BadRequest)
+}
+
+func TestGetTeam_NotFound(t *testing.T) {
+	teamId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetTeam(mock.Anything, teamId).Return(DatabaseTeam{}, sql.ErrNoRows)
+
+	service := NewTeamService(mockDatabase)
+	team, err := service.Get(context.Background(), teamId)
+
+	assert.Nil(t, team)
+	assertTeamError(t, err, NotFound)
+}
+
+func TestGetTeams(t *testing.T) {
+	userId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetTeams(mock.Anything, userId).Return([]DatabaseTeam{
+		{ID: uuid.New(), Name: "Platform", Role: Owner},
+		{ID: uuid.New(), Name: "Mobile", Role: Member},
+	}, nil)
+
+	service := NewTeamService(mockDatabase)
+	teams, err := service.GetAll(context.Background(), userId)
+
+	assert.Nil(t, err)
+	assert.Len(t, teams, 2)
+	assert.Equal(t, Member, teams[1].Role)
+}
+
+func TestDeleteTeam_DatabaseError(t *testing.T) {
+	teamId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().DeleteTeam(mock.Anything, teamId).Return(errors.New("database error"))
+
+	service := NewTeamService(mockDatabase)
+	err := service.Delete(context.Background(), teamId)
+
+	assertTeamError(t, err, Internal)
+}
+
+func TestAddMember(t *testing.T) {
+	teamId := uuid.New()
+	callerId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, callerId).Return(DatabaseTeamMember{Team: teamId, User: callerId, Role: Admin}, nil)
+	mockDatabase.EXPECT().UserExists(mock.Anything, userId).Return(true, nil)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, userId).Return(DatabaseTeamMember{}, sql.ErrNoRows)
+	mockDatabase.EXPECT().CreateMember(mock.Anything, DatabaseTeamMemberInsert{Team: teamId, User: userId, Role: Member}).
+		Return(DatabaseTeamMember{Team: teamId, User: userId, Role: Member}, nil)
+
+	service := NewTeamService(mockDatabase)
+	member, err := service.AddMember(context.Background(), TeamMemberCreateRequest{Team: teamId, User: userId, Caller: callerId})
+
+	assert.Nil(t, err)
+	assert.Equal(t, userId, member.User)
+	assert.Equal(t, Member, member.Role)
+}
+
+func TestAddMember_AdminCannotAddOwner(t *testing.T) {
+	teamId := uuid.New()
+	callerId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, callerId).Return(DatabaseTeamMember{Team: teamId, User: callerId, Role: Admin}, nil)
+
+	service := NewTeamService(mockDatabase)
+	member, err := service.AddMember(context.Background(), TeamMemberCreateRequest{Team: teamId, User: uuid.New(), Role: Owner, Caller: callerId})
+
+	assert.Nil(t, member)
+	assertTeamError(t, err, Forbidden)
+}
+
+func TestAddMember_MemberCannotAddMembers(t *testing.T) {
+	teamId := uuid.New()
+	callerId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, callerId).Return(DatabaseTeamMember{Team: teamId, User: callerId, Role: Member}, nil)
+
+	service := NewTeamService(mockDatabase)
+	member, err := service.AddMember(context.Background(), TeamMemberCreateRequest{Team: teamId, User: uuid.New(), Caller: callerId})
+
+	assert.Nil(t, member)
+	assertTeamError(t, err, Forbidden)
+}
+
+func TestAddMember_AlreadyMember(t *testing.T) {
+	teamId := uuid.New()
+	callerId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, callerId).Return(DatabaseTeamMember{Team: teamId, User: callerId, Role: Owner}, nil)
+	mockDatabase.EXPECT().UserExists(mock.Anything, userId).Return(true, nil)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, userId).Return(DatabaseTeamMember{Team: teamId, User: userId, Role: Member}, nil)
+
+	service := NewTeamService(mockDatabase)
+	member, err := service.AddMember(context.Background(), TeamMemberCreateRequest{Team: teamId, User: userId, Caller: callerId})
+
+	assert.Nil(t, member)
+	assertTeamError(t, err, Conflict)
+}
+
+func TestAddMember_UnknownUser(t *testing.T) {
+	teamId := uuid.New()
+	callerId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, callerId).Return(DatabaseTeamMember{Team: teamId, User: callerId, Role: Owner}, nil)
+	mockDatabase.EXPECT().UserExists(mock.Anything, userId).Return(false, nil)
+
+	service := NewTeamService(mockDatabase)
+	member, err := service.AddMember(context.Background(), TeamMemberCreateRequest{Team: teamId, User: userId, Caller: callerId})
+
+	assert.Nil(t, member)
+	assertTeamError(t, err, NotFound)
+}
+
+func TestUpdateMember_DemoteLastOwner(t *testing.T) {
+	teamId := uuid.New()
+	ownerId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, ownerId).Return(DatabaseTeamMember{Team: teamId, User: ownerId, Role: Owner}, nil)
+	mockDatabase.EXPECT().CountOwners(mock.Anything, teamId).Return(1, nil)
+
+	service := NewTeamService(mockDatabase)
+	member, err := service.UpdateMember(context.Background(), TeamMemberUpdateRequest{Team: teamId, User: ownerId, Role: Admin, Caller: ownerId})
+
+	assert.Nil(t, member)
+	assertTeamError(t, err, Conflict)
+}
+
+func TestUpdateMember_PromoteToAdmin(t *testing.T) {
+	teamId := uuid.New()
+	callerId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, userId).Return(DatabaseTeamMember{Team: teamId, User: userId, Role: Member}, nil)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, callerId).Return(DatabaseTeamMember{Team: teamId, User: callerId, Role: Admin}, nil)
+	mockDatabase.EXPECT().UpdateMember(mock.Anything, DatabaseTeamMemberUpdate{Team: teamId, User: userId, Role: Admin}).
+		Return(DatabaseTeamMember{Team: teamId, User: userId, Role: Admin}, nil)
+
+	service := NewTeamService(mockDatabase)
+	member, err := service.UpdateMember(context.Background(), TeamMemberUpdateRequest{Team: teamId, User: userId, Role: Admin, Caller: callerId})
+
+	assert.Nil(t, err)
+	assert.Equal(t, Admin, member.Role)
+}
+
+func TestUpdateMember_MissingRole(t *testing.T) {
+	service := NewTeamService(NewMockTeamDatabase(t))
+
+	member, err := service.UpdateMember(context.Background(), TeamMemberUpdateRequest{Team: uuid.New(), User: uuid.New(), Caller: uuid.New()})
+
+	assert.Nil(t, member)
+	assertTeamError(t, err, BadRequest)
+}
+
+func TestRemoveMember_Leave(t *testing.T) {
+	teamId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, userId).Return(DatabaseTeamMember{Team: teamId, User: userId, Role: Member}, nil)
+	mockDatabase.EXPECT().DeleteMember(mock.Anything, teamId, userId).Return(nil)
+
+	service := NewTeamService(mockDatabase)
+	err := service.RemoveMember(context.Background(), teamId, userId, userId)
+
+	assert.Nil(t, err)
+}
+
+func TestRemoveMember_AdminCannotRemoveOwner(t *testing.T) {
+	teamId := uuid.New()
+	callerId := uuid.New()
+	ownerId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, ownerId).Return(DatabaseTeamMember{Team: teamId, User: ownerId, Role: Owner}, nil)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, callerId).Return(DatabaseTeamMember{Team: teamId, User: callerId, Role: Admin}, nil)
+
+	service := NewTeamService(mockDatabase)
+	err := service.RemoveMember(context.Background(), teamId, ownerId, callerId)
+
+	assertTeamError(t, err, Forbidden)
+}
+
+func TestRemoveMember_LastOwnerCannotLeave(t *testing.T) {
+	teamId := uuid.New()
+	ownerId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, ownerId).Return(DatabaseTeamMember{Team: teamId, User: ownerId, Role: Owner}, nil)
+	mockDatabase.EXPECT().CountOwners(mock.Anything, teamId).Return(1, nil)
+
+	service := NewTeamService(mockDatabase)
+	err := service.RemoveMember(context.Background(), teamId, ownerId, ownerId)
+
+	assertTeamError(t, err, Conflict)
+}
+
+func TestMemberExists(t *testing.T) {
+	teamId := uuid.New()
+	userId := uuid.New()
+
+	mockDatabase := NewMockTeamDatabase(t)
+	mockDatabase.EXPECT().GetMember(mock.Anything, teamId, userId).Return(DatabaseTeamMember{}, sql.ErrNoRows)
+
+	service := NewTeamService(mockDatabase)
+	exists, err := service.MemberExists(context.Background(), teamId, userId)
+
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
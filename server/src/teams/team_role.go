@@ -0,0 +1,51 @@
+package teams
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// TeamRole is the role of a user within a team and can be one of owner, admin or member.
+type TeamRole string
+
+const (
+	// Owner can do everything within a team including deleting it and managing other owners.
+	Owner TeamRole = "OWNER"
+
+	// Admin can rename the team and manage its members.
+	Admin TeamRole = "ADMIN"
+
+	// Member can see and use the boards and templates of the team.
+	Member TeamRole = "MEMBER"
+)
+
+func (role *TeamRole) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	unmarshalledRole := TeamRole(s)
+	switch unmarshalledRole {
+	case Owner, Admin, Member:
+		*role = unmarshalledRole
+		return nil
+	}
+	return errors.New("invalid team role")
+}
+
+// Includes reports whether the role grants at least the privileges of the other role.
+func (role TeamRole) Includes(other TeamRole) bool {
+	return role.rank() >= other.rank()
+}
+
+func (role TeamRole) rank() int {
+	switch role {
+	case Owner:
+		return 3
+	case Admin:
+		return 2
+	case Member:
+		return 1
+	}
+	return 0
+}
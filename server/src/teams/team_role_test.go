@@ -0,0 +1,33 @@
+package teams
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalTeamRole(t *testing.T) {
+	var role TeamRole
+	err := json.Unmarshal([]byte(`"ADMIN"`), &role)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Admin, role)
+}
+
+func TestUnmarshalInvalidTeamRole(t *testing.T) {
+	var role TeamRole
+	err := json.Unmarshal([]byte(`"MODERATOR"`), &role)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, TeamRole(""), role)
+}
+
+func TestTeamRoleIncludes(t *testing.T) {
+	assert.True(t, Owner.Includes(Admin))
+	assert.True(t, Admin.Includes(Admin))
+	assert.True(t, Admin.Includes(Member))
+	assert.False(t, Member.Includes(Admin))
+	assert.False(t, Admin.Includes(Owner))
+	assert.False(t, TeamRole("").Includes(Member))
+}
@@ -3,6 +3,7 @@ package users
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -18,26 +19,40 @@ import (
 
 type UserService interface {
 	Create(ctx context.Context, id, name, avatarUrl string, accountType common.AccountType) (*User, error)
+	CreateOIDCUser(ctx context.Context, provider, issuer, id, name, avatarUrl string) (*User, error)
 	Get(ctx context.Context, id uuid.UUID) (*User, error)
 	GetBoardUsers(ctx context.Context, boardID uuid.UUID) ([]*User, error)
 	GetExistingUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]uuid.UUID, error)
 	Update(ctx context.Context, body UserUpdateRequest) (*User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	Export(ctx context.Context, id uuid.UUID) (*Export, error)
+	MergeAnonymousUser(ctx context.Context, anonymousUser, user uuid.UUID) (*User, error)
+	GetIdentities(ctx context.Context, user uuid.UUID) ([]*Identity, error)
+	LinkIdentity(ctx context.Context, user uuid.UUID, identity Identity) (*User, error)
+	UnlinkIdentity(ctx context.Context, user uuid.UUID, provider string) error
+	SetAdmin(ctx context.Context, id uuid.UUID, admin bool) error
 	IsUserAvailableForKeyMigration(ctx context.Context, id uuid.UUID) (bool, error)
 	SetKeyMigration(ctx context.Context, id uuid.UUID) (*User, error)
 }
 
+// SessionRevoker invalidates the sessions of users
+type SessionRevoker interface {
+	RevokeSessions(ctx context.Context, user uuid.UUID) error
+}
+
 type API struct {
 	service                       UserService
 	sessions                      sessions.SessionService
+	sessionRevoker                SessionRevoker
 	allowAnonymousBoardCreation   bool
 	allowAnonymousCustomTemplates bool
 }
 
-func NewUserApi(service UserService, sessionService sessions.SessionService, allowAnonymousBoardCreation, allowAnonymousCustomTemplates bool) UsersApi {
+func NewUserApi(service UserService, sessionService sessions.SessionService, sessionRevoker SessionRevoker, allowAnonymousBoardCreation, allowAnonymousCustomTemplates bool) UsersApi {
 	api := new(API)
 	api.service = service
 	api.sessions = sessionService
+	api.sessionRevoker = sessionRevoker
 	api.allowAnonymousBoardCreation = allowAnonymousBoardCreation
 	api.allowAnonymousCustomTemplates = allowAnonymousCustomTemplates
 	return api
@@ -229,6 +244,143 @@ func (api *API) Delete(w http.ResponseWriter, r *http.Request) {
 	render.Respond(w, r, err)
 }
 
+// Export all data of the logged in user
+//
+//	@Summary		Export all data of the logged in user
+//	@Description	Export everything stored about the logged in user across all boards that hold data of the user, including boards the user left, i.e. the profile, the accounts of the identity providers, the team memberships, the api tokens without their secrets, the board sessions, the authored notes and their revisions, votes, reactions, estimates, assigned action items and audit log entries and the created board templates. Feedback is not part of the export, since it is forwarded to the feedback webhook and not stored.
+//	@Tags			users
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			id		path	string	true	"id of the user"
+//	@Produce		json
+//	@Success		200	{object}	Export
+//	@Failure		403	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/users/{id}/export [get]
+func (api *API) Export(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.users.api.export")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.api.export.user", user.String()),
+	)
+
+	export, err := api.service.Export(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to export user")
+		span.RecordError(err)
+		log.Errorw("failed to export user", "user", user, "err", err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"scrumlr-user-%s.json\"", user))
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, export)
+}
+
+// Revoke all sessions of the logged in user
+//
+//	@Summary		Revoke all sessions of the logged in user
+//	@Description	Log the user out everywhere by revoking all tokens issued so far, including the one of this request
+//	@Tags			users
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Param			id		path	string	true	"id of the user"
+//	@Success		204
+//	@Failure		400	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/users/{id}/sessions [delete]
+func (api *API) RevokeSessions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.users.api.sessions.revoke")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.api.sessions.revoke.user", user.String()),
+	)
+
+	if err := api.sessionRevoker.RevokeSessions(ctx, user); err != nil {
+		span.SetStatus(codes.Error, "failed to revoke sessions")
+		span.RecordError(err)
+		log.Errorw("failed to revoke sessions", "user", user, "err", err)
+		common.Throw(w, r, common.InternalServerError)
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.Respond(w, r, nil)
+}
+
+// Get the identities of the logged in user
+//
+//	@Summary		Get the identities of the logged in user
+//	@Description	Get the accounts of the identity providers the logged in user can log in with. Further accounts are linked by logging in with the query parameter link=true.
+//	@Tags			users
+//	@Param			Cookie	header	string	true	"jwt token to authenticate"
+//	@Produce		json
+//	@Success		200	{object}	[]Identity
+//	@Failure		500	{object}	common.APIError
+//	@Router			/users/identities [get]
+func (api *API) GetIdentities(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.users.api.identities.get")
+	defer span.End()
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+
+	identities, err := api.service.GetIdentities(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get identities")
+		span.RecordError(err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.Respond(w, r, identities)
+}
+
+// Unlink an identity of the logged in user
+//
+//	@Summary		Unlink an identity of the logged in user
+//	@Description	Remove the account of the identity provider from the logged in user. The last account can not be removed.
+//	@Tags			users
+//	@Param			Cookie		header	string	true	"jwt token to authenticate"
+//	@Param			provider	path	string	true	"provider of the account, or the name of an additional OIDC provider"
+//	@Success		204
+//	@Failure		400	{object}	common.APIError
+//	@Failure		404	{object}	common.APIError
+//	@Failure		500	{object}	common.APIError
+//	@Router			/users/identities/{provider} [delete]
+func (api *API) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "scrumlr.users.api.identities.unlink")
+	defer span.End()
+	log := logger.FromContext(ctx)
+
+	user := ctx.Value(identifiers.UserIdentifier).(uuid.UUID)
+	provider := chi.URLParam(r, "provider")
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.api.identities.unlink.user", user.String()),
+		attribute.String("scrumlr.users.api.identities.unlink.provider", provider),
+	)
+
+	if err := api.service.UnlinkIdentity(ctx, user, provider); err != nil {
+		span.SetStatus(codes.Error, "failed to unlink identity")
+		span.RecordError(err)
+		log.Errorw("failed to unlink identity", "user", user, "provider", provider, "err", err)
+		common.Throw(w, r, apiError(err))
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.Respond(w, r, nil)
+}
+
 func (api *API) BoardAuthenticatedContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx, span := tracer.Start(r.Context(), "scrumlr.user.api.context.authenticated")
@@ -402,3 +554,20 @@ func (api *API) isAccountOwner(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// apiError translates user errors to HTTP API errors.
+func apiError(err error) error {
+	var userErr UserError
+	if errors.As(err, &userErr) {
+		switch userErr.Category {
+		case BadRequest:
+			return common.BadRequestError(err)
+		case Forbidden:
+			return common.ForbiddenError(err)
+		case NotFound:
+			return common.NotFoundError
+		}
+	}
+
+	return common.InternalServerError
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"scrumlr.io/server/common"
 	"scrumlr.io/server/identifiers"
+	"scrumlr.io/server/logger"
 	"scrumlr.io/server/realtime"
 	"scrumlr.io/server/sessions"
 	"scrumlr.io/server/technical_helper"
@@ -30,7 +31,7 @@ func TestGetUser_api(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, userId)
@@ -55,7 +56,7 @@ func TestGetUser_api_InvalidUUID(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, uuid.Nil)
@@ -75,7 +76,7 @@ func Test_GetUserByID(t *testing.T) {
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 
 	rr := httptest.NewRecorder()
 
@@ -102,7 +103,7 @@ func Test_GetUserByID_api_InvalidUUID(t *testing.T) {
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 
 	rr := httptest.NewRecorder()
 
@@ -118,7 +119,7 @@ func Test_GetUserByID_ServiceError(t *testing.T) {
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
 	userId := uuid.New()
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 
 	rr := httptest.NewRecorder()
 
@@ -147,7 +148,7 @@ func Test_GetBoardUsers_api(t *testing.T) {
 
 	mockUserService.EXPECT().GetBoardUsers(mock.Anything, boardID).Return(mockUsers, nil)
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 
 	req := technical_helper.NewTestRequestBuilder("GET", "/board/{id}", nil).AddToContext(identifiers.BoardIdentifier, boardID)
@@ -170,7 +171,7 @@ func Test_GetBoardUsers_ServiceError(t *testing.T) {
 
 	mockUserService.EXPECT().GetBoardUsers(mock.Anything, boardID).Return(nil, errors.New("db error"))
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 
 	req := technical_helper.NewTestRequestBuilder("GET", "/board/{id}", nil).AddToContext(identifiers.BoardIdentifier, boardID)
@@ -192,7 +193,7 @@ func Test_UpdateUser_api(t *testing.T) {
 
 	mockUserService.EXPECT().Update(mock.Anything, updateBody).Return(mockUpdatedUser, nil)
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 
 	bodyBytes, _ := json.Marshal(updateBody)
@@ -218,7 +219,7 @@ func Test_UpdateUser_ServiceError(t *testing.T) {
 
 	mockUserService.EXPECT().Update(mock.Anything, updateBody).Return(nil, errors.New("db error"))
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 
 	bodyBytes, _ := json.Marshal(updateBody)
@@ -230,6 +231,135 @@ func Test_UpdateUser_ServiceError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, rr.Result().StatusCode)
 }
 
+func Test_RevokeSessions_api(t *testing.T) {
+	userID := uuid.New()
+
+	mockSessionRevoker := NewMockSessionRevoker(t)
+	mockSessionRevoker.EXPECT().RevokeSessions(mock.Anything, userID).Return(nil)
+
+	userApi := NewUserApi(NewMockUserService(t), sessions.NewMockSessionService(t), mockSessionRevoker, true, true)
+	rr := httptest.NewRecorder()
+	req := technical_helper.NewTestRequestBuilder("DELETE", "/", nil).
+		AddToContext(identifiers.UserIdentifier, userID)
+
+	userApi.RevokeSessions(rr, req.Request())
+
+	assert.Equal(t, http.StatusNoContent, rr.Result().StatusCode)
+}
+
+func Test_RevokeSessions_Error(t *testing.T) {
+	userID := uuid.New()
+
+	mockSessionRevoker := NewMockSessionRevoker(t)
+	mockSessionRevoker.EXPECT().RevokeSessions(mock.Anything, userID).Return(errors.New("cache error"))
+
+	userApi := NewUserApi(NewMockUserService(t), sessions.NewMockSessionService(t), mockSessionRevoker, true, true)
+	rr := httptest.NewRecorder()
+	req := technical_helper.NewTestRequestBuilder("DELETE", "/", nil).
+		AddToContext(identifiers.UserIdentifier, userID)
+
+	userApi.RevokeSessions(rr, req.Request())
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Result().StatusCode)
+}
+
+func Test_Export_api(t *testing.T) {
+	userID := uuid.New()
+
+	mockUserService := NewMockUserService(t)
+	mockUserService.EXPECT().Export(mock.Anything, userID).
+		Return(&Export{User: &User{ID: userID, AccountType: common.Anonymous}, Identities: []*ExportedIdentity{}, Boards: []*BoardExport{}}, nil)
+
+	userApi := NewUserApi(mockUserService, sessions.NewMockSessionService(t), nil, true, true)
+	rr := httptest.NewRecorder()
+	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
+		AddToContext(identifiers.UserIdentifier, userID)
+
+	userApi.Export(rr, req.Request())
+
+	assert.Equal(t, http.StatusOK, rr.Result().StatusCode)
+	assert.Contains(t, rr.Header().Get("Content-Disposition"), "attachment")
+
+	var export Export
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&export))
+	assert.Equal(t, userID, export.User.ID)
+}
+
+func Test_Export_NotFound(t *testing.T) {
+	userID := uuid.New()
+
+	mockUserService := NewMockUserService(t)
+	mockUserService.EXPECT().Export(mock.Anything, userID).
+		Return(nil, CreateUserError(NotFound, "user not found", errors.New("no rows")))
+
+	userApi := NewUserApi(mockUserService, sessions.NewMockSessionService(t), nil, true, true)
+	rr := httptest.NewRecorder()
+	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
+		AddToContext(identifiers.UserIdentifier, userID)
+	req.Req = logger.InitTestLoggerRequest(req.Request())
+
+	userApi.Export(rr, req.Request())
+
+	assert.Equal(t, http.StatusNotFound, rr.Result().StatusCode)
+}
+
+func Test_GetIdentities_api(t *testing.T) {
+	userID := uuid.New()
+
+	mockUserService := NewMockUserService(t)
+	mockUserService.EXPECT().GetIdentities(mock.Anything, userID).
+		Return([]*Identity{{AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+
+	userApi := NewUserApi(mockUserService, sessions.NewMockSessionService(t), nil, true, true)
+	rr := httptest.NewRecorder()
+	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
+		AddToContext(identifiers.UserIdentifier, userID)
+
+	userApi.GetIdentities(rr, req.Request())
+
+	assert.Equal(t, http.StatusOK, rr.Result().StatusCode)
+	assert.JSONEq(t, `[{"accountType":"GITHUB","provider":"github","name":"Stan"}]`, rr.Body.String())
+}
+
+func Test_UnlinkIdentity_api(t *testing.T) {
+	userID := uuid.New()
+
+	mockUserService := NewMockUserService(t)
+	mockUserService.EXPECT().UnlinkIdentity(mock.Anything, userID, "github").Return(nil)
+
+	userApi := NewUserApi(mockUserService, sessions.NewMockSessionService(t), nil, true, true)
+	rr := httptest.NewRecorder()
+	req := technical_helper.NewTestRequestBuilder("DELETE", "/", nil).
+		AddToContext(identifiers.UserIdentifier, userID)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("provider", "github")
+	req.AddToContext(chi.RouteCtxKey, rctx)
+
+	userApi.UnlinkIdentity(rr, req.Request())
+
+	assert.Equal(t, http.StatusNoContent, rr.Result().StatusCode)
+}
+
+func Test_UnlinkIdentity_LastIdentity(t *testing.T) {
+	userID := uuid.New()
+
+	mockUserService := NewMockUserService(t)
+	mockUserService.EXPECT().UnlinkIdentity(mock.Anything, userID, "github").
+		Return(CreateUserError(BadRequest, "the last linked account can not be removed", errors.New("the last linked account can not be removed")))
+
+	userApi := NewUserApi(mockUserService, sessions.NewMockSessionService(t), nil, true, true)
+	rr := httptest.NewRecorder()
+	req := technical_helper.NewTestRequestBuilder("DELETE", "/", nil).
+		AddToContext(identifiers.UserIdentifier, userID)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("provider", "github")
+	req.AddToContext(chi.RouteCtxKey, rctx)
+
+	userApi.UnlinkIdentity(rr, req.Request())
+
+	assert.Equal(t, http.StatusBadRequest, rr.Result().StatusCode)
+}
+
 func Test_UpdateUserBoards_ServiceError(t *testing.T) {
 	userID := uuid.New()
 
@@ -241,7 +371,7 @@ func Test_UpdateUserBoards_ServiceError(t *testing.T) {
 
 	mockUserService.EXPECT().Update(mock.Anything, updateBody).Return(mockUpdatedUser, nil)
 
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 
 	bodyBytes, _ := json.Marshal(updateBody)
@@ -259,7 +389,7 @@ func Test_BoardAuthenticatedContext(t *testing.T) {
 
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, userId)
@@ -285,7 +415,7 @@ func Test_BoardAuthenticatedContext_NotAuthenticated(t *testing.T) {
 
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, userId)
@@ -312,7 +442,7 @@ func Test_BoardAuthenticatedContext_InvalidBoardID(t *testing.T) {
 
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, userId)
@@ -337,7 +467,7 @@ func Test_BoardAuthenticatedContext_InvalidUserID(t *testing.T) {
 
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, userId)
@@ -360,7 +490,7 @@ func Test_AnonymousBoardCreationContext(t *testing.T) {
 
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
-	userApi := NewUserApi(mockUserService, mockSessionService, true, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, true, true)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, userId)
@@ -385,7 +515,7 @@ func Test_AnonymousBoardCreationContext_NotAllowed(t *testing.T) {
 
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
-	userApi := NewUserApi(mockUserService, mockSessionService, false, true)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, false, true)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, userId)
@@ -410,7 +540,7 @@ func Test_AnonymousCustomTemplateCreationContext_NotAllowed(t *testing.T) {
 
 	mockUserService := NewMockUserService(t)
 	mockSessionService := sessions.NewMockSessionService(t)
-	userApi := NewUserApi(mockUserService, mockSessionService, false, false)
+	userApi := NewUserApi(mockUserService, mockSessionService, nil, false, false)
 	rr := httptest.NewRecorder()
 	req := technical_helper.NewTestRequestBuilder("GET", "/", nil).
 		AddToContext(identifiers.UserIdentifier, userId)
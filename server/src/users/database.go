@@ -36,27 +36,31 @@ func (db *DB) CreateAnonymousUser(ctx context.Context, name string) (DatabaseUse
 }
 
 func (db *DB) CreateAppleUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error) {
-	return db.createExternalUser(ctx, id, name, avatarUrl, common.Apple, "apple_users")
+	return db.createExternalUser(ctx, externalIdentity{table: "apple_users", id: id}, name, avatarUrl, common.Apple)
 }
 
 func (db *DB) CreateAzureAdUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error) {
-	return db.createExternalUser(ctx, id, name, avatarUrl, common.AzureAd, "azure_ad_users")
+	return db.createExternalUser(ctx, externalIdentity{table: "azure_ad_users", id: id}, name, avatarUrl, common.AzureAd)
 }
 
 func (db *DB) CreateGitHubUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error) {
-	return db.createExternalUser(ctx, id, name, avatarUrl, common.GitHub, "github_users")
+	return db.createExternalUser(ctx, externalIdentity{table: "github_users", id: id}, name, avatarUrl, common.GitHub)
 }
 
 func (db *DB) CreateGoogleUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error) {
-	return db.createExternalUser(ctx, id, name, avatarUrl, common.Google, "google_users")
+	return db.createExternalUser(ctx, externalIdentity{table: "google_users", id: id}, name, avatarUrl, common.Google)
 }
 
 func (db *DB) CreateMicrosoftUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error) {
-	return db.createExternalUser(ctx, id, name, avatarUrl, common.Microsoft, "microsoft_users")
+	return db.createExternalUser(ctx, externalIdentity{table: "microsoft_users", id: id}, name, avatarUrl, common.Microsoft)
 }
 
-func (db *DB) CreateOIDCUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error) {
-	return db.createExternalUser(ctx, id, name, avatarUrl, common.TypeOIDC, "oidc_users")
+func (db *DB) CreateOIDCUser(ctx context.Context, provider, issuer, id, name, avatarUrl string) (DatabaseUser, error) {
+	return db.createExternalUser(ctx, externalIdentity{table: "oidc_users", provider: provider, issuer: issuer, id: id}, name, avatarUrl, common.TypeOIDC)
+}
+
+func (db *DB) CreateSAMLUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error) {
+	return db.createExternalUser(ctx, externalIdentity{table: "saml_users", id: id}, name, avatarUrl, common.TypeSAML)
 }
 
 func (db *DB) UpdateUser(ctx context.Context, update DatabaseUserUpdate) (DatabaseUser, error) {
@@ -114,6 +118,37 @@ func (db *DB) GetExistingUserIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.U
 	return existingIDs, err
 }
 
+// GetBoardsWithUserData returns every board that holds data of the user, including boards the user is no longer a participant of.
+func (db *DB) GetBoardsWithUserData(ctx context.Context, user uuid.UUID) ([]uuid.UUID, error) {
+	boards := []uuid.UUID{}
+	err := db.db.NewRaw(
+		`SELECT board FROM board_sessions WHERE "user" = ?0
+		UNION SELECT board FROM notes WHERE author = ?0
+		UNION SELECT board FROM votes WHERE "user" = ?0
+		UNION SELECT n.board FROM note_revisions r JOIN notes n ON n.id = r.note WHERE r.author = ?0
+		UNION SELECT n.board FROM reactions r JOIN notes n ON n.id = r.note WHERE r."user" = ?0
+		UNION SELECT e.board FROM estimates es JOIN estimations e ON e.id = es.estimation WHERE es."user" = ?0
+		UNION SELECT board FROM action_items WHERE assignee = ?0
+		UNION SELECT board FROM audit_log WHERE actor = ?0`,
+		user,
+	).Scan(ctx, &boards)
+	if err != nil {
+		return nil, err
+	}
+
+	return boards, nil
+}
+
+func (db *DB) SetAdmin(ctx context.Context, id uuid.UUID, admin bool) error {
+	_, err := db.db.NewUpdate().
+		Table("users").
+		Set("admin = ?", admin).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	return err
+}
+
 func (db *DB) IsUserAnonymous(ctx context.Context, id uuid.UUID) (bool, error) {
 	count, err := db.db.NewSelect().
 		Table("users").
@@ -157,8 +192,160 @@ func (db *DB) SetKeyMigration(ctx context.Context, id uuid.UUID) (DatabaseUser,
 	return user, err
 }
 
-func (db *DB) createExternalUser(ctx context.Context, id, name, avatarUrl string, accountType common.AccountType, table string) (DatabaseUser, error) {
+// identityTables are the tables of the users of the identity providers
+var identityTables = []struct {
+	accountType common.AccountType
+	table       string
+}{
+	{common.Apple, "apple_users"},
+	{common.AzureAd, "azure_ad_users"},
+	{common.GitHub, "github_users"},
+	{common.Google, "google_users"},
+	{common.Microsoft, "microsoft_users"},
+	{common.TypeOIDC, "oidc_users"},
+	{common.TypeSAML, "saml_users"},
+}
+
+// newExternalIdentity returns the identity in the table of its provider
+func newExternalIdentity(identity DatabaseIdentity) (externalIdentity, error) {
+	for _, identityTable := range identityTables {
+		if identityTable.accountType != identity.AccountType {
+			continue
+		}
+
+		if identity.AccountType == common.TypeOIDC {
+			return externalIdentity{table: identityTable.table, provider: identity.Provider, issuer: identity.Issuer, id: identity.ID}, nil
+		}
+		return externalIdentity{table: identityTable.table, id: identity.ID}, nil
+	}
+
+	return externalIdentity{}, fmt.Errorf("no identity provider for account type %s", identity.AccountType)
+}
+
+// GetIdentities returns the accounts of the identity providers linked to the user
+func (db *DB) GetIdentities(ctx context.Context, user uuid.UUID) ([]DatabaseIdentity, error) {
+	queries := make([]string, 0, len(identityTables))
+	args := make([]any, 0, len(identityTables))
+	for _, identityTable := range identityTables {
+		provider := fmt.Sprintf("'%s'", strings.ToLower(string(identityTable.accountType)))
+		issuer := "''"
+		if identityTable.accountType == common.TypeOIDC {
+			provider = "provider"
+			issuer = "issuer"
+		}
+
+		queries = append(queries, fmt.Sprintf(
+			`SELECT "user", '%s' AS account_type, %s AS provider, %s AS issuer, id, name, COALESCE(avatar_url, '') AS avatar_url FROM %s WHERE "user" = ?`,
+			identityTable.accountType, provider, issuer, identityTable.table,
+		))
+		args = append(args, user)
+	}
+
+	identities := []DatabaseIdentity{}
+	err := db.db.NewRaw(strings.Join(queries, " UNION ALL ")+" ORDER BY account_type, provider", args...).
+		Scan(ctx, &identities)
+
+	return identities, err
+}
+
+// GetIdentityUser returns the user the account of the identity provider is linked to
+func (db *DB) GetIdentityUser(ctx context.Context, identity DatabaseIdentity) (uuid.UUID, error) {
+	external, err := newExternalIdentity(identity)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	condition, args := external.condition()
+	var user uuid.UUID
+	err = db.db.NewSelect().
+		Table(external.table).
+		Column("user").
+		Where(condition, args...).
+		Scan(ctx, &user)
+
+	return user, err
+}
+
+// AddIdentity links the account of the identity provider to the user of the identity
+func (db *DB) AddIdentity(ctx context.Context, identity DatabaseIdentity) error {
+	external, err := newExternalIdentity(identity)
+	if err != nil {
+		return err
+	}
+
+	if external.provider != "" {
+		_, err = db.db.NewRaw(
+			fmt.Sprintf("INSERT INTO %s (\"user\", provider, issuer, id, name, avatar_url) VALUES (?, ?, ?, ?, ?, ?)", external.table),
+			identity.User, external.provider, external.issuer, external.id, identity.Name, identity.AvatarUrl,
+		).Exec(ctx)
+
+		return err
+	}
+
+	_, err = db.db.NewRaw(
+		fmt.Sprintf("INSERT INTO %s (\"user\", id, name, avatar_url) VALUES (?, ?, ?, ?)", external.table),
+		identity.User, external.id, identity.Name, identity.AvatarUrl,
+	).Exec(ctx)
+
+	return err
+}
+
+// RemoveIdentity unlinks the account of the identity provider from its user, whose account type changes to the given one
+func (db *DB) RemoveIdentity(ctx context.Context, identity DatabaseIdentity, accountType common.AccountType) error {
+	external, err := newExternalIdentity(identity)
+	if err != nil {
+		return err
+	}
+
+	condition, args := external.condition()
+	return db.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().
+			Table(external.table).
+			Where(condition, args...).
+			Where("\"user\" = ?", identity.User).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewUpdate().
+			Table("users").
+			Set("account_type = ?", accountType).
+			Where("id = ?", identity.User).
+			Exec(ctx)
+
+		return err
+	})
+}
+
+// externalIdentity identifies a user in the table of its provider
+type externalIdentity struct {
+	table string
+	id    string
+
+	// provider is the name of providers which can be configured multiple times, it is only the route of the login
+	provider string
+
+	// issuer separates the users of providers which can be configured multiple times, even if a provider is renamed
+	issuer string
+}
+
+// condition returns the where clause matching the identity
+func (identity externalIdentity) condition() (string, []any) {
+	switch {
+	case identity.provider == "":
+		return "id = ?", []any{identity.id}
+	case identity.issuer == "":
+		return "issuer = '' AND provider = ? AND id = ?", []any{identity.provider, identity.id}
+	default:
+		// the issuer of users from before issuers were stored is unknown, they are only known by the name of their provider
+		return "id = ? AND (issuer = ? OR (issuer = '' AND provider = ?))", []any{identity.id, identity.issuer, identity.provider}
+	}
+}
+
+func (db *DB) createExternalUser(ctx context.Context, identity externalIdentity, name, avatarUrl string, accountType common.AccountType) (DatabaseUser, error) {
 	name = strings.TrimSpace(name)
+	condition, args := identity.condition()
 	var user DatabaseUser
 	err := db.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		var extUser struct {
@@ -166,9 +353,9 @@ func (db *DB) createExternalUser(ctx context.Context, id, name, avatarUrl string
 			Name   string    `bun:"name"`
 		}
 		err := tx.NewSelect().
-			Table(table).
+			Table(identity.table).
 			Column("user", "name").
-			Where("id = ?", id).
+			Where(condition, args...).
 			Scan(ctx, &extUser)
 
 		if err == nil { // external user exists
@@ -193,12 +380,15 @@ func (db *DB) createExternalUser(ctx context.Context, id, name, avatarUrl string
 				}
 			}
 
-			_, err = tx.NewUpdate().
-				Table(table).
+			update := tx.NewUpdate().
+				Table(identity.table).
 				Set("name = ?", name).
-				Set("avatar_url = ?", avatarUrl).
-				Where("id = ?", id).
-				Exec(ctx)
+				Set("avatar_url = ?", avatarUrl)
+			if identity.issuer != "" {
+				// keeps the issuer of users from before issuers were stored and the name of renamed providers
+				update = update.Set("provider = ?", identity.provider).Set("issuer = ?", identity.issuer)
+			}
+			_, err = update.Where(condition, args...).Exec(ctx)
 
 			return err
 		}
@@ -218,9 +408,18 @@ func (db *DB) createExternalUser(ctx context.Context, id, name, avatarUrl string
 			return err
 		}
 
+		if identity.provider != "" {
+			_, err = tx.NewRaw(
+				fmt.Sprintf("INSERT INTO %s (\"user\", provider, issuer, id, name, avatar_url) VALUES (?, ?, ?, ?, ?, ?)", identity.table),
+				user.ID, identity.provider, identity.issuer, identity.id, name, avatarUrl,
+			).Exec(ctx)
+
+			return err
+		}
+
 		_, err = tx.NewRaw(
-			fmt.Sprintf("INSERT INTO %s (\"user\", id, name, avatar_url) VALUES (?, ?, ?, ?)", table),
-			user.ID, id, name, avatarUrl,
+			fmt.Sprintf("INSERT INTO %s (\"user\", id, name, avatar_url) VALUES (?, ?, ?, ?)", identity.table),
+			user.ID, identity.id, name, avatarUrl,
 		).Exec(ctx)
 
 		return err
@@ -229,6 +428,83 @@ func (db *DB) createExternalUser(ctx context.Context, id, name, avatarUrl string
 	return user, err
 }
 
+// MergeUsers moves the board sessions, notes, votes, reactions, templates, audit log entries, note revisions and identities of a user to another user and deletes it.
+// If both users joined the same board or team, the higher role of both is kept. A ban of the deleted user is kept as well.
+func (db *DB) MergeUsers(ctx context.Context, from, into uuid.UUID) error {
+	return db.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		type statement struct {
+			query string
+			args  []any
+		}
+
+		statements := []statement{
+			{
+				`UPDATE board_sessions AS target SET
+					role = CASE
+						WHEN 'OWNER' IN (target.role, source.role) THEN 'OWNER'::session_role
+						WHEN 'MODERATOR' IN (target.role, source.role) THEN 'MODERATOR'::session_role
+						ELSE target.role END,
+					favourite = target.favourite OR source.favourite
+				FROM board_sessions AS source
+				WHERE source."user" = ? AND target."user" = ? AND source.board = target.board`,
+				[]any{from, into},
+			},
+			{
+				`UPDATE board_sessions SET "user" = ? WHERE "user" = ? AND board NOT IN (SELECT board FROM board_sessions WHERE "user" = ?)`,
+				[]any{into, from, into},
+			},
+			{
+				`UPDATE board_session_requests SET "user" = ? WHERE "user" = ? AND board NOT IN (SELECT board FROM board_session_requests WHERE "user" = ?)`,
+				[]any{into, from, into},
+			},
+			{`UPDATE notes SET author = ? WHERE author = ?`, []any{into, from}},
+			{`UPDATE votes SET "user" = ? WHERE "user" = ?`, []any{into, from}},
+			{
+				// a user reacts at most once to a note
+				`DELETE FROM reactions WHERE "user" = ? AND note IN (SELECT note FROM reactions WHERE "user" = ?)`,
+				[]any{from, into},
+			},
+			{`UPDATE reactions SET "user" = ? WHERE "user" = ?`, []any{into, from}},
+			{`UPDATE board_templates SET creator = ? WHERE creator = ?`, []any{into, from}},
+			{`UPDATE action_items SET assignee = ? WHERE assignee = ?`, []any{into, from}},
+			{`UPDATE audit_log SET actor = ? WHERE actor = ?`, []any{into, from}},
+			{`UPDATE note_revisions SET author = ? WHERE author = ?`, []any{into, from}},
+			{
+				`UPDATE team_members AS target SET
+					role = CASE
+						WHEN 'OWNER' IN (target.role, source.role) THEN 'OWNER'::team_role
+						WHEN 'ADMIN' IN (target.role, source.role) THEN 'ADMIN'::team_role
+						ELSE target.role END
+				FROM team_members AS source
+				WHERE source."user" = ? AND target."user" = ? AND source.team = target.team`,
+				[]any{from, into},
+			},
+			{
+				`UPDATE team_members SET "user" = ? WHERE "user" = ? AND team NOT IN (SELECT team FROM team_members WHERE "user" = ?)`,
+				[]any{into, from, into},
+			},
+		}
+
+		for _, identityTable := range identityTables {
+			statements = append(statements, statement{fmt.Sprintf(`UPDATE %s SET "user" = ? WHERE "user" = ?`, identityTable.table), []any{into, from}})
+		}
+
+		// a ban of either user applies to the merged user
+		statements = append(statements, statement{`UPDATE users SET banned = true WHERE id = ? AND EXISTS (SELECT 1 FROM users WHERE id = ? AND banned)`, []any{into, from}})
+
+		// the remaining sessions, requests and memberships of boards and teams both users joined are removed with the user
+		statements = append(statements, statement{`DELETE FROM users WHERE id = ?`, []any{from}})
+
+		for _, statement := range statements {
+			if _, err := tx.NewRaw(statement.query, statement.args...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 func (db *DB) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	_, err := db.db.NewDelete().
 		Model((*DatabaseUser)(nil)).
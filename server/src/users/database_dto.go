@@ -17,6 +17,12 @@ type DatabaseUser struct {
 	AccountType   common.AccountType
 	KeyMigration  *time.Time
 	CreatedAt     time.Time
+
+	// Admin is set for instance administrators granted by the group of an identity provider
+	Admin bool
+
+	// Banned users can not log in anymore
+	Banned bool
 }
 
 // UserInsert the insert type for a new User
@@ -32,3 +38,14 @@ type DatabaseUserUpdate struct {
 	Name          string
 	Avatar        *common.Avatar `bun:"type:jsonb,nullzero"`
 }
+
+// DatabaseIdentity is an account of an identity provider linked to a user
+type DatabaseIdentity struct {
+	User        uuid.UUID `bun:"user,type:uuid"`
+	AccountType common.AccountType
+	Provider    string
+	Issuer      string
+	ID          string
+	Name        string
+	AvatarUrl   string
+}
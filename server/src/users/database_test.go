@@ -212,7 +212,7 @@ func (suite *DatabaseUserTestSuite) TestDatabaseCreateOIDCUser() {
 	database := NewUserDatabase(suite.db)
 	userName := "Stan"
 
-	dbUser, err := database.CreateOIDCUser(context.Background(), "oidcId", userName, "")
+	dbUser, err := database.CreateOIDCUser(context.Background(), DefaultOIDCProvider, "https://id.example.com", "oidcId", userName, "")
 
 	assert.Nil(t, err)
 	assert.Equal(t, userName, dbUser.Name)
@@ -222,6 +222,73 @@ func (suite *DatabaseUserTestSuite) TestDatabaseCreateOIDCUser() {
 	assert.Nil(t, dbUser.Avatar)
 }
 
+func (suite *DatabaseUserTestSuite) TestDatabaseCreateOIDCUser_SeparatedByIssuer() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	salesUser, err := database.CreateOIDCUser(context.Background(), "sales", "https://sales.example.com", "keycloakId", "Stan", "")
+	assert.Nil(t, err)
+
+	supportUser, err := database.CreateOIDCUser(context.Background(), "support", "https://support.example.com", "keycloakId", "Stan", "")
+	assert.Nil(t, err)
+	assert.NotEqual(t, salesUser.ID, supportUser.ID)
+
+	existingUser, err := database.CreateOIDCUser(context.Background(), "sales", "https://sales.example.com", "keycloakId", "Stan", "")
+	assert.Nil(t, err)
+	assert.Equal(t, salesUser.ID, existingUser.ID)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseCreateOIDCUser_RenamedProvider() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	salesUser, err := database.CreateOIDCUser(context.Background(), "sales", "https://sales.example.com", "keycloakId", "Stan", "")
+	assert.Nil(t, err)
+
+	renamedUser, err := database.CreateOIDCUser(context.Background(), "marketing", "https://sales.example.com", "keycloakId", "Stan", "")
+	assert.Nil(t, err)
+	assert.Equal(t, salesUser.ID, renamedUser.ID)
+
+	identities, err := database.GetIdentities(context.Background(), salesUser.ID)
+	assert.Nil(t, err)
+	assert.Len(t, identities, 1)
+	assert.Equal(t, "marketing", identities[0].Provider)
+	assert.Equal(t, "https://sales.example.com", identities[0].Issuer)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseCreateOIDCUser_StoresIssuerOfExistingUser() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	// users from before issuers were stored have none
+	existingUser, err := database.CreateOIDCUser(context.Background(), DefaultOIDCProvider, "", "oidcId", "Stan", "")
+	assert.Nil(t, err)
+
+	dbUser, err := database.CreateOIDCUser(context.Background(), DefaultOIDCProvider, "https://id.example.com", "oidcId", "Stan", "")
+	assert.Nil(t, err)
+	assert.Equal(t, existingUser.ID, dbUser.ID)
+
+	identities, err := database.GetIdentities(context.Background(), existingUser.ID)
+	assert.Nil(t, err)
+	assert.Len(t, identities, 1)
+	assert.Equal(t, "https://id.example.com", identities[0].Issuer)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseCreateSAMLUser() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+	userName := "Stan"
+
+	dbUser, err := database.CreateSAMLUser(context.Background(), "samlId", userName, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, userName, dbUser.Name)
+	assert.Equal(t, common.TypeSAML, dbUser.AccountType)
+	assert.Nil(t, dbUser.KeyMigration)
+	assert.NotNil(t, dbUser.CreatedAt)
+	assert.Nil(t, dbUser.Avatar)
+}
+
 func (suite *DatabaseUserTestSuite) TestDatabaseUpdateUser() {
 	t := suite.T()
 	database := NewUserDatabase(suite.db)
@@ -252,6 +319,183 @@ func (suite *DatabaseUserTestSuite) TestDatabaseDeleteUser() {
 	assert.Nil(t, err)
 }
 
+func (suite *DatabaseUserTestSuite) TestDatabaseMergeUsers() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	githubUser, err := database.CreateGitHubUser(context.Background(), "githubMergeId", "Santa", "")
+	assert.Nil(t, err)
+
+	err = database.MergeUsers(context.Background(), suite.users["Santa"].ID, githubUser.ID)
+	assert.Nil(t, err)
+
+	_, err = database.GetUser(context.Background(), suite.users["Santa"].ID)
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	users, err := database.GetUsersByBoardID(context.Background(), suite.boards["Update"].id)
+	assert.Nil(t, err)
+
+	found := make(map[uuid.UUID]bool)
+	for _, u := range users {
+		found[u.ID] = true
+	}
+	assert.Len(t, users, 3)
+	assert.True(t, found[githubUser.ID], "expected the session to be moved")
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseMergeUsers_ExistingSession() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	err := database.MergeUsers(context.Background(), suite.users["Friend"].ID, suite.users["Stan"].ID)
+	assert.Nil(t, err)
+
+	var sessionRole string
+	err = suite.db.NewSelect().
+		Table("board_sessions").
+		Column("role").
+		Where("\"user\" = ?", suite.users["Stan"].ID).
+		Where("board = ?", suite.boards["Update"].id).
+		Scan(context.Background(), &sessionRole)
+	assert.Nil(t, err)
+	assert.Equal(t, string(role.OwnerRole), sessionRole)
+
+	users, err := database.GetUsersByBoardID(context.Background(), suite.boards["Update"].id)
+	assert.Nil(t, err)
+	assert.Len(t, users, 2)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseMergeUsers_KeepsBan() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	bannedUser, err := database.CreateGitHubUser(context.Background(), "githubBannedId", "Banned", "")
+	assert.Nil(t, err)
+	_, err = suite.db.NewUpdate().Table("users").Set("banned = true").Where("id = ?", bannedUser.ID).Exec(context.Background())
+	assert.Nil(t, err)
+	user, err := database.CreateMicrosoftUser(context.Background(), "microsoftBannedId", "Fresh", "")
+	assert.Nil(t, err)
+
+	err = database.MergeUsers(context.Background(), bannedUser.ID, user.ID)
+	assert.Nil(t, err)
+
+	mergedUser, err := database.GetUser(context.Background(), user.ID)
+	assert.Nil(t, err)
+	assert.True(t, mergedUser.Banned)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseMergeUsers_MovesIdentities() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	githubUser, err := database.CreateGitHubUser(context.Background(), "githubMergeId", "OldName", "")
+	assert.Nil(t, err)
+
+	err = database.MergeUsers(context.Background(), githubUser.ID, suite.users["ExistingGoogleUser"].ID)
+	assert.Nil(t, err)
+
+	identities, err := database.GetIdentities(context.Background(), suite.users["ExistingGoogleUser"].ID)
+	assert.Nil(t, err)
+	assert.Len(t, identities, 2)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseGetIdentities() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	identities, err := database.GetIdentities(context.Background(), suite.users["ExistingGoogleUser"].ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []DatabaseIdentity{
+		{User: suite.users["ExistingGoogleUser"].ID, AccountType: common.Google, Provider: "google", ID: "existingGoogleId", Name: "OldName"},
+	}, identities)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseGetIdentitiesAnonymous() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	identities, err := database.GetIdentities(context.Background(), suite.users["Santa"].ID)
+
+	assert.Nil(t, err)
+	assert.Empty(t, identities)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseAddIdentity() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+	userID := suite.users["ExistingGoogleUser"].ID
+
+	identity := DatabaseIdentity{User: userID, AccountType: common.TypeOIDC, Provider: "sales", Issuer: "https://sales.example.com", ID: "keycloakId", Name: "Stan"}
+	err := database.AddIdentity(context.Background(), identity)
+	assert.Nil(t, err)
+
+	identityUser, err := database.GetIdentityUser(context.Background(), identity)
+	assert.Nil(t, err)
+	assert.Equal(t, userID, identityUser)
+
+	// the user logs in with the identity from now on
+	dbUser, err := database.CreateOIDCUser(context.Background(), "sales", "https://sales.example.com", "keycloakId", "Stan", "")
+	assert.Nil(t, err)
+	assert.Equal(t, userID, dbUser.ID)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseAddIdentityOfLinkedProvider() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	err := database.AddIdentity(context.Background(), DatabaseIdentity{User: suite.users["ExistingGoogleUser"].ID, AccountType: common.Google, Provider: "google", ID: "otherGoogleId", Name: "Stan"})
+
+	assert.NotNil(t, err)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseGetIdentityUserNotFound() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	_, err := database.GetIdentityUser(context.Background(), DatabaseIdentity{AccountType: common.Google, Provider: "google", ID: "unknownGoogleId"})
+
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseRemoveIdentity() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+	userID := suite.users["ExistingGoogleUser"].ID
+
+	github := DatabaseIdentity{User: userID, AccountType: common.GitHub, Provider: "github", ID: "linkedGithubId", Name: "Stan"}
+	err := database.AddIdentity(context.Background(), github)
+	assert.Nil(t, err)
+
+	google := DatabaseIdentity{User: userID, AccountType: common.Google, Provider: "google", ID: "existingGoogleId"}
+	err = database.RemoveIdentity(context.Background(), google, common.GitHub)
+	assert.Nil(t, err)
+
+	identities, err := database.GetIdentities(context.Background(), userID)
+	assert.Nil(t, err)
+	assert.Len(t, identities, 1)
+	assert.Equal(t, common.GitHub, identities[0].AccountType)
+
+	dbUser, err := database.GetUser(context.Background(), userID)
+	assert.Nil(t, err)
+	assert.Equal(t, common.GitHub, dbUser.AccountType)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseSetAdmin() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	userId := suite.users["Update"].ID
+
+	err := database.SetAdmin(context.Background(), userId, true)
+	assert.Nil(t, err)
+
+	dbUser, err := database.GetUser(context.Background(), userId)
+	assert.Nil(t, err)
+	assert.True(t, dbUser.Admin)
+	assert.False(t, dbUser.Banned)
+}
+
 func (suite *DatabaseUserTestSuite) TestDatabaseGetUser() {
 	t := suite.T()
 	database := NewUserDatabase(suite.db)
@@ -301,6 +545,30 @@ func (suite *DatabaseUserTestSuite) TestDatabaseGetBoardUsersEmpty() {
 	assert.Nil(t, err)
 	assert.Empty(t, dbUser)
 }
+func (suite *DatabaseUserTestSuite) TestDatabaseGetBoardsWithUserData() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	// the user left the board, but the audit log still holds an action of the user
+	_, err := suite.db.NewRaw("INSERT INTO audit_log (board, actor, action) VALUES (?, ?, 'BOARD_UPDATED')", suite.boards["Former"].id, suite.users["Friend"].ID).Exec(context.Background())
+	assert.Nil(t, err)
+
+	boards, err := database.GetBoardsWithUserData(context.Background(), suite.users["Friend"].ID)
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{suite.boards["Update"].id, suite.boards["Former"].id}, boards)
+}
+
+func (suite *DatabaseUserTestSuite) TestDatabaseGetBoardsWithUserDataEmpty() {
+	t := suite.T()
+	database := NewUserDatabase(suite.db)
+
+	boards, err := database.GetBoardsWithUserData(context.Background(), suite.users["Delete"].ID)
+
+	assert.Nil(t, err)
+	assert.Empty(t, boards)
+}
+
 func (suite *DatabaseUserTestSuite) TestGetExistingUserIDs() {
 	t := suite.T()
 
@@ -468,6 +736,7 @@ func (suite *DatabaseUserTestSuite) seedData(db *bun.DB) {
 	// test boards
 	suite.boards = make(map[string]testBoard, 1)
 	suite.boards["Update"] = testBoard{id: uuid.New(), name: "Update"}
+	suite.boards["Former"] = testBoard{id: uuid.New(), name: "Former"}
 
 	// test sessions
 	suite.sessions = make(map[string]sessions.BoardSession, 1)
@@ -2,9 +2,20 @@ package users
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/boardtemplates"
 	"scrumlr.io/server/common"
+	"scrumlr.io/server/estimations"
+	"scrumlr.io/server/notes"
+	"scrumlr.io/server/reactions"
+	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
+	"scrumlr.io/server/votings"
 )
 
 // User is the response for all user requests.
@@ -51,3 +62,85 @@ func UserSlice(users []DatabaseUser) []*User {
 func (*User) Render(_ http.ResponseWriter, _ *http.Request) error {
 	return nil
 }
+
+// Identity is an account of an identity provider the user can log in with
+type Identity struct {
+	// The account type of the provider
+	AccountType common.AccountType `json:"accountType"`
+
+	// The name of the provider, which distinguishes the OIDC providers
+	Provider string `json:"provider"`
+
+	// The issuer of OIDC providers, which identifies the provider independent of its name
+	Issuer string `json:"-"`
+
+	// The identifier of the user at the provider
+	ID string `json:"-"`
+
+	// The name of the user at the provider
+	Name string `json:"name"`
+
+	// The avatar of the user at the provider
+	AvatarUrl string `json:"avatarUrl,omitempty"`
+}
+
+func (i *Identity) From(identity DatabaseIdentity) *Identity {
+	i.AccountType = identity.AccountType
+	i.Provider = identity.Provider
+	i.Issuer = identity.Issuer
+	i.ID = identity.ID
+	i.Name = identity.Name
+	i.AvatarUrl = identity.AvatarUrl
+	return i
+}
+
+func IdentitySlice(identities []DatabaseIdentity) []*Identity {
+	list := make([]*Identity, len(identities))
+	for index, identity := range identities {
+		list[index] = new(Identity).From(identity)
+	}
+	return list
+}
+
+// Export is all data stored about a user, as returned to an access request of the user.
+type Export struct {
+	// The profile of the user
+	User *User `json:"user"`
+
+	// The accounts of the identity providers the user can log in with
+	Identities []*ExportedIdentity `json:"identities"`
+
+	// The data of the user on every board, including boards the user no longer participates in
+	Boards []*BoardExport `json:"boards"`
+
+	// The board templates created by the user
+	Templates []*boardtemplates.BoardTemplateFull `json:"templates"`
+
+	// The teams the user is a member of, including the role of the user
+	Teams []*teams.Team `json:"teams"`
+
+	// The api tokens of the user, without their secrets
+	ApiTokens []*apitokens.ApiToken `json:"apiTokens"`
+
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+// ExportedIdentity is an identity including the identifier of the user at the provider.
+type ExportedIdentity struct {
+	Identity
+	ID string `json:"id"`
+}
+
+// BoardExport is the data of the user on a single board.
+// The session is empty if the user is no longer a participant of the board.
+type BoardExport struct {
+	Board         uuid.UUID                 `json:"board"`
+	Session       *sessions.BoardSession    `json:"session"`
+	Notes         []*notes.Note             `json:"notes"`
+	NoteRevisions []*notes.NoteRevision     `json:"noteRevisions"`
+	Votes         []*votings.Vote           `json:"votes"`
+	Reactions     []*reactions.Reaction     `json:"reactions"`
+	ActionItems   []*actionitems.ActionItem `json:"actionItems"`
+	Estimates     []*estimations.Estimate   `json:"estimates"`
+	AuditLog      []*audit.Entry            `json:"auditLog"`
+}
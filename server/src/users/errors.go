@@ -7,6 +7,7 @@ type UserErrorCategory string
 const (
 	NotFound   UserErrorCategory = "NOT_FOUND"
 	BadRequest UserErrorCategory = "BAD_REQUEST"
+	Forbidden  UserErrorCategory = "FORBIDDEN"
 	Internal   UserErrorCategory = "INTERNAL"
 )
 
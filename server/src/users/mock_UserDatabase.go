@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
+	"scrumlr.io/server/common"
 )
 
 // NewMockUserDatabase creates a new instance of MockUserDatabase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -38,6 +39,63 @@ func (_m *MockUserDatabase) EXPECT() *MockUserDatabase_Expecter {
 	return &MockUserDatabase_Expecter{mock: &_m.Mock}
 }
 
+// AddIdentity provides a mock function for the type MockUserDatabase
+func (_mock *MockUserDatabase) AddIdentity(ctx context.Context, identity DatabaseIdentity) error {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DatabaseIdentity) error); ok {
+		r0 = returnFunc(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDatabase_AddIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddIdentity'
+type MockUserDatabase_AddIdentity_Call struct {
+	*mock.Call
+}
+
+// AddIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity DatabaseIdentity
+func (_e *MockUserDatabase_Expecter) AddIdentity(ctx any, identity any) *MockUserDatabase_AddIdentity_Call {
+	return &MockUserDatabase_AddIdentity_Call{Call: _e.mock.On("AddIdentity", ctx, identity)}
+}
+
+func (_c *MockUserDatabase_AddIdentity_Call) Run(run func(ctx context.Context, identity DatabaseIdentity)) *MockUserDatabase_AddIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DatabaseIdentity
+		if args[1] != nil {
+			arg1 = args[1].(DatabaseIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDatabase_AddIdentity_Call) Return(err error) *MockUserDatabase_AddIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDatabase_AddIdentity_Call) RunAndReturn(run func(ctx context.Context, identity DatabaseIdentity) error) *MockUserDatabase_AddIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAnonymousUser provides a mock function for the type MockUserDatabase
 func (_mock *MockUserDatabase) CreateAnonymousUser(ctx context.Context, name string) (DatabaseUser, error) {
 	ret := _mock.Called(ctx, name)
@@ -495,13 +553,103 @@ func (_c *MockUserDatabase_CreateMicrosoftUser_Call) RunAndReturn(run func(ctx c
 }
 
 // CreateOIDCUser provides a mock function for the type MockUserDatabase
-func (_mock *MockUserDatabase) CreateOIDCUser(ctx context.Context, id string, name string, avatarUrl string) (DatabaseUser, error) {
-	ret := _mock.Called(ctx, id, name, avatarUrl)
+func (_mock *MockUserDatabase) CreateOIDCUser(ctx context.Context, provider string, issuer string, id string, name string, avatarUrl string) (DatabaseUser, error) {
+	ret := _mock.Called(ctx, provider, issuer, id, name, avatarUrl)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOIDCUser")
 	}
 
+	var r0 DatabaseUser
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (DatabaseUser, error)); ok {
+		return returnFunc(ctx, provider, issuer, id, name, avatarUrl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) DatabaseUser); ok {
+		r0 = returnFunc(ctx, provider, issuer, id, name, avatarUrl)
+	} else {
+		r0 = ret.Get(0).(DatabaseUser)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, issuer, id, name, avatarUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDatabase_CreateOIDCUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOIDCUser'
+type MockUserDatabase_CreateOIDCUser_Call struct {
+	*mock.Call
+}
+
+// CreateOIDCUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - issuer string
+//   - id string
+//   - name string
+//   - avatarUrl string
+func (_e *MockUserDatabase_Expecter) CreateOIDCUser(ctx any, provider any, issuer any, id any, name any, avatarUrl any) *MockUserDatabase_CreateOIDCUser_Call {
+	return &MockUserDatabase_CreateOIDCUser_Call{Call: _e.mock.On("CreateOIDCUser", ctx, provider, issuer, id, name, avatarUrl)}
+}
+
+func (_c *MockUserDatabase_CreateOIDCUser_Call) Run(run func(ctx context.Context, provider string, issuer string, id string, name string, avatarUrl string)) *MockUserDatabase_CreateOIDCUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDatabase_CreateOIDCUser_Call) Return(databaseUser DatabaseUser, err error) *MockUserDatabase_CreateOIDCUser_Call {
+	_c.Call.Return(databaseUser, err)
+	return _c
+}
+
+func (_c *MockUserDatabase_CreateOIDCUser_Call) RunAndReturn(run func(ctx context.Context, provider string, issuer string, id string, name string, avatarUrl string) (DatabaseUser, error)) *MockUserDatabase_CreateOIDCUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSAMLUser provides a mock function for the type MockUserDatabase
+func (_mock *MockUserDatabase) CreateSAMLUser(ctx context.Context, id string, name string, avatarUrl string) (DatabaseUser, error) {
+	ret := _mock.Called(ctx, id, name, avatarUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSAMLUser")
+	}
+
 	var r0 DatabaseUser
 	var r1 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (DatabaseUser, error)); ok {
@@ -520,21 +668,21 @@ func (_mock *MockUserDatabase) CreateOIDCUser(ctx context.Context, id string, na
 	return r0, r1
 }
 
-// MockUserDatabase_CreateOIDCUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOIDCUser'
-type MockUserDatabase_CreateOIDCUser_Call struct {
+// MockUserDatabase_CreateSAMLUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSAMLUser'
+type MockUserDatabase_CreateSAMLUser_Call struct {
 	*mock.Call
 }
 
-// CreateOIDCUser is a helper method to define mock.On call
+// CreateSAMLUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
 //   - name string
 //   - avatarUrl string
-func (_e *MockUserDatabase_Expecter) CreateOIDCUser(ctx any, id any, name any, avatarUrl any) *MockUserDatabase_CreateOIDCUser_Call {
-	return &MockUserDatabase_CreateOIDCUser_Call{Call: _e.mock.On("CreateOIDCUser", ctx, id, name, avatarUrl)}
+func (_e *MockUserDatabase_Expecter) CreateSAMLUser(ctx any, id any, name any, avatarUrl any) *MockUserDatabase_CreateSAMLUser_Call {
+	return &MockUserDatabase_CreateSAMLUser_Call{Call: _e.mock.On("CreateSAMLUser", ctx, id, name, avatarUrl)}
 }
 
-func (_c *MockUserDatabase_CreateOIDCUser_Call) Run(run func(ctx context.Context, id string, name string, avatarUrl string)) *MockUserDatabase_CreateOIDCUser_Call {
+func (_c *MockUserDatabase_CreateSAMLUser_Call) Run(run func(ctx context.Context, id string, name string, avatarUrl string)) *MockUserDatabase_CreateSAMLUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -562,12 +710,12 @@ func (_c *MockUserDatabase_CreateOIDCUser_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockUserDatabase_CreateOIDCUser_Call) Return(databaseUser DatabaseUser, err error) *MockUserDatabase_CreateOIDCUser_Call {
+func (_c *MockUserDatabase_CreateSAMLUser_Call) Return(databaseUser DatabaseUser, err error) *MockUserDatabase_CreateSAMLUser_Call {
 	_c.Call.Return(databaseUser, err)
 	return _c
 }
 
-func (_c *MockUserDatabase_CreateOIDCUser_Call) RunAndReturn(run func(ctx context.Context, id string, name string, avatarUrl string) (DatabaseUser, error)) *MockUserDatabase_CreateOIDCUser_Call {
+func (_c *MockUserDatabase_CreateSAMLUser_Call) RunAndReturn(run func(ctx context.Context, id string, name string, avatarUrl string) (DatabaseUser, error)) *MockUserDatabase_CreateSAMLUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -629,6 +777,74 @@ func (_c *MockUserDatabase_DeleteUser_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// GetBoardsWithUserData provides a mock function for the type MockUserDatabase
+func (_mock *MockUserDatabase) GetBoardsWithUserData(ctx context.Context, user uuid.UUID) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardsWithUserData")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDatabase_GetBoardsWithUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBoardsWithUserData'
+type MockUserDatabase_GetBoardsWithUserData_Call struct {
+	*mock.Call
+}
+
+// GetBoardsWithUserData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user uuid.UUID
+func (_e *MockUserDatabase_Expecter) GetBoardsWithUserData(ctx any, user any) *MockUserDatabase_GetBoardsWithUserData_Call {
+	return &MockUserDatabase_GetBoardsWithUserData_Call{Call: _e.mock.On("GetBoardsWithUserData", ctx, user)}
+}
+
+func (_c *MockUserDatabase_GetBoardsWithUserData_Call) Run(run func(ctx context.Context, user uuid.UUID)) *MockUserDatabase_GetBoardsWithUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDatabase_GetBoardsWithUserData_Call) Return(uUIDs []uuid.UUID, err error) *MockUserDatabase_GetBoardsWithUserData_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockUserDatabase_GetBoardsWithUserData_Call) RunAndReturn(run func(ctx context.Context, user uuid.UUID) ([]uuid.UUID, error)) *MockUserDatabase_GetBoardsWithUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExistingUserIDs provides a mock function for the type MockUserDatabase
 func (_mock *MockUserDatabase) GetExistingUserIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
 	ret := _mock.Called(ctx, ids)
@@ -697,6 +913,140 @@ func (_c *MockUserDatabase_GetExistingUserIDs_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// GetIdentities provides a mock function for the type MockUserDatabase
+func (_mock *MockUserDatabase) GetIdentities(ctx context.Context, user uuid.UUID) ([]DatabaseIdentity, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdentities")
+	}
+
+	var r0 []DatabaseIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]DatabaseIdentity, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []DatabaseIdentity); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DatabaseIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDatabase_GetIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdentities'
+type MockUserDatabase_GetIdentities_Call struct {
+	*mock.Call
+}
+
+// GetIdentities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user uuid.UUID
+func (_e *MockUserDatabase_Expecter) GetIdentities(ctx any, user any) *MockUserDatabase_GetIdentities_Call {
+	return &MockUserDatabase_GetIdentities_Call{Call: _e.mock.On("GetIdentities", ctx, user)}
+}
+
+func (_c *MockUserDatabase_GetIdentities_Call) Run(run func(ctx context.Context, user uuid.UUID)) *MockUserDatabase_GetIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDatabase_GetIdentities_Call) Return(databaseIdentitys []DatabaseIdentity, err error) *MockUserDatabase_GetIdentities_Call {
+	_c.Call.Return(databaseIdentitys, err)
+	return _c
+}
+
+func (_c *MockUserDatabase_GetIdentities_Call) RunAndReturn(run func(ctx context.Context, user uuid.UUID) ([]DatabaseIdentity, error)) *MockUserDatabase_GetIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIdentityUser provides a mock function for the type MockUserDatabase
+func (_mock *MockUserDatabase) GetIdentityUser(ctx context.Context, identity DatabaseIdentity) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdentityUser")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DatabaseIdentity) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, identity)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DatabaseIdentity) uuid.UUID); ok {
+		r0 = returnFunc(ctx, identity)
+	} else {
+		r0 = ret.Get(0).(uuid.UUID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, DatabaseIdentity) error); ok {
+		r1 = returnFunc(ctx, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDatabase_GetIdentityUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdentityUser'
+type MockUserDatabase_GetIdentityUser_Call struct {
+	*mock.Call
+}
+
+// GetIdentityUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity DatabaseIdentity
+func (_e *MockUserDatabase_Expecter) GetIdentityUser(ctx any, identity any) *MockUserDatabase_GetIdentityUser_Call {
+	return &MockUserDatabase_GetIdentityUser_Call{Call: _e.mock.On("GetIdentityUser", ctx, identity)}
+}
+
+func (_c *MockUserDatabase_GetIdentityUser_Call) Run(run func(ctx context.Context, identity DatabaseIdentity)) *MockUserDatabase_GetIdentityUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DatabaseIdentity
+		if args[1] != nil {
+			arg1 = args[1].(DatabaseIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDatabase_GetIdentityUser_Call) Return(uUID uuid.UUID, err error) *MockUserDatabase_GetIdentityUser_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *MockUserDatabase_GetIdentityUser_Call) RunAndReturn(run func(ctx context.Context, identity DatabaseIdentity) (uuid.UUID, error)) *MockUserDatabase_GetIdentityUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUser provides a mock function for the type MockUserDatabase
 func (_mock *MockUserDatabase) GetUser(ctx context.Context, id uuid.UUID) (DatabaseUser, error) {
 	ret := _mock.Called(ctx, id)
@@ -963,6 +1313,195 @@ func (_c *MockUserDatabase_IsUserAvailableForKeyMigration_Call) RunAndReturn(run
 	return _c
 }
 
+// MergeUsers provides a mock function for the type MockUserDatabase
+func (_mock *MockUserDatabase) MergeUsers(ctx context.Context, from uuid.UUID, into uuid.UUID) error {
+	ret := _mock.Called(ctx, from, into)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeUsers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, from, into)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDatabase_MergeUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeUsers'
+type MockUserDatabase_MergeUsers_Call struct {
+	*mock.Call
+}
+
+// MergeUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from uuid.UUID
+//   - into uuid.UUID
+func (_e *MockUserDatabase_Expecter) MergeUsers(ctx any, from any, into any) *MockUserDatabase_MergeUsers_Call {
+	return &MockUserDatabase_MergeUsers_Call{Call: _e.mock.On("MergeUsers", ctx, from, into)}
+}
+
+func (_c *MockUserDatabase_MergeUsers_Call) Run(run func(ctx context.Context, from uuid.UUID, into uuid.UUID)) *MockUserDatabase_MergeUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDatabase_MergeUsers_Call) Return(err error) *MockUserDatabase_MergeUsers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDatabase_MergeUsers_Call) RunAndReturn(run func(ctx context.Context, from uuid.UUID, into uuid.UUID) error) *MockUserDatabase_MergeUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveIdentity provides a mock function for the type MockUserDatabase
+func (_mock *MockUserDatabase) RemoveIdentity(ctx context.Context, identity DatabaseIdentity, accountType common.AccountType) error {
+	ret := _mock.Called(ctx, identity, accountType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DatabaseIdentity, common.AccountType) error); ok {
+		r0 = returnFunc(ctx, identity, accountType)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDatabase_RemoveIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveIdentity'
+type MockUserDatabase_RemoveIdentity_Call struct {
+	*mock.Call
+}
+
+// RemoveIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity DatabaseIdentity
+//   - accountType common.AccountType
+func (_e *MockUserDatabase_Expecter) RemoveIdentity(ctx any, identity any, accountType any) *MockUserDatabase_RemoveIdentity_Call {
+	return &MockUserDatabase_RemoveIdentity_Call{Call: _e.mock.On("RemoveIdentity", ctx, identity, accountType)}
+}
+
+func (_c *MockUserDatabase_RemoveIdentity_Call) Run(run func(ctx context.Context, identity DatabaseIdentity, accountType common.AccountType)) *MockUserDatabase_RemoveIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DatabaseIdentity
+		if args[1] != nil {
+			arg1 = args[1].(DatabaseIdentity)
+		}
+		var arg2 common.AccountType
+		if args[2] != nil {
+			arg2 = args[2].(common.AccountType)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDatabase_RemoveIdentity_Call) Return(err error) *MockUserDatabase_RemoveIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDatabase_RemoveIdentity_Call) RunAndReturn(run func(ctx context.Context, identity DatabaseIdentity, accountType common.AccountType) error) *MockUserDatabase_RemoveIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAdmin provides a mock function for the type MockUserDatabase
+func (_mock *MockUserDatabase) SetAdmin(ctx context.Context, id uuid.UUID, admin bool) error {
+	ret := _mock.Called(ctx, id, admin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAdmin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = returnFunc(ctx, id, admin)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDatabase_SetAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAdmin'
+type MockUserDatabase_SetAdmin_Call struct {
+	*mock.Call
+}
+
+// SetAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - admin bool
+func (_e *MockUserDatabase_Expecter) SetAdmin(ctx any, id any, admin any) *MockUserDatabase_SetAdmin_Call {
+	return &MockUserDatabase_SetAdmin_Call{Call: _e.mock.On("SetAdmin", ctx, id, admin)}
+}
+
+func (_c *MockUserDatabase_SetAdmin_Call) Run(run func(ctx context.Context, id uuid.UUID, admin bool)) *MockUserDatabase_SetAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDatabase_SetAdmin_Call) Return(err error) *MockUserDatabase_SetAdmin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDatabase_SetAdmin_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, admin bool) error) *MockUserDatabase_SetAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetKeyMigration provides a mock function for the type MockUserDatabase
 func (_mock *MockUserDatabase) SetKeyMigration(ctx context.Context, id uuid.UUID) (DatabaseUser, error) {
 	ret := _mock.Called(ctx, id)
@@ -125,6 +125,98 @@ func (_c *MockUserService_Create_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// CreateOIDCUser provides a mock function for the type MockUserService
+func (_mock *MockUserService) CreateOIDCUser(ctx context.Context, provider string, issuer string, id string, name string, avatarUrl string) (*User, error) {
+	ret := _mock.Called(ctx, provider, issuer, id, name, avatarUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOIDCUser")
+	}
+
+	var r0 *User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (*User, error)); ok {
+		return returnFunc(ctx, provider, issuer, id, name, avatarUrl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) *User); ok {
+		r0 = returnFunc(ctx, provider, issuer, id, name, avatarUrl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, issuer, id, name, avatarUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_CreateOIDCUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOIDCUser'
+type MockUserService_CreateOIDCUser_Call struct {
+	*mock.Call
+}
+
+// CreateOIDCUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - issuer string
+//   - id string
+//   - name string
+//   - avatarUrl string
+func (_e *MockUserService_Expecter) CreateOIDCUser(ctx any, provider any, issuer any, id any, name any, avatarUrl any) *MockUserService_CreateOIDCUser_Call {
+	return &MockUserService_CreateOIDCUser_Call{Call: _e.mock.On("CreateOIDCUser", ctx, provider, issuer, id, name, avatarUrl)}
+}
+
+func (_c *MockUserService_CreateOIDCUser_Call) Run(run func(ctx context.Context, provider string, issuer string, id string, name string, avatarUrl string)) *MockUserService_CreateOIDCUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_CreateOIDCUser_Call) Return(user *User, err error) *MockUserService_CreateOIDCUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_CreateOIDCUser_Call) RunAndReturn(run func(ctx context.Context, provider string, issuer string, id string, name string, avatarUrl string) (*User, error)) *MockUserService_CreateOIDCUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type MockUserService
 func (_mock *MockUserService) Delete(ctx context.Context, id uuid.UUID) error {
 	ret := _mock.Called(ctx, id)
@@ -182,6 +274,74 @@ func (_c *MockUserService_Delete_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// Export provides a mock function for the type MockUserService
+func (_mock *MockUserService) Export(ctx context.Context, id uuid.UUID) (*Export, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 *Export
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Export, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Export); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Export)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockUserService_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockUserService_Expecter) Export(ctx any, id any) *MockUserService_Export_Call {
+	return &MockUserService_Export_Call{Call: _e.mock.On("Export", ctx, id)}
+}
+
+func (_c *MockUserService_Export_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockUserService_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Export_Call) Return(export *Export, err error) *MockUserService_Export_Call {
+	_c.Call.Return(export, err)
+	return _c
+}
+
+func (_c *MockUserService_Export_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*Export, error)) *MockUserService_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockUserService
 func (_mock *MockUserService) Get(ctx context.Context, id uuid.UUID) (*User, error) {
 	ret := _mock.Called(ctx, id)
@@ -386,6 +546,74 @@ func (_c *MockUserService_GetExistingUserIDs_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetIdentities provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetIdentities(ctx context.Context, user uuid.UUID) ([]*Identity, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdentities")
+	}
+
+	var r0 []*Identity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*Identity, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*Identity); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Identity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_GetIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdentities'
+type MockUserService_GetIdentities_Call struct {
+	*mock.Call
+}
+
+// GetIdentities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user uuid.UUID
+func (_e *MockUserService_Expecter) GetIdentities(ctx any, user any) *MockUserService_GetIdentities_Call {
+	return &MockUserService_GetIdentities_Call{Call: _e.mock.On("GetIdentities", ctx, user)}
+}
+
+func (_c *MockUserService_GetIdentities_Call) Run(run func(ctx context.Context, user uuid.UUID)) *MockUserService_GetIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetIdentities_Call) Return(identitys []*Identity, err error) *MockUserService_GetIdentities_Call {
+	_c.Call.Return(identitys, err)
+	return _c
+}
+
+func (_c *MockUserService_GetIdentities_Call) RunAndReturn(run func(ctx context.Context, user uuid.UUID) ([]*Identity, error)) *MockUserService_GetIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsUserAvailableForKeyMigration provides a mock function for the type MockUserService
 func (_mock *MockUserService) IsUserAvailableForKeyMigration(ctx context.Context, id uuid.UUID) (bool, error) {
 	ret := _mock.Called(ctx, id)
@@ -452,6 +680,217 @@ func (_c *MockUserService_IsUserAvailableForKeyMigration_Call) RunAndReturn(run
 	return _c
 }
 
+// LinkIdentity provides a mock function for the type MockUserService
+func (_mock *MockUserService) LinkIdentity(ctx context.Context, user uuid.UUID, identity Identity) (*User, error) {
+	ret := _mock.Called(ctx, user, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkIdentity")
+	}
+
+	var r0 *User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, Identity) (*User, error)); ok {
+		return returnFunc(ctx, user, identity)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, Identity) *User); ok {
+		r0 = returnFunc(ctx, user, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, Identity) error); ok {
+		r1 = returnFunc(ctx, user, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_LinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkIdentity'
+type MockUserService_LinkIdentity_Call struct {
+	*mock.Call
+}
+
+// LinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user uuid.UUID
+//   - identity Identity
+func (_e *MockUserService_Expecter) LinkIdentity(ctx any, user any, identity any) *MockUserService_LinkIdentity_Call {
+	return &MockUserService_LinkIdentity_Call{Call: _e.mock.On("LinkIdentity", ctx, user, identity)}
+}
+
+func (_c *MockUserService_LinkIdentity_Call) Run(run func(ctx context.Context, user uuid.UUID, identity Identity)) *MockUserService_LinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 Identity
+		if args[2] != nil {
+			arg2 = args[2].(Identity)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_LinkIdentity_Call) Return(user *User, err error) *MockUserService_LinkIdentity_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_LinkIdentity_Call) RunAndReturn(run func(ctx context.Context, user uuid.UUID, identity Identity) (*User, error)) *MockUserService_LinkIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeAnonymousUser provides a mock function for the type MockUserService
+func (_mock *MockUserService) MergeAnonymousUser(ctx context.Context, anonymousUser uuid.UUID, user uuid.UUID) (*User, error) {
+	ret := _mock.Called(ctx, anonymousUser, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeAnonymousUser")
+	}
+
+	var r0 *User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*User, error)); ok {
+		return returnFunc(ctx, anonymousUser, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *User); ok {
+		r0 = returnFunc(ctx, anonymousUser, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, anonymousUser, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_MergeAnonymousUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeAnonymousUser'
+type MockUserService_MergeAnonymousUser_Call struct {
+	*mock.Call
+}
+
+// MergeAnonymousUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anonymousUser uuid.UUID
+//   - user uuid.UUID
+func (_e *MockUserService_Expecter) MergeAnonymousUser(ctx any, anonymousUser any, user any) *MockUserService_MergeAnonymousUser_Call {
+	return &MockUserService_MergeAnonymousUser_Call{Call: _e.mock.On("MergeAnonymousUser", ctx, anonymousUser, user)}
+}
+
+func (_c *MockUserService_MergeAnonymousUser_Call) Run(run func(ctx context.Context, anonymousUser uuid.UUID, user uuid.UUID)) *MockUserService_MergeAnonymousUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_MergeAnonymousUser_Call) Return(user *User, err error) *MockUserService_MergeAnonymousUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_MergeAnonymousUser_Call) RunAndReturn(run func(ctx context.Context, anonymousUser uuid.UUID, user uuid.UUID) (*User, error)) *MockUserService_MergeAnonymousUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAdmin provides a mock function for the type MockUserService
+func (_mock *MockUserService) SetAdmin(ctx context.Context, id uuid.UUID, admin bool) error {
+	ret := _mock.Called(ctx, id, admin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAdmin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = returnFunc(ctx, id, admin)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_SetAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAdmin'
+type MockUserService_SetAdmin_Call struct {
+	*mock.Call
+}
+
+// SetAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - admin bool
+func (_e *MockUserService_Expecter) SetAdmin(ctx any, id any, admin any) *MockUserService_SetAdmin_Call {
+	return &MockUserService_SetAdmin_Call{Call: _e.mock.On("SetAdmin", ctx, id, admin)}
+}
+
+func (_c *MockUserService_SetAdmin_Call) Run(run func(ctx context.Context, id uuid.UUID, admin bool)) *MockUserService_SetAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_SetAdmin_Call) Return(err error) *MockUserService_SetAdmin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_SetAdmin_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, admin bool) error) *MockUserService_SetAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetKeyMigration provides a mock function for the type MockUserService
 func (_mock *MockUserService) SetKeyMigration(ctx context.Context, id uuid.UUID) (*User, error) {
 	ret := _mock.Called(ctx, id)
@@ -520,6 +959,69 @@ func (_c *MockUserService_SetKeyMigration_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// UnlinkIdentity provides a mock function for the type MockUserService
+func (_mock *MockUserService) UnlinkIdentity(ctx context.Context, user uuid.UUID, provider string) error {
+	ret := _mock.Called(ctx, user, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, user, provider)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_UnlinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkIdentity'
+type MockUserService_UnlinkIdentity_Call struct {
+	*mock.Call
+}
+
+// UnlinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user uuid.UUID
+//   - provider string
+func (_e *MockUserService_Expecter) UnlinkIdentity(ctx any, user any, provider any) *MockUserService_UnlinkIdentity_Call {
+	return &MockUserService_UnlinkIdentity_Call{Call: _e.mock.On("UnlinkIdentity", ctx, user, provider)}
+}
+
+func (_c *MockUserService_UnlinkIdentity_Call) Run(run func(ctx context.Context, user uuid.UUID, provider string)) *MockUserService_UnlinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_UnlinkIdentity_Call) Return(err error) *MockUserService_UnlinkIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_UnlinkIdentity_Call) RunAndReturn(run func(ctx context.Context, user uuid.UUID, provider string) error) *MockUserService_UnlinkIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockUserService
 func (_mock *MockUserService) Update(ctx context.Context, body UserUpdateRequest) (*User, error) {
 	ret := _mock.Called(ctx, body)
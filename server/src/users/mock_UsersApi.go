@@ -242,6 +242,98 @@ func (_c *MockUsersApi_Delete_Call) RunAndReturn(run func(w http.ResponseWriter,
 	return _c
 }
 
+// Export provides a mock function for the type MockUsersApi
+func (_mock *MockUsersApi) Export(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockUsersApi_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockUsersApi_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockUsersApi_Expecter) Export(w any, r any) *MockUsersApi_Export_Call {
+	return &MockUsersApi_Export_Call{Call: _e.mock.On("Export", w, r)}
+}
+
+func (_c *MockUsersApi_Export_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockUsersApi_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsersApi_Export_Call) Return() *MockUsersApi_Export_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUsersApi_Export_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockUsersApi_Export_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetIdentities provides a mock function for the type MockUsersApi
+func (_mock *MockUsersApi) GetIdentities(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockUsersApi_GetIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdentities'
+type MockUsersApi_GetIdentities_Call struct {
+	*mock.Call
+}
+
+// GetIdentities is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockUsersApi_Expecter) GetIdentities(w any, r any) *MockUsersApi_GetIdentities_Call {
+	return &MockUsersApi_GetIdentities_Call{Call: _e.mock.On("GetIdentities", w, r)}
+}
+
+func (_c *MockUsersApi_GetIdentities_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockUsersApi_GetIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsersApi_GetIdentities_Call) Return() *MockUsersApi_GetIdentities_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUsersApi_GetIdentities_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockUsersApi_GetIdentities_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetUser provides a mock function for the type MockUsersApi
 func (_mock *MockUsersApi) GetUser(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
@@ -380,6 +472,98 @@ func (_c *MockUsersApi_GetUsersFromBoard_Call) RunAndReturn(run func(w http.Resp
 	return _c
 }
 
+// RevokeSessions provides a mock function for the type MockUsersApi
+func (_mock *MockUsersApi) RevokeSessions(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockUsersApi_RevokeSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSessions'
+type MockUsersApi_RevokeSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeSessions is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockUsersApi_Expecter) RevokeSessions(w any, r any) *MockUsersApi_RevokeSessions_Call {
+	return &MockUsersApi_RevokeSessions_Call{Call: _e.mock.On("RevokeSessions", w, r)}
+}
+
+func (_c *MockUsersApi_RevokeSessions_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockUsersApi_RevokeSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsersApi_RevokeSessions_Call) Return() *MockUsersApi_RevokeSessions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUsersApi_RevokeSessions_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockUsersApi_RevokeSessions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UnlinkIdentity provides a mock function for the type MockUsersApi
+func (_mock *MockUsersApi) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockUsersApi_UnlinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkIdentity'
+type MockUsersApi_UnlinkIdentity_Call struct {
+	*mock.Call
+}
+
+// UnlinkIdentity is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockUsersApi_Expecter) UnlinkIdentity(w any, r any) *MockUsersApi_UnlinkIdentity_Call {
+	return &MockUsersApi_UnlinkIdentity_Call{Call: _e.mock.On("UnlinkIdentity", w, r)}
+}
+
+func (_c *MockUsersApi_UnlinkIdentity_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockUsersApi_UnlinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsersApi_UnlinkIdentity_Call) Return() *MockUsersApi_UnlinkIdentity_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUsersApi_UnlinkIdentity_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockUsersApi_UnlinkIdentity_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockUsersApi
 func (_mock *MockUsersApi) Update(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
@@ -50,8 +50,38 @@ var oicdUserCreatedCounter, _ = meter.Int64Counter(
 	metric.WithUnit("users"),
 )
 
+var samlUserCreatedCounter, _ = meter.Int64Counter(
+	"scrumlr.users.saml.created.counter",
+	metric.WithDescription("Number of saml users created"),
+	metric.WithUnit("users"),
+)
+
+var mergedUserCounter, _ = meter.Int64Counter(
+	"scrumlr.users.merged.counter",
+	metric.WithDescription("Number of anonymous users merged into users of an identity provider"),
+	metric.WithUnit("users"),
+)
+
+var linkedIdentityCounter, _ = meter.Int64Counter(
+	"scrumlr.users.identities.linked.counter",
+	metric.WithDescription("Number of accounts of identity providers linked to users"),
+	metric.WithUnit("identities"),
+)
+
+var unlinkedIdentityCounter, _ = meter.Int64Counter(
+	"scrumlr.users.identities.unlinked.counter",
+	metric.WithDescription("Number of accounts of identity providers unlinked from users"),
+	metric.WithUnit("identities"),
+)
+
 var deletedUserCounter, _ = meter.Int64Counter(
 	"scrumlr.users.deleted.counter",
 	metric.WithDescription("Number of deleted users"),
 	metric.WithUnit("users"),
 )
+
+var userExportedCounter, _ = meter.Int64Counter(
+	"scrumlr.users.exported.counter",
+	metric.WithDescription("Number of exports of all data of users"),
+	metric.WithUnit("exports"),
+)
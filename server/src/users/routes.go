@@ -13,6 +13,10 @@ type UsersApi interface {
 	GetUsersFromBoard(w http.ResponseWriter, r *http.Request)
 	Update(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request)
+	Export(w http.ResponseWriter, r *http.Request)
+	RevokeSessions(w http.ResponseWriter, r *http.Request)
+	GetIdentities(w http.ResponseWriter, r *http.Request)
+	UnlinkIdentity(w http.ResponseWriter, r *http.Request)
 
 	isAccountOwner(next http.Handler) http.Handler
 	BoardAuthenticatedContext(next http.Handler) http.Handler
@@ -35,9 +39,13 @@ func (r *Router) RegisterRoutes() chi.Router {
 	router := chi.NewRouter()
 	router.Route("/users", func(router chi.Router) {
 		router.Get("/", r.usersApi.GetUser)
+		router.Get("/identities", r.usersApi.GetIdentities)
+		router.Delete("/identities/{provider}", r.usersApi.UnlinkIdentity)
 		router.Get("/{user}", r.usersApi.GetUserByID)
 		router.Put("/", r.usersApi.Update)
 		router.With(r.usersApi.isAccountOwner).Delete("/{user}", r.usersApi.Delete)
+		router.With(r.usersApi.isAccountOwner).Get("/{user}/export", r.usersApi.Export)
+		router.With(r.usersApi.isAccountOwner).Delete("/{user}/sessions", r.usersApi.RevokeSessions)
 		router.With(r.sessionApi.BoardParticipantContext).Get("/board/{id}", r.usersApi.GetUsersFromBoard)
 	})
 	return router
@@ -4,16 +4,25 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-
+	"slices"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/boardtemplates"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/notes"
+	"scrumlr.io/server/reactions"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
+	"scrumlr.io/server/votings"
 
 	"github.com/google/uuid"
 	"scrumlr.io/server/common"
@@ -21,6 +30,9 @@ import (
 	"scrumlr.io/server/realtime"
 )
 
+// DefaultOIDCProvider is the name of the OIDC provider configured by the auth-oidc-* flags
+const DefaultOIDCProvider = "oidc"
+
 var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/users")
 var meter metric.Meter = otel.Meter("scrumlr.io/server/users")
 
@@ -31,12 +43,21 @@ type UserDatabase interface {
 	CreateGitHubUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error)
 	CreateGoogleUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error)
 	CreateMicrosoftUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error)
-	CreateOIDCUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error)
+	CreateOIDCUser(ctx context.Context, provider, issuer, id, name, avatarUrl string) (DatabaseUser, error)
+	CreateSAMLUser(ctx context.Context, id, name, avatarUrl string) (DatabaseUser, error)
 	UpdateUser(ctx context.Context, update DatabaseUserUpdate) (DatabaseUser, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	MergeUsers(ctx context.Context, from, into uuid.UUID) error
+	GetIdentities(ctx context.Context, user uuid.UUID) ([]DatabaseIdentity, error)
+	GetIdentityUser(ctx context.Context, identity DatabaseIdentity) (uuid.UUID, error)
+	AddIdentity(ctx context.Context, identity DatabaseIdentity) error
+	RemoveIdentity(ctx context.Context, identity DatabaseIdentity, accountType common.AccountType) error
 	GetUser(ctx context.Context, id uuid.UUID) (DatabaseUser, error)
 	GetUsersByBoardID(ctx context.Context, boardID uuid.UUID) ([]DatabaseUser, error)
 	GetExistingUserIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+	GetBoardsWithUserData(ctx context.Context, user uuid.UUID) ([]uuid.UUID, error)
+
+	SetAdmin(ctx context.Context, id uuid.UUID, admin bool) error
 
 	IsUserAnonymous(ctx context.Context, id uuid.UUID) (bool, error)
 	IsUserAvailableForKeyMigration(ctx context.Context, id uuid.UUID) (bool, error)
@@ -44,18 +65,34 @@ type UserDatabase interface {
 }
 
 type Service struct {
-	database       UserDatabase
-	sessionService sessions.SessionService
-	realtime       *realtime.Broker
-	notesService   notes.NotesService
+	database             UserDatabase
+	sessionService       sessions.SessionService
+	realtime             *realtime.Broker
+	notesService         notes.NotesService
+	votingService        votings.VotingService
+	reactionService      reactions.ReactionService
+	boardTemplateService boardtemplates.BoardTemplateService
+	actionItemService    actionitems.ActionItemService
+	estimationService    estimations.EstimationService
+	teamService          teams.TeamService
+	apiTokenService      apitokens.ApiTokenService
+	auditService         audit.AuditService
 }
 
-func NewUserService(db UserDatabase, rt *realtime.Broker, sessionService sessions.SessionService, notesService notes.NotesService) UserService {
+func NewUserService(db UserDatabase, rt *realtime.Broker, sessionService sessions.SessionService, notesService notes.NotesService, votingService votings.VotingService, reactionService reactions.ReactionService, boardTemplateService boardtemplates.BoardTemplateService, actionItemService actionitems.ActionItemService, estimationService estimations.EstimationService, teamService teams.TeamService, apiTokenService apitokens.ApiTokenService, auditService audit.AuditService) UserService {
 	service := new(Service)
 	service.database = db
 	service.realtime = rt
 	service.sessionService = sessionService
 	service.notesService = notesService
+	service.votingService = votingService
+	service.reactionService = reactionService
+	service.boardTemplateService = boardTemplateService
+	service.actionItemService = actionItemService
+	service.estimationService = estimationService
+	service.teamService = teamService
+	service.apiTokenService = apiTokenService
+	service.auditService = auditService
 
 	return service
 }
@@ -100,7 +137,10 @@ func (service *Service) Create(ctx context.Context, id, name, avatarUrl string,
 		user, err = service.database.CreateMicrosoftUser(ctx, id, name, avatarUrl)
 	case common.TypeOIDC:
 		specificCounter = oicdUserCreatedCounter
-		user, err = service.database.CreateOIDCUser(ctx, id, name, avatarUrl)
+		user, err = service.database.CreateOIDCUser(ctx, DefaultOIDCProvider, "", id, name, avatarUrl)
+	case common.TypeSAML:
+		specificCounter = samlUserCreatedCounter
+		user, err = service.database.CreateSAMLUser(ctx, id, name, avatarUrl)
 	default:
 		return nil, CreateUserError(BadRequest, "invalid account type", errors.New("invalid account type"))
 	}
@@ -111,12 +151,54 @@ func (service *Service) Create(ctx context.Context, id, name, avatarUrl string,
 		return nil, CreateUserError(Internal, "failed to create user", err)
 	}
 
+	if user.Banned {
+		span.SetStatus(codes.Error, "user is banned")
+		return nil, CreateUserError(Forbidden, "user is banned", errors.New("user is banned"))
+	}
+
 	userCreatedCounter.Add(ctx, 1)
 	specificCounter.Add(ctx, 1)
 
 	return new(User).From(user), nil
 }
 
+// CreateOIDCUser creates the user of the named OIDC provider, unless the provider already knows the user.
+// The users are kept apart by the issuer of the provider, so the same identifier of different issuers belongs to
+// different users, while a renamed provider keeps its users.
+func (service *Service) CreateOIDCUser(ctx context.Context, provider, issuer, id, name, avatarUrl string) (*User, error) {
+	ctx, span := tracer.Start(ctx, "scrumlr.users.service.create.oidc")
+	defer span.End()
+
+	if err := validateUsername(name); err != nil {
+		span.SetStatus(codes.Error, "failed to validate user name")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.service.create.oidc.provider", provider),
+		attribute.String("scrumlr.users.service.create.oidc.issuer", issuer),
+		attribute.String("scrumlr.users.service.create.oidc.name", name),
+	)
+
+	user, err := service.database.CreateOIDCUser(ctx, provider, issuer, id, name, avatarUrl)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to create user")
+		span.RecordError(err)
+		return nil, CreateUserError(Internal, "failed to create user", err)
+	}
+
+	if user.Banned {
+		span.SetStatus(codes.Error, "user is banned")
+		return nil, CreateUserError(Forbidden, "user is banned", errors.New("user is banned"))
+	}
+
+	userCreatedCounter.Add(ctx, 1)
+	oicdUserCreatedCounter.Add(ctx, 1)
+
+	return new(User).From(user), nil
+}
+
 func (service *Service) Get(ctx context.Context, userID uuid.UUID) (*User, error) {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.users.service.get")
@@ -216,6 +298,173 @@ func (service *Service) Update(ctx context.Context, body UserUpdateRequest) (*Us
 	return new(User).From(user), err
 }
 
+// Export collects all data stored about the user to answer an access request of the user.
+// Feedback is not part of the export, since it is only forwarded to the feedback webhook and not stored.
+func (service *Service) Export(ctx context.Context, id uuid.UUID) (*Export, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.users.service.export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.service.export.id", id.String()),
+	)
+
+	user, err := service.Get(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get user")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	identities, err := service.database.GetIdentities(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get identities")
+		span.RecordError(err)
+		log.Errorw("unable to get identities", "user", id, "err", err)
+		return nil, CreateUserError(Internal, "failed to get identities", err)
+	}
+
+	export := &Export{
+		User:       user,
+		Identities: make([]*ExportedIdentity, 0, len(identities)),
+		Boards:     []*BoardExport{},
+		Templates:  []*boardtemplates.BoardTemplateFull{},
+		Teams:      []*teams.Team{},
+		ApiTokens:  []*apitokens.ApiToken{},
+		ExportedAt: time.Now(),
+	}
+	for _, identity := range identities {
+		export.Identities = append(export.Identities, &ExportedIdentity{Identity: *new(Identity).From(identity), ID: identity.ID})
+	}
+
+	userSessions, err := service.sessionService.GetUserBoardSessions(ctx, id, false)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get user boards")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sessionsByBoard := make(map[uuid.UUID]*sessions.BoardSession, len(userSessions))
+	for _, session := range userSessions {
+		sessionsByBoard[session.Board] = session
+	}
+
+	// the user may have left boards or been removed from them while their data stays on the board
+	boards, err := service.database.GetBoardsWithUserData(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get boards with user data")
+		span.RecordError(err)
+		log.Errorw("unable to get boards with data of user", "user", id, "err", err)
+		return nil, CreateUserError(Internal, "failed to get boards of user", err)
+	}
+
+	for _, board := range boards {
+		boardExport, err := service.exportBoard(ctx, id, board, sessionsByBoard[board])
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to export board")
+			span.RecordError(err)
+			log.Errorw("unable to export board of user", "board", board, "user", id, "err", err)
+			return nil, err
+		}
+		export.Boards = append(export.Boards, boardExport)
+	}
+
+	userTeams, err := service.teamService.GetAll(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get teams")
+		span.RecordError(err)
+		return nil, err
+	}
+	export.Teams = append(export.Teams, userTeams...)
+
+	tokens, err := service.apiTokenService.GetAll(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get api tokens")
+		span.RecordError(err)
+		return nil, err
+	}
+	export.ApiTokens = append(export.ApiTokens, tokens...)
+
+	// the templates of teams the user is a member of are only included if the user created them
+	templates, err := service.boardTemplateService.GetAll(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get board templates")
+		span.RecordError(err)
+		return nil, err
+	}
+	for _, template := range templates {
+		if template.Template.Creator == id {
+			export.Templates = append(export.Templates, template)
+		}
+	}
+
+	userExportedCounter.Add(ctx, 1)
+	return export, nil
+}
+
+func (service *Service) exportBoard(ctx context.Context, user, board uuid.UUID, session *sessions.BoardSession) (*BoardExport, error) {
+	boardNotes, err := service.notesService.GetByUserAndBoard(ctx, user, board)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := service.notesService.GetRevisionsByAuthor(ctx, board, user)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := service.votingService.GetVotes(ctx, board, votings.VoteFilter{User: &user})
+	if err != nil {
+		return nil, err
+	}
+
+	boardReactions, err := service.reactionService.GetAll(ctx, board)
+	if err != nil {
+		return nil, err
+	}
+
+	userReactions := make([]*reactions.Reaction, 0)
+	for _, reaction := range boardReactions {
+		if reaction.User == user {
+			userReactions = append(userReactions, reaction)
+		}
+	}
+
+	boardActionItems, err := service.actionItemService.GetAll(ctx, board)
+	if err != nil {
+		return nil, err
+	}
+
+	assignedActionItems := make([]*actionitems.ActionItem, 0)
+	for _, item := range boardActionItems {
+		if item.Assignee.Valid && item.Assignee.UUID == user {
+			assignedActionItems = append(assignedActionItems, item)
+		}
+	}
+
+	estimates, err := service.estimationService.GetEstimatesByUser(ctx, board, user)
+	if err != nil {
+		return nil, err
+	}
+
+	auditEntries, err := service.auditService.GetAllByActor(ctx, board, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoardExport{
+		Board:         board,
+		Session:       session,
+		Notes:         boardNotes,
+		NoteRevisions: revisions,
+		Votes:         votes,
+		Reactions:     userReactions,
+		ActionItems:   assignedActionItems,
+		Estimates:     estimates,
+		AuditLog:      auditEntries,
+	}, nil
+}
+
 func (service *Service) Delete(ctx context.Context, id uuid.UUID) error {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.users.service.delete")
@@ -252,6 +501,314 @@ func (service *Service) Delete(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+// MergeAnonymousUser moves everything the anonymous user contributed to the other user and deletes the anonymous user.
+// This way users keep their boards and templates if they log in with an identity provider later on.
+func (service *Service) MergeAnonymousUser(ctx context.Context, anonymousUser, user uuid.UUID) (*User, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.users.service.merge_anonymous")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.service.merge_anonymous.anonymous", anonymousUser.String()),
+		attribute.String("scrumlr.users.service.merge_anonymous.id", user.String()),
+	)
+
+	if anonymousUser == user {
+		span.SetStatus(codes.Error, "user can not be merged into itself")
+		return nil, CreateUserError(BadRequest, "user can not be merged into itself", errors.New("user can not be merged into itself"))
+	}
+
+	isAnonymous, err := service.database.IsUserAnonymous(ctx, anonymousUser)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to check account type")
+		span.RecordError(err)
+		log.Errorw("unable to check account type", "user", anonymousUser, "err", err)
+		return nil, CreateUserError(Internal, "failed to merge user", err)
+	}
+
+	if !isAnonymous {
+		span.SetStatus(codes.Error, "only anonymous users can be merged")
+		return nil, CreateUserError(BadRequest, "only anonymous users can be merged", errors.New("only anonymous users can be merged"))
+	}
+
+	// the ban of the anonymous user must not be lifted by merging it into the other user
+	dbAnonymousUser, err := service.database.GetUser(ctx, anonymousUser)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get anonymous user")
+		span.RecordError(err)
+		log.Errorw("unable to get anonymous user", "user", anonymousUser, "err", err)
+		return nil, CreateUserError(Internal, "failed to merge user", err)
+	}
+
+	if dbAnonymousUser.Banned {
+		span.SetStatus(codes.Error, "anonymous user is banned")
+		return nil, CreateUserError(Forbidden, "user is banned", errors.New("anonymous user is banned"))
+	}
+
+	if err := service.database.MergeUsers(ctx, anonymousUser, user); err != nil {
+		span.SetStatus(codes.Error, "failed to merge users")
+		span.RecordError(err)
+		log.Errorw("unable to merge users", "anonymous", anonymousUser, "user", user, "err", err)
+		return nil, CreateUserError(Internal, "failed to merge user", err)
+	}
+
+	mergedUser, err := service.database.GetUser(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get merged user")
+		span.RecordError(err)
+		return nil, CreateUserError(Internal, "failed to get merged user", err)
+	}
+
+	mergedUserCounter.Add(ctx, 1)
+	service.updatedUser(ctx, mergedUser)
+
+	return new(User).From(mergedUser), nil
+}
+
+// GetIdentities returns the accounts of the identity providers the user can log in with
+func (service *Service) GetIdentities(ctx context.Context, user uuid.UUID) ([]*Identity, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.users.service.identities.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.service.identities.get.id", user.String()),
+	)
+
+	identities, err := service.database.GetIdentities(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get identities")
+		span.RecordError(err)
+		log.Errorw("unable to get identities", "user", user, "err", err)
+		return nil, CreateUserError(Internal, "failed to get identities", err)
+	}
+
+	return IdentitySlice(identities), nil
+}
+
+// LinkIdentity adds the account of an identity provider to the user, so that the user can log in with either provider.
+// If the account already belongs to another user, that user is merged into this one.
+// Only one account of every provider can be linked.
+func (service *Service) LinkIdentity(ctx context.Context, user uuid.UUID, identity Identity) (*User, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.users.service.identities.link")
+	defer span.End()
+
+	if identity.AccountType == common.TypeOIDC {
+		if identity.Provider == "" {
+			identity.Provider = DefaultOIDCProvider
+		}
+	} else {
+		identity.Provider = strings.ToLower(string(identity.AccountType))
+	}
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.service.identities.link.id", user.String()),
+		attribute.String("scrumlr.users.service.identities.link.type", string(identity.AccountType)),
+		attribute.String("scrumlr.users.service.identities.link.provider", identity.Provider),
+	)
+
+	if identity.AccountType == common.Anonymous {
+		span.SetStatus(codes.Error, "invalid account type")
+		return nil, CreateUserError(BadRequest, "invalid account type", errors.New("invalid account type"))
+	}
+
+	isAnonymous, err := service.database.IsUserAnonymous(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to check account type")
+		span.RecordError(err)
+		log.Errorw("unable to check account type", "user", user, "err", err)
+		return nil, CreateUserError(Internal, "failed to link identity", err)
+	}
+
+	if isAnonymous {
+		span.SetStatus(codes.Error, "anonymous users can not link identities")
+		return nil, CreateUserError(BadRequest, "anonymous users can not link identities", errors.New("anonymous users can not link identities"))
+	}
+
+	dbUser, err := service.database.GetUser(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get user")
+		span.RecordError(err)
+		return nil, CreateUserError(Internal, "failed to get user", err)
+	}
+
+	if dbUser.Banned {
+		span.SetStatus(codes.Error, "user is banned")
+		return nil, CreateUserError(Forbidden, "user is banned", errors.New("user is banned"))
+	}
+
+	identities, err := service.database.GetIdentities(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get identities")
+		span.RecordError(err)
+		log.Errorw("unable to get identities", "user", user, "err", err)
+		return nil, CreateUserError(Internal, "failed to link identity", err)
+	}
+
+	databaseIdentity := DatabaseIdentity{
+		User:        user,
+		AccountType: identity.AccountType,
+		Provider:    identity.Provider,
+		Issuer:      identity.Issuer,
+		ID:          identity.ID,
+		Name:        strings.TrimSpace(identity.Name),
+		AvatarUrl:   identity.AvatarUrl,
+	}
+
+	owner, err := service.database.GetIdentityUser(ctx, databaseIdentity)
+	switch {
+	case err == nil && owner == user:
+		// the identity is linked already
+	case err == nil:
+		// the ban of the other user must not be lifted by merging it into this one
+		ownerUser, err := service.database.GetUser(ctx, owner)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to get user of identity")
+			span.RecordError(err)
+			log.Errorw("unable to get user of identity", "user", owner, "err", err)
+			return nil, CreateUserError(Internal, "failed to link identity", err)
+		}
+
+		if ownerUser.Banned {
+			span.SetStatus(codes.Error, "user of identity is banned")
+			return nil, CreateUserError(Forbidden, "user is banned", errors.New("user of identity is banned"))
+		}
+
+		ownerIdentities, err := service.database.GetIdentities(ctx, owner)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to get identities")
+			span.RecordError(err)
+			log.Errorw("unable to get identities", "user", owner, "err", err)
+			return nil, CreateUserError(Internal, "failed to link identity", err)
+		}
+
+		for _, ownerIdentity := range ownerIdentities {
+			if hasIdentityOf(identities, ownerIdentity.Provider) {
+				span.SetStatus(codes.Error, "both users have an identity of the same provider")
+				return nil, CreateUserError(BadRequest, "both users have linked an account of the same provider", errors.New("both users have linked an account of the same provider"))
+			}
+		}
+
+		if err := service.database.MergeUsers(ctx, owner, user); err != nil {
+			span.SetStatus(codes.Error, "failed to merge users")
+			span.RecordError(err)
+			log.Errorw("unable to merge users", "from", owner, "into", user, "err", err)
+			return nil, CreateUserError(Internal, "failed to link identity", err)
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		if hasIdentityOf(identities, identity.Provider) {
+			span.SetStatus(codes.Error, "identity of provider already linked")
+			return nil, CreateUserError(BadRequest, "an account of this provider is linked already", errors.New("an account of this provider is linked already"))
+		}
+
+		if err := service.database.AddIdentity(ctx, databaseIdentity); err != nil {
+			span.SetStatus(codes.Error, "failed to add identity")
+			span.RecordError(err)
+			log.Errorw("unable to add identity", "user", user, "err", err)
+			return nil, CreateUserError(Internal, "failed to link identity", err)
+		}
+	default:
+		span.SetStatus(codes.Error, "failed to get user of identity")
+		span.RecordError(err)
+		log.Errorw("unable to get user of identity", "err", err)
+		return nil, CreateUserError(Internal, "failed to link identity", err)
+	}
+
+	linkedUser, err := service.database.GetUser(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get user")
+		span.RecordError(err)
+		return nil, CreateUserError(Internal, "failed to get user", err)
+	}
+
+	linkedIdentityCounter.Add(ctx, 1)
+	service.updatedUser(ctx, linkedUser)
+
+	return new(User).From(linkedUser), nil
+}
+
+// UnlinkIdentity removes the account of the identity provider from the user. The last account can not be removed,
+// since the user could not log in anymore. If needed, the account type of the user changes to one of the remaining accounts.
+func (service *Service) UnlinkIdentity(ctx context.Context, user uuid.UUID, provider string) error {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.users.service.identities.unlink")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.service.identities.unlink.id", user.String()),
+		attribute.String("scrumlr.users.service.identities.unlink.provider", provider),
+	)
+
+	identities, err := service.database.GetIdentities(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get identities")
+		span.RecordError(err)
+		log.Errorw("unable to get identities", "user", user, "err", err)
+		return CreateUserError(Internal, "failed to unlink identity", err)
+	}
+
+	index := slices.IndexFunc(identities, func(identity DatabaseIdentity) bool { return identity.Provider == provider })
+	if index < 0 {
+		span.SetStatus(codes.Error, "identity not found")
+		return CreateUserError(NotFound, "identity not found", errors.New("identity not found"))
+	}
+
+	if len(identities) == 1 {
+		span.SetStatus(codes.Error, "last identity can not be unlinked")
+		return CreateUserError(BadRequest, "the last linked account can not be removed", errors.New("the last linked account can not be removed"))
+	}
+
+	dbUser, err := service.database.GetUser(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get user")
+		span.RecordError(err)
+		return CreateUserError(Internal, "failed to unlink identity", err)
+	}
+
+	remaining := slices.Delete(slices.Clone(identities), index, index+1)
+	accountType := dbUser.AccountType
+	if !slices.ContainsFunc(remaining, func(identity DatabaseIdentity) bool { return identity.AccountType == accountType }) {
+		accountType = remaining[0].AccountType
+	}
+
+	if err := service.database.RemoveIdentity(ctx, identities[index], accountType); err != nil {
+		span.SetStatus(codes.Error, "failed to remove identity")
+		span.RecordError(err)
+		log.Errorw("unable to remove identity", "user", user, "provider", provider, "err", err)
+		return CreateUserError(Internal, "failed to unlink identity", err)
+	}
+
+	unlinkedIdentityCounter.Add(ctx, 1)
+	if accountType != dbUser.AccountType {
+		dbUser.AccountType = accountType
+		service.updatedUser(ctx, dbUser)
+	}
+
+	return nil
+}
+
+// SetAdmin grants or revokes the instance administration, e.g. if the user joined or left the admin group of a provider
+func (service *Service) SetAdmin(ctx context.Context, id uuid.UUID, admin bool) error {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.users.service.set_admin")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.users.service.set_admin.id", id.String()),
+		attribute.Bool("scrumlr.users.service.set_admin.admin", admin),
+	)
+
+	if err := service.database.SetAdmin(ctx, id, admin); err != nil {
+		span.SetStatus(codes.Error, "failed to set admin")
+		span.RecordError(err)
+		log.Errorw("unable to set admin", "user", id, "admin", admin, "err", err)
+		return CreateUserError(Internal, "failed to set admin", err)
+	}
+
+	return nil
+}
+
 func (service *Service) IsUserAvailableForKeyMigration(ctx context.Context, id uuid.UUID) (bool, error) {
 	ctx, span := tracer.Start(ctx, "scrumlr.users.service.available_key_migration")
 	defer span.End()
@@ -313,6 +870,11 @@ func (service *Service) updatedUser(ctx context.Context, user DatabaseUser) {
 	}
 }
 
+// hasIdentityOf checks whether an account of the provider is among the identities
+func hasIdentityOf(identities []DatabaseIdentity, provider string) bool {
+	return slices.ContainsFunc(identities, func(identity DatabaseIdentity) bool { return identity.Provider == provider })
+}
+
 func validateUsername(name string) error {
 	if strings.TrimSpace(name) == "" {
 		return CreateUserError(BadRequest, "name may not be empty", nil)
@@ -12,16 +12,26 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go/modules/nats"
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/boardtemplates"
 	"scrumlr.io/server/cache"
 	"scrumlr.io/server/columns"
+	"scrumlr.io/server/columntemplates"
 	"scrumlr.io/server/common"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/initialize"
 	"scrumlr.io/server/initialize/testDbTemplates"
 	"scrumlr.io/server/notes"
+	"scrumlr.io/server/reactions"
 	"scrumlr.io/server/realtime"
 	"scrumlr.io/server/role"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
 	"scrumlr.io/server/technical_helper"
+	"scrumlr.io/server/timeprovider"
+	"scrumlr.io/server/votings"
 )
 
 type UserServiceIntegrationTestsuite struct {
@@ -104,13 +114,21 @@ func (suite *UserServiceIntegrationTestsuite) SetupTest() {
 
 	boardLastModifiedUpdater := common.NewSimpleBoardLastModifiedUpdater(db)
 	noteDatabase := notes.NewNotesDatabase(db)
-	noteService := notes.NewNotesService(noteDatabase, broker, ch, boardLastModifiedUpdater)
+	auditService := audit.NewAuditService(audit.NewAuditDatabase(db))
+	noteService := notes.NewNotesService(noteDatabase, broker, ch, boardLastModifiedUpdater, auditService)
 	columnDatabase := columns.NewColumnsDatabase(db)
-	columnService := columns.NewColumnService(columnDatabase, broker, noteService, boardLastModifiedUpdater)
+	columnService := columns.NewColumnService(columnDatabase, broker, noteService, boardLastModifiedUpdater, auditService)
 	sessionDatabase := sessions.NewSessionDatabase(db)
-	sessionService := sessions.NewSessionService(sessionDatabase, broker, columnService, noteService)
+	sessionService := sessions.NewSessionService(sessionDatabase, broker, columnService, noteService, auditService)
 	userDatabase := NewUserDatabase(db)
-	userService := NewUserService(userDatabase, broker, sessionService, noteService)
+	votingService := votings.NewVotingService(votings.NewVotingDatabase(db), broker, auditService, noteService, timeprovider.NewClock())
+	reactionService := reactions.NewReactionService(reactions.NewReactionsDatabase(db), broker)
+	teamService := teams.NewTeamService(teams.NewTeamDatabase(db))
+	boardTemplateService := boardtemplates.NewBoardTemplateService(boardtemplates.NewBoardTemplateDatabase(db), columntemplates.NewColumnTemplateService(columntemplates.NewColumnTemplateDatabase(db)), teamService)
+	actionItemService := actionitems.NewActionItemService(actionitems.NewActionItemDatabase(db), broker, sessionService, boardLastModifiedUpdater)
+	estimationService := estimations.NewEstimationService(estimations.NewEstimationDatabase(db), broker, noteService, boardLastModifiedUpdater)
+	apiTokenService := apitokens.NewApiTokenService(apitokens.NewApiTokenDatabase(db))
+	userService := NewUserService(userDatabase, broker, sessionService, noteService, votingService, reactionService, boardTemplateService, actionItemService, estimationService, teamService, apiTokenService, auditService)
 
 	suite.userService = userService
 	suite.notesService = noteService
@@ -188,6 +206,16 @@ func (suite *UserServiceIntegrationTestsuite) Test_CreateOIDCUser() {
 	suite.Equal(common.TypeOIDC, user.AccountType)
 }
 
+func (suite *UserServiceIntegrationTestsuite) Test_CreateSAMLUser() {
+	ctx := context.Background()
+
+	user, err := suite.userService.Create(ctx, "samlId", suite.testUserName, "", common.TypeSAML)
+
+	suite.Nil(err)
+	suite.Equal(suite.testUserName, user.Name)
+	suite.Equal(common.TypeSAML, user.AccountType)
+}
+
 func (suite *UserServiceIntegrationTestsuite) Test_Update() {
 	ctx := context.Background()
 	userId := suite.updateUser.ID
@@ -258,6 +286,46 @@ func (suite *UserServiceIntegrationTestsuite) Test_Delete_WithoutNotes() {
 	suite.Len(notesAfterDelete, 0)
 }
 
+func (suite *UserServiceIntegrationTestsuite) Test_Export() {
+	ctx := context.Background()
+	userId := suite.baseData.Users["Stan"].ID
+
+	export, err := suite.userService.Export(ctx, userId)
+
+	suite.Nil(err)
+	suite.Equal(userId, export.User.ID)
+	suite.NotEmpty(export.Boards)
+
+	boardIds := make([]uuid.UUID, 0, len(export.Boards))
+	for _, board := range export.Boards {
+		suite.Equal(userId, board.Session.UserID)
+		for _, note := range board.Notes {
+			suite.Equal(userId, note.Author)
+		}
+		for _, vote := range board.Votes {
+			suite.Equal(userId, vote.User)
+		}
+		for _, reaction := range board.Reactions {
+			suite.Equal(userId, reaction.User)
+		}
+		boardIds = append(boardIds, board.Board)
+	}
+	suite.Contains(boardIds, suite.updateBoard.ID)
+
+	for _, template := range export.Templates {
+		suite.Equal(userId, template.Template.Creator)
+	}
+}
+
+func (suite *UserServiceIntegrationTestsuite) Test_Export_NotFound() {
+	ctx := context.Background()
+
+	export, err := suite.userService.Export(ctx, uuid.New())
+
+	suite.Nil(export)
+	suite.NotNil(err)
+}
+
 func (suite *UserServiceIntegrationTestsuite) Test_Get() {
 	ctx := context.Background()
 	userId := suite.baseData.Users["Stan"].ID
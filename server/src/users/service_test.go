@@ -6,9 +6,17 @@ import (
 	"errors"
 	"testing"
 
+	"scrumlr.io/server/actionitems"
+	"scrumlr.io/server/apitokens"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/boardtemplates"
 	"scrumlr.io/server/common"
+	"scrumlr.io/server/estimations"
 	"scrumlr.io/server/notes"
+	"scrumlr.io/server/reactions"
 	"scrumlr.io/server/sessions"
+	"scrumlr.io/server/teams"
+	"scrumlr.io/server/votings"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -43,7 +51,7 @@ func (suite *UserServiceTestSuite) TestGetUser() {
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Get(context.Background(), suite.userID)
 
@@ -55,7 +63,7 @@ func (suite *UserServiceTestSuite) TestGetUser_NotFound() {
 	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).Return(DatabaseUser{}, sql.ErrNoRows)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 	user, err := userService.Get(context.Background(), suite.userID)
 
 	suite.Nil(user)
@@ -72,7 +80,7 @@ func (suite *UserServiceTestSuite) TestGetUser_DatabaseError() {
 	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Get(context.Background(), suite.userID)
 
@@ -86,7 +94,7 @@ func (suite *UserServiceTestSuite) TestGetExistingUserIDs() {
 	suite.mockUserDatabase.EXPECT().GetExistingUserIDs(mock.Anything, userIDs).Return([]uuid.UUID{userIDs[0], userIDs[1], userIDs[2]}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.GetExistingUserIDs(context.Background(), userIDs)
 
@@ -100,7 +108,7 @@ func (suite *UserServiceTestSuite) TestGetExistingUserIDsError() {
 	suite.mockUserDatabase.EXPECT().GetExistingUserIDs(mock.Anything, userIDs).Return(nil, errors.New(dbError))
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.GetExistingUserIDs(context.Background(), userIDs)
 
@@ -119,7 +127,7 @@ func (suite *UserServiceTestSuite) TestGetBoardUsers() {
 	}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.GetBoardUsers(context.Background(), boardID)
 
@@ -132,7 +140,7 @@ func (suite *UserServiceTestSuite) TestCreateAnonymusUser() {
 	suite.mockUserDatabase.EXPECT().CreateAnonymousUser(mock.Anything, name).Return(DatabaseUser{ID: uuid.New(), Name: name}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), "", name, "", common.Anonymous)
 
@@ -146,7 +154,7 @@ func (suite *UserServiceTestSuite) TestCreateAnonymusUser_DatabaseError() {
 	suite.mockUserDatabase.EXPECT().CreateAnonymousUser(mock.Anything, name).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), "", name, "", common.Anonymous)
 
@@ -159,7 +167,7 @@ func (suite *UserServiceTestSuite) TestCreateAnonymusUser_EmptyUsername() {
 	name := "   "
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), "", name, "", common.Anonymous)
 
@@ -176,7 +184,7 @@ func (suite *UserServiceTestSuite) TestCreateAnonymusUser_NewLineUsername() {
 	name := "Stan\n"
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), "", name, "", common.Anonymous)
 
@@ -196,7 +204,7 @@ func (suite *UserServiceTestSuite) TestCreateAppleUser() {
 		Return(DatabaseUser{ID: suite.userID, Name: name}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Apple)
 
@@ -211,7 +219,7 @@ func (suite *UserServiceTestSuite) TestCreateAppleUser_DatabaseError() {
 	suite.mockUserDatabase.EXPECT().CreateAppleUser(mock.Anything, suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Apple)
 
@@ -225,7 +233,7 @@ func (suite *UserServiceTestSuite) TestCreateAppleUser_EmptyUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Apple)
 
@@ -244,7 +252,7 @@ func (suite *UserServiceTestSuite) TestCreateAppleUser_NewLineUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Apple)
 
@@ -265,7 +273,7 @@ func (suite *UserServiceTestSuite) TestCreateAzureUser() {
 		Return(DatabaseUser{ID: suite.userID, Name: name}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.AzureAd)
 
@@ -280,7 +288,7 @@ func (suite *UserServiceTestSuite) TestCreateAzureUser_DatabaseError() {
 	suite.mockUserDatabase.EXPECT().CreateAzureAdUser(mock.Anything, suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.AzureAd)
 
@@ -294,7 +302,7 @@ func (suite *UserServiceTestSuite) TestCreateAzureUser_EmptyUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.AzureAd)
 
@@ -312,7 +320,7 @@ func (suite *UserServiceTestSuite) TestCreateAzureUser_NewLineUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.AzureAd)
 
@@ -332,7 +340,7 @@ func (suite *UserServiceTestSuite) TestCreateGitHubUser() {
 		Return(DatabaseUser{ID: suite.userID, Name: name}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.GitHub)
 
@@ -347,7 +355,7 @@ func (suite *UserServiceTestSuite) TestCreateGitHubUser_DatabaseError() {
 	suite.mockUserDatabase.EXPECT().CreateGitHubUser(mock.Anything, suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.GitHub)
 
@@ -361,7 +369,7 @@ func (suite *UserServiceTestSuite) TestCreateGitHubUser_EmptyUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.GitHub)
 
@@ -379,7 +387,7 @@ func (suite *UserServiceTestSuite) TestCreateGitHubUser_NewLineUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.GitHub)
 
@@ -399,7 +407,7 @@ func (suite *UserServiceTestSuite) TestCreateGoogleUser() {
 		Return(DatabaseUser{ID: suite.userID, Name: name}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Google)
 
@@ -414,7 +422,7 @@ func (suite *UserServiceTestSuite) TestCreateGoogleUser_DatabaseError() {
 	suite.mockUserDatabase.EXPECT().CreateGoogleUser(mock.Anything, suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Google)
 
@@ -428,7 +436,7 @@ func (suite *UserServiceTestSuite) TestCreateGoogleUser_EmptyUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Google)
 
@@ -446,7 +454,7 @@ func (suite *UserServiceTestSuite) TestCreateGoogleUser_NewLineUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Google)
 
@@ -466,7 +474,7 @@ func (suite *UserServiceTestSuite) TestCreateMicrosoftUser() {
 		Return(DatabaseUser{ID: suite.userID, Name: name}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Microsoft)
 
@@ -481,7 +489,7 @@ func (suite *UserServiceTestSuite) TestCreateMicrosoftUser_DatabaseError() {
 	suite.mockUserDatabase.EXPECT().CreateMicrosoftUser(mock.Anything, suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Microsoft)
 
@@ -495,7 +503,7 @@ func (suite *UserServiceTestSuite) TestCreateMicrosoftUser_EmptyUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Microsoft)
 
@@ -513,7 +521,7 @@ func (suite *UserServiceTestSuite) TestCreateMicrosoftUser_NewLineUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.Microsoft)
 
@@ -529,11 +537,11 @@ func (suite *UserServiceTestSuite) TestCreateMicrosoftUser_NewLineUsername() {
 func (suite *UserServiceTestSuite) TestCreateOIDCUser() {
 	name := "Stan"
 	avatarUrl := ""
-	suite.mockUserDatabase.EXPECT().CreateOIDCUser(mock.Anything, suite.userID.String(), name, avatarUrl).
+	suite.mockUserDatabase.EXPECT().CreateOIDCUser(mock.Anything, DefaultOIDCProvider, "", suite.userID.String(), name, avatarUrl).
 		Return(DatabaseUser{ID: suite.userID, Name: name}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.TypeOIDC)
 
@@ -545,10 +553,10 @@ func (suite *UserServiceTestSuite) TestCreateOIDCUser_DatabaseError() {
 	name := "Stan"
 	avatarUrl := ""
 	dbError := errors.New("unable to execute")
-	suite.mockUserDatabase.EXPECT().CreateOIDCUser(mock.Anything, suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
+	suite.mockUserDatabase.EXPECT().CreateOIDCUser(mock.Anything, DefaultOIDCProvider, "", suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.TypeOIDC)
 
@@ -557,12 +565,29 @@ func (suite *UserServiceTestSuite) TestCreateOIDCUser_DatabaseError() {
 	suite.ErrorIs(err, dbError)
 }
 
+func (suite *UserServiceTestSuite) TestCreateOIDCUser_Banned() {
+	name := "Stan"
+	avatarUrl := ""
+	suite.mockUserDatabase.EXPECT().CreateOIDCUser(mock.Anything, DefaultOIDCProvider, "", suite.userID.String(), name, avatarUrl).
+		Return(DatabaseUser{ID: suite.userID, Name: name, Banned: true}, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.TypeOIDC)
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(Forbidden, userErr.Category)
+}
+
 func (suite *UserServiceTestSuite) TestCreateOIDCUser_EmptyUsername() {
 	name := "   "
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.TypeOIDC)
 
@@ -580,7 +605,7 @@ func (suite *UserServiceTestSuite) TestCreateOIDCUser_NewLineUsername() {
 	avatarUrl := ""
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.TypeOIDC)
 
@@ -593,6 +618,87 @@ func (suite *UserServiceTestSuite) TestCreateOIDCUser_NewLineUsername() {
 	suite.Equal("name may not contain newline characters", userErr.Message)
 }
 
+// salesIssuer is the issuer of the additional OIDC provider named sales
+const salesIssuer = "https://sales.example.com"
+
+func (suite *UserServiceTestSuite) TestCreateNamedOIDCUser() {
+	name := "Stan"
+	avatarUrl := ""
+	suite.mockUserDatabase.EXPECT().CreateOIDCUser(mock.Anything, "sales", salesIssuer, suite.userID.String(), name, avatarUrl).
+		Return(DatabaseUser{ID: suite.userID, Name: name, AccountType: common.TypeOIDC}, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.CreateOIDCUser(context.Background(), "sales", salesIssuer, suite.userID.String(), name, avatarUrl)
+
+	suite.Nil(err)
+	suite.Equal(suite.userID, user.ID)
+	suite.Equal(common.TypeOIDC, user.AccountType)
+}
+
+func (suite *UserServiceTestSuite) TestCreateNamedOIDCUser_DatabaseError() {
+	name := "Stan"
+	avatarUrl := ""
+	dbError := errors.New("unable to execute")
+	suite.mockUserDatabase.EXPECT().CreateOIDCUser(mock.Anything, "sales", salesIssuer, suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.CreateOIDCUser(context.Background(), "sales", salesIssuer, suite.userID.String(), name, avatarUrl)
+
+	suite.Nil(user)
+	suite.NotNil(err)
+	suite.ErrorIs(err, dbError)
+}
+
+func (suite *UserServiceTestSuite) TestCreateNamedOIDCUser_EmptyUsername() {
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.CreateOIDCUser(context.Background(), "sales", salesIssuer, suite.userID.String(), "   ", "")
+
+	suite.Nil(user)
+	suite.NotNil(err)
+
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(BadRequest, userErr.Category)
+}
+
+func (suite *UserServiceTestSuite) TestCreateSAMLUser() {
+	name := "Stan"
+	avatarUrl := ""
+	suite.mockUserDatabase.EXPECT().CreateSAMLUser(mock.Anything, suite.userID.String(), name, avatarUrl).
+		Return(DatabaseUser{ID: suite.userID, Name: name}, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.TypeSAML)
+
+	suite.Nil(err)
+	suite.NotNil(user)
+}
+
+func (suite *UserServiceTestSuite) TestCreateSAMLUser_DatabaseError() {
+	name := "Stan"
+	avatarUrl := ""
+	dbError := errors.New("unable to execute")
+	suite.mockUserDatabase.EXPECT().CreateSAMLUser(mock.Anything, suite.userID.String(), name, avatarUrl).Return(DatabaseUser{}, dbError)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.Create(context.Background(), suite.userID.String(), name, avatarUrl, common.TypeSAML)
+
+	suite.Nil(user)
+	suite.NotNil(err)
+	suite.ErrorIs(err, dbError)
+}
+
 func (suite *UserServiceTestSuite) TestUpdateUser() {
 	firstBoardID := uuid.New()
 	secondBoardID := uuid.New()
@@ -611,7 +717,7 @@ func (suite *UserServiceTestSuite) TestUpdateUser() {
 			{UserID: user.ID, Board: secondBoardID},
 		}, nil)
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockUserService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockUserService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	updatedUser, err := userService.Update(context.Background(), UserUpdateRequest{ID: suite.userID, Name: name})
 
@@ -626,7 +732,7 @@ func (suite *UserServiceTestSuite) TestUpdateUser_DatabaseError() {
 		Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Update(context.Background(), UserUpdateRequest{ID: suite.userID, Name: name})
 
@@ -639,7 +745,7 @@ func (suite *UserServiceTestSuite) TestUpdateUser_EmptyUsername() {
 	name := "   "
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Update(context.Background(), UserUpdateRequest{ID: suite.userID, Name: name})
 
@@ -656,7 +762,7 @@ func (suite *UserServiceTestSuite) TestUpdateUser_NewLineUsername() {
 	name := "Stan\n"
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.Update(context.Background(), UserUpdateRequest{ID: suite.userID, Name: name})
 
@@ -673,7 +779,7 @@ func (suite *UserServiceTestSuite) TestAvailableForKeyMigration() {
 	suite.mockUserDatabase.EXPECT().IsUserAvailableForKeyMigration(mock.Anything, suite.userID).Return(true, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	available, err := userService.IsUserAvailableForKeyMigration(context.Background(), suite.userID)
 
@@ -686,7 +792,7 @@ func (suite *UserServiceTestSuite) TestAvailableForKeyMigration_DatabaseError()
 	suite.mockUserDatabase.EXPECT().IsUserAvailableForKeyMigration(mock.Anything, suite.userID).Return(false, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	available, err := userService.IsUserAvailableForKeyMigration(context.Background(), suite.userID)
 
@@ -699,7 +805,7 @@ func (suite *UserServiceTestSuite) TestSetKeyMigration() {
 	suite.mockUserDatabase.EXPECT().SetKeyMigration(mock.Anything, suite.userID).Return(DatabaseUser{ID: suite.userID}, nil)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.SetKeyMigration(context.Background(), suite.userID)
 
@@ -712,7 +818,7 @@ func (suite *UserServiceTestSuite) TestSetKeymigration_DatabaseError() {
 	suite.mockUserDatabase.EXPECT().SetKeyMigration(mock.Anything, suite.userID).Return(DatabaseUser{}, dbError)
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	user, err := userService.SetKeyMigration(context.Background(), suite.userID)
 
@@ -727,7 +833,7 @@ func (suite *UserServiceTestSuite) TestDeleteUser() {
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, false).Return([]*sessions.BoardSession{}, nil)
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	err := userService.Delete(context.Background(), suite.userID)
 
@@ -741,10 +847,421 @@ func (suite *UserServiceTestSuite) TestDeleteUser_DatabaseError() {
 	mockSessionService := sessions.NewMockSessionService(suite.T())
 	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, false).Return([]*sessions.BoardSession{}, nil)
 	mockNotesService := notes.NewMockNotesService(suite.T())
-	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
 
 	err := userService.Delete(context.Background(), suite.userID)
 
 	suite.NotNil(err)
 	suite.ErrorIs(err, dbError)
 }
+
+func (suite *UserServiceTestSuite) TestExportUser() {
+	boardID := uuid.New()
+	formerBoardID := uuid.New()
+	otherUser := uuid.New()
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).Return(DatabaseUser{ID: suite.userID, Name: "Stan"}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId"}}, nil)
+	suite.mockUserDatabase.EXPECT().GetBoardsWithUserData(mock.Anything, suite.userID).Return([]uuid.UUID{boardID, formerBoardID}, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, false).
+		Return([]*sessions.BoardSession{{Board: boardID, UserID: suite.userID}}, nil)
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	mockNotesService.EXPECT().GetByUserAndBoard(mock.Anything, suite.userID, boardID).Return([]*notes.Note{{ID: uuid.New(), Author: suite.userID}}, nil)
+	mockNotesService.EXPECT().GetByUserAndBoard(mock.Anything, suite.userID, formerBoardID).Return([]*notes.Note{}, nil)
+	mockNotesService.EXPECT().GetRevisionsByAuthor(mock.Anything, boardID, suite.userID).Return([]*notes.NoteRevision{{ID: uuid.New(), Author: &suite.userID}}, nil)
+	mockNotesService.EXPECT().GetRevisionsByAuthor(mock.Anything, formerBoardID, suite.userID).Return([]*notes.NoteRevision{}, nil)
+	mockVotingService := votings.NewMockVotingService(suite.T())
+	mockVotingService.EXPECT().GetVotes(mock.Anything, boardID, votings.VoteFilter{User: &suite.userID}).Return([]*votings.Vote{{User: suite.userID}}, nil)
+	mockVotingService.EXPECT().GetVotes(mock.Anything, formerBoardID, votings.VoteFilter{User: &suite.userID}).Return([]*votings.Vote{}, nil)
+	mockReactionService := reactions.NewMockReactionService(suite.T())
+	mockReactionService.EXPECT().GetAll(mock.Anything, boardID).
+		Return([]*reactions.Reaction{{ID: uuid.New(), User: suite.userID}, {ID: uuid.New(), User: otherUser}}, nil)
+	mockReactionService.EXPECT().GetAll(mock.Anything, formerBoardID).Return([]*reactions.Reaction{}, nil)
+	mockActionItemService := actionitems.NewMockActionItemService(suite.T())
+	mockActionItemService.EXPECT().GetAll(mock.Anything, boardID).Return([]*actionitems.ActionItem{}, nil)
+	mockActionItemService.EXPECT().GetAll(mock.Anything, formerBoardID).
+		Return([]*actionitems.ActionItem{
+			{ID: uuid.New(), Assignee: uuid.NullUUID{UUID: suite.userID, Valid: true}},
+			{ID: uuid.New(), Assignee: uuid.NullUUID{UUID: otherUser, Valid: true}},
+			{ID: uuid.New()},
+		}, nil)
+	mockEstimationService := estimations.NewMockEstimationService(suite.T())
+	mockEstimationService.EXPECT().GetEstimatesByUser(mock.Anything, boardID, suite.userID).Return([]*estimations.Estimate{{User: suite.userID}}, nil)
+	mockEstimationService.EXPECT().GetEstimatesByUser(mock.Anything, formerBoardID, suite.userID).Return([]*estimations.Estimate{}, nil)
+	mockAuditService := audit.NewMockAuditService(suite.T())
+	mockAuditService.EXPECT().GetAllByActor(mock.Anything, boardID, suite.userID).Return([]*audit.Entry{}, nil)
+	mockAuditService.EXPECT().GetAllByActor(mock.Anything, formerBoardID, suite.userID).Return([]*audit.Entry{{ID: uuid.New()}}, nil)
+	mockBoardTemplateService := boardtemplates.NewMockBoardTemplateService(suite.T())
+	mockBoardTemplateService.EXPECT().GetAll(mock.Anything, suite.userID).
+		Return([]*boardtemplates.BoardTemplateFull{
+			{Template: &boardtemplates.BoardTemplate{ID: uuid.New(), Creator: suite.userID}},
+			{Template: &boardtemplates.BoardTemplate{ID: uuid.New(), Creator: otherUser}},
+		}, nil)
+	mockTeamService := teams.NewMockTeamService(suite.T())
+	mockTeamService.EXPECT().GetAll(mock.Anything, suite.userID).Return([]*teams.Team{{ID: uuid.New(), Name: "Team"}}, nil)
+	mockApiTokenService := apitokens.NewMockApiTokenService(suite.T())
+	mockApiTokenService.EXPECT().GetAll(mock.Anything, suite.userID).Return([]*apitokens.ApiToken{{ID: uuid.New(), Name: "CI"}}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, mockVotingService, mockReactionService, mockBoardTemplateService, mockActionItemService, mockEstimationService, mockTeamService, mockApiTokenService, mockAuditService)
+
+	export, err := userService.Export(context.Background(), suite.userID)
+
+	suite.Nil(err)
+	suite.Equal(suite.userID, export.User.ID)
+	suite.Len(export.Identities, 1)
+	suite.Equal("githubId", export.Identities[0].ID)
+	suite.Len(export.Boards, 2)
+	suite.Equal(boardID, export.Boards[0].Board)
+	suite.NotNil(export.Boards[0].Session)
+	suite.Len(export.Boards[0].Notes, 1)
+	suite.Len(export.Boards[0].NoteRevisions, 1)
+	suite.Len(export.Boards[0].Votes, 1)
+	suite.Len(export.Boards[0].Reactions, 1)
+	suite.Equal(suite.userID, export.Boards[0].Reactions[0].User)
+	suite.Len(export.Boards[0].Estimates, 1)
+	suite.Equal(formerBoardID, export.Boards[1].Board)
+	suite.Nil(export.Boards[1].Session)
+	suite.Len(export.Boards[1].ActionItems, 1)
+	suite.Equal(suite.userID, export.Boards[1].ActionItems[0].Assignee.UUID)
+	suite.Len(export.Boards[1].AuditLog, 1)
+	suite.Len(export.Templates, 1)
+	suite.Equal(suite.userID, export.Templates[0].Template.Creator)
+	suite.Len(export.Teams, 1)
+	suite.Len(export.ApiTokens, 1)
+}
+
+func (suite *UserServiceTestSuite) TestExportUser_NotFound() {
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).Return(DatabaseUser{}, sql.ErrNoRows)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	export, err := userService.Export(context.Background(), suite.userID)
+
+	suite.Nil(export)
+	suite.Equal(CreateUserError(NotFound, "user not found", sql.ErrNoRows), err)
+}
+
+func (suite *UserServiceTestSuite) TestExportUser_SessionError() {
+	sessionError := errors.New("session error")
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).Return(DatabaseUser{ID: suite.userID}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).Return([]DatabaseIdentity{}, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, false).Return(nil, sessionError)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	export, err := userService.Export(context.Background(), suite.userID)
+
+	suite.Nil(export)
+	suite.ErrorIs(err, sessionError)
+}
+
+func (suite *UserServiceTestSuite) TestExportUser_BoardsError() {
+	dbError := errors.New("database error")
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).Return(DatabaseUser{ID: suite.userID}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).Return([]DatabaseIdentity{}, nil)
+	suite.mockUserDatabase.EXPECT().GetBoardsWithUserData(mock.Anything, suite.userID).Return(nil, dbError)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, false).Return([]*sessions.BoardSession{}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	export, err := userService.Export(context.Background(), suite.userID)
+
+	suite.Nil(export)
+	suite.Equal(CreateUserError(Internal, "failed to get boards of user", dbError), err)
+}
+
+func (suite *UserServiceTestSuite) TestSetAdmin() {
+	suite.mockUserDatabase.EXPECT().SetAdmin(mock.Anything, suite.userID, true).Return(nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	err := userService.SetAdmin(context.Background(), suite.userID, true)
+
+	suite.Nil(err)
+}
+
+func (suite *UserServiceTestSuite) TestSetAdmin_DatabaseError() {
+	dbError := errors.New("database error")
+	suite.mockUserDatabase.EXPECT().SetAdmin(mock.Anything, suite.userID, false).Return(dbError)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	err := userService.SetAdmin(context.Background(), suite.userID, false)
+
+	suite.ErrorIs(err, dbError)
+}
+
+func (suite *UserServiceTestSuite) TestMergeAnonymousUser() {
+	anonymousUserID := uuid.New()
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, anonymousUserID).Return(true, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, anonymousUserID).
+		Return(DatabaseUser{ID: anonymousUserID, Name: "Stan", AccountType: common.Anonymous}, nil)
+	suite.mockUserDatabase.EXPECT().MergeUsers(mock.Anything, anonymousUserID, suite.userID).Return(nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub}, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, true).Return([]*sessions.BoardSession{}, nil)
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.MergeAnonymousUser(context.Background(), anonymousUserID, suite.userID)
+
+	suite.Nil(err)
+	suite.Equal(suite.userID, user.ID)
+	suite.Equal(common.GitHub, user.AccountType)
+}
+
+func (suite *UserServiceTestSuite) TestMergeAnonymousUser_SameUser() {
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.MergeAnonymousUser(context.Background(), suite.userID, suite.userID)
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(BadRequest, userErr.Category)
+}
+
+func (suite *UserServiceTestSuite) TestMergeAnonymousUser_NotAnonymous() {
+	otherUserID := uuid.New()
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, otherUserID).Return(false, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.MergeAnonymousUser(context.Background(), otherUserID, suite.userID)
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(BadRequest, userErr.Category)
+	suite.Equal("only anonymous users can be merged", userErr.Message)
+}
+
+func (suite *UserServiceTestSuite) TestMergeAnonymousUser_DatabaseError() {
+	anonymousUserID := uuid.New()
+	dbError := errors.New("database error")
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, anonymousUserID).Return(true, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, anonymousUserID).
+		Return(DatabaseUser{ID: anonymousUserID, Name: "Stan", AccountType: common.Anonymous}, nil)
+	suite.mockUserDatabase.EXPECT().MergeUsers(mock.Anything, anonymousUserID, suite.userID).Return(dbError)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockNotesService := notes.NewMockNotesService(suite.T())
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, mockNotesService, votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.MergeAnonymousUser(context.Background(), anonymousUserID, suite.userID)
+
+	suite.Nil(user)
+	suite.ErrorIs(err, dbError)
+}
+
+func (suite *UserServiceTestSuite) TestMergeAnonymousUser_Banned() {
+	anonymousUserID := uuid.New()
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, anonymousUserID).Return(true, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, anonymousUserID).
+		Return(DatabaseUser{ID: anonymousUserID, Name: "Stan", AccountType: common.Anonymous, Banned: true}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.MergeAnonymousUser(context.Background(), anonymousUserID, suite.userID)
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(Forbidden, userErr.Category)
+	suite.mockUserDatabase.AssertNotCalled(suite.T(), "MergeUsers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestGetIdentities() {
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	identities, err := userService.GetIdentities(context.Background(), suite.userID)
+
+	suite.Nil(err)
+	suite.Equal([]*Identity{{AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, identities)
+}
+
+func (suite *UserServiceTestSuite) TestLinkIdentity() {
+	identity := DatabaseIdentity{User: suite.userID, AccountType: common.Microsoft, Provider: "microsoft", ID: "microsoftId", Name: "Stan"}
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, suite.userID).Return(false, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentityUser(mock.Anything, identity).Return(uuid.Nil, sql.ErrNoRows)
+	suite.mockUserDatabase.EXPECT().AddIdentity(mock.Anything, identity).Return(nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub}, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, true).Return([]*sessions.BoardSession{}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.LinkIdentity(context.Background(), suite.userID, Identity{AccountType: common.Microsoft, ID: "microsoftId", Name: "Stan"})
+
+	suite.Nil(err)
+	suite.Equal(suite.userID, user.ID)
+}
+
+func (suite *UserServiceTestSuite) TestLinkIdentity_MergesUserOfIdentity() {
+	otherUserID := uuid.New()
+	identity := DatabaseIdentity{User: suite.userID, AccountType: common.TypeOIDC, Provider: "sales", ID: "keycloakId", Name: "Stan"}
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, suite.userID).Return(false, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentityUser(mock.Anything, identity).Return(otherUserID, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, otherUserID).
+		Return(DatabaseUser{ID: otherUserID, Name: "Stan", AccountType: common.TypeOIDC}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, otherUserID).Return([]DatabaseIdentity{identity}, nil)
+	suite.mockUserDatabase.EXPECT().MergeUsers(mock.Anything, otherUserID, suite.userID).Return(nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub}, nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, true).Return([]*sessions.BoardSession{}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.LinkIdentity(context.Background(), suite.userID, Identity{AccountType: common.TypeOIDC, Provider: "sales", ID: "keycloakId", Name: "Stan"})
+
+	suite.Nil(err)
+	suite.Equal(suite.userID, user.ID)
+}
+
+func (suite *UserServiceTestSuite) TestLinkIdentity_ConflictingIdentities() {
+	otherUserID := uuid.New()
+	identity := DatabaseIdentity{User: suite.userID, AccountType: common.Microsoft, Provider: "microsoft", ID: "microsoftId", Name: "Stan"}
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, suite.userID).Return(false, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentityUser(mock.Anything, identity).Return(otherUserID, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, otherUserID).
+		Return(DatabaseUser{ID: otherUserID, Name: "Stan", AccountType: common.Microsoft}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, otherUserID).
+		Return([]DatabaseIdentity{identity, {User: otherUserID, AccountType: common.GitHub, Provider: "github", ID: "otherGithubId", Name: "Stan"}}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.LinkIdentity(context.Background(), suite.userID, Identity{AccountType: common.Microsoft, ID: "microsoftId", Name: "Stan"})
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(BadRequest, userErr.Category)
+}
+
+func (suite *UserServiceTestSuite) TestLinkIdentity_ProviderLinkedAlready() {
+	identity := DatabaseIdentity{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "otherGithubId", Name: "Stan"}
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, suite.userID).Return(false, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentityUser(mock.Anything, identity).Return(uuid.Nil, sql.ErrNoRows)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.LinkIdentity(context.Background(), suite.userID, Identity{AccountType: common.GitHub, ID: "otherGithubId", Name: "Stan"})
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(BadRequest, userErr.Category)
+}
+
+func (suite *UserServiceTestSuite) TestLinkIdentity_UserOfIdentityBanned() {
+	otherUserID := uuid.New()
+	identity := DatabaseIdentity{User: suite.userID, AccountType: common.Microsoft, Provider: "microsoft", ID: "microsoftId", Name: "Stan"}
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, suite.userID).Return(false, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub}, nil)
+	suite.mockUserDatabase.EXPECT().GetIdentityUser(mock.Anything, identity).Return(otherUserID, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, otherUserID).
+		Return(DatabaseUser{ID: otherUserID, Name: "Stan", AccountType: common.Microsoft, Banned: true}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.LinkIdentity(context.Background(), suite.userID, Identity{AccountType: common.Microsoft, ID: "microsoftId", Name: "Stan"})
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(Forbidden, userErr.Category)
+	suite.mockUserDatabase.AssertNotCalled(suite.T(), "MergeUsers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestLinkIdentity_Banned() {
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, suite.userID).Return(false, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub, Banned: true}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.LinkIdentity(context.Background(), suite.userID, Identity{AccountType: common.Microsoft, ID: "microsoftId", Name: "Stan"})
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(Forbidden, userErr.Category)
+}
+
+func (suite *UserServiceTestSuite) TestLinkIdentity_AnonymousUser() {
+	suite.mockUserDatabase.EXPECT().IsUserAnonymous(mock.Anything, suite.userID).Return(true, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	user, err := userService.LinkIdentity(context.Background(), suite.userID, Identity{AccountType: common.GitHub, ID: "githubId", Name: "Stan"})
+
+	suite.Nil(user)
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(BadRequest, userErr.Category)
+}
+
+func (suite *UserServiceTestSuite) TestUnlinkIdentity() {
+	github := DatabaseIdentity{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}
+	microsoft := DatabaseIdentity{User: suite.userID, AccountType: common.Microsoft, Provider: "microsoft", ID: "microsoftId", Name: "Stan"}
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).Return([]DatabaseIdentity{github, microsoft}, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub}, nil)
+	suite.mockUserDatabase.EXPECT().RemoveIdentity(mock.Anything, microsoft, common.GitHub).Return(nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	err := userService.UnlinkIdentity(context.Background(), suite.userID, "microsoft")
+
+	suite.Nil(err)
+}
+
+func (suite *UserServiceTestSuite) TestUnlinkIdentity_ChangesAccountType() {
+	github := DatabaseIdentity{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}
+	microsoft := DatabaseIdentity{User: suite.userID, AccountType: common.Microsoft, Provider: "microsoft", ID: "microsoftId", Name: "Stan"}
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).Return([]DatabaseIdentity{github, microsoft}, nil)
+	suite.mockUserDatabase.EXPECT().GetUser(mock.Anything, suite.userID).
+		Return(DatabaseUser{ID: suite.userID, Name: "Stan", AccountType: common.GitHub}, nil)
+	suite.mockUserDatabase.EXPECT().RemoveIdentity(mock.Anything, github, common.Microsoft).Return(nil)
+	mockSessionService := sessions.NewMockSessionService(suite.T())
+	mockSessionService.EXPECT().GetUserBoardSessions(mock.Anything, suite.userID, true).Return([]*sessions.BoardSession{}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, mockSessionService, notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	err := userService.UnlinkIdentity(context.Background(), suite.userID, "github")
+
+	suite.Nil(err)
+}
+
+func (suite *UserServiceTestSuite) TestUnlinkIdentity_LastIdentity() {
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	err := userService.UnlinkIdentity(context.Background(), suite.userID, "github")
+
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(BadRequest, userErr.Category)
+}
+
+func (suite *UserServiceTestSuite) TestUnlinkIdentity_NotFound() {
+	suite.mockUserDatabase.EXPECT().GetIdentities(mock.Anything, suite.userID).
+		Return([]DatabaseIdentity{{User: suite.userID, AccountType: common.GitHub, Provider: "github", ID: "githubId", Name: "Stan"}}, nil)
+	userService := NewUserService(suite.mockUserDatabase, suite.broker, sessions.NewMockSessionService(suite.T()), notes.NewMockNotesService(suite.T()), votings.NewMockVotingService(suite.T()), reactions.NewMockReactionService(suite.T()), boardtemplates.NewMockBoardTemplateService(suite.T()), actionitems.NewMockActionItemService(suite.T()), estimations.NewMockEstimationService(suite.T()), teams.NewMockTeamService(suite.T()), apitokens.NewMockApiTokenService(suite.T()), audit.NewMockAuditService(suite.T()))
+
+	err := userService.UnlinkIdentity(context.Background(), suite.userID, "google")
+
+	var userErr UserError
+	suite.ErrorAs(err, &userErr)
+	suite.Equal(NotFound, userErr.Category)
+}
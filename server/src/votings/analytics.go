@@ -0,0 +1,179 @@
+package votings
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VotingAnalytics compares the results of all closed votings of a board.
+type VotingAnalytics struct {
+	// The number of participants of the board.
+	Participants int `json:"participants"`
+
+	// The closed votings of the board, oldest first.
+	Rounds []VotingRound `json:"rounds"`
+
+	// The notes that received votes in at least one round, ordered by their combined totals.
+	Notes []NoteAnalytics `json:"notes"`
+}
+
+func (*VotingAnalytics) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+// VotingRound summarizes a single closed voting.
+type VotingRound struct {
+	Voting      uuid.UUID  `json:"voting"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	Mode        VotingMode `json:"mode"`
+	IsAnonymous bool       `json:"isAnonymous"`
+	Total       int        `json:"total"`
+
+	// The number of participants that voted.
+	Voters int `json:"voters"`
+
+	// The share of the participants of the board that voted, between 0 and 1.
+	ParticipationRate float64 `json:"participationRate"`
+}
+
+// NoteAnalytics holds the results of a note in every round.
+type NoteAnalytics struct {
+	Note   uuid.UUID         `json:"note"`
+	Rounds []NoteRoundResult `json:"rounds"`
+}
+
+// NoteRoundResult is the result of a note in a single round.
+type NoteRoundResult struct {
+	Voting uuid.UUID `json:"voting"`
+	Total  int       `json:"total"`
+
+	// The position of the note in the round, notes with the same total share a rank.
+	// Not set if the note received no votes.
+	Rank *int `json:"rank,omitempty"`
+
+	// The number of positions the note moved up compared to the previous round, negative if it moved down.
+	// Only set if the note was ranked in both rounds.
+	RankChange *int `json:"rankChange,omitempty"`
+
+	// The votes per user, only set for votings that are not anonymous.
+	Users *[]VotingResultsPerUser `json:"userVotes,omitempty"`
+}
+
+func getAnalytics(votings []DatabaseVoting, votes []DatabaseVote, participants int) *VotingAnalytics {
+	closed := make([]DatabaseVoting, 0, len(votings))
+	for _, voting := range votings {
+		if voting.Status == Closed {
+			closed = append(closed, voting)
+		}
+	}
+	sort.SliceStable(closed, func(i, j int) bool {
+		return closed[i].CreatedAt.Before(closed[j].CreatedAt)
+	})
+
+	analytics := &VotingAnalytics{
+		Participants: participants,
+		Rounds:       make([]VotingRound, len(closed)),
+	}
+
+	results := make([]*VotingResults, len(closed))
+	combinedTotals := map[uuid.UUID]int{}
+	for index, voting := range closed {
+		results[index] = getVotingWithResults(voting, votes)
+
+		round := VotingRound{
+			Voting:      voting.ID,
+			CreatedAt:   voting.CreatedAt,
+			Mode:        voting.Mode,
+			IsAnonymous: voting.IsAnonymous,
+			Voters:      countVoters(voting, votes),
+		}
+		if results[index] != nil {
+			round.Total = results[index].Total
+			for note, result := range results[index].Votes {
+				combinedTotals[note] += result.Total
+			}
+		}
+		if participants > 0 {
+			round.ParticipationRate = float64(round.Voters) / float64(participants)
+		}
+		analytics.Rounds[index] = round
+	}
+
+	notes := make([]uuid.UUID, 0, len(combinedTotals))
+	for note := range combinedTotals {
+		notes = append(notes, note)
+	}
+	sort.Slice(notes, func(i, j int) bool {
+		if combinedTotals[notes[i]] != combinedTotals[notes[j]] {
+			return combinedTotals[notes[i]] > combinedTotals[notes[j]]
+		}
+		return notes[i].String() < notes[j].String()
+	})
+
+	ranks := make([]map[uuid.UUID]int, len(closed))
+	for index := range closed {
+		ranks[index] = rankNotes(results[index])
+	}
+
+	analytics.Notes = make([]NoteAnalytics, len(notes))
+	for noteIndex, note := range notes {
+		noteAnalytics := NoteAnalytics{Note: note, Rounds: make([]NoteRoundResult, len(closed))}
+		for index, voting := range closed {
+			result := NoteRoundResult{Voting: voting.ID}
+			if results[index] != nil {
+				if votesPerNote, ok := results[index].Votes[note]; ok {
+					result.Total = votesPerNote.Total
+					result.Users = votesPerNote.Users
+				}
+			}
+
+			if rank, ok := ranks[index][note]; ok {
+				result.Rank = &rank
+				if index > 0 {
+					if previousRank, ok := ranks[index-1][note]; ok {
+						change := previousRank - rank
+						result.RankChange = &change
+					}
+				}
+			}
+			noteAnalytics.Rounds[index] = result
+		}
+		analytics.Notes[noteIndex] = noteAnalytics
+	}
+
+	return analytics
+}
+
+// countVoters returns the number of distinct users that voted in a voting.
+func countVoters(voting DatabaseVoting, votes []DatabaseVote) int {
+	voters := map[uuid.UUID]bool{}
+	for _, vote := range votes {
+		if vote.Voting == voting.ID {
+			voters[vote.User] = true
+		}
+	}
+	return len(voters)
+}
+
+// rankNotes ranks the notes of a voting by their totals, notes with the same total share a rank.
+func rankNotes(results *VotingResults) map[uuid.UUID]int {
+	ranks := map[uuid.UUID]int{}
+	if results == nil {
+		return ranks
+	}
+
+	totals := make([]int, 0, len(results.Votes))
+	for _, result := range results.Votes {
+		totals = append(totals, result.Total)
+	}
+	slices.SortFunc(totals, func(a, b int) int { return b - a })
+
+	for note, result := range results.Votes {
+		ranks[note] = slices.Index(totals, result.Total) + 1
+	}
+	return ranks
+}
@@ -0,0 +1,150 @@
+package votings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyticsWithoutVotings(t *testing.T) {
+	analytics := getAnalytics(nil, nil, 3)
+
+	assert.Equal(t, 3, analytics.Participants)
+	assert.Empty(t, analytics.Rounds)
+	assert.Empty(t, analytics.Notes)
+}
+
+func TestAnalyticsOnlyContainsClosedVotingsInChronologicalOrder(t *testing.T) {
+	now := time.Now()
+	first := buildVoting(uuid.New(), Closed, false, false)
+	first.CreatedAt = now.Add(-2 * time.Hour)
+	second := buildVoting(uuid.New(), Closed, false, false)
+	second.CreatedAt = now.Add(-time.Hour)
+	open := buildVoting(uuid.New(), Open, false, false)
+
+	analytics := getAnalytics([]DatabaseVoting{*open, *second, *first}, nil, 1)
+
+	assert.Len(t, analytics.Rounds, 2)
+	assert.Equal(t, first.ID, analytics.Rounds[0].Voting)
+	assert.Equal(t, second.ID, analytics.Rounds[1].Voting)
+}
+
+func TestAnalyticsParticipationRate(t *testing.T) {
+	voting := buildVoting(uuid.New(), Closed, false, false)
+	userA := uuid.New()
+	userB := uuid.New()
+	note := uuid.New()
+
+	votes := []DatabaseVote{
+		*buildVote(voting.ID, note, userA),
+		*buildVote(voting.ID, note, userA),
+		*buildVote(voting.ID, note, userB),
+		*buildVote(uuid.New(), note, uuid.New()),
+	}
+
+	analytics := getAnalytics([]DatabaseVoting{*voting}, votes, 4)
+
+	assert.Equal(t, 2, analytics.Rounds[0].Voters)
+	assert.Equal(t, 3, analytics.Rounds[0].Total)
+	assert.Equal(t, 0.5, analytics.Rounds[0].ParticipationRate)
+}
+
+func TestAnalyticsParticipationRateWithoutParticipants(t *testing.T) {
+	voting := buildVoting(uuid.New(), Closed, false, false)
+	votes := []DatabaseVote{*buildVote(voting.ID, uuid.New(), uuid.New())}
+
+	analytics := getAnalytics([]DatabaseVoting{*voting}, votes, 0)
+
+	assert.Equal(t, 1, analytics.Rounds[0].Voters)
+	assert.Equal(t, 0.0, analytics.Rounds[0].ParticipationRate)
+}
+
+func TestAnalyticsRankMovement(t *testing.T) {
+	now := time.Now()
+	first := buildVoting(uuid.New(), Closed, false, false)
+	first.CreatedAt = now.Add(-time.Hour)
+	second := buildVoting(uuid.New(), Closed, false, false)
+	second.CreatedAt = now
+
+	noteA := uuid.New()
+	noteB := uuid.New()
+	noteC := uuid.New()
+	user := uuid.New()
+
+	votes := []DatabaseVote{
+		// first round: A 3, B 1, C no votes
+		*buildVote(first.ID, noteA, user),
+		*buildVote(first.ID, noteA, user),
+		*buildVote(first.ID, noteA, user),
+		*buildVote(first.ID, noteB, user),
+		// second round: B 2, C 2, A 1
+		*buildVote(second.ID, noteB, user),
+		*buildVote(second.ID, noteB, user),
+		*buildVote(second.ID, noteC, user),
+		*buildVote(second.ID, noteC, user),
+		*buildVote(second.ID, noteA, user),
+	}
+
+	analytics := getAnalytics([]DatabaseVoting{*second, *first}, votes, 1)
+
+	assert.Len(t, analytics.Notes, 3)
+	results := map[uuid.UUID][]NoteRoundResult{}
+	for _, note := range analytics.Notes {
+		results[note.Note] = note.Rounds
+	}
+
+	assert.Equal(t, noteA, analytics.Notes[0].Note)
+	assert.Equal(t, 3, results[noteA][0].Total)
+	assert.Equal(t, 1, *results[noteA][0].Rank)
+	assert.Nil(t, results[noteA][0].RankChange)
+	assert.Equal(t, 3, *results[noteA][1].Rank)
+	assert.Equal(t, -2, *results[noteA][1].RankChange)
+
+	assert.Equal(t, 2, *results[noteB][0].Rank)
+	assert.Equal(t, 1, *results[noteB][1].Rank)
+	assert.Equal(t, 1, *results[noteB][1].RankChange)
+
+	assert.Equal(t, 0, results[noteC][0].Total)
+	assert.Nil(t, results[noteC][0].Rank)
+	assert.Equal(t, 2, results[noteC][1].Total)
+	assert.Equal(t, 1, *results[noteC][1].Rank)
+	assert.Nil(t, results[noteC][1].RankChange)
+}
+
+func TestAnalyticsRespectsAnonymousVotings(t *testing.T) {
+	now := time.Now()
+	anonymous := buildVoting(uuid.New(), Closed, false, true)
+	anonymous.CreatedAt = now.Add(-time.Hour)
+	public := buildVoting(uuid.New(), Closed, false, false)
+	public.CreatedAt = now
+
+	note := uuid.New()
+	user := uuid.New()
+	votes := []DatabaseVote{
+		*buildVote(anonymous.ID, note, user),
+		*buildVote(public.ID, note, user),
+	}
+
+	analytics := getAnalytics([]DatabaseVoting{*anonymous, *public}, votes, 1)
+
+	assert.True(t, analytics.Rounds[0].IsAnonymous)
+	assert.Nil(t, analytics.Notes[0].Rounds[0].Users)
+	assert.Equal(t, &[]VotingResultsPerUser{{ID: user, Total: 1}}, analytics.Notes[0].Rounds[1].Users)
+}
+
+func TestAnalyticsUsesScoreOfVotingMode(t *testing.T) {
+	voting := buildVoting(uuid.New(), Closed, false, false)
+	voting.Mode = PointsMode
+	voting.VoteLimit = 5
+
+	note := uuid.New()
+	vote := buildVote(voting.ID, note, uuid.New())
+	vote.Weight = 4
+
+	analytics := getAnalytics([]DatabaseVoting{*voting}, []DatabaseVote{*vote}, 1)
+
+	assert.Equal(t, 4, analytics.Rounds[0].Total)
+	assert.Equal(t, 4, analytics.Notes[0].Rounds[0].Total)
+}
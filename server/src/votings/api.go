@@ -15,6 +15,8 @@ type VotingService interface {
 	AddVote(ctx context.Context, req VoteRequest) (*Vote, error)
 	RemoveVote(ctx context.Context, req VoteRequest) error
 	Close(ctx context.Context, id uuid.UUID, board uuid.UUID, affectedNotes []Note) (*Voting, error)
+	CloseExpired(ctx context.Context) (int, error)
+	GetAnalytics(ctx context.Context, board uuid.UUID) (*VotingAnalytics, error)
 }
 
 type VotingApi struct {
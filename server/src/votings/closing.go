@@ -0,0 +1,55 @@
+package votings
+
+import (
+	"context"
+	"time"
+
+	"scrumlr.io/server/logger"
+)
+
+// ClosingJob periodically closes open votings whose end passed.
+//
+// The end of a voting is stored in the database, so votings are closed after a restart as well.
+// Several instances may run the job, since a voting can only be closed once.
+type ClosingJob struct {
+	service  VotingService
+	interval time.Duration
+}
+
+// NewClosingJob creates a job that closes expired votings every interval.
+func NewClosingJob(service VotingService, interval time.Duration) *ClosingJob {
+	return &ClosingJob{service: service, interval: interval}
+}
+
+// Start runs the job in the background until the context is done.
+func (job *ClosingJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(job.interval)
+		defer ticker.Stop()
+
+		for {
+			job.Run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Run closes all currently expired votings once.
+func (job *ClosingJob) Run(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	closed, err := job.service.CloseExpired(ctx)
+	if err != nil {
+		log.Errorw("unable to close all expired votings", "closed", closed, "err", err)
+		return
+	}
+
+	if closed > 0 {
+		log.Infow("closed expired votings", "closed", closed)
+	}
+}
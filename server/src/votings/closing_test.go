@@ -0,0 +1,43 @@
+package votings
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClosingJob_Run(t *testing.T) {
+	mockVotingService := NewMockVotingService(t)
+	mockVotingService.EXPECT().CloseExpired(mock.Anything).Return(1, nil).Once()
+
+	NewClosingJob(mockVotingService, time.Second).Run(context.Background())
+}
+
+func TestClosingJob_RunError(t *testing.T) {
+	mockVotingService := NewMockVotingService(t)
+	mockVotingService.EXPECT().CloseExpired(mock.Anything).Return(0, errors.New("failed")).Once()
+
+	NewClosingJob(mockVotingService, time.Second).Run(context.Background())
+}
+
+func TestClosingJob_StartRunsImmediately(t *testing.T) {
+	done := make(chan struct{})
+	mockVotingService := NewMockVotingService(t)
+	mockVotingService.EXPECT().CloseExpired(mock.Anything).
+		Run(func(_ context.Context) { close(done) }).
+		Return(0, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	NewClosingJob(mockVotingService, time.Hour).Start(ctx)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("closing job did not run")
+	}
+}
@@ -2,6 +2,8 @@ package votings
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -38,10 +40,12 @@ func (d *DB) Close(ctx context.Context, update DatabaseVotingUpdate) (DatabaseVo
 		Where("status = ?", Open).
 		Returning("*")
 
+	// the voting may have been closed by another instance in the meantime, which must not reset the shown voting
 	updateBoard := d.db.NewUpdate().
 		Model((*common.DatabaseBoard)(nil)).
 		Set("show_voting = (SELECT id FROM \"updateQuery\")").
-		Where("id = ?", update.Board)
+		Where("id = ?", update.Board).
+		Where("EXISTS (SELECT 1 FROM \"updateQuery\")")
 
 	err := d.db.NewSelect().
 		With("updateQuery", updateQuery).
@@ -97,16 +101,16 @@ func (d *DB) GetVotes(ctx context.Context, board uuid.UUID, f VoteFilter) ([]Dat
 	return votes, err
 }
 
-func (d *DB) AddVote(ctx context.Context, board, user, note uuid.UUID) (DatabaseVote, error) {
+func (d *DB) AddVote(ctx context.Context, board, user, note uuid.UUID, weight int, rank *int) (DatabaseVote, error) {
 	openVotingQuery := d.db.NewSelect().
 		Model((*DatabaseVoting)(nil)).
-		Column("id", "vote_limit", "allow_multiple_votes").
+		Column("id", "vote_limit", "allow_multiple_votes", "mode").
 		Where("board = ?", board).
 		Where("status = ?", Open)
 
 	currentVoteCount := d.db.NewSelect().
 		Model((*DatabaseVote)(nil)).
-		ColumnExpr("note").
+		Column("note", "weight", "rank").
 		Where("voting = (SELECT id FROM \"openVotingQuery\")").
 		Where("\"user\" = ?", user)
 
@@ -122,7 +126,22 @@ func (d *DB) AddVote(ctx context.Context, board, user, note uuid.UUID) (Database
 		ColumnExpr("uuid(?) as board", board).
 		ColumnExpr("uuid(?) as note", note).
 		ColumnExpr("uuid(?) as \"user\"", user).
-		Where("(SELECT COUNT(*) FROM \"currentVoteCount\") < (SELECT vote_limit FROM \"openVotingQuery\")").
+		ColumnExpr("?::int as weight", weight).
+		ColumnExpr("?::int as rank", rank).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			// the points of a point budget voting are limited by the budget, all other votes by their number
+			return q.
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.
+						Where("(SELECT mode FROM \"openVotingQuery\") = ?", PointsMode).
+						Where("(SELECT COALESCE(SUM(weight), 0) FROM \"currentVoteCount\") + ? <= (SELECT vote_limit FROM \"openVotingQuery\")", weight)
+				}).
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.
+						Where("(SELECT mode FROM \"openVotingQuery\") <> ?", PointsMode).
+						Where("(SELECT COUNT(*) FROM \"currentVoteCount\") < (SELECT vote_limit FROM \"openVotingQuery\")")
+				})
+		}).
 		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
 			return q.
 				WhereOr("(SELECT allow_multiple_votes FROM \"openVotingQuery\")").
@@ -132,6 +151,12 @@ func (d *DB) AddVote(ctx context.Context, board, user, note uuid.UUID) (Database
 						Where("(SELECT count FROM \"currentVotesOnNoteCount\") < 1")
 				})
 
+		}).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			// every rank can only be given once on a ballot of a ranked-choice voting
+			return q.
+				WhereOr("(SELECT mode FROM \"openVotingQuery\") <> ?", RankedMode).
+				WhereOr("NOT EXISTS (SELECT 1 FROM \"currentVoteCount\" WHERE rank = ?)", rank)
 		})
 
 	var result DatabaseVote
@@ -140,9 +165,9 @@ func (d *DB) AddVote(ctx context.Context, board, user, note uuid.UUID) (Database
 		With("currentVoteCount", currentVoteCount).
 		With("currentVotesOnNoteCount", currentVotesOnNoteCount).
 		With("_values", values).
-		Model(new(DatabaseVote{Board: board, User: user, Note: note})).
+		Model(new(DatabaseVote{Board: board, User: user, Note: note, Weight: weight, Rank: rank})).
 		TableExpr("_values").
-		Column("board", "voting", "user", "note").
+		Column("board", "voting", "user", "note", "weight", "rank").
 		Returning("*").
 		Exec(ctx, &result)
 
@@ -175,6 +200,52 @@ func (d *DB) RemoveVote(ctx context.Context, board, user, note uuid.UUID) error
 	return err
 }
 
+func (d *DB) IsScopeOnBoard(ctx context.Context, board uuid.UUID, scope VotingScope) (bool, error) {
+	columnsOnBoard, err := d.idsOnBoard(ctx, "columns", board, scope.Columns)
+	if err != nil || !columnsOnBoard {
+		return false, err
+	}
+
+	return d.idsOnBoard(ctx, "notes", board, scope.Notes)
+}
+
+func (d *DB) idsOnBoard(ctx context.Context, table string, board uuid.UUID, ids []uuid.UUID) (bool, error) {
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	var found []uuid.UUID
+	err := d.db.NewSelect().
+		Table(table).
+		Column("id").
+		Where("board = ?", board).
+		Where("id IN (?)", bun.In(ids)).
+		Scan(ctx, &found)
+	if err != nil {
+		return false, err
+	}
+
+	for _, id := range ids {
+		if !slices.Contains(found, id) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (d *DB) GetExpiredVotings(ctx context.Context, now time.Time) ([]DatabaseVoting, error) {
+	var votings []DatabaseVoting
+	err := d.db.NewSelect().
+		Model(&votings).
+		Where("status = ?", Open).
+		Where("ends_at IS NOT NULL").
+		Where("ends_at <= ?", now).
+		Scan(ctx)
+
+	return votings, err
+}
+
 func (d *DB) GetOpenVoting(ctx context.Context, board uuid.UUID) (DatabaseVoting, error) {
 	var voting DatabaseVoting
 	err := d.db.NewSelect().
@@ -185,3 +256,10 @@ func (d *DB) GetOpenVoting(ctx context.Context, board uuid.UUID) (DatabaseVoting
 
 	return voting, err
 }
+
+func (d *DB) CountBoardSessions(ctx context.Context, board uuid.UUID) (int, error) {
+	return d.db.NewSelect().
+		Table("board_sessions").
+		Where("board = ?", board).
+		Count(ctx)
+}
@@ -17,6 +17,10 @@ type DatabaseVoting struct {
 	ShowVotesOfOthers  bool
 	IsAnonymous        bool
 	Status             VotingStatus
+	Mode               VotingMode
+	EndsAt             *time.Time
+	ScopeColumns       []uuid.UUID `bun:",array"`
+	ScopeNotes         []uuid.UUID `bun:",array"`
 }
 
 type DatabaseVotingInsert struct {
@@ -27,6 +31,10 @@ type DatabaseVotingInsert struct {
 	ShowVotesOfOthers  bool
 	IsAnonymous        bool
 	Status             VotingStatus
+	Mode               VotingMode `bun:",nullzero"`
+	EndsAt             *time.Time
+	ScopeColumns       []uuid.UUID `bun:",array"`
+	ScopeNotes         []uuid.UUID `bun:",array"`
 }
 
 type DatabaseVotingUpdate struct {
@@ -42,4 +50,6 @@ type DatabaseVote struct {
 	Voting        uuid.UUID
 	User          uuid.UUID
 	Note          uuid.UUID
+	Weight        int
+	Rank          *int
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -110,6 +111,31 @@ func (suite *DatabaseVotingTestSuite) Test_Database_Close() {
 	assert.Equal(t, 2, noteRankMap[suite.baseData.Notes["Update3"].ID])
 }
 
+func (suite *DatabaseVotingTestSuite) Test_Database_Close_Twice() {
+	t := suite.T()
+	database := NewVotingDatabase(suite.db)
+
+	votingId := suite.baseData.Votings["Update"].ID
+	boardId := suite.baseData.Boards["Update"].ID
+	update := DatabaseVotingUpdate{ID: votingId, Board: boardId, Status: Closed}
+
+	_, err := database.Close(context.Background(), update)
+	assert.Nil(t, err)
+
+	// e.g. the closing job of another instance or a moderator closing the voting at the same time
+	_, err = database.Close(context.Background(), update)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	var showVoting *uuid.UUID
+	err = suite.db.NewSelect().
+		Table("boards").
+		Column("show_voting").
+		Where("id = ?", boardId).
+		Scan(context.Background(), &showVoting)
+	assert.Nil(t, err)
+	assert.Equal(t, &votingId, showVoting)
+}
+
 func (suite *DatabaseVotingTestSuite) Test_Database_Get_Open() {
 	t := suite.T()
 	database := NewVotingDatabase(suite.db)
@@ -183,6 +209,78 @@ func (suite *DatabaseVotingTestSuite) Test_Database_GetOpenVoting() {
 	assert.NotNil(t, dbVoting.CreatedAt)
 }
 
+func (suite *DatabaseVotingTestSuite) Test_Database_GetExpiredVotings() {
+	t := suite.T()
+	database := NewVotingDatabase(suite.db)
+
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	expiredVoting, err := database.Create(context.Background(), DatabaseVotingInsert{Board: suite.baseData.Boards["Create"].ID, VoteLimit: 5, Status: Open, EndsAt: &past})
+	assert.Nil(t, err)
+	_, err = database.Create(context.Background(), DatabaseVotingInsert{Board: suite.baseData.Boards["CreateEmpty"].ID, VoteLimit: 5, Status: Open, EndsAt: &future})
+	assert.Nil(t, err)
+
+	dbVotings, err := database.GetExpiredVotings(context.Background(), now)
+
+	assert.Nil(t, err)
+	assert.Len(t, dbVotings, 1)
+	assert.Equal(t, expiredVoting.ID, dbVotings[0].ID)
+}
+
+func (suite *DatabaseVotingTestSuite) Test_Database_IsScopeOnBoard() {
+	t := suite.T()
+	database := NewVotingDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Update"].ID
+	scope := VotingScope{
+		Columns: []uuid.UUID{suite.baseData.Columns["Update"].ID},
+		Notes:   []uuid.UUID{suite.baseData.Notes["Update1"].ID, suite.baseData.Notes["Update2"].ID},
+	}
+
+	onBoard, err := database.IsScopeOnBoard(context.Background(), boardId, scope)
+	assert.Nil(t, err)
+	assert.True(t, onBoard)
+
+	onBoard, err = database.IsScopeOnBoard(context.Background(), boardId, VotingScope{Notes: []uuid.UUID{suite.baseData.Notes["Read1"].ID}})
+	assert.Nil(t, err)
+	assert.False(t, onBoard)
+
+	onBoard, err = database.IsScopeOnBoard(context.Background(), boardId, VotingScope{Columns: []uuid.UUID{suite.baseData.Columns["Read"].ID}})
+	assert.Nil(t, err)
+	assert.False(t, onBoard)
+}
+
+func (suite *DatabaseVotingTestSuite) Test_Database_CountBoardSessions() {
+	t := suite.T()
+	database := NewVotingDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Read"].ID
+	assert.Nil(t, testDbTemplates.InsertSession(suite.db, suite.baseData.Users["Stan"].ID, boardId, "OWNER", false, false, false, false))
+	assert.Nil(t, testDbTemplates.InsertSession(suite.db, suite.baseData.Users["Santa"].ID, boardId, "PARTICIPANT", false, false, false, false))
+
+	count, err := database.CountBoardSessions(context.Background(), boardId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func (suite *DatabaseVotingTestSuite) Test_Database_CreateScoped() {
+	t := suite.T()
+	database := NewVotingDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Create"].ID
+	columnId := suite.baseData.Columns["Create"].ID
+	noteId := suite.baseData.Notes["Create"].ID
+
+	dbVoting, err := database.Create(context.Background(), DatabaseVotingInsert{Board: boardId, VoteLimit: 5, Status: Open, ScopeColumns: []uuid.UUID{columnId}, ScopeNotes: []uuid.UUID{noteId}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{columnId}, dbVoting.ScopeColumns)
+	assert.Equal(t, []uuid.UUID{noteId}, dbVoting.ScopeNotes)
+}
+
 func (suite *DatabaseVotingTestSuite) Test_Database_AddVote() {
 	t := suite.T()
 	database := NewVotingDatabase(suite.db)
@@ -191,7 +289,7 @@ func (suite *DatabaseVotingTestSuite) Test_Database_AddVote() {
 	userId := suite.baseData.Users["Santa"].ID
 	noteId := suite.baseData.Notes["WriteAdd"].ID
 
-	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId)
+	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId, 1, nil)
 
 	assert.Nil(t, err)
 	assert.Equal(t, boardId, dbVote.Board)
@@ -208,7 +306,7 @@ func (suite *DatabaseVotingTestSuite) Test_Database_AddVote_Closed() {
 	userId := suite.baseData.Users["Santa"].ID
 	noteId := suite.baseData.Notes["WriteClosed"].ID
 
-	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId)
+	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId, 1, nil)
 
 	assert.NotNil(t, err)
 	assert.Equal(t, sql.ErrNoRows, err)
@@ -223,7 +321,7 @@ func (suite *DatabaseVotingTestSuite) Test_Database_AddVote_AboveLimit() {
 	userId := suite.baseData.Users["Stan"].ID
 	noteId := suite.baseData.Notes["WriteLimit"].ID
 
-	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId)
+	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId, 1, nil)
 
 	assert.NotNil(t, err)
 	assert.Equal(t, sql.ErrNoRows, err)
@@ -238,13 +336,63 @@ func (suite *DatabaseVotingTestSuite) Test_Database_AddVote_MultipleNotAllowed()
 	userId := suite.baseData.Users["Stan"].ID
 	noteId := suite.baseData.Notes["WriteMultiple"].ID
 
-	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId)
+	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId, 1, nil)
 
 	assert.NotNil(t, err)
 	assert.Equal(t, sql.ErrNoRows, err)
 	assert.Equal(t, DatabaseVote{}, dbVote)
 }
 
+func (suite *DatabaseVotingTestSuite) Test_Database_AddVote_PointBudget() {
+	t := suite.T()
+	database := NewVotingDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["Create"].ID
+	userId := suite.baseData.Users["Stan"].ID
+	noteId := suite.baseData.Notes["Create"].ID
+
+	_, err := database.Create(context.Background(), DatabaseVotingInsert{Board: boardId, VoteLimit: 5, AllowMultipleVotes: true, Status: Open, Mode: PointsMode})
+	assert.Nil(t, err)
+
+	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId, 3, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, dbVote.Weight)
+
+	dbVote, err = database.AddVote(context.Background(), boardId, userId, noteId, 3, nil)
+	assert.Equal(t, sql.ErrNoRows, err)
+	assert.Equal(t, DatabaseVote{}, dbVote)
+
+	dbVote, err = database.AddVote(context.Background(), boardId, userId, noteId, 2, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, dbVote.Weight)
+}
+
+func (suite *DatabaseVotingTestSuite) Test_Database_AddVote_RankedDuplicateRank() {
+	t := suite.T()
+	database := NewVotingDatabase(suite.db)
+
+	boardId := suite.baseData.Boards["CreateEmpty"].ID
+	userId := suite.baseData.Users["Stan"].ID
+	noteId := suite.baseData.Notes["CreateEmpty"].ID
+	first := 1
+	second := 2
+
+	_, err := database.Create(context.Background(), DatabaseVotingInsert{Board: boardId, VoteLimit: 3, AllowMultipleVotes: true, Status: Open, Mode: RankedMode})
+	assert.Nil(t, err)
+
+	dbVote, err := database.AddVote(context.Background(), boardId, userId, noteId, 1, &first)
+	assert.Nil(t, err)
+	assert.Equal(t, &first, dbVote.Rank)
+
+	dbVote, err = database.AddVote(context.Background(), boardId, userId, noteId, 1, &first)
+	assert.Equal(t, sql.ErrNoRows, err)
+	assert.Equal(t, DatabaseVote{}, dbVote)
+
+	dbVote, err = database.AddVote(context.Background(), boardId, userId, noteId, 1, &second)
+	assert.Nil(t, err)
+	assert.Equal(t, &second, dbVote.Rank)
+}
+
 func (suite *DatabaseVotingTestSuite) Test_Database_RemoveVote() {
 	t := suite.T()
 	database := NewVotingDatabase(suite.db)
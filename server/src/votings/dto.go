@@ -2,20 +2,30 @@ package votings
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
+	"scrumlr.io/server/notes"
 )
 
 type Vote struct {
 	Voting uuid.UUID `json:"voting"`
 	Note   uuid.UUID `json:"note"`
 	User   uuid.UUID `json:"user"`
+
+	// The points given with the vote in a point budget voting, 1 in all other modes.
+	Weight int `json:"weight"`
+
+	// The position of the note on the ballot of a ranked-choice voting.
+	Rank *int `json:"rank,omitempty"`
 }
 
 func (v *Vote) From(vote DatabaseVote) *Vote {
 	v.Voting = vote.Voting
 	v.Note = vote.Note
 	v.User = vote.User
+	v.Weight = vote.Weight
+	v.Rank = vote.Rank
 	return v
 }
 
@@ -33,18 +43,38 @@ func Votes(votes []DatabaseVote) []*Vote {
 
 // VoteRequest represents the request to add or delete a vote.
 type VoteRequest struct {
-	Note  uuid.UUID `json:"note"`
+	Note uuid.UUID `json:"note"`
+
+	// The points to give in a point budget voting, defaults to 1.
+	Weight int `json:"weight,omitempty"`
+
+	// The position of the note on the ballot of a ranked-choice voting, starting with 1 for the first choice.
+	Rank *int `json:"rank,omitempty"`
+
 	Board uuid.UUID `json:"-"`
 	User  uuid.UUID `json:"-"`
 }
 
 // VotingCreateRequest represents the request to create a new voting session.
 type VotingCreateRequest struct {
-	Board              uuid.UUID `json:"-"`
-	VoteLimit          int       `json:"voteLimit"`
-	AllowMultipleVotes bool      `json:"allowMultipleVotes"`
-	ShowVotesOfOthers  bool      `json:"showVotesOfOthers"`
-	IsAnonymous        bool      `json:"isAnonymous"`
+	Board              uuid.UUID  `json:"-"`
+	VoteLimit          int        `json:"voteLimit"`
+	AllowMultipleVotes bool       `json:"allowMultipleVotes"`
+	ShowVotesOfOthers  bool       `json:"showVotesOfOthers"`
+	IsAnonymous        bool       `json:"isAnonymous"`
+	Mode               VotingMode `json:"mode,omitempty"`
+
+	// The number of seconds after which the voting is closed automatically.
+	Duration int `json:"duration,omitempty"`
+
+	// The point in time at which the voting is closed automatically, an alternative to the duration.
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+
+	// Whether the board timer is set to run until the end of the voting.
+	StartTimer bool `json:"startTimer,omitempty"`
+
+	// The columns and notes the voting is restricted to, the whole board if not set.
+	Scope *VotingScope `json:"scope,omitempty"`
 }
 
 // VotingCloseRequest represents the request to update a voting session.
@@ -62,6 +92,9 @@ type Voting struct {
 	Status             VotingStatus   `json:"status"`
 	VotingResults      *VotingResults `json:"votes,omitempty"`
 	IsAnonymous        bool           `json:"isAnonymous"`
+	Mode               VotingMode     `json:"mode"`
+	EndsAt             *time.Time     `json:"endsAt,omitempty"`
+	Scope              *VotingScope   `json:"scope,omitempty"`
 }
 
 func (v *Voting) From(voting DatabaseVoting, votes []DatabaseVote) *Voting {
@@ -72,6 +105,9 @@ func (v *Voting) From(voting DatabaseVoting, votes []DatabaseVote) *Voting {
 	v.Status = voting.Status
 	v.VotingResults = getVotingWithResults(voting, votes)
 	v.IsAnonymous = voting.IsAnonymous
+	v.Mode = voting.Mode
+	v.EndsAt = voting.EndsAt
+	v.Scope = scopeFrom(voting)
 	return v
 }
 
@@ -79,6 +115,9 @@ func (*Voting) Render(_ http.ResponseWriter, _ *http.Request) error {
 	return nil
 }
 
+// VotingResults are the scores of the notes in a closed voting.
+// The score of a vote depends on the mode of the voting: every vote counts 1, except for the points
+// of a vote in a point budget voting and the Borda count of a vote in a ranked-choice voting.
 type VotingResults struct {
 	Total int                                `json:"total"`
 	Votes map[uuid.UUID]VotingResultsPerNote `json:"votesPerNote"`
@@ -132,3 +171,25 @@ type NotePosition struct {
 	// The note rank.
 	Rank int `json:"rank"`
 }
+
+func (n *Note) From(note notes.Note) *Note {
+	n.ID = note.ID
+	n.Author = note.Author
+	n.Text = note.Text
+	n.Edited = note.Edited
+	n.Position = NotePosition{
+		Column: note.Position.Column,
+		Stack:  note.Position.Stack,
+		Rank:   note.Position.Rank,
+	}
+	return n
+}
+
+// AffectedNotes converts the notes of a board to the notes that are sorted and sent with a closed voting.
+func AffectedNotes(boardNotes []*notes.Note) []Note {
+	var affectedNotes []Note
+	for _, note := range boardNotes {
+		affectedNotes = append(affectedNotes, *new(Note).From(*note))
+	}
+	return affectedNotes
+}
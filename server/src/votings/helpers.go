@@ -58,17 +58,18 @@ func getVotingWithResults(voting DatabaseVoting, votes []DatabaseVote) *VotingRe
 		return nil
 	}
 
-	votingResult := VotingResults{Total: len(relevantVoting), Votes: map[uuid.UUID]VotingResultsPerNote{}}
+	votingResult := VotingResults{Total: 0, Votes: map[uuid.UUID]VotingResultsPerNote{}}
 	totalVotePerNote := map[uuid.UUID]int{}
-	votesPerUser := map[uuid.UUID][]uuid.UUID{}
+	votesPerUser := map[uuid.UUID]map[uuid.UUID]int{}
 	for _, vote := range relevantVoting {
-		if _, ok := totalVotePerNote[vote.Note]; ok {
-			totalVotePerNote[vote.Note] = totalVotePerNote[vote.Note] + 1
-			votesPerUser[vote.Note] = append(votesPerUser[vote.Note], vote.User)
-		} else {
-			totalVotePerNote[vote.Note] = 1
-			votesPerUser[vote.Note] = []uuid.UUID{vote.User}
+		score := voting.Mode.score(vote, voting.VoteLimit)
+		votingResult.Total += score
+		totalVotePerNote[vote.Note] += score
+
+		if _, ok := votesPerUser[vote.Note]; !ok {
+			votesPerUser[vote.Note] = map[uuid.UUID]int{}
 		}
+		votesPerUser[vote.Note][vote.User] += score
 	}
 
 	for note, total := range totalVotePerNote {
@@ -77,17 +78,8 @@ func getVotingWithResults(voting DatabaseVoting, votes []DatabaseVote) *VotingRe
 		}
 
 		if !voting.IsAnonymous {
-			userVotes := map[uuid.UUID]int{}
-			for _, user := range votesPerUser[note] {
-				if _, ok := userVotes[user]; ok {
-					userVotes[user] = userVotes[user] + 1
-				} else {
-					userVotes[user] = 1
-				}
-			}
-
 			var votingResultsPerUser []VotingResultsPerUser
-			for user, total := range userVotes {
+			for user, total := range votesPerUser[note] {
 				votingResultsPerUser = append(votingResultsPerUser, VotingResultsPerUser{
 					ID:    user,
 					Total: total,
@@ -109,6 +101,10 @@ func (v *Voting) calculateTotalVoteCount(notes []Note) *VotingResults {
 	}
 
 	for _, note := range notes {
+		if !v.Scope.Contains(note) {
+			continue
+		}
+
 		if voteResults, ok := v.VotingResults.Votes[note.ID]; ok { // Check if note was voted on
 			votingResultsPerNode.Votes[note.ID] = VotingResultsPerNote{
 				Total: voteResults.Total,
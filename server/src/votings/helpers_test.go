@@ -114,6 +114,86 @@ func TestMultipleVotesForOneNoteFromOneUser(t *testing.T) {
 	assert.Equal(t, userId, users[0].ID)
 }
 
+func TestPointsAreSummedUpInPointBudgetVoting(t *testing.T) {
+	voteId := uuid.New()
+	note1Id := uuid.New()
+	note2Id := uuid.New()
+	user1Id := uuid.New()
+	user2Id := uuid.New()
+
+	voting := buildVoting(voteId, Closed, true, false)
+	voting.Mode = PointsMode
+	voting.VoteLimit = 10
+
+	vote1 := buildVote(voteId, note1Id, user1Id)
+	vote1.Weight = 7
+	vote2 := buildVote(voteId, note2Id, user1Id)
+	vote2.Weight = 3
+	vote3 := buildVote(voteId, note1Id, user2Id)
+	vote3.Weight = 10
+
+	res := getVotingWithResults(*voting, []DatabaseVote{*vote1, *vote2, *vote3})
+
+	assert.Equal(t, 20, res.Total)
+	assert.Equal(t, 17, res.Votes[note1Id].Total)
+	assert.Equal(t, 3, res.Votes[note2Id].Total)
+	assert.Len(t, *res.Votes[note1Id].Users, 2)
+}
+
+func TestBallotsAreTalliedWithBordaCountInRankedVoting(t *testing.T) {
+	voteId := uuid.New()
+	note1Id := uuid.New()
+	note2Id := uuid.New()
+	note3Id := uuid.New()
+	user1Id := uuid.New()
+	user2Id := uuid.New()
+
+	voting := buildVoting(voteId, Closed, true, false)
+	voting.Mode = RankedMode
+	voting.VoteLimit = 3
+
+	ranked := func(note, user uuid.UUID, rank int) DatabaseVote {
+		vote := buildVote(voteId, note, user)
+		vote.Rank = &rank
+		return *vote
+	}
+
+	votes := []DatabaseVote{
+		ranked(note1Id, user1Id, 1), ranked(note2Id, user1Id, 2), ranked(note3Id, user1Id, 3),
+		ranked(note2Id, user2Id, 1), ranked(note1Id, user2Id, 2),
+	}
+
+	res := getVotingWithResults(*voting, votes)
+
+	assert.Equal(t, 11, res.Total)
+	assert.Equal(t, 5, res.Votes[note1Id].Total)
+	assert.Equal(t, 5, res.Votes[note2Id].Total)
+	assert.Equal(t, 1, res.Votes[note3Id].Total)
+}
+
+func TestEveryBallotCountsOnceInSingleChoiceVoting(t *testing.T) {
+	voteId := uuid.New()
+	note1Id := uuid.New()
+	note2Id := uuid.New()
+
+	voting := buildVoting(voteId, Closed, false, true)
+	voting.Mode = SingleChoiceMode
+	voting.VoteLimit = 1
+
+	votes := []DatabaseVote{
+		*buildVote(voteId, note1Id, uuid.New()),
+		*buildVote(voteId, note1Id, uuid.New()),
+		*buildVote(voteId, note2Id, uuid.New()),
+	}
+
+	res := getVotingWithResults(*voting, votes)
+
+	assert.Equal(t, 3, res.Total)
+	assert.Equal(t, 2, res.Votes[note1Id].Total)
+	assert.Equal(t, 1, res.Votes[note2Id].Total)
+	assert.Nil(t, res.Votes[note1Id].Users)
+}
+
 func TestCalculateVoteCountsWithEmptySlice(t *testing.T) {
 
 	var noteSlice []Note
@@ -180,6 +260,28 @@ func TestShouldReturnVotingResults(t *testing.T) {
 	assert.Equal(t, voting, updatedVoting.Voting)
 }
 
+func TestShouldOnlyReturnVotingResultsInScope(t *testing.T) {
+
+	voteId := uuid.New()
+	columnId := uuid.New()
+	note1Id := uuid.New()
+	note2Id := uuid.New()
+	userId := uuid.New()
+
+	voting := Votings(
+		[]DatabaseVoting{*buildVoting(voteId, Closed, true, false)},
+		[]DatabaseVote{*buildVote(voteId, note1Id, userId), *buildVote(voteId, note2Id, userId)},
+	)[0]
+	voting.Scope = &VotingScope{Columns: []uuid.UUID{columnId}}
+	noteSlice := []Note{{ID: note1Id, Position: NotePosition{Column: columnId}}, {ID: note2Id, Position: NotePosition{Column: uuid.New()}}}
+
+	updatedVoting := voting.UpdateVoting(noteSlice)
+
+	assert.Equal(t, 1, updatedVoting.Voting.VotingResults.Total)
+	assert.Contains(t, updatedVoting.Voting.VotingResults.Votes, note1Id)
+	assert.NotContains(t, updatedVoting.Voting.VotingResults.Votes, note2Id)
+}
+
 func TestShouldUnmarshallVoteData(t *testing.T) {
 
 	voteId := uuid.New()
@@ -277,6 +379,7 @@ func buildVote(votingId, noteId, userId uuid.UUID) *DatabaseVote {
 		Board:     uuid.UUID{},
 		User:      userId,
 		Note:      noteId,
+		Weight:    1,
 	}
 }
 
@@ -291,5 +394,6 @@ func buildVoting(id uuid.UUID, status VotingStatus, showVotesOfOthers bool, isAn
 		ShowVotesOfOthers:  showVotesOfOthers,
 		IsAnonymous:        isAnonymous,
 		Status:             status,
+		Mode:               DefaultMode,
 	}
 }
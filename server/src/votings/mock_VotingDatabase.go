@@ -6,6 +6,7 @@ package votings
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -39,8 +40,8 @@ func (_m *MockVotingDatabase) EXPECT() *MockVotingDatabase_Expecter {
 }
 
 // AddVote provides a mock function for the type MockVotingDatabase
-func (_mock *MockVotingDatabase) AddVote(ctx context.Context, board uuid.UUID, user uuid.UUID, note uuid.UUID) (DatabaseVote, error) {
-	ret := _mock.Called(ctx, board, user, note)
+func (_mock *MockVotingDatabase) AddVote(ctx context.Context, board uuid.UUID, user uuid.UUID, note uuid.UUID, weight int, rank *int) (DatabaseVote, error) {
+	ret := _mock.Called(ctx, board, user, note, weight, rank)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddVote")
@@ -48,16 +49,16 @@ func (_mock *MockVotingDatabase) AddVote(ctx context.Context, board uuid.UUID, u
 
 	var r0 DatabaseVote
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (DatabaseVote, error)); ok {
-		return returnFunc(ctx, board, user, note)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int, *int) (DatabaseVote, error)); ok {
+		return returnFunc(ctx, board, user, note, weight, rank)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) DatabaseVote); ok {
-		r0 = returnFunc(ctx, board, user, note)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int, *int) DatabaseVote); ok {
+		r0 = returnFunc(ctx, board, user, note, weight, rank)
 	} else {
 		r0 = ret.Get(0).(DatabaseVote)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, board, user, note)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, int, *int) error); ok {
+		r1 = returnFunc(ctx, board, user, note, weight, rank)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,11 +75,13 @@ type MockVotingDatabase_AddVote_Call struct {
 //   - board uuid.UUID
 //   - user uuid.UUID
 //   - note uuid.UUID
-func (_e *MockVotingDatabase_Expecter) AddVote(ctx any, board any, user any, note any) *MockVotingDatabase_AddVote_Call {
-	return &MockVotingDatabase_AddVote_Call{Call: _e.mock.On("AddVote", ctx, board, user, note)}
+//   - weight int
+//   - rank *int
+func (_e *MockVotingDatabase_Expecter) AddVote(ctx any, board any, user any, note any, weight any, rank any) *MockVotingDatabase_AddVote_Call {
+	return &MockVotingDatabase_AddVote_Call{Call: _e.mock.On("AddVote", ctx, board, user, note, weight, rank)}
 }
 
-func (_c *MockVotingDatabase_AddVote_Call) Run(run func(ctx context.Context, board uuid.UUID, user uuid.UUID, note uuid.UUID)) *MockVotingDatabase_AddVote_Call {
+func (_c *MockVotingDatabase_AddVote_Call) Run(run func(ctx context.Context, board uuid.UUID, user uuid.UUID, note uuid.UUID, weight int, rank *int)) *MockVotingDatabase_AddVote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -96,11 +99,21 @@ func (_c *MockVotingDatabase_AddVote_Call) Run(run func(ctx context.Context, boa
 		if args[3] != nil {
 			arg3 = args[3].(uuid.UUID)
 		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		var arg5 *int
+		if args[5] != nil {
+			arg5 = args[5].(*int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
+			arg5,
 		)
 	})
 	return _c
@@ -111,7 +124,7 @@ func (_c *MockVotingDatabase_AddVote_Call) Return(databaseVote DatabaseVote, err
 	return _c
 }
 
-func (_c *MockVotingDatabase_AddVote_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, user uuid.UUID, note uuid.UUID) (DatabaseVote, error)) *MockVotingDatabase_AddVote_Call {
+func (_c *MockVotingDatabase_AddVote_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, user uuid.UUID, note uuid.UUID, weight int, rank *int) (DatabaseVote, error)) *MockVotingDatabase_AddVote_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -182,6 +195,72 @@ func (_c *MockVotingDatabase_Close_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// CountBoardSessions provides a mock function for the type MockVotingDatabase
+func (_mock *MockVotingDatabase) CountBoardSessions(ctx context.Context, board uuid.UUID) (int, error) {
+	ret := _mock.Called(ctx, board)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBoardSessions")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return returnFunc(ctx, board)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = returnFunc(ctx, board)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, board)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVotingDatabase_CountBoardSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBoardSessions'
+type MockVotingDatabase_CountBoardSessions_Call struct {
+	*mock.Call
+}
+
+// CountBoardSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+func (_e *MockVotingDatabase_Expecter) CountBoardSessions(ctx any, board any) *MockVotingDatabase_CountBoardSessions_Call {
+	return &MockVotingDatabase_CountBoardSessions_Call{Call: _e.mock.On("CountBoardSessions", ctx, board)}
+}
+
+func (_c *MockVotingDatabase_CountBoardSessions_Call) Run(run func(ctx context.Context, board uuid.UUID)) *MockVotingDatabase_CountBoardSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVotingDatabase_CountBoardSessions_Call) Return(n int, err error) *MockVotingDatabase_CountBoardSessions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockVotingDatabase_CountBoardSessions_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID) (int, error)) *MockVotingDatabase_CountBoardSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockVotingDatabase
 func (_mock *MockVotingDatabase) Create(ctx context.Context, insert DatabaseVotingInsert) (DatabaseVoting, error) {
 	ret := _mock.Called(ctx, insert)
@@ -388,6 +467,74 @@ func (_c *MockVotingDatabase_GetAll_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// GetExpiredVotings provides a mock function for the type MockVotingDatabase
+func (_mock *MockVotingDatabase) GetExpiredVotings(ctx context.Context, now time.Time) ([]DatabaseVoting, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiredVotings")
+	}
+
+	var r0 []DatabaseVoting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]DatabaseVoting, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []DatabaseVoting); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DatabaseVoting)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVotingDatabase_GetExpiredVotings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiredVotings'
+type MockVotingDatabase_GetExpiredVotings_Call struct {
+	*mock.Call
+}
+
+// GetExpiredVotings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockVotingDatabase_Expecter) GetExpiredVotings(ctx any, now any) *MockVotingDatabase_GetExpiredVotings_Call {
+	return &MockVotingDatabase_GetExpiredVotings_Call{Call: _e.mock.On("GetExpiredVotings", ctx, now)}
+}
+
+func (_c *MockVotingDatabase_GetExpiredVotings_Call) Run(run func(ctx context.Context, now time.Time)) *MockVotingDatabase_GetExpiredVotings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVotingDatabase_GetExpiredVotings_Call) Return(databaseVotings []DatabaseVoting, err error) *MockVotingDatabase_GetExpiredVotings_Call {
+	_c.Call.Return(databaseVotings, err)
+	return _c
+}
+
+func (_c *MockVotingDatabase_GetExpiredVotings_Call) RunAndReturn(run func(ctx context.Context, now time.Time) ([]DatabaseVoting, error)) *MockVotingDatabase_GetExpiredVotings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOpenVoting provides a mock function for the type MockVotingDatabase
 func (_mock *MockVotingDatabase) GetOpenVoting(ctx context.Context, board uuid.UUID) (DatabaseVoting, error) {
 	ret := _mock.Called(ctx, board)
@@ -528,6 +675,78 @@ func (_c *MockVotingDatabase_GetVotes_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// IsScopeOnBoard provides a mock function for the type MockVotingDatabase
+func (_mock *MockVotingDatabase) IsScopeOnBoard(ctx context.Context, board uuid.UUID, scope VotingScope) (bool, error) {
+	ret := _mock.Called(ctx, board, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsScopeOnBoard")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, VotingScope) (bool, error)); ok {
+		return returnFunc(ctx, board, scope)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, VotingScope) bool); ok {
+		r0 = returnFunc(ctx, board, scope)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, VotingScope) error); ok {
+		r1 = returnFunc(ctx, board, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVotingDatabase_IsScopeOnBoard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsScopeOnBoard'
+type MockVotingDatabase_IsScopeOnBoard_Call struct {
+	*mock.Call
+}
+
+// IsScopeOnBoard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+//   - scope VotingScope
+func (_e *MockVotingDatabase_Expecter) IsScopeOnBoard(ctx any, board any, scope any) *MockVotingDatabase_IsScopeOnBoard_Call {
+	return &MockVotingDatabase_IsScopeOnBoard_Call{Call: _e.mock.On("IsScopeOnBoard", ctx, board, scope)}
+}
+
+func (_c *MockVotingDatabase_IsScopeOnBoard_Call) Run(run func(ctx context.Context, board uuid.UUID, scope VotingScope)) *MockVotingDatabase_IsScopeOnBoard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 VotingScope
+		if args[2] != nil {
+			arg2 = args[2].(VotingScope)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVotingDatabase_IsScopeOnBoard_Call) Return(b bool, err error) *MockVotingDatabase_IsScopeOnBoard_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockVotingDatabase_IsScopeOnBoard_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID, scope VotingScope) (bool, error)) *MockVotingDatabase_IsScopeOnBoard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveVote provides a mock function for the type MockVotingDatabase
 func (_mock *MockVotingDatabase) RemoveVote(ctx context.Context, board uuid.UUID, user uuid.UUID, note uuid.UUID) error {
 	ret := _mock.Called(ctx, board, user, note)
@@ -186,6 +186,66 @@ func (_c *MockVotingService_Close_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// CloseExpired provides a mock function for the type MockVotingService
+func (_mock *MockVotingService) CloseExpired(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVotingService_CloseExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseExpired'
+type MockVotingService_CloseExpired_Call struct {
+	*mock.Call
+}
+
+// CloseExpired is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockVotingService_Expecter) CloseExpired(ctx any) *MockVotingService_CloseExpired_Call {
+	return &MockVotingService_CloseExpired_Call{Call: _e.mock.On("CloseExpired", ctx)}
+}
+
+func (_c *MockVotingService_CloseExpired_Call) Run(run func(ctx context.Context)) *MockVotingService_CloseExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVotingService_CloseExpired_Call) Return(n int, err error) *MockVotingService_CloseExpired_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockVotingService_CloseExpired_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockVotingService_CloseExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockVotingService
 func (_mock *MockVotingService) Create(ctx context.Context, body VotingCreateRequest) (*Voting, error) {
 	ret := _mock.Called(ctx, body)
@@ -396,6 +456,74 @@ func (_c *MockVotingService_GetAll_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// GetAnalytics provides a mock function for the type MockVotingService
+func (_mock *MockVotingService) GetAnalytics(ctx context.Context, board uuid.UUID) (*VotingAnalytics, error) {
+	ret := _mock.Called(ctx, board)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnalytics")
+	}
+
+	var r0 *VotingAnalytics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*VotingAnalytics, error)); ok {
+		return returnFunc(ctx, board)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *VotingAnalytics); ok {
+		r0 = returnFunc(ctx, board)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*VotingAnalytics)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, board)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVotingService_GetAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalytics'
+type MockVotingService_GetAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetAnalytics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board uuid.UUID
+func (_e *MockVotingService_Expecter) GetAnalytics(ctx any, board any) *MockVotingService_GetAnalytics_Call {
+	return &MockVotingService_GetAnalytics_Call{Call: _e.mock.On("GetAnalytics", ctx, board)}
+}
+
+func (_c *MockVotingService_GetAnalytics_Call) Run(run func(ctx context.Context, board uuid.UUID)) *MockVotingService_GetAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVotingService_GetAnalytics_Call) Return(votingAnalytics *VotingAnalytics, err error) *MockVotingService_GetAnalytics_Call {
+	_c.Call.Return(votingAnalytics, err)
+	return _c
+}
+
+func (_c *MockVotingService_GetAnalytics_Call) RunAndReturn(run func(ctx context.Context, board uuid.UUID) (*VotingAnalytics, error)) *MockVotingService_GetAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOpen provides a mock function for the type MockVotingService
 func (_mock *MockVotingService) GetOpen(ctx context.Context, board uuid.UUID) (*Voting, error) {
 	ret := _mock.Called(ctx, board)
@@ -8,6 +8,12 @@ var votingCreatedCounter, _ = meter.Int64Counter(
 	metric.WithUnit("votings"),
 )
 
+var votingExpiredCounter, _ = meter.Int64Counter(
+	"scrumlr.votings.expired.counter",
+	metric.WithDescription("Number of votings closed automatically because their end passed"),
+	metric.WithUnit("votings"),
+)
+
 var voteCreatedCounter, _ = meter.Int64Counter(
 	"scrumlr.vote.created.counter",
 	metric.WithDescription("Number of created votes"),
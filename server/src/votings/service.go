@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
@@ -11,8 +12,11 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"scrumlr.io/server/audit"
 	"scrumlr.io/server/logger"
+	"scrumlr.io/server/notes"
 	"scrumlr.io/server/realtime"
+	"scrumlr.io/server/timeprovider"
 )
 
 var tracer trace.Tracer = otel.Tracer("scrumlr.io/server/votings")
@@ -24,20 +28,29 @@ type VotingDatabase interface {
 	Get(ctx context.Context, board, id uuid.UUID) (DatabaseVoting, error)
 	GetAll(ctx context.Context, board uuid.UUID) ([]DatabaseVoting, error)
 	GetVotes(ctx context.Context, board uuid.UUID, f VoteFilter) ([]DatabaseVote, error)
-	AddVote(ctx context.Context, board, user, note uuid.UUID) (DatabaseVote, error)
+	AddVote(ctx context.Context, board, user, note uuid.UUID, weight int, rank *int) (DatabaseVote, error)
 	RemoveVote(ctx context.Context, board, user, note uuid.UUID) error
 	GetOpenVoting(ctx context.Context, board uuid.UUID) (DatabaseVoting, error)
+	GetExpiredVotings(ctx context.Context, now time.Time) ([]DatabaseVoting, error)
+	IsScopeOnBoard(ctx context.Context, board uuid.UUID, scope VotingScope) (bool, error)
+	CountBoardSessions(ctx context.Context, board uuid.UUID) (int, error)
 }
 
 type Service struct {
-	database VotingDatabase
-	realtime *realtime.Broker
+	database     VotingDatabase
+	realtime     *realtime.Broker
+	auditService audit.AuditService
+	notesService notes.NotesService
+	clock        timeprovider.TimeProvider
 }
 
-func NewVotingService(db VotingDatabase, rt *realtime.Broker) VotingService {
+func NewVotingService(db VotingDatabase, rt *realtime.Broker, auditService audit.AuditService, notesService notes.NotesService, clock timeprovider.TimeProvider) VotingService {
 	service := new(Service)
 	service.database = db
 	service.realtime = rt
+	service.auditService = auditService
+	service.notesService = notesService
+	service.clock = clock
 
 	return service
 }
@@ -53,6 +66,9 @@ func (service *Service) Create(ctx context.Context, body VotingCreateRequest) (*
 		attribute.Bool("scrumlr.votings.service.create.multiple_votes", body.AllowMultipleVotes),
 		attribute.Bool("scrumlr.votings.service.create.anonymous", body.IsAnonymous),
 		attribute.Bool("scrumlr.votings.service.create.show_votes", body.ShowVotesOfOthers),
+		attribute.String("scrumlr.votings.service.create.mode", string(body.Mode)),
+		attribute.Int("scrumlr.votings.service.create.duration", body.Duration),
+		attribute.Bool("scrumlr.votings.service.create.scoped", !body.Scope.IsEmpty()),
 	)
 
 	if body.VoteLimit < 0 {
@@ -69,26 +85,77 @@ func (service *Service) Create(ctx context.Context, body VotingCreateRequest) (*
 		return nil, err
 	}
 
-	openVoting, err := service.GetOpen(ctx, body.Board)
-	if openVoting != nil || (err != nil && !errors.Is(err, sql.ErrNoRows)) {
-		if openVoting != nil {
-			err := CreateVotingError(BadRequest, "only one open voting per session is allowed", err)
-			span.SetStatus(codes.Error, "only one open voting per session is allowed")
+	switch body.Mode {
+	case "":
+		body.Mode = DefaultMode
+	case SingleChoiceMode:
+		body.VoteLimit = 1
+		body.AllowMultipleVotes = false
+	case RankedMode, PointsMode:
+		if body.VoteLimit < 1 {
+			err := CreateVotingError(BadRequest, "vote limit has to be at least 1 in this voting mode", nil)
+			span.SetStatus(codes.Error, "vote limit has to be at least 1 in this voting mode")
 			span.RecordError(err)
 			return nil, err
 		}
 
+		// a note can only be ranked once on a ballot
+		if body.Mode == RankedMode {
+			body.AllowMultipleVotes = false
+		}
+	}
+
+	endsAt, err := service.votingEnd(body)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid end of voting")
+		span.RecordError(err)
 		return nil, err
 	}
 
-	voting, err := service.database.Create(ctx, DatabaseVotingInsert{
+	insert := DatabaseVotingInsert{
 		Board:              body.Board,
 		VoteLimit:          body.VoteLimit,
 		AllowMultipleVotes: body.AllowMultipleVotes,
 		ShowVotesOfOthers:  body.ShowVotesOfOthers,
 		IsAnonymous:        body.IsAnonymous,
 		Status:             Open,
-	})
+		Mode:               body.Mode,
+		EndsAt:             endsAt,
+	}
+
+	if !body.Scope.IsEmpty() {
+		onBoard, err := service.database.IsScopeOnBoard(ctx, body.Board, *body.Scope)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to check voting scope")
+			span.RecordError(err)
+			log.Errorw("unable to check voting scope", "board", body.Board, "error", err)
+			return nil, CreateVotingError(Internal, "failed to create voting", err)
+		}
+
+		if !onBoard {
+			err := CreateVotingError(BadRequest, "the scope of a voting can only contain columns and notes of its board", nil)
+			span.SetStatus(codes.Error, "scope not on board")
+			span.RecordError(err)
+			return nil, err
+		}
+
+		insert.ScopeColumns = body.Scope.Columns
+		insert.ScopeNotes = body.Scope.Notes
+	}
+
+	openVoting, err := service.GetOpen(ctx, body.Board)
+	if openVoting != nil || (err != nil && !errors.Is(err, sql.ErrNoRows)) {
+		if openVoting != nil {
+			err := CreateVotingError(BadRequest, "only one open voting per session is allowed", err)
+			span.SetStatus(codes.Error, "only one open voting per session is allowed")
+			span.RecordError(err)
+			return nil, err
+		}
+
+		return nil, err
+	}
+
+	voting, err := service.database.Create(ctx, insert)
 
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to create voting")
@@ -98,11 +165,38 @@ func (service *Service) Create(ctx context.Context, body VotingCreateRequest) (*
 	}
 
 	service.createdVoting(ctx, body.Board, voting)
+	service.auditService.Record(ctx, body.Board, audit.VotingCreated, nil, new(Voting).From(voting, nil))
 
 	votingCreatedCounter.Add(ctx, 1)
 	return new(Voting).From(voting, nil), err
 }
 
+// votingEnd returns the point in time at which a voting is closed automatically, if the request sets a duration or an end.
+func (service *Service) votingEnd(body VotingCreateRequest) (*time.Time, error) {
+	if body.Duration < 0 {
+		return nil, CreateVotingError(BadRequest, "duration cannot be negative", nil)
+	}
+
+	if body.Duration > 0 && body.EndsAt != nil {
+		return nil, CreateVotingError(BadRequest, "either a duration or an end can be set", nil)
+	}
+
+	now := service.clock.Now()
+	endsAt := body.EndsAt
+	if body.Duration > 0 {
+		end := now.Add(time.Duration(body.Duration) * time.Second)
+		endsAt = &end
+	} else if endsAt != nil && !endsAt.After(now) {
+		return nil, CreateVotingError(BadRequest, "the end of a voting has to be in the future", nil)
+	}
+
+	if body.StartTimer && endsAt == nil {
+		return nil, CreateVotingError(BadRequest, "the board timer can only be started for a voting with a duration or an end", nil)
+	}
+
+	return endsAt, nil
+}
+
 func (service *Service) Get(ctx context.Context, boardID, id uuid.UUID) (*Voting, error) {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.votings.service.get")
@@ -168,6 +262,42 @@ func (service *Service) GetAll(ctx context.Context, boardID uuid.UUID) ([]*Votin
 	return Votings(votings, votes), err
 }
 
+func (service *Service) GetAnalytics(ctx context.Context, boardID uuid.UUID) (*VotingAnalytics, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.votings.service.get.analytics")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("scrumlr.votings.service.get.analytics.board", boardID.String()),
+	)
+
+	votings, err := service.database.GetAll(ctx, boardID)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get votings")
+		span.RecordError(err)
+		log.Errorw("unable to get votings", "board", boardID, "error", err)
+		return nil, CreateVotingError(Internal, "failed to get votings", err)
+	}
+
+	votes, err := service.database.GetVotes(ctx, boardID, VoteFilter{})
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get votes")
+		span.RecordError(err)
+		log.Errorw("unable to get votes", "board", boardID, "error", err)
+		return nil, CreateVotingError(Internal, "unable to get votes", err)
+	}
+
+	participants, err := service.database.CountBoardSessions(ctx, boardID)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to count participants")
+		span.RecordError(err)
+		log.Errorw("unable to count participants", "board", boardID, "error", err)
+		return nil, CreateVotingError(Internal, "unable to count participants", err)
+	}
+
+	return getAnalytics(votings, votes, participants), nil
+}
+
 func (service *Service) GetOpen(ctx context.Context, boardID uuid.UUID) (*Voting, error) {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.votings.service.get.open")
@@ -220,9 +350,50 @@ func (service *Service) AddVote(ctx context.Context, body VoteRequest) (*Vote, e
 	span.SetAttributes(
 		attribute.String("scrumlr.votes.service.add.board", body.Board.String()),
 		attribute.String("scrumlr.votes.service.add.note", body.Note.String()),
+		attribute.Int("scrumlr.votes.service.add.weight", body.Weight),
 	)
 
-	vote, err := service.database.AddVote(ctx, body.Board, body.User, body.Note)
+	voting, err := service.database.GetOpenVoting(ctx, body.Board)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "no open voting")
+			span.RecordError(err)
+			return nil, CreateVotingError(NotFound, "no active voting session found", err)
+		}
+
+		span.SetStatus(codes.Error, "failed to get open voting")
+		span.RecordError(err)
+		log.Errorw("unable to get open voting", "board", body.Board, "err", err)
+		return nil, CreateVotingError(Internal, "failed to add vote", err)
+	}
+
+	if body.Weight == 0 {
+		body.Weight = 1
+	}
+
+	if err := validateVote(voting, body); err != nil {
+		span.SetStatus(codes.Error, "invalid vote")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if scope := scopeFrom(voting); scope != nil {
+		note, err := service.notesService.Get(ctx, body.Note)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to get note")
+			span.RecordError(err)
+			return nil, err
+		}
+
+		if !scope.Contains(*new(Note).From(*note)) {
+			err := CreateVotingError(BadRequest, "the note is not part of the voting", nil)
+			span.SetStatus(codes.Error, "note not in scope")
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	vote, err := service.database.AddVote(ctx, body.Board, body.User, body.Note, body.Weight, body.Rank)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			span.SetStatus(codes.Error, "No rows returned")
@@ -240,6 +411,27 @@ func (service *Service) AddVote(ctx context.Context, body VoteRequest) (*Vote, e
 	return new(Vote).From(vote), err
 }
 
+// validateVote checks the weight and rank of a vote against the mode of the open voting.
+func validateVote(voting DatabaseVoting, body VoteRequest) error {
+	if body.Weight < 1 {
+		return CreateVotingError(BadRequest, "the weight of a vote has to be at least 1", nil)
+	}
+
+	if body.Weight > 1 && voting.Mode != PointsMode {
+		return CreateVotingError(BadRequest, "weighted votes are only allowed in a point budget voting", nil)
+	}
+
+	if voting.Mode == RankedMode {
+		if body.Rank == nil || *body.Rank < 1 || *body.Rank > voting.VoteLimit {
+			return CreateVotingError(BadRequest, "a vote in a ranked-choice voting needs a rank between 1 and the vote limit", nil)
+		}
+	} else if body.Rank != nil {
+		return CreateVotingError(BadRequest, "ranks are only allowed in a ranked-choice voting", nil)
+	}
+
+	return nil
+}
+
 func (service *Service) RemoveVote(ctx context.Context, body VoteRequest) error {
 	log := logger.FromContext(ctx)
 	ctx, span := tracer.Start(ctx, "scrumlr.votes.service.remove")
@@ -301,9 +493,60 @@ func (service *Service) Close(ctx context.Context, id uuid.UUID, board uuid.UUID
 	}
 
 	service.updatedVoting(ctx, board, voting, receivedVotes, affectedNotes)
+	service.auditService.Record(ctx, board, audit.VotingClosed, nil, new(Voting).From(voting, receivedVotes))
+
 	return new(Voting).From(voting, receivedVotes), err
 }
 
+// CloseExpired closes all open votings whose end passed and returns the number of closed votings.
+// Votings are closed one by one through Close, so that participants are notified about the results.
+// A voting closed by another instance in the meantime is skipped.
+func (service *Service) CloseExpired(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+	ctx, span := tracer.Start(ctx, "scrumlr.votings.service.close_expired")
+	defer span.End()
+
+	expiredVotings, err := service.database.GetExpiredVotings(ctx, service.clock.Now())
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get expired votings")
+		span.RecordError(err)
+		log.Errorw("unable to get expired votings", "err", err)
+		return 0, CreateVotingError(Internal, "failed to get expired votings", err)
+	}
+
+	closed := 0
+	var errs []error
+	for _, voting := range expiredVotings {
+		boardNotes, err := service.notesService.GetAll(ctx, voting.Board)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if _, err := service.Close(ctx, voting.ID, voting.Board, AffectedNotes(boardNotes)); err != nil {
+			var votingErr VotingError
+			if errors.As(err, &votingErr) && votingErr.Category == NotFound {
+				continue
+			}
+
+			errs = append(errs, err)
+			continue
+		}
+		closed++
+	}
+
+	votingExpiredCounter.Add(ctx, int64(closed))
+
+	if len(errs) > 0 {
+		err := errors.Join(errs...)
+		span.SetStatus(codes.Error, "failed to close expired votings")
+		span.RecordError(err)
+		return closed, err
+	}
+
+	return closed, nil
+}
+
 func (service *Service) createdVoting(ctx context.Context, board uuid.UUID, voting DatabaseVoting) {
 	ctx, span := tracer.Start(ctx, "scrumlr.votings.service.create")
 	defer span.End()
@@ -4,10 +4,16 @@ import (
 	"context"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/uptrace/bun"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/cache"
+	"scrumlr.io/server/common"
 	"scrumlr.io/server/initialize"
 	"scrumlr.io/server/initialize/testDbTemplates"
+	"scrumlr.io/server/notes"
+	"scrumlr.io/server/timeprovider"
 
 	"github.com/stretchr/testify/require"
 
@@ -30,6 +36,7 @@ type VotingServiceIntegrationTestSuite struct {
 	natsConnectionString string
 	votingService        VotingService
 	broker               *realtime.Broker
+	db                   *bun.DB
 	baseData             testDbTemplates.DbBaseIDs
 }
 
@@ -59,8 +66,15 @@ func (suite *VotingServiceIntegrationTestSuite) SetupTest() {
 	broker, err := realtime.NewNats(suite.natsConnectionString)
 	require.NoError(suite.T(), err, "Failed to connect to nats server")
 
+	ch, err := cache.NewNats(suite.natsConnectionString, "scrumlr-test-votings")
+	require.NoError(suite.T(), err, "Failed to connect to nats cache")
+
+	auditService := audit.NewAuditService(audit.NewAuditDatabase(db))
+	noteService := notes.NewNotesService(notes.NewNotesDatabase(db), broker, ch, common.NewSimpleBoardLastModifiedUpdater(db), auditService)
+
 	suite.broker = broker
-	suite.votingService = NewVotingService(votingDB, broker)
+	suite.db = db
+	suite.votingService = NewVotingService(votingDB, broker, auditService, noteService, timeprovider.NewClock())
 }
 
 func (suite *VotingServiceIntegrationTestSuite) TeardownSuite() {
@@ -228,6 +242,37 @@ func (suite *VotingServiceIntegrationTestSuite) Test_CloseVoting() {
 	assert.Equal(t, 6, votingData.Voting.VotingResults.Total)
 }
 
+func (suite *VotingServiceIntegrationTestSuite) Test_CloseExpired() {
+	t := suite.T()
+	ctx := context.Background()
+
+	boardId := suite.baseData.Boards["Create"].ID
+	endsAt := time.Now().Add(-time.Minute)
+
+	expiredVoting, err := NewVotingDatabase(suite.db).Create(ctx, DatabaseVotingInsert{Board: boardId, VoteLimit: 5, Status: Open, EndsAt: &endsAt})
+	require.NoError(t, err)
+
+	events, err := suite.broker.GetBoardChannel(ctx, boardId)
+	require.NoError(t, err, "Failed to subscribe to board channel")
+
+	closed, err := suite.votingService.CloseExpired(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, closed)
+
+	msg := <-events
+	assert.Equal(t, realtime.BoardEventVotingUpdated, msg.Type)
+
+	voting, err := suite.votingService.Get(ctx, boardId, expiredVoting.ID)
+	require.NoError(t, err)
+	assert.Equal(t, Closed, voting.Status)
+
+	closed, err = suite.votingService.CloseExpired(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, closed)
+}
+
 func (suite *VotingServiceIntegrationTestSuite) Test_CloseVoting_Sorted_Cards() {
 	t := suite.T()
 	ctx := context.Background()
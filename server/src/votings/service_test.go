@@ -5,10 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"scrumlr.io/server/audit"
+	"scrumlr.io/server/notes"
+	"scrumlr.io/server/timeprovider"
 
 	"scrumlr.io/server/realtime"
 )
@@ -20,14 +24,16 @@ func TestAddVote(t *testing.T) {
 	votingID := uuid.New()
 
 	mockDb := NewMockVotingDatabase(t)
-	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, noteID).
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardID).
+		Return(DatabaseVoting{Board: boardID, VoteLimit: 5, Status: Open, Mode: DefaultMode}, nil)
+	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, noteID, 1, (*int)(nil)).
 		Return(DatabaseVote{Board: boardID, Voting: votingID, User: userID, Note: noteID}, nil)
 
 	mockBroker := realtime.NewMockClient(t)
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	vote, err := service.AddVote(context.Background(), VoteRequest{Board: boardID, User: userID, Note: noteID})
 
 	assert.Nil(t, err)
@@ -42,14 +48,16 @@ func TestAddVote_VoteLimit(t *testing.T) {
 	noteID := uuid.New()
 
 	mockDb := NewMockVotingDatabase(t)
-	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, noteID).
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardID).
+		Return(DatabaseVoting{Board: boardID, VoteLimit: 5, Status: Open, Mode: DefaultMode}, nil)
+	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, noteID, 1, (*int)(nil)).
 		Return(DatabaseVote{}, sql.ErrNoRows)
 
 	mockBroker := realtime.NewMockClient(t)
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	vote, err := service.AddVote(context.Background(), VoteRequest{Board: boardID, User: userID, Note: noteID})
 
 	assert.Nil(t, vote)
@@ -68,14 +76,16 @@ func TestAddVote_Failed(t *testing.T) {
 	dbError := errors.New("Failed to add vote")
 
 	mockDb := NewMockVotingDatabase(t)
-	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, noteID).
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardID).
+		Return(DatabaseVoting{Board: boardID, VoteLimit: 5, Status: Open, Mode: DefaultMode}, nil)
+	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, noteID, 1, (*int)(nil)).
 		Return(DatabaseVote{}, dbError)
 
 	mockBroker := realtime.NewMockClient(t)
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	vote, err := service.AddVote(context.Background(), VoteRequest{Board: boardID, User: userID, Note: noteID})
 
 	assert.Nil(t, vote)
@@ -83,6 +93,159 @@ func TestAddVote_Failed(t *testing.T) {
 	assert.ErrorIs(t, err, dbError)
 }
 
+func TestAddVote_NoOpenVoting(t *testing.T) {
+	boardID := uuid.New()
+	userID := uuid.New()
+	noteID := uuid.New()
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardID).
+		Return(DatabaseVoting{}, sql.ErrNoRows)
+
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+	vote, err := service.AddVote(context.Background(), VoteRequest{Board: boardID, User: userID, Note: noteID})
+
+	assert.Nil(t, vote)
+
+	var votingErr VotingError
+	assert.ErrorAs(t, err, &votingErr)
+	assert.Equal(t, NotFound, votingErr.Category)
+}
+
+func TestAddVote_Points(t *testing.T) {
+	boardID := uuid.New()
+	userID := uuid.New()
+	noteID := uuid.New()
+	votingID := uuid.New()
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardID).
+		Return(DatabaseVoting{ID: votingID, Board: boardID, VoteLimit: 10, Status: Open, Mode: PointsMode}, nil)
+	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, noteID, 4, (*int)(nil)).
+		Return(DatabaseVote{Board: boardID, Voting: votingID, User: userID, Note: noteID, Weight: 4}, nil)
+
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+	vote, err := service.AddVote(context.Background(), VoteRequest{Board: boardID, User: userID, Note: noteID, Weight: 4})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, vote.Weight)
+}
+
+func TestAddVote_Ranked(t *testing.T) {
+	boardID := uuid.New()
+	userID := uuid.New()
+	noteID := uuid.New()
+	votingID := uuid.New()
+	rank := 2
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardID).
+		Return(DatabaseVoting{ID: votingID, Board: boardID, VoteLimit: 3, Status: Open, Mode: RankedMode}, nil)
+	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, noteID, 1, &rank).
+		Return(DatabaseVote{Board: boardID, Voting: votingID, User: userID, Note: noteID, Weight: 1, Rank: &rank}, nil)
+
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+	vote, err := service.AddVote(context.Background(), VoteRequest{Board: boardID, User: userID, Note: noteID, Rank: &rank})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &rank, vote.Rank)
+}
+
+func TestAddVote_InvalidForMode(t *testing.T) {
+	rank := 1
+	outOfBallot := 4
+
+	tests := []struct {
+		name    string
+		mode    VotingMode
+		weight  int
+		rank    *int
+		message string
+	}{
+		{"weight in default voting", DefaultMode, 2, nil, "weighted votes are only allowed in a point budget voting"},
+		{"weight in single choice voting", SingleChoiceMode, 2, nil, "weighted votes are only allowed in a point budget voting"},
+		{"negative weight", PointsMode, -1, nil, "the weight of a vote has to be at least 1"},
+		{"rank in default voting", DefaultMode, 0, &rank, "ranks are only allowed in a ranked-choice voting"},
+		{"missing rank", RankedMode, 0, nil, "a vote in a ranked-choice voting needs a rank between 1 and the vote limit"},
+		{"rank out of ballot", RankedMode, 0, &outOfBallot, "a vote in a ranked-choice voting needs a rank between 1 and the vote limit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boardID := uuid.New()
+
+			mockDb := NewMockVotingDatabase(t)
+			mockDb.EXPECT().GetOpenVoting(mock.Anything, boardID).
+				Return(DatabaseVoting{Board: boardID, VoteLimit: 3, Status: Open, Mode: tt.mode}, nil)
+
+			mockBroker := realtime.NewMockClient(t)
+			broker := new(realtime.Broker)
+			broker.Con = mockBroker
+
+			service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+			vote, err := service.AddVote(context.Background(), VoteRequest{Board: boardID, User: uuid.New(), Note: uuid.New(), Weight: tt.weight, Rank: tt.rank})
+
+			assert.Nil(t, vote)
+
+			var votingErr VotingError
+			assert.ErrorAs(t, err, &votingErr)
+			assert.Equal(t, BadRequest, votingErr.Category)
+			assert.Equal(t, tt.message, votingErr.Message)
+		})
+	}
+}
+
+func TestAddVote_Scoped(t *testing.T) {
+	boardID := uuid.New()
+	columnID := uuid.New()
+	inScopeNoteID := uuid.New()
+	outOfScopeNoteID := uuid.New()
+	votingID := uuid.New()
+	userID := uuid.New()
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardID).
+		Return(DatabaseVoting{ID: votingID, Board: boardID, VoteLimit: 5, Status: Open, Mode: DefaultMode, ScopeColumns: []uuid.UUID{columnID}}, nil)
+	mockDb.EXPECT().AddVote(mock.Anything, boardID, userID, inScopeNoteID, 1, (*int)(nil)).
+		Return(DatabaseVote{Board: boardID, Voting: votingID, User: userID, Note: inScopeNoteID, Weight: 1}, nil)
+
+	mockNotes := notes.NewMockNotesService(t)
+	mockNotes.EXPECT().Get(mock.Anything, inScopeNoteID).
+		Return(&notes.Note{ID: inScopeNoteID, Position: notes.NotePosition{Column: columnID}}, nil)
+	mockNotes.EXPECT().Get(mock.Anything, outOfScopeNoteID).
+		Return(&notes.Note{ID: outOfScopeNoteID, Position: notes.NotePosition{Column: uuid.New()}}, nil)
+
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), mockNotes, timeprovider.NewClock())
+
+	vote, err := service.AddVote(context.Background(), VoteRequest{Board: boardID, User: userID, Note: inScopeNoteID})
+	assert.Nil(t, err)
+	assert.Equal(t, inScopeNoteID, vote.Note)
+
+	vote, err = service.AddVote(context.Background(), VoteRequest{Board: boardID, User: userID, Note: outOfScopeNoteID})
+	assert.Nil(t, vote)
+
+	var votingErr VotingError
+	assert.ErrorAs(t, err, &votingErr)
+	assert.Equal(t, BadRequest, votingErr.Category)
+	assert.Equal(t, "the note is not part of the voting", votingErr.Message)
+}
+
 func TestRemoveVote(t *testing.T) {
 	boardId := uuid.New()
 	userId := uuid.New()
@@ -95,7 +258,7 @@ func TestRemoveVote(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	err := service.RemoveVote(context.Background(), VoteRequest{Board: boardId, User: userId, Note: noteId})
 
 	assert.Nil(t, err)
@@ -114,7 +277,7 @@ func TestRemoveVote_Failed(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	err := service.RemoveVote(context.Background(), VoteRequest{Board: boardId, User: userId, Note: noteId})
 
 	assert.NotNil(t, err)
@@ -136,7 +299,7 @@ func TestGetVotes(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	votes, err := service.GetVotes(context.Background(), boardId, VoteFilter{})
 
 	assert.Nil(t, err)
@@ -155,7 +318,7 @@ func TestGetVotes_Failed(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	votes, err := service.GetVotes(context.Background(), boardId, VoteFilter{})
 
 	assert.Nil(t, votes)
@@ -173,7 +336,7 @@ func TestCreateVoting(t *testing.T) {
 	mockDb := NewMockVotingDatabase(t)
 	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardId).
 		Return(DatabaseVoting{}, sql.ErrNoRows)
-	mockDb.EXPECT().Create(mock.Anything, DatabaseVotingInsert{Board: boardId, VoteLimit: votingLimit, AllowMultipleVotes: allowMultiple, ShowVotesOfOthers: showVotes, Status: Open}).
+	mockDb.EXPECT().Create(mock.Anything, DatabaseVotingInsert{Board: boardId, VoteLimit: votingLimit, AllowMultipleVotes: allowMultiple, ShowVotesOfOthers: showVotes, Status: Open, Mode: DefaultMode}).
 		Return(DatabaseVoting{ID: votingId, Board: boardId, VoteLimit: votingLimit, AllowMultipleVotes: allowMultiple, ShowVotesOfOthers: showVotes, Status: Open}, nil)
 
 	mockBroker := realtime.NewMockClient(t)
@@ -181,7 +344,10 @@ func TestCreateVoting(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	mockAudit := audit.NewMockAuditService(t)
+	mockAudit.EXPECT().Record(mock.Anything, boardId, audit.VotingCreated, nil, mock.AnythingOfType("*votings.Voting")).Return()
+
+	service := NewVotingService(mockDb, broker, mockAudit, notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Create(context.Background(), VotingCreateRequest{
 		Board:              boardId,
 		VoteLimit:          votingLimit,
@@ -197,6 +363,78 @@ func TestCreateVoting(t *testing.T) {
 	assert.False(t, voting.IsAnonymous)
 }
 
+func TestCreateVoting_Modes(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        VotingCreateRequest
+		expectedInsert DatabaseVotingInsert
+	}{
+		{
+			name:           "single choice forces a single vote",
+			request:        VotingCreateRequest{VoteLimit: 5, AllowMultipleVotes: true, Mode: SingleChoiceMode},
+			expectedInsert: DatabaseVotingInsert{VoteLimit: 1, AllowMultipleVotes: false, Status: Open, Mode: SingleChoiceMode},
+		},
+		{
+			name:           "ranked choice forbids multiple votes on a note",
+			request:        VotingCreateRequest{VoteLimit: 3, AllowMultipleVotes: true, Mode: RankedMode},
+			expectedInsert: DatabaseVotingInsert{VoteLimit: 3, AllowMultipleVotes: false, Status: Open, Mode: RankedMode},
+		},
+		{
+			name:           "point budget",
+			request:        VotingCreateRequest{VoteLimit: 20, AllowMultipleVotes: true, Mode: PointsMode},
+			expectedInsert: DatabaseVotingInsert{VoteLimit: 20, AllowMultipleVotes: true, Status: Open, Mode: PointsMode},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boardId := uuid.New()
+			tt.request.Board = boardId
+			tt.expectedInsert.Board = boardId
+
+			mockDb := NewMockVotingDatabase(t)
+			mockDb.EXPECT().GetOpenVoting(mock.Anything, boardId).
+				Return(DatabaseVoting{}, sql.ErrNoRows)
+			mockDb.EXPECT().Create(mock.Anything, tt.expectedInsert).
+				Return(DatabaseVoting{ID: uuid.New(), Board: boardId, VoteLimit: tt.expectedInsert.VoteLimit, AllowMultipleVotes: tt.expectedInsert.AllowMultipleVotes, Status: Open, Mode: tt.expectedInsert.Mode}, nil)
+
+			mockBroker := realtime.NewMockClient(t)
+			mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+			broker := new(realtime.Broker)
+			broker.Con = mockBroker
+
+			mockAudit := audit.NewMockAuditService(t)
+			mockAudit.EXPECT().Record(mock.Anything, boardId, audit.VotingCreated, nil, mock.AnythingOfType("*votings.Voting")).Return()
+
+			service := NewVotingService(mockDb, broker, mockAudit, notes.NewMockNotesService(t), timeprovider.NewClock())
+			voting, err := service.Create(context.Background(), tt.request)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedInsert.Mode, voting.Mode)
+			assert.Equal(t, tt.expectedInsert.VoteLimit, voting.VoteLimit)
+		})
+	}
+}
+
+func TestCreateVoting_RankedWithoutLimit(t *testing.T) {
+	boardId := uuid.New()
+
+	mockDb := NewMockVotingDatabase(t)
+
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+	voting, err := service.Create(context.Background(), VotingCreateRequest{Board: boardId, VoteLimit: 0, Mode: RankedMode})
+
+	assert.Nil(t, voting)
+
+	var votingErr VotingError
+	assert.ErrorAs(t, err, &votingErr)
+	assert.Equal(t, BadRequest, votingErr.Category)
+}
+
 func TestCreateVoting_SecondVoting(t *testing.T) {
 	boardId := uuid.New()
 	votingLimit := 10
@@ -211,7 +449,7 @@ func TestCreateVoting_SecondVoting(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Create(context.Background(), VotingCreateRequest{
 		Board:              boardId,
 		VoteLimit:          votingLimit,
@@ -228,6 +466,120 @@ func TestCreateVoting_SecondVoting(t *testing.T) {
 	assert.Equal(t, "only one open voting per session is allowed", votingErr.Message)
 }
 
+func TestCreateVoting_WithDuration(t *testing.T) {
+	votingId := uuid.New()
+	boardId := uuid.New()
+	now := time.Now()
+	endsAt := now.Add(90 * time.Second)
+
+	mockClock := timeprovider.NewMockTimeProvider(t)
+	mockClock.EXPECT().Now().Return(now)
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardId).
+		Return(DatabaseVoting{}, sql.ErrNoRows)
+	mockDb.EXPECT().Create(mock.Anything, DatabaseVotingInsert{Board: boardId, VoteLimit: 5, Status: Open, Mode: DefaultMode, EndsAt: &endsAt}).
+		Return(DatabaseVoting{ID: votingId, Board: boardId, VoteLimit: 5, Status: Open, Mode: DefaultMode, EndsAt: &endsAt}, nil)
+
+	mockBroker := realtime.NewMockClient(t)
+	mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	mockAudit := audit.NewMockAuditService(t)
+	mockAudit.EXPECT().Record(mock.Anything, boardId, audit.VotingCreated, nil, mock.AnythingOfType("*votings.Voting")).Return()
+
+	service := NewVotingService(mockDb, broker, mockAudit, notes.NewMockNotesService(t), mockClock)
+	voting, err := service.Create(context.Background(), VotingCreateRequest{Board: boardId, VoteLimit: 5, Duration: 90})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &endsAt, voting.EndsAt)
+}
+
+func TestCreateVoting_InvalidEnd(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Minute)
+
+	tests := []struct {
+		name    string
+		request VotingCreateRequest
+		message string
+	}{
+		{"negative duration", VotingCreateRequest{VoteLimit: 5, Duration: -1}, "duration cannot be negative"},
+		{"duration and end", VotingCreateRequest{VoteLimit: 5, Duration: 60, EndsAt: &future}, "either a duration or an end can be set"},
+		{"end in the past", VotingCreateRequest{VoteLimit: 5, EndsAt: &past}, "the end of a voting has to be in the future"},
+		{"timer without end", VotingCreateRequest{VoteLimit: 5, StartTimer: true}, "the board timer can only be started for a voting with a duration or an end"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDb := NewMockVotingDatabase(t)
+
+			mockBroker := realtime.NewMockClient(t)
+			broker := new(realtime.Broker)
+			broker.Con = mockBroker
+
+			service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+			voting, err := service.Create(context.Background(), tt.request)
+
+			assert.Nil(t, voting)
+
+			var votingErr VotingError
+			assert.ErrorAs(t, err, &votingErr)
+			assert.Equal(t, BadRequest, votingErr.Category)
+			assert.Equal(t, tt.message, votingErr.Message)
+		})
+	}
+}
+
+func TestCreateVoting_Scoped(t *testing.T) {
+	votingId := uuid.New()
+	boardId := uuid.New()
+	scope := VotingScope{Columns: []uuid.UUID{uuid.New()}, Notes: []uuid.UUID{uuid.New()}}
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().IsScopeOnBoard(mock.Anything, boardId, scope).Return(true, nil)
+	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardId).
+		Return(DatabaseVoting{}, sql.ErrNoRows)
+	mockDb.EXPECT().Create(mock.Anything, DatabaseVotingInsert{Board: boardId, VoteLimit: 5, Status: Open, Mode: DefaultMode, ScopeColumns: scope.Columns, ScopeNotes: scope.Notes}).
+		Return(DatabaseVoting{ID: votingId, Board: boardId, VoteLimit: 5, Status: Open, Mode: DefaultMode, ScopeColumns: scope.Columns, ScopeNotes: scope.Notes}, nil)
+
+	mockBroker := realtime.NewMockClient(t)
+	mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	mockAudit := audit.NewMockAuditService(t)
+	mockAudit.EXPECT().Record(mock.Anything, boardId, audit.VotingCreated, nil, mock.AnythingOfType("*votings.Voting")).Return()
+
+	service := NewVotingService(mockDb, broker, mockAudit, notes.NewMockNotesService(t), timeprovider.NewClock())
+	voting, err := service.Create(context.Background(), VotingCreateRequest{Board: boardId, VoteLimit: 5, Scope: &scope})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &scope, voting.Scope)
+}
+
+func TestCreateVoting_ScopeNotOnBoard(t *testing.T) {
+	boardId := uuid.New()
+	scope := VotingScope{Notes: []uuid.UUID{uuid.New()}}
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().IsScopeOnBoard(mock.Anything, boardId, scope).Return(false, nil)
+
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+	voting, err := service.Create(context.Background(), VotingCreateRequest{Board: boardId, VoteLimit: 5, Scope: &scope})
+
+	assert.Nil(t, voting)
+
+	var votingErr VotingError
+	assert.ErrorAs(t, err, &votingErr)
+	assert.Equal(t, BadRequest, votingErr.Category)
+}
+
 func TestCreateVoting_Failed(t *testing.T) {
 	boardId := uuid.New()
 	votingLimit := 10
@@ -238,14 +590,14 @@ func TestCreateVoting_Failed(t *testing.T) {
 	mockDb := NewMockVotingDatabase(t)
 	mockDb.EXPECT().GetOpenVoting(mock.Anything, boardId).
 		Return(DatabaseVoting{}, sql.ErrNoRows)
-	mockDb.EXPECT().Create(mock.Anything, DatabaseVotingInsert{Board: boardId, VoteLimit: votingLimit, AllowMultipleVotes: allowMultiple, ShowVotesOfOthers: showVotes, Status: Open}).
+	mockDb.EXPECT().Create(mock.Anything, DatabaseVotingInsert{Board: boardId, VoteLimit: votingLimit, AllowMultipleVotes: allowMultiple, ShowVotesOfOthers: showVotes, Status: Open, Mode: DefaultMode}).
 		Return(DatabaseVoting{}, dbError)
 
 	mockBroker := realtime.NewMockClient(t)
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Create(context.Background(), VotingCreateRequest{
 		Board:              boardId,
 		VoteLimit:          votingLimit,
@@ -273,7 +625,10 @@ func TestCloseVoting(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	mockAudit := audit.NewMockAuditService(t)
+	mockAudit.EXPECT().Record(mock.Anything, boardId, audit.VotingClosed, nil, mock.AnythingOfType("*votings.Voting")).Return()
+
+	service := NewVotingService(mockDb, broker, mockAudit, notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Close(context.Background(), votingID, boardId, nil)
 
 	assert.NoError(t, err)
@@ -293,7 +648,7 @@ func TestCloseVoting_NotFound(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Close(context.Background(), votingID, boardId, nil)
 
 	assert.Nil(t, voting)
@@ -318,7 +673,7 @@ func TestCloseVoting_Failed(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Close(context.Background(), votingID, boardId, nil)
 
 	assert.Nil(t, voting)
@@ -342,7 +697,7 @@ func TestCloseVoting_FailedToGetVotes(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Close(context.Background(), votingID, boardId, nil)
 
 	assert.Nil(t, voting)
@@ -350,6 +705,92 @@ func TestCloseVoting_FailedToGetVotes(t *testing.T) {
 	assert.ErrorIs(t, err, dbError)
 }
 
+func TestCloseExpired(t *testing.T) {
+	now := time.Now()
+	boardId := uuid.New()
+	votingId := uuid.New()
+	alreadyClosedId := uuid.New()
+	noteId := uuid.New()
+
+	mockClock := timeprovider.NewMockTimeProvider(t)
+	mockClock.EXPECT().Now().Return(now)
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetExpiredVotings(mock.Anything, now).
+		Return([]DatabaseVoting{{ID: votingId, Board: boardId, Status: Open}, {ID: alreadyClosedId, Board: boardId, Status: Open}}, nil)
+	mockDb.EXPECT().Close(mock.Anything, DatabaseVotingUpdate{ID: votingId, Board: boardId, Status: Closed}).
+		Return(DatabaseVoting{ID: votingId, Board: boardId, Status: Closed}, nil)
+	mockDb.EXPECT().Close(mock.Anything, DatabaseVotingUpdate{ID: alreadyClosedId, Board: boardId, Status: Closed}).
+		Return(DatabaseVoting{}, sql.ErrNoRows)
+	mockDb.EXPECT().GetVotes(mock.Anything, boardId, VoteFilter{Voting: &votingId}).
+		Return([]DatabaseVote{{Board: boardId, Voting: votingId, Note: noteId, User: uuid.New(), Weight: 1}}, nil)
+
+	mockNotes := notes.NewMockNotesService(t)
+	mockNotes.EXPECT().GetAll(mock.Anything, boardId).
+		Return([]*notes.Note{{ID: noteId}}, nil)
+
+	mockBroker := realtime.NewMockClient(t)
+	mockBroker.EXPECT().Publish(mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	mockAudit := audit.NewMockAuditService(t)
+	mockAudit.EXPECT().Record(mock.Anything, boardId, audit.VotingClosed, nil, mock.AnythingOfType("*votings.Voting")).Return()
+
+	service := NewVotingService(mockDb, broker, mockAudit, mockNotes, mockClock)
+	closed, err := service.CloseExpired(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, closed)
+}
+
+func TestCloseExpired_FailedToGetVotings(t *testing.T) {
+	now := time.Now()
+	dbError := errors.New("failed to get expired votings")
+
+	mockClock := timeprovider.NewMockTimeProvider(t)
+	mockClock.EXPECT().Now().Return(now)
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetExpiredVotings(mock.Anything, now).Return(nil, dbError)
+
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), mockClock)
+	closed, err := service.CloseExpired(context.Background())
+
+	assert.Equal(t, 0, closed)
+	assert.ErrorIs(t, err, dbError)
+}
+
+func TestCloseExpired_FailedToGetNotes(t *testing.T) {
+	now := time.Now()
+	boardId := uuid.New()
+	notesError := errors.New("failed to get notes")
+
+	mockClock := timeprovider.NewMockTimeProvider(t)
+	mockClock.EXPECT().Now().Return(now)
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetExpiredVotings(mock.Anything, now).
+		Return([]DatabaseVoting{{ID: uuid.New(), Board: boardId, Status: Open}}, nil)
+
+	mockNotes := notes.NewMockNotesService(t)
+	mockNotes.EXPECT().GetAll(mock.Anything, boardId).Return(nil, notesError)
+
+	mockBroker := realtime.NewMockClient(t)
+	broker := new(realtime.Broker)
+	broker.Con = mockBroker
+
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), mockNotes, mockClock)
+	closed, err := service.CloseExpired(context.Background())
+
+	assert.Equal(t, 0, closed)
+	assert.ErrorIs(t, err, notesError)
+}
+
 func TestGetVoting_Open(t *testing.T) {
 	boardId := uuid.New()
 	votingId := uuid.New()
@@ -366,7 +807,7 @@ func TestGetVoting_Open(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Get(context.Background(), boardId, votingId)
 
 	assert.Nil(t, err)
@@ -391,7 +832,7 @@ func TestGetVoting_Open_NotFound(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Get(context.Background(), boardId, votingId)
 
 	assert.Nil(t, voting)
@@ -416,7 +857,7 @@ func TestGetVoting_Open_FailedToGetVoting(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Get(context.Background(), boardId, votingId)
 
 	assert.Nil(t, voting)
@@ -446,7 +887,7 @@ func TestGetVoting_Closed(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Get(context.Background(), boardId, votingId)
 
 	assert.Nil(t, err)
@@ -479,7 +920,7 @@ func TestGetVoting_Closed_FailedToGetVotes(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.Get(context.Background(), boardId, votingId)
 
 	assert.Nil(t, voting)
@@ -510,7 +951,7 @@ func TestGetAllVotings(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	votings, err := service.GetAll(context.Background(), boardId)
 
 	assert.Nil(t, err)
@@ -545,7 +986,7 @@ func TestGetAllVotings_FailedToGetVotings(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	votings, err := service.GetAll(context.Background(), boardId)
 
 	assert.Nil(t, votings)
@@ -564,7 +1005,7 @@ func TestGetAllVotings_FailedToGetVotes(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	votings, err := service.GetAll(context.Background(), boardId)
 
 	assert.Nil(t, votings)
@@ -572,6 +1013,54 @@ func TestGetAllVotings_FailedToGetVotes(t *testing.T) {
 	assert.ErrorIs(t, err, dbError)
 }
 
+func TestGetAnalytics(t *testing.T) {
+	boardId := uuid.New()
+	openVotingId := uuid.New()
+	closedVotingId := uuid.New()
+	noteId := uuid.New()
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetAll(mock.Anything, boardId).
+		Return([]DatabaseVoting{
+			{ID: openVotingId, Board: boardId, Status: Open, Mode: DefaultMode},
+			{ID: closedVotingId, Board: boardId, Status: Closed, Mode: DefaultMode},
+		}, nil)
+	mockDb.EXPECT().GetVotes(mock.Anything, boardId, VoteFilter{}).
+		Return([]DatabaseVote{
+			{Voting: openVotingId, Board: boardId, User: uuid.New(), Note: noteId, Weight: 1},
+			{Voting: closedVotingId, Board: boardId, User: uuid.New(), Note: noteId, Weight: 1},
+		}, nil)
+	mockDb.EXPECT().CountBoardSessions(mock.Anything, boardId).Return(4, nil)
+
+	service := NewVotingService(mockDb, new(realtime.Broker), audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+	analytics, err := service.GetAnalytics(context.Background(), boardId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, analytics.Participants)
+	assert.Len(t, analytics.Rounds, 1)
+	assert.Equal(t, closedVotingId, analytics.Rounds[0].Voting)
+	assert.Equal(t, 0.25, analytics.Rounds[0].ParticipationRate)
+	assert.Len(t, analytics.Notes, 1)
+	assert.Equal(t, noteId, analytics.Notes[0].Note)
+}
+
+func TestGetAnalytics_FailedToCountParticipants(t *testing.T) {
+	boardId := uuid.New()
+	dbError := errors.New("database error")
+
+	mockDb := NewMockVotingDatabase(t)
+	mockDb.EXPECT().GetAll(mock.Anything, boardId).Return([]DatabaseVoting{}, nil)
+	mockDb.EXPECT().GetVotes(mock.Anything, boardId, VoteFilter{}).Return([]DatabaseVote{}, nil)
+	mockDb.EXPECT().CountBoardSessions(mock.Anything, boardId).Return(0, dbError)
+
+	service := NewVotingService(mockDb, new(realtime.Broker), audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
+	analytics, err := service.GetAnalytics(context.Background(), boardId)
+
+	assert.Nil(t, analytics)
+	assert.NotNil(t, err)
+	assert.Equal(t, CreateVotingError(Internal, "unable to count participants", dbError), err)
+}
+
 func TestGetOpenVoting(t *testing.T) {
 	boardId := uuid.New()
 	votingId := uuid.New()
@@ -588,7 +1077,7 @@ func TestGetOpenVoting(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.GetOpen(context.Background(), boardId)
 
 	assert.Nil(t, err)
@@ -613,7 +1102,7 @@ func TestGetOpenVoting_FailedToGetVoting(t *testing.T) {
 	broker := new(realtime.Broker)
 	broker.Con = mockBroker
 
-	service := NewVotingService(mockDb, broker)
+	service := NewVotingService(mockDb, broker, audit.NewMockAuditService(t), notes.NewMockNotesService(t), timeprovider.NewClock())
 	voting, err := service.GetOpen(context.Background(), boardId)
 
 	assert.Nil(t, voting)
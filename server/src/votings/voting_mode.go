@@ -0,0 +1,57 @@
+package votings
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// VotingMode is the way votes are cast and tallied in a voting session.
+type VotingMode string
+
+const (
+	// DefaultMode gives every participant a number of votes up to the vote limit,
+	// optionally allowing more than one vote on the same note.
+	DefaultMode VotingMode = "DEFAULT"
+
+	// PointsMode gives every participant a budget of points, set by the vote limit,
+	// to distribute on the notes with weighted votes.
+	PointsMode VotingMode = "POINTS"
+
+	// RankedMode lets every participant rank up to vote limit notes on a ballot.
+	//
+	// The ballots are tallied with a Borda count, so the first choice scores vote limit points,
+	// the second one point less and so on.
+	RankedMode VotingMode = "RANKED"
+
+	// SingleChoiceMode lets every participant pick exactly one note.
+	SingleChoiceMode VotingMode = "SINGLE_CHOICE"
+)
+
+func (votingMode *VotingMode) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	unmarshalledVotingMode := VotingMode(s)
+	switch unmarshalledVotingMode {
+	case DefaultMode, PointsMode, RankedMode, SingleChoiceMode:
+		*votingMode = unmarshalledVotingMode
+		return nil
+	}
+	return errors.New("invalid voting mode")
+}
+
+// score is the number of points a vote adds to the result of its note in a voting with this mode.
+func (votingMode VotingMode) score(vote DatabaseVote, voteLimit int) int {
+	switch votingMode {
+	case PointsMode:
+		return vote.Weight
+	case RankedMode:
+		if vote.Rank == nil {
+			return 1
+		}
+		return voteLimit - *vote.Rank + 1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,47 @@
+package votings
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVotingModeEnum(t *testing.T) {
+	values := []VotingMode{DefaultMode, PointsMode, RankedMode, SingleChoiceMode}
+	for _, value := range values {
+		var votingMode VotingMode
+		err := votingMode.UnmarshalJSON(fmt.Appendf(nil, "\"%s\"", value))
+		assert.Nil(t, err)
+		assert.Equal(t, value, votingMode)
+	}
+}
+
+func TestUnmarshalVotingModeNil(t *testing.T) {
+	var votingMode VotingMode
+	err := votingMode.UnmarshalJSON(nil)
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalVotingModeEmptyStringWithQuotation(t *testing.T) {
+	var votingMode VotingMode
+	err := votingMode.UnmarshalJSON([]byte("\"\""))
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalVotingModeRandomValue(t *testing.T) {
+	var votingMode VotingMode
+	err := votingMode.UnmarshalJSON([]byte("\"SOME_RANDOM_VALUE\""))
+	assert.NotNil(t, err)
+}
+
+func TestVotingModeScore(t *testing.T) {
+	first := 1
+	third := 3
+
+	assert.Equal(t, 1, DefaultMode.score(DatabaseVote{Weight: 1}, 5))
+	assert.Equal(t, 1, SingleChoiceMode.score(DatabaseVote{Weight: 1}, 1))
+	assert.Equal(t, 4, PointsMode.score(DatabaseVote{Weight: 4}, 10))
+	assert.Equal(t, 3, RankedMode.score(DatabaseVote{Weight: 1, Rank: &first}, 3))
+	assert.Equal(t, 1, RankedMode.score(DatabaseVote{Weight: 1, Rank: &third}, 3))
+}
@@ -0,0 +1,43 @@
+package votings
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// VotingScope restricts a voting to the notes of some columns and to single notes.
+// An empty scope covers the whole board.
+type VotingScope struct {
+	// The columns whose notes can be voted on.
+	Columns []uuid.UUID `json:"columns,omitempty"`
+
+	// The notes that can be voted on, including the notes stacked on them.
+	Notes []uuid.UUID `json:"notes,omitempty"`
+}
+
+func (scope *VotingScope) IsEmpty() bool {
+	return scope == nil || (len(scope.Columns) == 0 && len(scope.Notes) == 0)
+}
+
+// Contains reports whether a note can be voted on in a voting with this scope.
+func (scope *VotingScope) Contains(note Note) bool {
+	if scope.IsEmpty() {
+		return true
+	}
+
+	if slices.Contains(scope.Columns, note.Position.Column) || slices.Contains(scope.Notes, note.ID) {
+		return true
+	}
+
+	return note.Position.Stack.Valid && slices.Contains(scope.Notes, note.Position.Stack.UUID)
+}
+
+func scopeFrom(voting DatabaseVoting) *VotingScope {
+	scope := &VotingScope{Columns: voting.ScopeColumns, Notes: voting.ScopeNotes}
+	if scope.IsEmpty() {
+		return nil
+	}
+
+	return scope
+}
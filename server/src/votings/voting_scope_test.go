@@ -0,0 +1,35 @@
+package votings
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVotingScopeIsEmpty(t *testing.T) {
+	var nilScope *VotingScope
+
+	assert.True(t, nilScope.IsEmpty())
+	assert.True(t, (&VotingScope{}).IsEmpty())
+	assert.False(t, (&VotingScope{Columns: []uuid.UUID{uuid.New()}}).IsEmpty())
+	assert.False(t, (&VotingScope{Notes: []uuid.UUID{uuid.New()}}).IsEmpty())
+}
+
+func TestEmptyVotingScopeContainsEveryNote(t *testing.T) {
+	var nilScope *VotingScope
+
+	assert.True(t, nilScope.Contains(Note{ID: uuid.New()}))
+	assert.True(t, (&VotingScope{}).Contains(Note{ID: uuid.New()}))
+}
+
+func TestVotingScopeContains(t *testing.T) {
+	columnId := uuid.New()
+	noteId := uuid.New()
+	scope := &VotingScope{Columns: []uuid.UUID{columnId}, Notes: []uuid.UUID{noteId}}
+
+	assert.True(t, scope.Contains(Note{ID: uuid.New(), Position: NotePosition{Column: columnId}}))
+	assert.True(t, scope.Contains(Note{ID: noteId, Position: NotePosition{Column: uuid.New()}}))
+	assert.True(t, scope.Contains(Note{ID: uuid.New(), Position: NotePosition{Column: uuid.New(), Stack: uuid.NullUUID{UUID: noteId, Valid: true}}}))
+	assert.False(t, scope.Contains(Note{ID: uuid.New(), Position: NotePosition{Column: uuid.New()}}))
+}